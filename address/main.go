@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
 )
 
@@ -61,3 +62,25 @@ func Split(address string) (name, domain string, err error) {
 
 	return
 }
+
+// IsMuxed returns true if the provided address is a muxed account address
+// ("M..."), which combines an account ID with a 64-bit ID.
+func IsMuxed(address string) bool {
+	return strkey.IsValidMuxedAccount(address)
+}
+
+// SplitMuxed takes a muxed account address ("M...") and provides the account ID
+// ("G...") and the ID it's made of.
+func SplitMuxed(address string) (accountID string, id uint64, err error) {
+	accountID, id, err = strkey.DecodeMuxedAccount(address)
+	if err != nil {
+		err = errors.Wrap(err, "invalid muxed account address")
+	}
+	return
+}
+
+// JoinMuxed returns the muxed account address ("M...") made of the provided
+// account ID ("G...") and ID.
+func JoinMuxed(accountID string, id uint64) (string, error) {
+	return strkey.EncodeMuxedAccount(accountID, id)
+}
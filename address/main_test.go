@@ -50,3 +50,24 @@ func TestSplit(t *testing.T) {
 		}
 	}
 }
+
+func TestMuxed(t *testing.T) {
+	accountID := "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+
+	muxed, err := JoinMuxed(accountID, 9876)
+	assert.NoError(t, err)
+	assert.True(t, IsMuxed(muxed))
+	assert.False(t, IsMuxed(accountID))
+	assert.False(t, IsMuxed("scott*stellar.org"))
+
+	splitID, id, err := SplitMuxed(muxed)
+	assert.NoError(t, err)
+	assert.Equal(t, accountID, splitID)
+	assert.Equal(t, uint64(9876), id)
+
+	_, _, err = SplitMuxed(accountID)
+	assert.EqualError(t, err, "invalid muxed account address: invalid version byte")
+
+	_, err = JoinMuxed("scott*stellar.org", 1)
+	assert.Error(t, err)
+}
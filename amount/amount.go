@@ -0,0 +1,210 @@
+package amount
+
+import (
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// Amount is an exact stellar amount, counted in stroops: one whole unit is
+// `One` stroops. Unlike float64 values, amounts add up and are multiplied
+// exactly, the rounding of multiplications and divisions is explicit and
+// overflows are reported.
+type Amount int64
+
+// RoundingMode tells how the result of an operation that isn't a whole
+// number of stroops is rounded.
+type RoundingMode int
+
+const (
+	// RoundDown rounds toward negative infinity.
+	RoundDown RoundingMode = iota
+	// RoundUp rounds toward positive infinity.
+	RoundUp
+	// RoundHalfUp rounds to the nearest value, and ties away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest value, and ties to the even value.
+	RoundHalfEven
+)
+
+// Precision is the number of fractional digits of stellar amounts.
+const Precision = 7
+
+var (
+	// ErrOverflow is returned when the result of an operation doesn't fit in
+	// an Amount.
+	ErrOverflow = errors.New("amount overflow")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("division by 0")
+)
+
+// ParseAmount parses an amount string, see `Parse`.
+func ParseAmount(v string) (Amount, error) {
+	i, err := ParseInt64(v)
+	return Amount(i), err
+}
+
+// ParseWithPrecision parses an amount string with at most precision
+// fractional digits, e.g. 2 for an asset representing US dollars.
+func ParseWithPrecision(v string, precision int) (Amount, error) {
+	unit, err := precisionUnit(precision)
+	if err != nil {
+		return 0, err
+	}
+
+	a, err := ParseAmount(v)
+	if err != nil {
+		return 0, err
+	}
+	if int64(a)%unit != 0 {
+		return 0, errors.Errorf("more than %d significant digits: %s", precision, v)
+	}
+	return a, nil
+}
+
+// FromXDR returns the amount of the raw xdr value v.
+func FromXDR(v xdr.Int64) Amount {
+	return Amount(v)
+}
+
+// XDR returns the raw xdr value of a.
+func (a Amount) XDR() xdr.Int64 {
+	return xdr.Int64(a)
+}
+
+// String returns the amount string of a, with 7 fractional digits.
+func (a Amount) String() string {
+	return StringFromInt64(int64(a))
+}
+
+// Float64 returns the closest float64 to the value of a in whole units. It's
+// meant for displaying and for statistics, not for further computations.
+func (a Amount) Float64() float64 {
+	f, _ := big.NewRat(int64(a), One).Float64()
+	return f
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) (Amount, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, ErrOverflow
+	}
+	return sum, nil
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	diff := a - b
+	if (b > 0 && diff > a) || (b < 0 && diff < a) {
+		return 0, ErrOverflow
+	}
+	return diff, nil
+}
+
+// Mul returns a * b, multiplying their values in whole units, rounded to a
+// whole number of stroops with mode. For example 2.5 * 0.5 is 1.25.
+func (a Amount) Mul(b Amount, mode RoundingMode) (Amount, error) {
+	return mulDiv(int64(a), int64(b), One, mode)
+}
+
+// Div returns a / b, dividing their values in whole units, rounded to a
+// whole number of stroops with mode. For example 1 / 3 is 0.3333333 rounded
+// down.
+func (a Amount) Div(b Amount, mode RoundingMode) (Amount, error) {
+	return mulDiv(int64(a), One, int64(b), mode)
+}
+
+// MulFraction returns a * n / d rounded with mode, e.g. the amount of the
+// buying asset worth a at the price n/d.
+func (a Amount) MulFraction(n, d int64, mode RoundingMode) (Amount, error) {
+	return mulDiv(int64(a), n, d, mode)
+}
+
+// Round returns a rounded to precision fractional digits with mode.
+func (a Amount) Round(precision int, mode RoundingMode) (Amount, error) {
+	unit, err := precisionUnit(precision)
+	if err != nil {
+		return 0, err
+	}
+
+	units, err := mulDiv(int64(a), 1, unit, mode)
+	if err != nil {
+		return 0, err
+	}
+	return mulDiv(int64(units), unit, 1, mode)
+}
+
+// Format returns the amount string of a rounded to precision fractional
+// digits with mode, e.g. "10.50" with a precision of 2.
+func (a Amount) Format(precision int, mode RoundingMode) (string, error) {
+	rounded, err := a.Round(precision, mode)
+	if err != nil {
+		return "", err
+	}
+
+	s := rounded.String()
+	s = s[:len(s)-(Precision-precision)]
+	return strings.TrimSuffix(s, "."), nil
+}
+
+// precisionUnit returns the number of stroops of the smallest amount with
+// precision fractional digits.
+func precisionUnit(precision int) (int64, error) {
+	if precision < 0 || precision > Precision {
+		return 0, errors.Errorf("invalid precision %d", precision)
+	}
+	return int64(math.Pow10(Precision - precision)), nil
+}
+
+// mulDiv returns x * y / z, rounded with mode.
+func mulDiv(x, y, z int64, mode RoundingMode) (Amount, error) {
+	if z == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	d := big.NewInt(z)
+	q, r := new(big.Int).QuoRem(
+		new(big.Int).Mul(big.NewInt(x), big.NewInt(y)),
+		d,
+		new(big.Int),
+	)
+
+	if r.Sign() != 0 {
+		// q is truncated toward zero, away is the next value away from zero
+		away := int64(1)
+		if r.Sign() != d.Sign() {
+			away = -1
+		}
+
+		var roundAway bool
+		switch mode {
+		case RoundDown:
+			roundAway = away < 0
+		case RoundUp:
+			roundAway = away > 0
+		case RoundHalfUp, RoundHalfEven:
+			half := new(big.Int).Abs(r)
+			switch half.Lsh(half, 1).Cmp(new(big.Int).Abs(d)) {
+			case 1:
+				roundAway = true
+			case 0:
+				roundAway = mode == RoundHalfUp || q.Bit(0) == 1
+			}
+		default:
+			return 0, errors.Errorf("invalid rounding mode %d", mode)
+		}
+
+		if roundAway {
+			q.Add(q, big.NewInt(away))
+		}
+	}
+
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return Amount(q.Int64()), nil
+}
@@ -0,0 +1,113 @@
+package amount_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stellar/go/amount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmountArithmetic(t *testing.T) {
+	a := amount.Amount(25000000) // 2.5
+	b := amount.Amount(5000000)  // 0.5
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, "3.0000000", sum.String())
+
+	diff, err := b.Sub(a)
+	require.NoError(t, err)
+	assert.Equal(t, "-2.0000000", diff.String())
+
+	product, err := a.Mul(b, amount.RoundDown)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2500000", product.String())
+
+	quotient, err := a.Div(b, amount.RoundDown)
+	require.NoError(t, err)
+	assert.Equal(t, amount.Amount(5*amount.One), quotient)
+
+	_, err = amount.Amount(math.MaxInt64).Add(1)
+	assert.Equal(t, amount.ErrOverflow, err)
+	_, err = amount.Amount(math.MinInt64).Sub(1)
+	assert.Equal(t, amount.ErrOverflow, err)
+	_, err = amount.Amount(math.MaxInt64).Mul(2*amount.One, amount.RoundDown)
+	assert.Equal(t, amount.ErrOverflow, err)
+	_, err = a.Div(0, amount.RoundDown)
+	assert.Equal(t, amount.ErrDivisionByZero, err)
+
+	assert.Equal(t, 2.5, a.Float64())
+}
+
+func TestAmountRounding(t *testing.T) {
+	for _, testCase := range []struct {
+		x, n, d  int64
+		mode     amount.RoundingMode
+		expected amount.Amount
+	}{
+		{10, 1, 3, amount.RoundDown, 3},
+		{10, 1, 3, amount.RoundUp, 4},
+		{-10, 1, 3, amount.RoundDown, -4},
+		{-10, 1, 3, amount.RoundUp, -3},
+		{10, 1, 4, amount.RoundHalfUp, 3},
+		{-10, 1, 4, amount.RoundHalfUp, -3},
+		{10, 1, 4, amount.RoundHalfEven, 2},
+		{14, 1, 4, amount.RoundHalfEven, 4},
+		{-10, 1, 4, amount.RoundHalfEven, -2},
+		{11, 1, 4, amount.RoundHalfEven, 3},
+		{9, 1, 4, amount.RoundHalfUp, 2},
+		{9, 1, -4, amount.RoundDown, -3},
+		{12, 1, 4, amount.RoundUp, 3},
+		{math.MaxInt64, 3, 3, amount.RoundUp, math.MaxInt64},
+	} {
+		result, err := amount.Amount(testCase.x).MulFraction(testCase.n, testCase.d, testCase.mode)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, result, "%d * %d / %d (%d)", testCase.x, testCase.n, testCase.d, testCase.mode)
+	}
+
+	_, err := amount.Amount(1).MulFraction(1, 2, amount.RoundingMode(42))
+	assert.EqualError(t, err, "invalid rounding mode 42")
+}
+
+func TestAmountPrecision(t *testing.T) {
+	a, err := amount.ParseWithPrecision("10.25", 2)
+	require.NoError(t, err)
+	assert.Equal(t, amount.Amount(102500000), a)
+
+	_, err = amount.ParseWithPrecision("10.255", 2)
+	assert.EqualError(t, err, "more than 2 significant digits: 10.255")
+
+	_, err = amount.ParseWithPrecision("10", 8)
+	assert.EqualError(t, err, "invalid precision 8")
+
+	a = amount.FromXDR(amount.MustParse("10.255"))
+	for _, testCase := range []struct {
+		precision int
+		mode      amount.RoundingMode
+		expected  string
+	}{
+		{2, amount.RoundDown, "10.25"},
+		{2, amount.RoundUp, "10.26"},
+		{2, amount.RoundHalfUp, "10.26"},
+		{2, amount.RoundHalfEven, "10.26"},
+		{1, amount.RoundHalfEven, "10.3"},
+		{0, amount.RoundDown, "10"},
+		{7, amount.RoundDown, "10.2550000"},
+	} {
+		s, err := a.Format(testCase.precision, testCase.mode)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, s)
+	}
+
+	rounded, err := amount.FromXDR(amount.MustParse("-10.255")).Round(2, amount.RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, "-10.2600000", rounded.String())
+
+	parsed, err := amount.ParseAmount("-0.0000001")
+	require.NoError(t, err)
+	assert.Equal(t, amount.Amount(-1), parsed)
+	assert.Equal(t, amount.FromXDR(-1), parsed)
+	assert.Equal(t, parsed.XDR(), amount.MustParse("-0.0000001"))
+}
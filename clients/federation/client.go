@@ -1,15 +1,23 @@
 package federation
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/stellar/go/address"
+	"github.com/stellar/go/clients/stellartoml"
 	proto "github.com/stellar/go/protocols/federation"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/http/cachecontrol"
+	"github.com/stellar/go/support/log"
 )
 
 // LookupByAddress performs a federated lookup following to the stellar
@@ -18,23 +26,136 @@ import (
 // "name" type is a legacy holdover from the legacy stellar network's federation
 // protocol. It is unfortunate.
 func (c *Client) LookupByAddress(addy string) (*proto.NameResponse, error) {
+	return c.LookupByAddressContext(context.Background(), addy)
+}
+
+// LookupByAddressContext works like LookupByAddress, but the requests are sent
+// in ctx, with the traceparent header of ctx when c.HTTP is able to send any
+// request like *http.Client.
+func (c *Client) LookupByAddressContext(ctx context.Context, addy string) (*proto.NameResponse, error) {
 	_, domain, err := address.Split(addy)
 	if err != nil {
 		return nil, errors.Wrap(err, "parse address failed")
 	}
 
-	fserv, err := c.getFederationServer(domain)
+	fserv, err := c.getFederationServer(ctx, domain)
 	if err != nil {
 		return nil, errors.Wrap(err, "lookup federation server failed")
 	}
 
+	return c.lookupByAddress(ctx, fserv, addy)
+}
+
+// LookupMany resolves many addresses using "name" type requests. The
+// stellar.toml file of every domain is fetched once, the same address is only
+// resolved once and at most `LookupConcurrency` requests are sent at the same
+// time. The results are returned in the order of addresses, a failure to
+// resolve an address is reported in the `Err` field of its result.
+func (c *Client) LookupMany(addresses []string) []LookupResult {
+	return c.LookupManyContext(context.Background(), addresses)
+}
+
+// LookupManyContext works like LookupMany, but the requests are sent in ctx,
+// see LookupByAddressContext. Lookups that haven't started when ctx is done
+// fail with the error of ctx.
+func (c *Client) LookupManyContext(ctx context.Context, addresses []string) []LookupResult {
+	results := make([]LookupResult, len(addresses))
+
+	// indexes of the addresses by domain and then by address
+	var domains []string
+	byDomain := map[string]map[string][]int{}
+	for i, addy := range addresses {
+		results[i].Address = addy
+
+		_, domain, err := address.Split(addy)
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "parse address failed")
+			continue
+		}
+
+		domain = strings.ToLower(domain)
+		if _, ok := byDomain[domain]; !ok {
+			domains = append(domains, domain)
+			byDomain[domain] = map[string][]int{}
+		}
+		byDomain[domain][addy] = append(byDomain[domain][addy], i)
+	}
+
+	sem := make(chan struct{}, c.lookupConcurrency())
+	var wg sync.WaitGroup
+
+	for _, domain := range domains {
+		wg.Add(1)
+		go func(domain string, byAddress map[string][]int) {
+			defer wg.Done()
+
+			var fserv string
+			err := acquire(ctx, sem)
+			if err == nil {
+				fserv, err = c.getFederationServer(ctx, domain)
+				<-sem
+			}
+
+			if err != nil {
+				err = errors.Wrap(err, "lookup federation server failed")
+				for _, indexes := range byAddress {
+					for _, i := range indexes {
+						results[i].Err = err
+					}
+				}
+				return
+			}
+
+			for addy, indexes := range byAddress {
+				wg.Add(1)
+				go func(addy string, indexes []int) {
+					defer wg.Done()
+
+					var resp *proto.NameResponse
+					err := acquire(ctx, sem)
+					if err == nil {
+						resp, err = c.lookupByAddress(ctx, fserv, addy)
+						<-sem
+					}
+
+					for _, i := range indexes {
+						results[i].Err = err
+						if resp != nil {
+							result := *resp
+							results[i].Response = &result
+						}
+					}
+				}(addy, indexes)
+			}
+		}(domain, byDomain[domain])
+	}
+
+	wg.Wait()
+	return results
+}
+
+// acquire waits for a slot in sem, the semaphore of LookupManyContext, unless
+// ctx is done first.
+func acquire(ctx context.Context, sem chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *Client) lookupByAddress(ctx context.Context, fserv, addy string) (*proto.NameResponse, error) {
 	qstr := url.Values{}
 	qstr.Add("type", "name")
 	qstr.Add("q", addy)
 	url := c.url(fserv, qstr)
 
 	var resp proto.NameResponse
-	err = c.getJSON(url, &resp)
+	err := c.getCachedJSON(ctx, url, &resp)
 	if err != nil {
 		return nil, errors.Wrap(err, "get federation failed")
 	}
@@ -50,7 +171,12 @@ func (c *Client) LookupByAddress(addy string) (*proto.NameResponse, error) {
 // federation protocol using the "id" type request.  The provided strkey-encoded
 // account id is used to resolve what server the request should be made against.
 func (c *Client) LookupByAccountID(aid string) (*proto.IDResponse, error) {
+	return c.LookupByAccountIDContext(context.Background(), aid)
+}
 
+// LookupByAccountIDContext works like LookupByAccountID, but the federation
+// requests are sent in ctx, see LookupByAddressContext.
+func (c *Client) LookupByAccountIDContext(ctx context.Context, aid string) (*proto.IDResponse, error) {
 	domain, err := c.Horizon.HomeDomainForAccount(aid)
 	if err != nil {
 		return nil, errors.Wrap(err, "get homedomain failed")
@@ -60,7 +186,7 @@ func (c *Client) LookupByAccountID(aid string) (*proto.IDResponse, error) {
 		return nil, errors.New("homedomain not set")
 	}
 
-	fserv, err := c.getFederationServer(domain)
+	fserv, err := c.getFederationServer(ctx, domain)
 	if err != nil {
 		return nil, errors.Wrap(err, "lookup federation server failed")
 	}
@@ -71,7 +197,7 @@ func (c *Client) LookupByAccountID(aid string) (*proto.IDResponse, error) {
 	url := c.url(fserv, qstr)
 
 	var resp proto.IDResponse
-	err = c.getJSON(url, &resp)
+	err = c.getCachedJSON(ctx, url, &resp)
 	if err != nil {
 		return nil, errors.Wrap(err, "get federation failed")
 	}
@@ -82,7 +208,13 @@ func (c *Client) LookupByAccountID(aid string) (*proto.IDResponse, error) {
 // ForwardRequest performs a federated lookup following to the stellar
 // federation protocol using the "forward" type request.
 func (c *Client) ForwardRequest(domain string, fields url.Values) (*proto.NameResponse, error) {
-	fserv, err := c.getFederationServer(domain)
+	return c.ForwardRequestContext(context.Background(), domain, fields)
+}
+
+// ForwardRequestContext works like ForwardRequest, but the requests are sent
+// in ctx, see LookupByAddressContext.
+func (c *Client) ForwardRequestContext(ctx context.Context, domain string, fields url.Values) (*proto.NameResponse, error) {
+	fserv, err := c.getFederationServer(ctx, domain)
 	if err != nil {
 		return nil, errors.Wrap(err, "lookup federation server failed")
 	}
@@ -91,7 +223,7 @@ func (c *Client) ForwardRequest(domain string, fields url.Values) (*proto.NameRe
 	url := c.url(fserv, fields)
 
 	var resp proto.NameResponse
-	err = c.getJSON(url, &resp)
+	err = c.getJSON(ctx, url, &resp)
 	if err != nil {
 		return nil, errors.Wrap(err, "get federation failed")
 	}
@@ -103,8 +235,16 @@ func (c *Client) ForwardRequest(domain string, fields url.Values) (*proto.NameRe
 	return &resp, nil
 }
 
-func (c *Client) getFederationServer(domain string) (string, error) {
-	stoml, err := c.StellarTOML.GetStellarToml(domain)
+func (c *Client) getFederationServer(ctx context.Context, domain string) (string, error) {
+	var stoml *stellartoml.Response
+	var err error
+	if withContext, ok := c.StellarTOML.(interface {
+		GetStellarTomlContext(ctx context.Context, domain string) (*stellartoml.Response, error)
+	}); ok {
+		stoml, err = withContext.GetStellarTomlContext(ctx, domain)
+	} else {
+		stoml, err = c.StellarTOML.GetStellarToml(domain)
+	}
 	if err != nil {
 		return "", errors.Wrap(err, "get stellar.toml failed")
 	}
@@ -122,25 +262,91 @@ func (c *Client) getFederationServer(domain string) (string, error) {
 
 // getJSON populates `dest` with the contents at `url`, provided the request
 // succeeds and the json can be successfully decoded.
-func (c *Client) getJSON(url string, dest interface{}) error {
-	hresp, err := c.HTTP.Get(url)
+func (c *Client) getJSON(ctx context.Context, url string, dest interface{}) error {
+	body, _, err := c.get(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(body, dest)
+}
+
+// getCachedJSON works like getJSON but responses are cached when
+// `c.CacheTTL` is greater than zero.
+func (c *Client) getCachedJSON(ctx context.Context, url string, dest interface{}) error {
+	if c.CacheTTL <= 0 {
+		return c.getJSON(ctx, url, dest)
+	}
+
+	body, ok := c.cached(url)
+	if !ok {
+		var (
+			header http.Header
+			err    error
+		)
+		body, header, err = c.get(ctx, url)
+		if err != nil {
+			return err
+		}
+
+		// Only responses that can be decoded are cached.
+		err = decodeJSON(body, dest)
+		if err != nil {
+			return err
+		}
+
+		c.store(url, body, cachecontrol.TTL(header, c.clock.Now(), c.CacheTTL))
+		return nil
+	}
+
+	return decodeJSON(body, dest)
+}
+
+// get returns the body and headers of the response at `url`, provided the
+// request succeeds.
+func (c *Client) get(ctx context.Context, url string) ([]byte, http.Header, error) {
+	hresp, err := c.send(ctx, url)
 	if err != nil {
-		return errors.Wrap(err, "http get errored")
+		return nil, nil, errors.Wrap(err, "http get errored")
 	}
 
 	defer hresp.Body.Close()
 
 	if !(hresp.StatusCode >= 200 && hresp.StatusCode < 300) {
-		return errors.Errorf("http get failed with (%d) status code", hresp.StatusCode)
+		return nil, nil, errors.Errorf("http get failed with (%d) status code", hresp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(hresp.Body, FederationResponseMaxSize+1))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "http read errored")
+	}
+
+	if len(body) > FederationResponseMaxSize {
+		return nil, nil, errors.Errorf("federation response exceeds %d bytes limit", FederationResponseMaxSize)
 	}
 
-	limitReader := io.LimitReader(hresp.Body, FederationResponseMaxSize)
+	return body, hresp.Header, nil
+}
 
-	err = json.NewDecoder(limitReader).Decode(dest)
-	if err == io.ErrUnexpectedEOF && limitReader.(*io.LimitedReader).N == 0 {
-		return errors.Errorf("federation response exceeds %d bytes limit", FederationResponseMaxSize)
+// send sends a GET request to url in ctx, see LookupByAddressContext.
+func (c *Client) send(ctx context.Context, url string) (*http.Response, error) {
+	doer, ok := c.HTTP.(interface {
+		Do(req *http.Request) (*http.Response, error)
+	})
+	if !ok {
+		return c.HTTP.Get(url)
 	}
 
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	log.SetTraceparent(ctx, req.Header)
+	return doer.Do(req.WithContext(ctx))
+}
+
+func decodeJSON(body []byte, dest interface{}) error {
+	err := json.Unmarshal(body, dest)
 	if err != nil {
 		return errors.Wrap(err, "json decode errored")
 	}
@@ -148,6 +354,55 @@ func (c *Client) getJSON(url string, dest interface{}) error {
 	return nil
 }
 
+func (c *Client) cached(url string) ([]byte, bool) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	entry, ok := c.cache[url]
+	if !ok {
+		return nil, false
+	}
+	if !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.cache, url)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *Client) store(url string, body []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.cache == nil {
+		c.cache = map[string]cachedResponse{}
+	}
+
+	now := c.clock.Now()
+	if len(c.cache) >= cacheMaxEntries {
+		for key, entry := range c.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(c.cache, key)
+			}
+		}
+		if len(c.cache) >= cacheMaxEntries {
+			c.cache = map[string]cachedResponse{}
+		}
+	}
+
+	c.cache[url] = cachedResponse{body: body, expiresAt: now.Add(ttl)}
+}
+
+func (c *Client) lookupConcurrency() int {
+	if c.LookupConcurrency <= 0 {
+		return DefaultLookupConcurrency
+	}
+	return c.LookupConcurrency
+}
+
 func (c *Client) url(endpoint string, qstr url.Values) string {
 	return fmt.Sprintf("%s?%s", endpoint, qstr.Encode())
 }
@@ -1,15 +1,22 @@
 package federation
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	stdhttptest "net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/clock/clocktest"
 	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/support/log"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -163,6 +170,35 @@ func TestForwardRequest(t *testing.T) {
 	}
 }
 
+func TestForwardRequestContext(t *testing.T) {
+	trace := log.NewTrace()
+	var server *stdhttptest.Server
+	server = stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, trace.Traceparent(), r.Header.Get(log.TraceparentHeader))
+		switch r.URL.Path {
+		case stellartoml.WellKnownPath:
+			fmt.Fprintf(w, "FEDERATION_SERVER=%q", server.URL+"/federation")
+		case "/federation":
+			w.Write([]byte(`{"account_id": "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := &Client{
+		StellarTOML: &stellartoml.Client{HTTP: http.DefaultClient, UseHTTP: true},
+		HTTP:        http.DefaultClient,
+		AllowHTTP:   true,
+	}
+	domain := strings.TrimPrefix(server.URL, "http://")
+	ctx := log.WithTrace(context.Background(), trace)
+	resp, err := c.ForwardRequestContext(ctx, domain, url.Values{"acct": {"2382376"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C", resp.AccountID)
+	}
+}
+
 func Test_url(t *testing.T) {
 	c := &Client{}
 
@@ -182,3 +218,124 @@ func Test_url(t *testing.T) {
 	furl = c.url("", qstr)
 	assert.Equal(t, "?q=scott%2Breceiver1%40stellar.org%2Astellar.org&type=q", furl)
 }
+
+func TestLookupByAddressCache(t *testing.T) {
+	hmock := httptest.NewClient()
+	tomlmock := &stellartoml.MockClient{}
+	now := time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC)
+	c := &Client{StellarTOML: tomlmock, HTTP: hmock, CacheTTL: time.Hour}
+	c.clock = &clock.Clock{Source: clocktest.FixedSource(now)}
+
+	tomlmock.On("GetStellarToml", "stellar.org").Return(&stellartoml.Response{
+		FederationServer: "https://stellar.org/federation",
+	}, nil)
+	hmock.On("GET", "https://stellar.org/federation").
+		ReturnJSONWithHeader(http.StatusOK, map[string]string{
+			"account_id": "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C",
+		}, http.Header{"Cache-Control": []string{"max-age=60"}})
+
+	resp, err := c.LookupByAddress("scott*stellar.org")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C", resp.AccountID)
+	}
+
+	hmock.On("GET", "https://stellar.org/federation").
+		ReturnJSON(http.StatusOK, map[string]string{
+			"account_id": "GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3",
+		})
+
+	// cached for max-age
+	resp, err = c.LookupByAddress("scott*stellar.org")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C", resp.AccountID)
+	}
+
+	c.clock = &clock.Clock{Source: clocktest.FixedSource(now.Add(time.Minute))}
+	resp, err = c.LookupByAddress("scott*stellar.org")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3", resp.AccountID)
+	}
+
+	// errors are not cached
+	hmock.On("GET", "https://stellar.org/federation").
+		ReturnString(http.StatusNotFound, "not found")
+	c.clock = &clock.Clock{Source: clocktest.FixedSource(now.Add(3 * time.Hour))}
+	_, err = c.LookupByAddress("scott*stellar.org")
+	assert.Error(t, err)
+	assert.Len(t, c.cache, 0)
+}
+
+func TestLookupMany(t *testing.T) {
+	hmock := httptest.NewClient()
+	tomlmock := &stellartoml.MockClient{}
+	c := &Client{StellarTOML: tomlmock, HTTP: hmock, LookupConcurrency: 2}
+
+	tomlmock.On("GetStellarToml", "stellar.org").Return(&stellartoml.Response{
+		FederationServer: "https://stellar.org/federation",
+	}, nil).Once()
+	tomlmock.On("GetStellarToml", "missing.org").Return(
+		(*stellartoml.Response)(nil),
+		errors.New("http request failed with non-200 status code"),
+	).Once()
+
+	hmock.On("GET", "https://stellar.org/federation?q=scott%2Astellar.org&type=name").
+		ReturnJSON(http.StatusOK, map[string]string{
+			"account_id": "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C",
+		})
+	hmock.On("GET", "https://stellar.org/federation?q=bartek%2Astellar.org&type=name").
+		ReturnJSON(http.StatusOK, map[string]string{
+			"account_id": "GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3",
+			"memo_type":  "id",
+			"memo":       "1",
+		})
+	hmock.On("GET", "https://stellar.org/federation?q=jed%2Astellar.org&type=name").
+		ReturnString(http.StatusNotFound, "not found")
+
+	results := c.LookupMany([]string{
+		"scott*stellar.org",
+		"bartek*stellar.org",
+		"invalid",
+		"jed*stellar.org",
+		"scott*missing.org",
+		"scott*stellar.org",
+	})
+
+	if assert.Len(t, results, 6) {
+		assert.Equal(t, "scott*stellar.org", results[0].Address)
+		if assert.NoError(t, results[0].Err) {
+			assert.Equal(t, "GASTNVNLHVR3NFO3QACMHCJT3JUSIV4NBXDHDO4VTPDTNN65W3B2766C", results[0].Response.AccountID)
+		}
+		if assert.NoError(t, results[1].Err) {
+			assert.Equal(t, "GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3", results[1].Response.AccountID)
+			assert.Equal(t, "1", results[1].Response.Memo.String())
+		}
+		assert.Contains(t, results[2].Err.Error(), "parse address failed")
+		assert.Contains(t, results[3].Err.Error(), "failed with (404)")
+		assert.Nil(t, results[3].Response)
+		assert.Contains(t, results[4].Err.Error(), "lookup federation server failed")
+		if assert.NoError(t, results[5].Err) {
+			assert.Equal(t, results[0].Response, results[5].Response)
+		}
+	}
+
+	tomlmock.AssertExpectations(t)
+}
+
+func TestLookupManyContext(t *testing.T) {
+	hmock := httptest.NewClient()
+	tomlmock := &stellartoml.MockClient{}
+	c := &Client{StellarTOML: tomlmock, HTTP: hmock}
+
+	// no request is sent once ctx is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := c.LookupManyContext(ctx, []string{"scott*stellar.org", "jed*stellar.org"})
+
+	if assert.Len(t, results, 2) {
+		for _, result := range results {
+			assert.EqualError(t, result.Err, "lookup federation server failed: context canceled")
+			assert.Nil(t, result.Response)
+		}
+	}
+	tomlmock.AssertExpectations(t)
+}
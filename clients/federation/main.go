@@ -1,17 +1,29 @@
 package federation
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/clients/stellartoml"
 	proto "github.com/stellar/go/protocols/federation"
+	"github.com/stellar/go/support/clock"
 )
 
 // FederationResponseMaxSize is the maximum size of response from a federation server
 const FederationResponseMaxSize = 100 * 1024
 
+// DefaultLookupConcurrency is the number of requests LookupMany sends at the
+// same time when Client.LookupConcurrency is not set.
+const DefaultLookupConcurrency = 10
+
+// cacheMaxEntries is the number of cached responses after which expired
+// responses are purged from the cache.
+const cacheMaxEntries = 10000
+
 // DefaultTestNetClient is a default federation client for testnet
 var DefaultTestNetClient = &Client{
 	HTTP:        http.DefaultClient,
@@ -33,12 +45,42 @@ type Client struct {
 	HTTP        HTTP
 	Horizon     Horizon
 	AllowHTTP   bool
+
+	// CacheTTL enables caching of federation responses to "name" and "id"
+	// requests when greater than zero. Responses are cached for CacheTTL, or for
+	// less when the Cache-Control or Expires headers of the response say so.
+	// Use a stellartoml.Client with CacheTTL set to cache stellar.toml files as
+	// well.
+	CacheTTL time.Duration
+
+	// LookupConcurrency is the maximum number of requests sent at the same time
+	// by LookupMany. Defaults to DefaultLookupConcurrency.
+	LookupConcurrency int
+
+	cache      map[string]cachedResponse
+	cacheMutex sync.Mutex
+	clock      *clock.Clock
+}
+
+// LookupResult is the result of resolving a single address with LookupMany.
+type LookupResult struct {
+	Address  string
+	Response *proto.NameResponse
+	Err      error
+}
+
+type cachedResponse struct {
+	body      []byte
+	expiresAt time.Time
 }
 
 type ClientInterface interface {
 	LookupByAddress(addy string) (*proto.NameResponse, error)
 	LookupByAccountID(aid string) (*proto.IDResponse, error)
 	ForwardRequest(domain string, fields url.Values) (*proto.NameResponse, error)
+	LookupByAddressContext(ctx context.Context, addy string) (*proto.NameResponse, error)
+	LookupByAccountIDContext(ctx context.Context, aid string) (*proto.IDResponse, error)
+	ForwardRequestContext(ctx context.Context, domain string, fields url.Values) (*proto.NameResponse, error)
 }
 
 // Horizon represents a horizon client that can be consulted for data when
@@ -19,54 +19,147 @@ import (
 	"github.com/stellar/go/protocols/horizon/effects"
 	"github.com/stellar/go/protocols/horizon/operations"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
 )
 
 // sendRequest builds the URL for the given horizon request and sends the url to a horizon server
-func (c *Client) sendRequest(hr HorizonRequest, resp interface{}) (err error) {
+func (c *Client) sendRequest(ctx context.Context, hr HorizonRequest, resp interface{}) (err error) {
 	endpoint, err := hr.BuildURL()
 	if err != nil {
 		return
 	}
 
 	c.HorizonURL = c.fixHorizonURL()
-	_, ok := hr.(submitRequest)
+	sr, ok := hr.(submitRequest)
 	if ok {
-		return c.sendRequestURL(c.HorizonURL+endpoint, "post", resp)
+		var beforeRetry func() bool
+		if sr.hash != "" {
+			beforeRetry = func() bool {
+				return c.transactionIncluded(ctx, sr.hash, resp)
+			}
+		}
+		return c.send(ctx, c.HorizonURL+endpoint, "post", resp, beforeRetry)
 	}
 
-	return c.sendRequestURL(c.HorizonURL+endpoint, "get", resp)
+	return c.sendRequestURL(ctx, c.HorizonURL+endpoint, "get", resp)
 }
 
-// sendRequestURL sends a url to a horizon server.
+// sendRequestURL sends a url to a horizon server, in ctx and with its trace.
+// Failed GET requests are sent again according to c.RetryPolicy.
 // It can be used for requests that do not implement the HorizonRequest interface.
-func (c *Client) sendRequestURL(requestURL string, method string, a interface{}) (err error) {
+func (c *Client) sendRequestURL(ctx context.Context, requestURL string, method string, a interface{}) (err error) {
+	return c.send(ctx, requestURL, method, a, nil)
+}
+
+// send sends a request to a horizon server and decodes the response into a,
+// sending the request again according to c.RetryPolicy when it fails.
+// beforeRetry, when not nil, is called before sending a POST request again
+// and makes it safe to retry it after failures which Horizon may have
+// processed the request in. The request isn't sent again when it returns true.
+func (c *Client) send(ctx context.Context, requestURL string, method string, a interface{}, beforeRetry func() bool) error {
+	req, err := c.newRequest(ctx, requestURL, method)
+	if err != nil {
+		return err
+	}
+
+	safe := req.Method == "GET" || beforeRetry != nil
+	for attempt := 1; ; attempt++ {
+		resp, err := c.sendOnce(ctx, req, a)
+		if err == nil {
+			return nil
+		}
+
+		delay, ok := c.retryDelay(attempt, resp, safe)
+		if !ok || !wait(ctx, delay) {
+			return err
+		}
+		if req.Method == "POST" && beforeRetry != nil && beforeRetry() {
+			return nil
+		}
+	}
+}
+
+// newRequest creates a request to a horizon server, with the headers of the
+// client and the trace of ctx.
+func (c *Client) newRequest(ctx context.Context, requestURL string, method string) (*http.Request, error) {
 	var req *http.Request
+	var err error
 
 	if method == "post" || method == "POST" {
 		req, err = http.NewRequest("POST", requestURL, nil)
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded; param=value")
+		}
 	} else {
 		req, err = http.NewRequest("GET", requestURL, nil)
 	}
 
 	if err != nil {
-		return errors.Wrap(err, "error creating HTTP request")
+		return nil, errors.Wrap(err, "error creating HTTP request")
 	}
 	c.setClientAppHeaders(req)
+	log.SetTraceparent(ctx, req.Header)
 	c.setDefaultClient()
 	if c.horizonTimeOut == 0 {
 		c.horizonTimeOut = HorizonTimeOut
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*c.horizonTimeOut)
+	return req, nil
+}
+
+// sendOnce sends req in ctx, with the client timeout, and decodes the
+// response into a. The response is returned along with the error when one
+// was received.
+func (c *Client) sendOnce(ctx context.Context, req *http.Request, a interface{}) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*c.horizonTimeOut)
+	defer cancel()
+
 	resp, err := c.HTTP.Do(req.WithContext(ctx))
 	if err != nil {
-		cancel()
-		return
+		return nil, err
 	}
+	c.updateRateLimit(resp)
 
-	err = decodeResponse(resp, &a, c)
-	cancel()
-	return
+	return resp, decodeResponse(resp, &a, c)
+}
+
+// transactionIncluded returns true if the transaction with the given hash was
+// successfully included in a ledger, and then fills a, the
+// *hProtocol.TransactionSuccess of its submission.
+func (c *Client) transactionIncluded(ctx context.Context, hash string, a interface{}) bool {
+	success, ok := a.(*hProtocol.TransactionSuccess)
+	if !ok {
+		return false
+	}
+
+	req, err := c.newRequest(ctx, c.HorizonURL+"transactions/"+hash, "get")
+	if err != nil {
+		return false
+	}
+	var tx hProtocol.Transaction
+	if _, err = c.sendOnce(ctx, req, &tx); err != nil || !tx.Successful {
+		return false
+	}
+
+	success.Links.Transaction = tx.Links.Self
+	success.Hash = tx.Hash
+	success.Ledger = tx.Ledger
+	success.Env = tx.EnvelopeXdr
+	success.Result = tx.ResultXdr
+	success.Meta = tx.ResultMetaXdr
+	return true
+}
+
+// wait waits for d, and returns false if ctx is done first.
+func wait(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
 }
 
 // stream handles connections to endpoints that support streaming on a horizon server
@@ -85,6 +178,18 @@ func (c *Client) stream(
 		query.Set("cursor", "now")
 	}
 
+	// attempt counts the failed connections in a row, which are retried
+	// according to c.RetryPolicy like GET requests.
+	attempt := 0
+	// retry waits before connecting again after a failure, and returns false
+	// when the stream should stop instead.
+	retry := func(resp *http.Response) bool {
+		attempt++
+		delay, ok := c.retryDelay(attempt, resp, true)
+		return ok && wait(ctx, delay)
+	}
+
+Connect:
 	for {
 		// updates the url with new cursor
 		su.RawQuery = query.Encode()
@@ -95,17 +200,33 @@ func (c *Client) stream(
 		req.Header.Set("Accept", "text/event-stream")
 		c.setDefaultClient()
 		c.setClientAppHeaders(req)
+		log.SetTraceparent(ctx, req.Header)
 
 		// We can use c.HTTP here because we set Timeout per request not on the client. See sendRequest()
 		resp, err := c.HTTP.Do(req)
 		if err != nil {
+			if retry(nil) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
 			return errors.Wrap(err, "error sending HTTP request")
 		}
+		c.updateRateLimit(resp)
 
 		// Expected statusCode are 200-299
 		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+			resp.Body.Close()
+			if retry(resp) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
 			return fmt.Errorf("got bad HTTP status code %d", resp.StatusCode)
 		}
+		attempt = 0
 		defer resp.Body.Close()
 
 		reader := bufio.NewReader(resp.Body)
@@ -149,6 +270,13 @@ func (c *Client) stream(
 							break Events
 						}
 					} else {
+						resp.Body.Close()
+						if retry(nil) {
+							continue Connect
+						}
+						if ctx.Err() != nil {
+							return nil
+						}
 						return errors.Wrap(err, "error reading line")
 					}
 				}
@@ -229,6 +357,11 @@ func (c *Client) HorizonTimeOut() time.Duration {
 // AccountDetail returns information for a single account.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/accounts-single.html
 func (c *Client) AccountDetail(request AccountRequest) (account hProtocol.Account, err error) {
+	return c.AccountDetailContext(context.Background(), request)
+}
+
+// AccountDetailContext is like AccountDetail, but the request is sent in ctx.
+func (c *Client) AccountDetailContext(ctx context.Context, request AccountRequest) (account hProtocol.Account, err error) {
 	if request.AccountID == "" {
 		err = errors.New("no account ID provided")
 	}
@@ -237,13 +370,18 @@ func (c *Client) AccountDetail(request AccountRequest) (account hProtocol.Accoun
 		return
 	}
 
-	err = c.sendRequest(request, &account)
+	err = c.sendRequest(ctx, request, &account)
 	return
 }
 
 // AccountData returns a single data associated with a given account
 // See https://www.stellar.org/developers/horizon/reference/endpoints/data-for-account.html
 func (c *Client) AccountData(request AccountRequest) (accountData hProtocol.AccountData, err error) {
+	return c.AccountDataContext(context.Background(), request)
+}
+
+// AccountDataContext is like AccountData, but the request is sent in ctx.
+func (c *Client) AccountDataContext(ctx context.Context, request AccountRequest) (accountData hProtocol.AccountData, err error) {
 	if request.AccountID == "" || request.DataKey == "" {
 		err = errors.New("too few parameters")
 	}
@@ -252,34 +390,54 @@ func (c *Client) AccountData(request AccountRequest) (accountData hProtocol.Acco
 		return
 	}
 
-	err = c.sendRequest(request, &accountData)
+	err = c.sendRequest(ctx, request, &accountData)
 	return
 }
 
 // Effects returns effects(https://www.stellar.org/developers/horizon/reference/resources/effect.html)
 // It can be used to return effects for an account, a ledger, an operation, a transaction and all effects on the network.
 func (c *Client) Effects(request EffectRequest) (effects effects.EffectsPage, err error) {
-	err = c.sendRequest(request, &effects)
+	return c.EffectsContext(context.Background(), request)
+}
+
+// EffectsContext is like Effects, but the request is sent in ctx.
+func (c *Client) EffectsContext(ctx context.Context, request EffectRequest) (effects effects.EffectsPage, err error) {
+	err = c.sendRequest(ctx, request, &effects)
 	return
 }
 
 // Assets returns asset information.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/assets-all.html
 func (c *Client) Assets(request AssetRequest) (assets hProtocol.AssetsPage, err error) {
-	err = c.sendRequest(request, &assets)
+	return c.AssetsContext(context.Background(), request)
+}
+
+// AssetsContext is like Assets, but the request is sent in ctx.
+func (c *Client) AssetsContext(ctx context.Context, request AssetRequest) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendRequest(ctx, request, &assets)
 	return
 }
 
 // Ledgers returns information about all ledgers.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/ledgers-all.html
 func (c *Client) Ledgers(request LedgerRequest) (ledgers hProtocol.LedgersPage, err error) {
-	err = c.sendRequest(request, &ledgers)
+	return c.LedgersContext(context.Background(), request)
+}
+
+// LedgersContext is like Ledgers, but the request is sent in ctx.
+func (c *Client) LedgersContext(ctx context.Context, request LedgerRequest) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendRequest(ctx, request, &ledgers)
 	return
 }
 
 // LedgerDetail returns information about a particular ledger for a given sequence number
 // See https://www.stellar.org/developers/horizon/reference/endpoints/ledgers-single.html
 func (c *Client) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err error) {
+	return c.LedgerDetailContext(context.Background(), sequence)
+}
+
+// LedgerDetailContext is like LedgerDetail, but the request is sent in ctx.
+func (c *Client) LedgerDetailContext(ctx context.Context, sequence uint32) (ledger hProtocol.Ledger, err error) {
 	if sequence == 0 {
 		err = errors.New("invalid sequence number provided")
 	}
@@ -289,43 +447,80 @@ func (c *Client) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err err
 	}
 
 	request := LedgerRequest{forSequence: sequence}
-	err = c.sendRequest(request, &ledger)
+	err = c.sendRequest(ctx, request, &ledger)
 	return
 }
 
 // Metrics returns monitoring information about a horizon server
 // See https://www.stellar.org/developers/horizon/reference/endpoints/metrics.html
 func (c *Client) Metrics() (metrics hProtocol.Metrics, err error) {
+	return c.MetricsContext(context.Background())
+}
+
+// MetricsContext is like Metrics, but the request is sent in ctx.
+func (c *Client) MetricsContext(ctx context.Context) (metrics hProtocol.Metrics, err error) {
 	request := metricsRequest{endpoint: "metrics"}
-	err = c.sendRequest(request, &metrics)
+	err = c.sendRequest(ctx, request, &metrics)
 	return
 }
 
 // FeeStats returns information about fees in the last 5 ledgers.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/fee-stats.html
 func (c *Client) FeeStats() (feestats hProtocol.FeeStats, err error) {
+	return c.FeeStatsContext(context.Background())
+}
+
+// FeeStatsContext is like FeeStats, but the request is sent in ctx.
+func (c *Client) FeeStatsContext(ctx context.Context) (feestats hProtocol.FeeStats, err error) {
 	request := feeStatsRequest{endpoint: "fee_stats"}
-	err = c.sendRequest(request, &feestats)
+	err = c.sendRequest(ctx, request, &feestats)
+	return
+}
+
+// FeeInclusion returns the probability that a transaction bidding request.Fee per
+// operation is included in the next ledger, estimated from the recent ledgers.
+func (c *Client) FeeInclusion(request FeeInclusionRequest) (inclusion hProtocol.FeeInclusion, err error) {
+	return c.FeeInclusionContext(context.Background(), request)
+}
+
+// FeeInclusionContext is like FeeInclusion, but the request is sent in ctx.
+func (c *Client) FeeInclusionContext(ctx context.Context, request FeeInclusionRequest) (inclusion hProtocol.FeeInclusion, err error) {
+	err = c.sendRequest(ctx, request, &inclusion)
 	return
 }
 
 // Offers returns information about offers made on the SDEX.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/offers-for-account.html
 func (c *Client) Offers(request OfferRequest) (offers hProtocol.OffersPage, err error) {
-	err = c.sendRequest(request, &offers)
+	return c.OffersContext(context.Background(), request)
+}
+
+// OffersContext is like Offers, but the request is sent in ctx.
+func (c *Client) OffersContext(ctx context.Context, request OfferRequest) (offers hProtocol.OffersPage, err error) {
+	err = c.sendRequest(ctx, request, &offers)
 	return
 }
 
 // Operations returns stellar operations (https://www.stellar.org/developers/horizon/reference/resources/operation.html)
 // It can be used to return operations for an account, a ledger, a transaction and all operations on the network.
 func (c *Client) Operations(request OperationRequest) (ops operations.OperationsPage, err error) {
-	err = c.sendRequest(request.SetOperationsEndpoint(), &ops)
+	return c.OperationsContext(context.Background(), request)
+}
+
+// OperationsContext is like Operations, but the request is sent in ctx.
+func (c *Client) OperationsContext(ctx context.Context, request OperationRequest) (ops operations.OperationsPage, err error) {
+	err = c.sendRequest(ctx, request.SetOperationsEndpoint(), &ops)
 	return
 }
 
 // OperationDetail returns a single stellar operations (https://www.stellar.org/developers/horizon/reference/resources/operation.html)
 // for a given operation id
 func (c *Client) OperationDetail(id string) (ops operations.Operation, err error) {
+	return c.OperationDetailContext(context.Background(), id)
+}
+
+// OperationDetailContext is like OperationDetail, but the request is sent in ctx.
+func (c *Client) OperationDetailContext(ctx context.Context, id string) (ops operations.Operation, err error) {
 	if id == "" {
 		return ops, errors.New("invalid operation id provided")
 	}
@@ -334,7 +529,7 @@ func (c *Client) OperationDetail(id string) (ops operations.Operation, err error
 
 	var record interface{}
 
-	err = c.sendRequest(request, &record)
+	err = c.sendRequest(ctx, request, &record)
 	if err != nil {
 		return ops, errors.Wrap(err, "sending request to horizon")
 	}
@@ -359,14 +554,33 @@ func (c *Client) OperationDetail(id string) (ops operations.Operation, err error
 // See https://www.stellar.org/developers/horizon/reference/endpoints/transactions-create.html
 func (c *Client) SubmitTransactionXDR(transactionXdr string) (txSuccess hProtocol.TransactionSuccess,
 	err error) {
-	request := submitRequest{endpoint: "transactions", transactionXdr: transactionXdr}
-	err = c.sendRequest(request, &txSuccess)
+	return c.SubmitTransactionXDRContext(context.Background(), transactionXdr)
+}
+
+// SubmitTransactionXDRContext is like SubmitTransactionXDR, but the request is sent in ctx.
+func (c *Client) SubmitTransactionXDRContext(ctx context.Context, transactionXdr string) (txSuccess hProtocol.TransactionSuccess,
+	err error) {
+	return c.submitTransactionXDR(ctx, transactionXdr, "")
+}
+
+// submitTransactionXDR submits a transaction with the given hex hash, or with
+// an unknown hash when empty.
+func (c *Client) submitTransactionXDR(ctx context.Context, transactionXdr, hash string) (txSuccess hProtocol.TransactionSuccess,
+	err error) {
+	request := submitRequest{endpoint: "transactions", transactionXdr: transactionXdr, hash: hash}
+	err = c.sendRequest(ctx, request, &txSuccess)
 	return
 }
 
 // SubmitTransaction submits a transaction to the network. err can be either error object or horizon.Error object.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/transactions-create.html
 func (c *Client) SubmitTransaction(transaction txnbuild.Transaction) (txSuccess hProtocol.TransactionSuccess,
+	err error) {
+	return c.SubmitTransactionContext(context.Background(), transaction)
+}
+
+// SubmitTransactionContext is like SubmitTransaction, but the request is sent in ctx.
+func (c *Client) SubmitTransactionContext(ctx context.Context, transaction txnbuild.Transaction) (txSuccess hProtocol.TransactionSuccess,
 	err error) {
 	txeBase64, err := transaction.Base64()
 	if err != nil {
@@ -374,62 +588,103 @@ func (c *Client) SubmitTransaction(transaction txnbuild.Transaction) (txSuccess
 		return
 	}
 
-	return c.SubmitTransactionXDR(txeBase64)
+	// The hash makes retrying the submission safe, as the client can check if
+	// a previous attempt got the transaction included. It's unknown when the
+	// transaction has no network passphrase.
+	hash, _ := transaction.HashHex()
+
+	return c.submitTransactionXDR(ctx, txeBase64, hash)
 }
 
 // Transactions returns stellar transactions (https://www.stellar.org/developers/horizon/reference/resources/transaction.html)
 // It can be used to return transactions for an account, a ledger,and all transactions on the network.
 func (c *Client) Transactions(request TransactionRequest) (txs hProtocol.TransactionsPage, err error) {
-	err = c.sendRequest(request, &txs)
+	return c.TransactionsContext(context.Background(), request)
+}
+
+// TransactionsContext is like Transactions, but the request is sent in ctx.
+func (c *Client) TransactionsContext(ctx context.Context, request TransactionRequest) (txs hProtocol.TransactionsPage, err error) {
+	err = c.sendRequest(ctx, request, &txs)
 	return
 }
 
 // TransactionDetail returns information about a particular transaction for a given transaction hash
 // See https://www.stellar.org/developers/horizon/reference/endpoints/transactions-single.html
 func (c *Client) TransactionDetail(txHash string) (tx hProtocol.Transaction, err error) {
+	return c.TransactionDetailContext(context.Background(), txHash)
+}
+
+// TransactionDetailContext is like TransactionDetail, but the request is sent in ctx.
+func (c *Client) TransactionDetailContext(ctx context.Context, txHash string) (tx hProtocol.Transaction, err error) {
 	if txHash == "" {
 		return tx, errors.New("no transaction hash provided")
 	}
 
 	request := TransactionRequest{forTransactionHash: txHash}
-	err = c.sendRequest(request, &tx)
+	err = c.sendRequest(ctx, request, &tx)
 	return
 }
 
 // OrderBook returns the orderbook for an asset pair (https://www.stellar.org/developers/horizon/reference/resources/orderbook.html)
 func (c *Client) OrderBook(request OrderBookRequest) (obs hProtocol.OrderBookSummary, err error) {
-	err = c.sendRequest(request, &obs)
+	return c.OrderBookContext(context.Background(), request)
+}
+
+// OrderBookContext is like OrderBook, but the request is sent in ctx.
+func (c *Client) OrderBookContext(ctx context.Context, request OrderBookRequest) (obs hProtocol.OrderBookSummary, err error) {
+	err = c.sendRequest(ctx, request, &obs)
 	return
 }
 
 // Paths returns the available paths to make a payment. See https://www.stellar.org/developers/horizon/reference/endpoints/path-finding.html
 func (c *Client) Paths(request PathsRequest) (paths hProtocol.PathsPage, err error) {
-	err = c.sendRequest(request, &paths)
+	return c.PathsContext(context.Background(), request)
+}
+
+// PathsContext is like Paths, but the request is sent in ctx.
+func (c *Client) PathsContext(ctx context.Context, request PathsRequest) (paths hProtocol.PathsPage, err error) {
+	err = c.sendRequest(ctx, request, &paths)
 	return
 }
 
 // Payments returns stellar account_merge, create_account, path payment and payment operations.
 // It can be used to return payments for an account, a ledger, a transaction and all payments on the network.
 func (c *Client) Payments(request OperationRequest) (ops operations.OperationsPage, err error) {
-	err = c.sendRequest(request.SetPaymentsEndpoint(), &ops)
+	return c.PaymentsContext(context.Background(), request)
+}
+
+// PaymentsContext is like Payments, but the request is sent in ctx.
+func (c *Client) PaymentsContext(ctx context.Context, request OperationRequest) (ops operations.OperationsPage, err error) {
+	err = c.sendRequest(ctx, request.SetPaymentsEndpoint(), &ops)
 	return
 }
 
 // Trades returns stellar trades (https://www.stellar.org/developers/horizon/reference/resources/trade.html)
 // It can be used to return trades for an account, an offer and all trades on the network.
 func (c *Client) Trades(request TradeRequest) (tds hProtocol.TradesPage, err error) {
-	err = c.sendRequest(request, &tds)
+	return c.TradesContext(context.Background(), request)
+}
+
+// TradesContext is like Trades, but the request is sent in ctx.
+func (c *Client) TradesContext(ctx context.Context, request TradeRequest) (tds hProtocol.TradesPage, err error) {
+	err = c.sendRequest(ctx, request, &tds)
 	return
 }
 
 // Fund creates a new account funded from friendbot. It only works on test networks. See
 // https://www.stellar.org/developers/guides/get-started/create-account.html for more information.
+// A muxed account address funds the account it's made of.
 func (c *Client) Fund(addr string) (txSuccess hProtocol.TransactionSuccess, err error) {
+	return c.FundContext(context.Background(), addr)
+}
+
+// FundContext is like Fund, but the request is sent in ctx.
+func (c *Client) FundContext(ctx context.Context, addr string) (txSuccess hProtocol.TransactionSuccess, err error) {
 	if !c.isTestNet {
 		return txSuccess, errors.New("can't fund account from friendbot on production network")
 	}
-	friendbotURL := fmt.Sprintf("%sfriendbot?addr=%s", c.fixHorizonURL(), addr)
-	err = c.sendRequestURL(friendbotURL, "get", &txSuccess)
+	friendbotURL := fmt.Sprintf("%sfriendbot?addr=%s", c.fixHorizonURL(), demuxAccount(addr))
+	err = c.sendRequestURL(ctx, friendbotURL, "get", &txSuccess)
 	return
 }
 
@@ -443,7 +698,12 @@ func (c *Client) StreamTrades(ctx context.Context, request TradeRequest, handler
 
 // TradeAggregations returns stellar trade aggregations (https://www.stellar.org/developers/horizon/reference/resources/trade_aggregation.html)
 func (c *Client) TradeAggregations(request TradeAggregationRequest) (tds hProtocol.TradeAggregationsPage, err error) {
-	err = c.sendRequest(request, &tds)
+	return c.TradeAggregationsContext(context.Background(), request)
+}
+
+// TradeAggregationsContext is like TradeAggregations, but the request is sent in ctx.
+func (c *Client) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (tds hProtocol.TradeAggregationsPage, err error) {
+	err = c.sendRequest(ctx, request, &tds)
 	return
 }
 
@@ -520,7 +780,12 @@ func (c *Client) FetchTimebounds(seconds int64) (txnbuild.Timebounds, error) {
 
 // Root loads the root endpoint of horizon
 func (c *Client) Root() (root hProtocol.Root, err error) {
-	err = c.sendRequestURL(c.fixHorizonURL(), "get", &root)
+	return c.RootContext(context.Background())
+}
+
+// RootContext is like Root, but the request is sent in ctx.
+func (c *Client) RootContext(ctx context.Context) (root hProtocol.Root, err error) {
+	err = c.sendRequestURL(ctx, c.fixHorizonURL(), "get", &root)
 	return
 }
 
@@ -531,105 +796,190 @@ func (c *Client) Version() string {
 
 // NextAssetsPage returns the next page of assets.
 func (c *Client) NextAssetsPage(page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &assets)
+	return c.NextAssetsPageContext(context.Background(), page)
+}
+
+// NextAssetsPageContext is like NextAssetsPage, but the request is sent in ctx.
+func (c *Client) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &assets)
 	return
 }
 
 // PrevAssetsPage returns the previous page of assets.
 func (c *Client) PrevAssetsPage(page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &assets)
+	return c.PrevAssetsPageContext(context.Background(), page)
+}
+
+// PrevAssetsPageContext is like PrevAssetsPage, but the request is sent in ctx.
+func (c *Client) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &assets)
 	return
 }
 
 // NextLedgersPage returns the next page of ledgers.
 func (c *Client) NextLedgersPage(page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &ledgers)
+	return c.NextLedgersPageContext(context.Background(), page)
+}
+
+// NextLedgersPageContext is like NextLedgersPage, but the request is sent in ctx.
+func (c *Client) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &ledgers)
 	return
 }
 
 // PrevLedgersPage returns the previous page of ledgers.
 func (c *Client) PrevLedgersPage(page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &ledgers)
+	return c.PrevLedgersPageContext(context.Background(), page)
+}
+
+// PrevLedgersPageContext is like PrevLedgersPage, but the request is sent in ctx.
+func (c *Client) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &ledgers)
 	return
 }
 
 // NextEffectsPage returns the next page of effects.
 func (c *Client) NextEffectsPage(page effects.EffectsPage) (efp effects.EffectsPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &efp)
+	return c.NextEffectsPageContext(context.Background(), page)
+}
+
+// NextEffectsPageContext is like NextEffectsPage, but the request is sent in ctx.
+func (c *Client) NextEffectsPageContext(ctx context.Context, page effects.EffectsPage) (efp effects.EffectsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &efp)
 	return
 }
 
 // PrevEffectsPage returns the previous page of effects.
 func (c *Client) PrevEffectsPage(page effects.EffectsPage) (efp effects.EffectsPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &efp)
+	return c.PrevEffectsPageContext(context.Background(), page)
+}
+
+// PrevEffectsPageContext is like PrevEffectsPage, but the request is sent in ctx.
+func (c *Client) PrevEffectsPageContext(ctx context.Context, page effects.EffectsPage) (efp effects.EffectsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &efp)
 	return
 }
 
 // NextTransactionsPage returns the next page of transactions.
 func (c *Client) NextTransactionsPage(page hProtocol.TransactionsPage) (transactions hProtocol.TransactionsPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &transactions)
+	return c.NextTransactionsPageContext(context.Background(), page)
+}
+
+// NextTransactionsPageContext is like NextTransactionsPage, but the request is sent in ctx.
+func (c *Client) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (transactions hProtocol.TransactionsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &transactions)
 	return
 }
 
 // PrevTransactionsPage returns the previous page of transactions.
 func (c *Client) PrevTransactionsPage(page hProtocol.TransactionsPage) (transactions hProtocol.TransactionsPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &transactions)
+	return c.PrevTransactionsPageContext(context.Background(), page)
+}
+
+// PrevTransactionsPageContext is like PrevTransactionsPage, but the request is sent in ctx.
+func (c *Client) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (transactions hProtocol.TransactionsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &transactions)
 	return
 }
 
 // NextOperationsPage returns the next page of operations.
 func (c *Client) NextOperationsPage(page operations.OperationsPage) (operations operations.OperationsPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &operations)
+	return c.NextOperationsPageContext(context.Background(), page)
+}
+
+// NextOperationsPageContext is like NextOperationsPage, but the request is sent in ctx.
+func (c *Client) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations operations.OperationsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &operations)
 	return
 }
 
 // PrevOperationsPage returns the previous page of operations.
 func (c *Client) PrevOperationsPage(page operations.OperationsPage) (operations operations.OperationsPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &operations)
+	return c.PrevOperationsPageContext(context.Background(), page)
+}
+
+// PrevOperationsPageContext is like PrevOperationsPage, but the request is sent in ctx.
+func (c *Client) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations operations.OperationsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &operations)
 	return
 }
 
 // NextPaymentsPage returns the next page of payments.
 func (c *Client) NextPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
-	return c.NextOperationsPage(page)
+	return c.NextPaymentsPageContext(context.Background(), page)
+}
+
+// NextPaymentsPageContext is like NextPaymentsPage, but the request is sent in ctx.
+func (c *Client) NextPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return c.NextOperationsPageContext(ctx, page)
 }
 
 // PrevPaymentsPage returns the previous page of payments.
 func (c *Client) PrevPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
-	return c.PrevOperationsPage(page)
+	return c.PrevPaymentsPageContext(context.Background(), page)
+}
+
+// PrevPaymentsPageContext is like PrevPaymentsPage, but the request is sent in ctx.
+func (c *Client) PrevPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return c.PrevOperationsPageContext(ctx, page)
 }
 
 // NextOffersPage returns the next page of offers.
 func (c *Client) NextOffersPage(page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &offers)
+	return c.NextOffersPageContext(context.Background(), page)
+}
+
+// NextOffersPageContext is like NextOffersPage, but the request is sent in ctx.
+func (c *Client) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &offers)
 	return
 }
 
 // PrevOffersPage returns the previous page of offers.
 func (c *Client) PrevOffersPage(page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &offers)
+	return c.PrevOffersPageContext(context.Background(), page)
+}
+
+// PrevOffersPageContext is like PrevOffersPage, but the request is sent in ctx.
+func (c *Client) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &offers)
 	return
 }
 
 // NextTradesPage returns the next page of trades.
 func (c *Client) NextTradesPage(page hProtocol.TradesPage) (trades hProtocol.TradesPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &trades)
+	return c.NextTradesPageContext(context.Background(), page)
+}
+
+// NextTradesPageContext is like NextTradesPage, but the request is sent in ctx.
+func (c *Client) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (trades hProtocol.TradesPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &trades)
 	return
 }
 
 // PrevTradesPage returns the previous page of trades.
 func (c *Client) PrevTradesPage(page hProtocol.TradesPage) (trades hProtocol.TradesPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &trades)
+	return c.PrevTradesPageContext(context.Background(), page)
+}
+
+// PrevTradesPageContext is like PrevTradesPage, but the request is sent in ctx.
+func (c *Client) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (trades hProtocol.TradesPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &trades)
 	return
 }
 
 // HomeDomainForAccount returns the home domain for a single account.
 func (c *Client) HomeDomainForAccount(aid string) (string, error) {
+	return c.HomeDomainForAccountContext(context.Background(), aid)
+}
+
+// HomeDomainForAccountContext is like HomeDomainForAccount, but the requests are sent in ctx.
+func (c *Client) HomeDomainForAccountContext(ctx context.Context, aid string) (string, error) {
 	if aid == "" {
 		return "", errors.New("no account ID provided")
 	}
 
-	accountDetail, err := c.AccountDetail(AccountRequest{AccountID: aid})
+	accountDetail, err := c.AccountDetailContext(ctx, AccountRequest{AccountID: aid})
 	if err != nil {
 		return "", errors.Wrap(err, "get account detail failed")
 	}
@@ -640,14 +990,24 @@ func (c *Client) HomeDomainForAccount(aid string) (string, error) {
 // NextTradeAggregationsPage returns the next page of trade aggregations from the current
 // trade aggregations response.
 func (c *Client) NextTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (ta hProtocol.TradeAggregationsPage, err error) {
-	err = c.sendRequestURL(page.Links.Next.Href, "get", &ta)
+	return c.NextTradeAggregationsPageContext(context.Background(), page)
+}
+
+// NextTradeAggregationsPageContext is like NextTradeAggregationsPage, but the request is sent in ctx.
+func (c *Client) NextTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (ta hProtocol.TradeAggregationsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Next.Href, "get", &ta)
 	return
 }
 
 // PrevTradeAggregationsPage returns the previous page of trade aggregations from the current
 // trade aggregations response.
 func (c *Client) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (ta hProtocol.TradeAggregationsPage, err error) {
-	err = c.sendRequestURL(page.Links.Prev.Href, "get", &ta)
+	return c.PrevTradeAggregationsPageContext(context.Background(), page)
+}
+
+// PrevTradeAggregationsPageContext is like PrevTradeAggregationsPage, but the request is sent in ctx.
+func (c *Client) PrevTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (ta hProtocol.TradeAggregationsPage, err error) {
+	err = c.sendRequestURL(ctx, page.Links.Prev.Href, "get", &ta)
 	return
 }
 
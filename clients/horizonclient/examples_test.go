@@ -85,6 +85,17 @@ func ExampleClient_FeeStats() {
 
 }
 
+func ExampleClient_FeeInclusion() {
+	client := horizonclient.DefaultPublicNetClient
+	// probability that a transaction bidding 200 stroops per operation is included
+	inclusion, err := client.FeeInclusion(horizonclient.FeeInclusionRequest{Fee: 200, Ledgers: 20})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(inclusion.InclusionProbability)
+}
+
 func ExampleClient_Fund() {
 	client := horizonclient.DefaultTestNetClient
 	// fund an account
@@ -0,0 +1,28 @@
+package horizonclient
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// BuildURL creates the endpoint to be queried based on the data in the FeeInclusionRequest struct.
+func (fr FeeInclusionRequest) BuildURL() (endpoint string, err error) {
+	if fr.Fee == 0 {
+		return endpoint, errors.New("invalid request: no fee provided")
+	}
+
+	paramMap := map[string]string{"fee": fmt.Sprint(fr.Fee)}
+	if fr.Ledgers != 0 {
+		paramMap["ledgers"] = fmt.Sprint(fr.Ledgers)
+	}
+	endpoint = fmt.Sprintf("fee_stats/inclusion?%s", addQueryParams(paramMap))
+
+	_, err = url.Parse(endpoint)
+	if err != nil {
+		err = errors.Wrap(err, "failed to parse endpoint")
+	}
+
+	return endpoint, err
+}
@@ -0,0 +1,49 @@
+package horizonclient
+
+import (
+	"testing"
+
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeInclusionRequestBuildUrl(t *testing.T) {
+	_, err := FeeInclusionRequest{}.BuildURL()
+	assert.EqualError(t, err, "invalid request: no fee provided")
+
+	endpoint, err := FeeInclusionRequest{Fee: 200}.BuildURL()
+	require.NoError(t, err)
+	assert.Equal(t, "fee_stats/inclusion?fee=200", endpoint)
+
+	endpoint, err = FeeInclusionRequest{Fee: 200, Ledgers: 20}.BuildURL()
+	require.NoError(t, err)
+	assert.Equal(t, "fee_stats/inclusion?fee=200&ledgers=20", endpoint)
+}
+
+func TestFeeInclusion(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/fee_stats/inclusion?fee=200&ledgers=20",
+	).ReturnString(200, `{
+  "fee": "200",
+  "ledgers": "20",
+  "last_ledger": "22606298",
+  "full_ledgers": "8",
+  "inclusion_probability": "0.85"
+}`)
+
+	inclusion, err := client.FeeInclusion(FeeInclusionRequest{Fee: 200, Ledgers: 20})
+	require.NoError(t, err)
+	assert.Equal(t, 200, inclusion.Fee)
+	assert.Equal(t, 20, inclusion.Ledgers)
+	assert.Equal(t, 22606298, inclusion.LastLedger)
+	assert.Equal(t, 8, inclusion.FullLedgers)
+	assert.Equal(t, 0.85, inclusion.InclusionProbability)
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
 )
 
@@ -144,3 +145,14 @@ func currentServerTime(host string, currentTimeUTC int64) int64 {
 	}
 	return currentTimeUTC - st.LocalTimeRecorded + st.ServerTime
 }
+
+// demuxAccount returns the account ID ("G...") a muxed account address ("M...")
+// is made of. Other values are returned as is. Horizon doesn't know about muxed
+// accounts, so destinations are sent as the underlying account.
+func demuxAccount(address string) string {
+	accountID, _, err := strkey.DecodeMuxedAccount(address)
+	if err != nil {
+		return address
+	}
+	return accountID
+}
@@ -0,0 +1,625 @@
+package horizonclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+)
+
+// IteratorOptions configures the iterators returned by the NewXIterator
+// functions. The Limit of their request is the size of the pages fetched.
+type IteratorOptions struct {
+	// Limit is the maximum number of records returned, there is no limit when
+	// it's zero.
+	Limit int
+	// UntilLedger stops the iteration at the first record past this ledger:
+	// after it in ascending order, before it in descending order. It's
+	// ignored when zero, and by the asset, offer and trade aggregation
+	// iterators.
+	UntilLedger int32
+	// UntilTime stops the iteration at the first record past this time, like
+	// UntilLedger. It's ignored when zero, and by the asset and offer
+	// iterators.
+	UntilTime time.Time
+	// Stream makes the iterator stream the records added after the last
+	// page, instead of stopping there. It's ignored in descending order, and
+	// by the asset and trade aggregation iterators.
+	Stream bool
+}
+
+// pager fetches the records of a collection endpoint for an iterator.
+type pager struct {
+	// desc is true when the records are in descending order.
+	desc bool
+	// fetch returns the records of the first page, then of the following
+	// ones.
+	fetch func(ctx context.Context) ([]interface{}, error)
+	// stream streams the records following cursor to handler. It's nil when
+	// the endpoint can't be streamed.
+	stream func(ctx context.Context, cursor string, handler func(interface{})) error
+	// position returns the ledger and the close time of a record, which are
+	// zero when unknown. It's nil when neither is ever known.
+	position func(record interface{}) (int32, time.Time)
+}
+
+// iterator implements the iteration of the typed iterators, which embed it.
+type iterator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	options IteratorOptions
+	pager   pager
+
+	records []interface{}
+	current interface{}
+	count   int
+	cursor  string
+	last    bool
+	done    bool
+	err     error
+
+	streamed  chan interface{}
+	streamErr chan error
+}
+
+func newIterator(ctx context.Context, options IteratorOptions, p pager) iterator {
+	ctx, cancel := context.WithCancel(ctx)
+	return iterator{
+		ctx:     ctx,
+		cancel:  cancel,
+		options: options,
+		pager:   p,
+	}
+}
+
+// Next moves to the next record, fetching the next page when needed. It
+// returns false when the iteration is over or failed, see Err.
+func (it *iterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.options.Limit > 0 && it.count >= it.options.Limit {
+		it.Close()
+		return false
+	}
+
+	record, ok := it.next()
+	if !ok || it.past(record) {
+		it.Close()
+		return false
+	}
+
+	it.current = record
+	it.count++
+	if pageable, ok := record.(interface{ PagingToken() string }); ok {
+		it.cursor = pageable.PagingToken()
+	}
+	return true
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration, and the stream it reads from if any. It must be
+// called when the iteration is left before Next returns false.
+func (it *iterator) Close() {
+	it.done = true
+	it.cancel()
+}
+
+// next returns the next record of the current page, the next page or the
+// stream.
+func (it *iterator) next() (interface{}, bool) {
+	for len(it.records) == 0 {
+		if it.last {
+			return it.nextStreamed()
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return nil, false
+		}
+
+		records, err := it.pager.fetch(it.ctx)
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.records = records
+		it.last = len(records) == 0
+	}
+
+	record := it.records[0]
+	it.records = it.records[1:]
+	return record, true
+}
+
+// nextStreamed returns the next record of the stream, which is started after
+// the last record returned from the pages.
+func (it *iterator) nextStreamed() (interface{}, bool) {
+	if !it.options.Stream || it.pager.stream == nil || it.pager.desc {
+		return nil, false
+	}
+
+	if it.streamed == nil {
+		it.streamed = make(chan interface{})
+		it.streamErr = make(chan error, 1)
+		go func(cursor string) {
+			it.streamErr <- it.pager.stream(it.ctx, cursor, func(record interface{}) {
+				select {
+				case it.streamed <- record:
+				case <-it.ctx.Done():
+				}
+			})
+		}(it.cursor)
+	}
+
+	select {
+	case record := <-it.streamed:
+		return record, true
+	case err := <-it.streamErr:
+		if err == nil {
+			err = it.ctx.Err()
+		}
+		it.err = err
+		return nil, false
+	}
+}
+
+// past returns true if record is past the ledger or time boundary of the
+// iteration.
+func (it *iterator) past(record interface{}) bool {
+	if it.pager.position == nil {
+		return false
+	}
+	ledger, closedAt := it.pager.position(record)
+
+	if it.options.UntilLedger > 0 && ledger > 0 {
+		if it.pager.desc && ledger < it.options.UntilLedger ||
+			!it.pager.desc && ledger > it.options.UntilLedger {
+			return true
+		}
+	}
+	if !it.options.UntilTime.IsZero() && !closedAt.IsZero() {
+		if it.pager.desc && closedAt.Before(it.options.UntilTime) ||
+			!it.pager.desc && closedAt.After(it.options.UntilTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenLedger returns the ledger of the records whose paging token starts
+// with their TOID, e.g. "12884905985-1".
+func tokenLedger(record interface{}) int32 {
+	pageable, ok := record.(interface{ PagingToken() string })
+	if !ok {
+		return 0
+	}
+	id, err := strconv.ParseInt(strings.SplitN(pageable.PagingToken(), "-", 2)[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return int32(id >> 32)
+}
+
+// tokenPosition returns the position of operations and effects.
+func tokenPosition(record interface{}) (int32, time.Time) {
+	var closedAt time.Time
+	if closed, ok := record.(interface{ GetLedgerCloseTime() time.Time }); ok {
+		closedAt = closed.GetLedgerCloseTime()
+	}
+	return tokenLedger(record), closedAt
+}
+
+// AssetIterator iterates over the assets of an AssetRequest.
+type AssetIterator struct {
+	iterator
+}
+
+// NewAssetIterator returns an iterator over the assets of request, whose
+// pages are fetched from client when needed.
+func NewAssetIterator(ctx context.Context, client ClientInterface, request AssetRequest, options IteratorOptions) *AssetIterator {
+	var page *hProtocol.AssetsPage
+	return &AssetIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.AssetsPage
+			var err error
+			if page == nil {
+				next, err = client.AssetsContext(ctx, request)
+			} else {
+				next, err = client.NextAssetsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+	})}
+}
+
+// Asset returns the current asset.
+func (it *AssetIterator) Asset() hProtocol.AssetStat {
+	return it.current.(hProtocol.AssetStat)
+}
+
+// EffectIterator iterates over the effects of an EffectRequest.
+type EffectIterator struct {
+	iterator
+}
+
+// NewEffectIterator returns an iterator over the effects of request, whose
+// pages are fetched from client when needed.
+func NewEffectIterator(ctx context.Context, client ClientInterface, request EffectRequest, options IteratorOptions) *EffectIterator {
+	var page *effects.EffectsPage
+	return &EffectIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next effects.EffectsPage
+			var err error
+			if page == nil {
+				next, err = client.EffectsContext(ctx, request)
+			} else {
+				next, err = client.NextEffectsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamEffects(ctx, request, func(effect effects.Effect) {
+				handler(effect)
+			})
+		},
+		position: tokenPosition,
+	})}
+}
+
+// Effect returns the current effect.
+func (it *EffectIterator) Effect() effects.Effect {
+	return it.current.(effects.Effect)
+}
+
+// LedgerIterator iterates over the ledgers of a LedgerRequest.
+type LedgerIterator struct {
+	iterator
+}
+
+// NewLedgerIterator returns an iterator over the ledgers of request, whose
+// pages are fetched from client when needed.
+func NewLedgerIterator(ctx context.Context, client ClientInterface, request LedgerRequest, options IteratorOptions) *LedgerIterator {
+	var page *hProtocol.LedgersPage
+	return &LedgerIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.LedgersPage
+			var err error
+			if page == nil {
+				next, err = client.LedgersContext(ctx, request)
+			} else {
+				next, err = client.NextLedgersPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamLedgers(ctx, request, func(ledger hProtocol.Ledger) {
+				handler(ledger)
+			})
+		},
+		position: func(record interface{}) (int32, time.Time) {
+			ledger := record.(hProtocol.Ledger)
+			return ledger.Sequence, ledger.ClosedAt
+		},
+	})}
+}
+
+// Ledger returns the current ledger.
+func (it *LedgerIterator) Ledger() hProtocol.Ledger {
+	return it.current.(hProtocol.Ledger)
+}
+
+// OfferIterator iterates over the offers of an OfferRequest.
+type OfferIterator struct {
+	iterator
+}
+
+// NewOfferIterator returns an iterator over the offers of request, whose
+// pages are fetched from client when needed.
+func NewOfferIterator(ctx context.Context, client ClientInterface, request OfferRequest, options IteratorOptions) *OfferIterator {
+	var page *hProtocol.OffersPage
+	return &OfferIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.OffersPage
+			var err error
+			if page == nil {
+				next, err = client.OffersContext(ctx, request)
+			} else {
+				next, err = client.NextOffersPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamOffers(ctx, request, func(offer hProtocol.Offer) {
+				handler(offer)
+			})
+		},
+	})}
+}
+
+// Offer returns the current offer.
+func (it *OfferIterator) Offer() hProtocol.Offer {
+	return it.current.(hProtocol.Offer)
+}
+
+// OperationIterator iterates over the operations or the payments of an
+// OperationRequest.
+type OperationIterator struct {
+	iterator
+}
+
+// NewOperationIterator returns an iterator over the operations of request,
+// whose pages are fetched from client when needed.
+func NewOperationIterator(ctx context.Context, client ClientInterface, request OperationRequest, options IteratorOptions) *OperationIterator {
+	var page *operations.OperationsPage
+	return &OperationIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next operations.OperationsPage
+			var err error
+			if page == nil {
+				next, err = client.OperationsContext(ctx, request)
+			} else {
+				next, err = client.NextOperationsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+			return operationRecords(page), nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamOperations(ctx, request, func(op operations.Operation) {
+				handler(op)
+			})
+		},
+		position: tokenPosition,
+	})}
+}
+
+// NewPaymentIterator returns an iterator over the payments of request, whose
+// pages are fetched from client when needed.
+func NewPaymentIterator(ctx context.Context, client ClientInterface, request OperationRequest, options IteratorOptions) *OperationIterator {
+	var page *operations.OperationsPage
+	return &OperationIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next operations.OperationsPage
+			var err error
+			if page == nil {
+				next, err = client.PaymentsContext(ctx, request)
+			} else {
+				next, err = client.NextPaymentsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+			return operationRecords(page), nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamPayments(ctx, request, func(op operations.Operation) {
+				handler(op)
+			})
+		},
+		position: tokenPosition,
+	})}
+}
+
+func operationRecords(page *operations.OperationsPage) []interface{} {
+	records := make([]interface{}, len(page.Embedded.Records))
+	for i, record := range page.Embedded.Records {
+		records[i] = record
+	}
+	return records
+}
+
+// Operation returns the current operation.
+func (it *OperationIterator) Operation() operations.Operation {
+	return it.current.(operations.Operation)
+}
+
+// TransactionIterator iterates over the transactions of a
+// TransactionRequest.
+type TransactionIterator struct {
+	iterator
+}
+
+// NewTransactionIterator returns an iterator over the transactions of
+// request, whose pages are fetched from client when needed.
+func NewTransactionIterator(ctx context.Context, client ClientInterface, request TransactionRequest, options IteratorOptions) *TransactionIterator {
+	var page *hProtocol.TransactionsPage
+	return &TransactionIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.TransactionsPage
+			var err error
+			if page == nil {
+				next, err = client.TransactionsContext(ctx, request)
+			} else {
+				next, err = client.NextTransactionsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamTransactions(ctx, request, func(tx hProtocol.Transaction) {
+				handler(tx)
+			})
+		},
+		position: func(record interface{}) (int32, time.Time) {
+			tx := record.(hProtocol.Transaction)
+			return tx.Ledger, tx.LedgerCloseTime
+		},
+	})}
+}
+
+// Transaction returns the current transaction.
+func (it *TransactionIterator) Transaction() hProtocol.Transaction {
+	return it.current.(hProtocol.Transaction)
+}
+
+// TradeIterator iterates over the trades of a TradeRequest.
+type TradeIterator struct {
+	iterator
+}
+
+// NewTradeIterator returns an iterator over the trades of request, whose
+// pages are fetched from client when needed.
+func NewTradeIterator(ctx context.Context, client ClientInterface, request TradeRequest, options IteratorOptions) *TradeIterator {
+	var page *hProtocol.TradesPage
+	return &TradeIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.TradesPage
+			var err error
+			if page == nil {
+				next, err = client.TradesContext(ctx, request)
+			} else {
+				next, err = client.NextTradesPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		stream: func(ctx context.Context, cursor string, handler func(interface{})) error {
+			if cursor != "" {
+				request.Cursor = cursor
+			}
+			return client.StreamTrades(ctx, request, func(trade hProtocol.Trade) {
+				handler(trade)
+			})
+		},
+		position: func(record interface{}) (int32, time.Time) {
+			return tokenLedger(record), record.(hProtocol.Trade).LedgerCloseTime
+		},
+	})}
+}
+
+// Trade returns the current trade.
+func (it *TradeIterator) Trade() hProtocol.Trade {
+	return it.current.(hProtocol.Trade)
+}
+
+// TradeAggregationIterator iterates over the trade aggregations of a
+// TradeAggregationRequest.
+type TradeAggregationIterator struct {
+	iterator
+}
+
+// NewTradeAggregationIterator returns an iterator over the trade aggregations
+// of request, whose pages are fetched from client when needed.
+func NewTradeAggregationIterator(ctx context.Context, client ClientInterface, request TradeAggregationRequest, options IteratorOptions) *TradeAggregationIterator {
+	var page *hProtocol.TradeAggregationsPage
+	return &TradeAggregationIterator{newIterator(ctx, options, pager{
+		desc: request.Order == OrderDesc,
+		fetch: func(ctx context.Context) ([]interface{}, error) {
+			var next hProtocol.TradeAggregationsPage
+			var err error
+			if page == nil {
+				next, err = client.TradeAggregationsContext(ctx, request)
+			} else {
+				next, err = client.NextTradeAggregationsPageContext(ctx, *page)
+			}
+			if err != nil {
+				return nil, err
+			}
+			page = &next
+
+			records := make([]interface{}, len(page.Embedded.Records))
+			for i, record := range page.Embedded.Records {
+				records[i] = record
+			}
+			return records, nil
+		},
+		position: func(record interface{}) (int32, time.Time) {
+			timestamp := record.(hProtocol.TradeAggregation).Timestamp
+			return 0, time.Unix(0, timestamp*int64(time.Millisecond))
+		},
+	})}
+}
+
+// TradeAggregation returns the current trade aggregation.
+func (it *TradeAggregationIterator) TradeAggregation() hProtocol.TradeAggregation {
+	return it.current.(hProtocol.TradeAggregation)
+}
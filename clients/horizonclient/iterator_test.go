@@ -0,0 +1,135 @@
+package horizonclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func tradesPage(next string, trades ...hProtocol.Trade) hProtocol.TradesPage {
+	var page hProtocol.TradesPage
+	page.Links.Next.Href = next
+	page.Embedded.Records = trades
+	return page
+}
+
+func TestTradeIterator(t *testing.T) {
+	hmock := &MockClient{}
+	request := TradeRequest{Limit: 2}
+	first := tradesPage("2", hProtocol.Trade{ID: "1"}, hProtocol.Trade{ID: "2"})
+	second := tradesPage("3", hProtocol.Trade{ID: "3"})
+	hmock.On("Trades", request).Return(first, nil).Once()
+	hmock.On("NextTradesPage", first).Return(second, nil).Once()
+	hmock.On("NextTradesPage", second).Return(tradesPage("3"), nil).Once()
+
+	it := NewTradeIterator(context.Background(), hmock, request, IteratorOptions{})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Trade().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.False(t, it.Next())
+	hmock.AssertExpectations(t)
+
+	// the next pages aren't fetched once the limit is reached
+	hmock = &MockClient{}
+	hmock.On("Trades", request).Return(first, nil).Once()
+	it = NewTradeIterator(context.Background(), hmock, request, IteratorOptions{Limit: 2})
+	ids = nil
+	for it.Next() {
+		ids = append(ids, it.Trade().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2"}, ids)
+	hmock.AssertExpectations(t)
+}
+
+func TestTradeIteratorUntilTime(t *testing.T) {
+	now := time.Now()
+	hmock := &MockClient{}
+	request := TradeRequest{Order: OrderDesc}
+	hmock.On("Trades", request).Return(tradesPage("2",
+		hProtocol.Trade{ID: "3", LedgerCloseTime: now},
+		hProtocol.Trade{ID: "2", LedgerCloseTime: now.Add(-time.Hour)},
+		hProtocol.Trade{ID: "1", LedgerCloseTime: now.Add(-2 * time.Hour)},
+	), nil).Once()
+
+	it := NewTradeIterator(context.Background(), hmock, request, IteratorOptions{
+		UntilTime: now.Add(-90 * time.Minute),
+	})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Trade().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"3", "2"}, ids)
+	hmock.AssertExpectations(t)
+}
+
+func TestOperationIteratorUntilLedger(t *testing.T) {
+	hmock := &MockClient{}
+	request := OperationRequest{ForAccount: "GABC"}
+	var page operations.OperationsPage
+	page.Embedded.Records = []operations.Operation{
+		operations.Payment{Base: operations.Base{ID: "12884905985", PT: "12884905985"}},
+		operations.Payment{Base: operations.Base{ID: "12884905986", PT: "12884905986"}},
+		operations.Payment{Base: operations.Base{ID: "17179873281", PT: "17179873281"}},
+	}
+	hmock.On("Operations", request).Return(page, nil).Once()
+
+	it := NewOperationIterator(context.Background(), hmock, request, IteratorOptions{UntilLedger: 3})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Operation().GetID())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"12884905985", "12884905986"}, ids)
+	hmock.AssertExpectations(t)
+}
+
+func TestLedgerIteratorStream(t *testing.T) {
+	hmock := &MockClient{}
+	request := LedgerRequest{}
+	var page hProtocol.LedgersPage
+	page.Embedded.Records = []hProtocol.Ledger{{Sequence: 1, PT: "4294967296"}}
+	hmock.On("Ledgers", request).Return(page, nil).Once()
+	hmock.On("NextLedgersPage", page).Return(hProtocol.LedgersPage{}, nil).Once()
+	hmock.On("StreamLedgers", mock.Anything, LedgerRequest{Cursor: "4294967296"}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			ctx := args.Get(0).(context.Context)
+			handler := args.Get(2).(LedgerHandler)
+			handler(hProtocol.Ledger{Sequence: 2, PT: "8589934592"})
+			handler(hProtocol.Ledger{Sequence: 3, PT: "12884901888"})
+			<-ctx.Done()
+		}).
+		Return(nil).Once()
+
+	it := NewLedgerIterator(context.Background(), hmock, request, IteratorOptions{Stream: true})
+	var sequences []int32
+	for it.Next() {
+		sequences = append(sequences, it.Ledger().Sequence)
+		if len(sequences) == 2 {
+			it.Close()
+		}
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int32{1, 2}, sequences)
+}
+
+func TestIteratorContext(t *testing.T) {
+	hmock := &MockClient{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := NewLedgerIterator(ctx, hmock, LedgerRequest{}, IteratorOptions{})
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+	hmock.AssertExpectations(t)
+}
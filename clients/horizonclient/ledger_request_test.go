@@ -2,10 +2,13 @@ package horizonclient
 
 import (
 	"context"
+	"net/http"
+	stdhttptest "net/http/httptest"
 	"testing"
 
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/support/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -151,6 +154,25 @@ func TestLedgerRequestStreamLedgers(t *testing.T) {
 	}
 }
 
+func TestStreamLedgersTraceparent(t *testing.T) {
+	trace := log.NewTrace()
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, trace.Traceparent(), r.Header.Get(log.TraceparentHeader))
+		w.Write([]byte(ledgerStreamResponse))
+	}))
+	defer server.Close()
+
+	client := &Client{HorizonURL: server.URL}
+	ctx, cancel := context.WithCancel(log.WithTrace(context.Background(), trace))
+	var ledgers []hProtocol.Ledger
+	err := client.StreamLedgers(ctx, LedgerRequest{}, func(ledger hProtocol.Ledger) {
+		ledgers = append(ledgers, ledger)
+		cancel()
+	})
+	require.NoError(t, err)
+	assert.Len(t, ledgers, 1)
+}
+
 func TestNextLedgersPage(t *testing.T) {
 	hmock := httptest.NewClient()
 	client := &Client{
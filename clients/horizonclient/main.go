@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	hProtocol "github.com/stellar/go/protocols/horizon"
@@ -135,33 +136,63 @@ type Client struct {
 	horizonTimeOut time.Duration
 	isTestNet      bool
 
+	// RetryPolicy decides if and when failed requests and closed streams are
+	// retried, see RetryPolicy. Requests aren't retried when it's nil.
+	RetryPolicy RetryPolicy
+
 	// clock is a Clock returning the current time.
 	clock *clock.Clock
+
+	// rateLimit holds the RateLimit reported by the last response.
+	rateLimit atomic.Value
 }
 
 // ClientInterface contains methods implemented by the horizon client
 type ClientInterface interface {
 	AccountDetail(request AccountRequest) (hProtocol.Account, error)
+	AccountDetailContext(ctx context.Context, request AccountRequest) (hProtocol.Account, error)
 	AccountData(request AccountRequest) (hProtocol.AccountData, error)
+	AccountDataContext(ctx context.Context, request AccountRequest) (hProtocol.AccountData, error)
 	Effects(request EffectRequest) (effects.EffectsPage, error)
+	EffectsContext(ctx context.Context, request EffectRequest) (effects.EffectsPage, error)
 	Assets(request AssetRequest) (hProtocol.AssetsPage, error)
+	AssetsContext(ctx context.Context, request AssetRequest) (hProtocol.AssetsPage, error)
 	Ledgers(request LedgerRequest) (hProtocol.LedgersPage, error)
+	LedgersContext(ctx context.Context, request LedgerRequest) (hProtocol.LedgersPage, error)
 	LedgerDetail(sequence uint32) (hProtocol.Ledger, error)
+	LedgerDetailContext(ctx context.Context, sequence uint32) (hProtocol.Ledger, error)
 	Metrics() (hProtocol.Metrics, error)
+	MetricsContext(ctx context.Context) (hProtocol.Metrics, error)
 	FeeStats() (hProtocol.FeeStats, error)
+	FeeStatsContext(ctx context.Context) (hProtocol.FeeStats, error)
+	FeeInclusion(request FeeInclusionRequest) (hProtocol.FeeInclusion, error)
+	FeeInclusionContext(ctx context.Context, request FeeInclusionRequest) (hProtocol.FeeInclusion, error)
 	Offers(request OfferRequest) (hProtocol.OffersPage, error)
+	OffersContext(ctx context.Context, request OfferRequest) (hProtocol.OffersPage, error)
 	Operations(request OperationRequest) (operations.OperationsPage, error)
+	OperationsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error)
 	OperationDetail(id string) (operations.Operation, error)
+	OperationDetailContext(ctx context.Context, id string) (operations.Operation, error)
 	SubmitTransactionXDR(transactionXdr string) (hProtocol.TransactionSuccess, error)
+	SubmitTransactionXDRContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error)
 	SubmitTransaction(transactionXdr txnbuild.Transaction) (hProtocol.TransactionSuccess, error)
+	SubmitTransactionContext(ctx context.Context, transactionXdr txnbuild.Transaction) (hProtocol.TransactionSuccess, error)
 	Transactions(request TransactionRequest) (hProtocol.TransactionsPage, error)
+	TransactionsContext(ctx context.Context, request TransactionRequest) (hProtocol.TransactionsPage, error)
 	TransactionDetail(txHash string) (hProtocol.Transaction, error)
+	TransactionDetailContext(ctx context.Context, txHash string) (hProtocol.Transaction, error)
 	OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error)
+	OrderBookContext(ctx context.Context, request OrderBookRequest) (hProtocol.OrderBookSummary, error)
 	Paths(request PathsRequest) (hProtocol.PathsPage, error)
+	PathsContext(ctx context.Context, request PathsRequest) (hProtocol.PathsPage, error)
 	Payments(request OperationRequest) (operations.OperationsPage, error)
+	PaymentsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error)
 	TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error)
+	TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error)
 	Trades(request TradeRequest) (hProtocol.TradesPage, error)
+	TradesContext(ctx context.Context, request TradeRequest) (hProtocol.TradesPage, error)
 	Fund(addr string) (hProtocol.TransactionSuccess, error)
+	FundContext(ctx context.Context, addr string) (hProtocol.TransactionSuccess, error)
 	StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error
 	StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error
 	StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error
@@ -171,25 +202,45 @@ type ClientInterface interface {
 	StreamLedgers(ctx context.Context, request LedgerRequest, handler LedgerHandler) error
 	StreamOrderBooks(ctx context.Context, request OrderBookRequest, handler OrderBookHandler) error
 	Root() (hProtocol.Root, error)
+	RootContext(ctx context.Context) (hProtocol.Root, error)
 	NextAssetsPage(hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
+	NextAssetsPageContext(context.Context, hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
 	PrevAssetsPage(hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
+	PrevAssetsPageContext(context.Context, hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
 	NextLedgersPage(hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
+	NextLedgersPageContext(context.Context, hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
 	PrevLedgersPage(hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
+	PrevLedgersPageContext(context.Context, hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
 	NextEffectsPage(effects.EffectsPage) (effects.EffectsPage, error)
+	NextEffectsPageContext(context.Context, effects.EffectsPage) (effects.EffectsPage, error)
 	PrevEffectsPage(effects.EffectsPage) (effects.EffectsPage, error)
+	PrevEffectsPageContext(context.Context, effects.EffectsPage) (effects.EffectsPage, error)
 	NextTransactionsPage(hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
+	NextTransactionsPageContext(context.Context, hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
 	PrevTransactionsPage(hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
+	PrevTransactionsPageContext(context.Context, hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
 	NextOperationsPage(operations.OperationsPage) (operations.OperationsPage, error)
+	NextOperationsPageContext(context.Context, operations.OperationsPage) (operations.OperationsPage, error)
 	PrevOperationsPage(operations.OperationsPage) (operations.OperationsPage, error)
+	PrevOperationsPageContext(context.Context, operations.OperationsPage) (operations.OperationsPage, error)
 	NextPaymentsPage(operations.OperationsPage) (operations.OperationsPage, error)
+	NextPaymentsPageContext(context.Context, operations.OperationsPage) (operations.OperationsPage, error)
 	PrevPaymentsPage(operations.OperationsPage) (operations.OperationsPage, error)
+	PrevPaymentsPageContext(context.Context, operations.OperationsPage) (operations.OperationsPage, error)
 	NextOffersPage(hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	NextOffersPageContext(context.Context, hProtocol.OffersPage) (hProtocol.OffersPage, error)
 	PrevOffersPage(hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	PrevOffersPageContext(context.Context, hProtocol.OffersPage) (hProtocol.OffersPage, error)
 	NextTradesPage(hProtocol.TradesPage) (hProtocol.TradesPage, error)
+	NextTradesPageContext(context.Context, hProtocol.TradesPage) (hProtocol.TradesPage, error)
 	PrevTradesPage(hProtocol.TradesPage) (hProtocol.TradesPage, error)
+	PrevTradesPageContext(context.Context, hProtocol.TradesPage) (hProtocol.TradesPage, error)
 	HomeDomainForAccount(aid string) (string, error)
+	HomeDomainForAccountContext(ctx context.Context, aid string) (string, error)
 	NextTradeAggregationsPage(hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error)
+	NextTradeAggregationsPageContext(context.Context, hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error)
 	PrevTradeAggregationsPage(hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error)
+	PrevTradeAggregationsPageContext(context.Context, hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error)
 }
 
 // DefaultTestNetClient is a default client to connect to test network.
@@ -262,6 +313,14 @@ type feeStatsRequest struct {
 	endpoint string
 }
 
+// FeeInclusionRequest struct contains data for estimating the probability that a transaction
+// bidding a fee per operation is included in the next ledger. "Fee" is required, "Ledgers" is
+// the number of recent ledgers the estimate is made from (Horizon defaults to 5).
+type FeeInclusionRequest struct {
+	Fee     uint32
+	Ledgers uint
+}
+
 // OfferRequest struct contains data for getting offers made by an account from a horizon server.
 // "ForAccount" is required.
 // The query parameters (Order, Cursor and Limit) are optional. All or none can be set.
@@ -292,6 +351,8 @@ type OperationRequest struct {
 type submitRequest struct {
 	endpoint       string
 	transactionXdr string
+	// hash is the hex hash of the transaction, when known.
+	hash string
 }
 
 // TransactionRequest struct contains data for getting transaction details from a horizon server.
@@ -321,7 +382,8 @@ type OrderBookRequest struct {
 }
 
 // PathsRequest struct contains data for getting available payment paths from a horizon server.
-// All parameters are required.
+// All parameters are required. DestinationAccount can be a muxed account address ("M..."), in
+// which case the paths to the account it's made of are returned.
 type PathsRequest struct {
 	DestinationAccount     string
 	DestinationAssetType   AssetType
@@ -21,126 +21,242 @@ func (m *MockClient) AccountDetail(request AccountRequest) (hProtocol.Account, e
 	return a.Get(0).(hProtocol.Account), a.Error(1)
 }
 
+// AccountDetailContext is a mocking method, which shares the expectations of AccountDetail
+func (m *MockClient) AccountDetailContext(ctx context.Context, request AccountRequest) (hProtocol.Account, error) {
+	return m.AccountDetail(request)
+}
+
 // AccountData is a mocking method
 func (m *MockClient) AccountData(request AccountRequest) (hProtocol.AccountData, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.AccountData), a.Error(1)
 }
 
+// AccountDataContext is a mocking method, which shares the expectations of AccountData
+func (m *MockClient) AccountDataContext(ctx context.Context, request AccountRequest) (hProtocol.AccountData, error) {
+	return m.AccountData(request)
+}
+
 // Effects is a mocking method
 func (m *MockClient) Effects(request EffectRequest) (effects.EffectsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(effects.EffectsPage), a.Error(1)
 }
 
+// EffectsContext is a mocking method, which shares the expectations of Effects
+func (m *MockClient) EffectsContext(ctx context.Context, request EffectRequest) (effects.EffectsPage, error) {
+	return m.Effects(request)
+}
+
 // Assets is a mocking method
 func (m *MockClient) Assets(request AssetRequest) (hProtocol.AssetsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
 }
 
+// AssetsContext is a mocking method, which shares the expectations of Assets
+func (m *MockClient) AssetsContext(ctx context.Context, request AssetRequest) (hProtocol.AssetsPage, error) {
+	return m.Assets(request)
+}
+
 // Ledgers is a mocking method
 func (m *MockClient) Ledgers(request LedgerRequest) (hProtocol.LedgersPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
 }
 
+// LedgersContext is a mocking method, which shares the expectations of Ledgers
+func (m *MockClient) LedgersContext(ctx context.Context, request LedgerRequest) (hProtocol.LedgersPage, error) {
+	return m.Ledgers(request)
+}
+
 // LedgerDetail is a mocking method
 func (m *MockClient) LedgerDetail(sequence uint32) (hProtocol.Ledger, error) {
 	a := m.Called(sequence)
 	return a.Get(0).(hProtocol.Ledger), a.Error(1)
 }
 
+// LedgerDetailContext is a mocking method, which shares the expectations of LedgerDetail
+func (m *MockClient) LedgerDetailContext(ctx context.Context, sequence uint32) (hProtocol.Ledger, error) {
+	return m.LedgerDetail(sequence)
+}
+
 // Metrics is a mocking method
 func (m *MockClient) Metrics() (hProtocol.Metrics, error) {
 	a := m.Called()
 	return a.Get(0).(hProtocol.Metrics), a.Error(1)
 }
 
+// MetricsContext is a mocking method, which shares the expectations of Metrics
+func (m *MockClient) MetricsContext(ctx context.Context) (hProtocol.Metrics, error) {
+	return m.Metrics()
+}
+
 // FeeStats is a mocking method
 func (m *MockClient) FeeStats() (hProtocol.FeeStats, error) {
 	a := m.Called()
 	return a.Get(0).(hProtocol.FeeStats), a.Error(1)
 }
 
+// FeeStatsContext is a mocking method, which shares the expectations of FeeStats
+func (m *MockClient) FeeStatsContext(ctx context.Context) (hProtocol.FeeStats, error) {
+	return m.FeeStats()
+}
+
+// FeeInclusion is a mocking method
+func (m *MockClient) FeeInclusion(request FeeInclusionRequest) (hProtocol.FeeInclusion, error) {
+	a := m.Called(request)
+	return a.Get(0).(hProtocol.FeeInclusion), a.Error(1)
+}
+
+// FeeInclusionContext is a mocking method, which shares the expectations of FeeInclusion
+func (m *MockClient) FeeInclusionContext(ctx context.Context, request FeeInclusionRequest) (hProtocol.FeeInclusion, error) {
+	return m.FeeInclusion(request)
+}
+
 // Offers is a mocking method
 func (m *MockClient) Offers(request OfferRequest) (hProtocol.OffersPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
 }
 
+// OffersContext is a mocking method, which shares the expectations of Offers
+func (m *MockClient) OffersContext(ctx context.Context, request OfferRequest) (hProtocol.OffersPage, error) {
+	return m.Offers(request)
+}
+
 // Operations is a mocking method
 func (m *MockClient) Operations(request OperationRequest) (operations.OperationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// OperationsContext is a mocking method, which shares the expectations of Operations
+func (m *MockClient) OperationsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error) {
+	return m.Operations(request)
+}
+
 // OperationDetail is a mocking method
 func (m *MockClient) OperationDetail(id string) (operations.Operation, error) {
 	a := m.Called(id)
 	return a.Get(0).(operations.Operation), a.Error(1)
 }
 
+// OperationDetailContext is a mocking method, which shares the expectations of OperationDetail
+func (m *MockClient) OperationDetailContext(ctx context.Context, id string) (operations.Operation, error) {
+	return m.OperationDetail(id)
+}
+
 // SubmitTransactionXDR is a mocking method
 func (m *MockClient) SubmitTransactionXDR(transactionXdr string) (hProtocol.TransactionSuccess, error) {
 	a := m.Called(transactionXdr)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
 
+// SubmitTransactionXDRContext is a mocking method, which shares the expectations of SubmitTransactionXDR
+func (m *MockClient) SubmitTransactionXDRContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	return m.SubmitTransactionXDR(transactionXdr)
+}
+
 // SubmitTransaction is a mocking method
 func (m *MockClient) SubmitTransaction(transaction txnbuild.Transaction) (hProtocol.TransactionSuccess, error) {
 	a := m.Called(transaction)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
 
+// SubmitTransactionContext is a mocking method, which shares the expectations of SubmitTransaction
+func (m *MockClient) SubmitTransactionContext(ctx context.Context, transaction txnbuild.Transaction) (hProtocol.TransactionSuccess, error) {
+	return m.SubmitTransaction(transaction)
+}
+
 // Transactions is a mocking method
 func (m *MockClient) Transactions(request TransactionRequest) (hProtocol.TransactionsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
 }
 
+// TransactionsContext is a mocking method, which shares the expectations of Transactions
+func (m *MockClient) TransactionsContext(ctx context.Context, request TransactionRequest) (hProtocol.TransactionsPage, error) {
+	return m.Transactions(request)
+}
+
 // TransactionDetail is a mocking method
 func (m *MockClient) TransactionDetail(txHash string) (hProtocol.Transaction, error) {
 	a := m.Called(txHash)
 	return a.Get(0).(hProtocol.Transaction), a.Error(1)
 }
 
+// TransactionDetailContext is a mocking method, which shares the expectations of TransactionDetail
+func (m *MockClient) TransactionDetailContext(ctx context.Context, txHash string) (hProtocol.Transaction, error) {
+	return m.TransactionDetail(txHash)
+}
+
 // OrderBook is a mocking method
 func (m *MockClient) OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.OrderBookSummary), a.Error(1)
 }
 
+// OrderBookContext is a mocking method, which shares the expectations of OrderBook
+func (m *MockClient) OrderBookContext(ctx context.Context, request OrderBookRequest) (hProtocol.OrderBookSummary, error) {
+	return m.OrderBook(request)
+}
+
 // Paths is a mocking method
 func (m *MockClient) Paths(request PathsRequest) (hProtocol.PathsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.PathsPage), a.Error(1)
 }
 
+// PathsContext is a mocking method, which shares the expectations of Paths
+func (m *MockClient) PathsContext(ctx context.Context, request PathsRequest) (hProtocol.PathsPage, error) {
+	return m.Paths(request)
+}
+
 // Payments is a mocking method
 func (m *MockClient) Payments(request OperationRequest) (operations.OperationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// PaymentsContext is a mocking method, which shares the expectations of Payments
+func (m *MockClient) PaymentsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error) {
+	return m.Payments(request)
+}
+
 // TradeAggregations is a mocking method
 func (m *MockClient) TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TradeAggregationsPage), a.Error(1)
 }
 
+// TradeAggregationsContext is a mocking method, which shares the expectations of TradeAggregations
+func (m *MockClient) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
+	return m.TradeAggregations(request)
+}
+
 // Trades is a mocking method
 func (m *MockClient) Trades(request TradeRequest) (hProtocol.TradesPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
 }
 
+// TradesContext is a mocking method, which shares the expectations of Trades
+func (m *MockClient) TradesContext(ctx context.Context, request TradeRequest) (hProtocol.TradesPage, error) {
+	return m.Trades(request)
+}
+
 // Fund is a mocking method
 func (m *MockClient) Fund(addr string) (hProtocol.TransactionSuccess, error) {
 	a := m.Called(addr)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
 
+// FundContext is a mocking method, which shares the expectations of Fund
+func (m *MockClient) FundContext(ctx context.Context, addr string) (hProtocol.TransactionSuccess, error) {
+	return m.Fund(addr)
+}
+
 // StreamTransactions is a mocking method
 func (m *MockClient) StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error {
 	return m.Called(ctx, request, handler).Error(0)
@@ -187,117 +303,217 @@ func (m *MockClient) Root() (hProtocol.Root, error) {
 	return a.Get(0).(hProtocol.Root), a.Error(1)
 }
 
+// RootContext is a mocking method, which shares the expectations of Root
+func (m *MockClient) RootContext(ctx context.Context) (hProtocol.Root, error) {
+	return m.Root()
+}
+
 // NextAssetsPage is a mocking method
 func (m *MockClient) NextAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
 }
 
+// NextAssetsPageContext is a mocking method, which shares the expectations of NextAssetsPage
+func (m *MockClient) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	return m.NextAssetsPage(page)
+}
+
 // PrevAssetsPage is a mocking method
 func (m *MockClient) PrevAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
 }
 
+// PrevAssetsPageContext is a mocking method, which shares the expectations of PrevAssetsPage
+func (m *MockClient) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	return m.PrevAssetsPage(page)
+}
+
 // NextLedgersPage is a mocking method
 func (m *MockClient) NextLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
 }
 
+// NextLedgersPageContext is a mocking method, which shares the expectations of NextLedgersPage
+func (m *MockClient) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	return m.NextLedgersPage(page)
+}
+
 // PrevLedgersPage is a mocking method
 func (m *MockClient) PrevLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
 }
 
+// PrevLedgersPageContext is a mocking method, which shares the expectations of PrevLedgersPage
+func (m *MockClient) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	return m.PrevLedgersPage(page)
+}
+
 // NextEffectsPage is a mocking method
 func (m *MockClient) NextEffectsPage(page effects.EffectsPage) (effects.EffectsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(effects.EffectsPage), a.Error(1)
 }
 
+// NextEffectsPageContext is a mocking method, which shares the expectations of NextEffectsPage
+func (m *MockClient) NextEffectsPageContext(ctx context.Context, page effects.EffectsPage) (effects.EffectsPage, error) {
+	return m.NextEffectsPage(page)
+}
+
 // PrevEffectsPage is a mocking method
 func (m *MockClient) PrevEffectsPage(page effects.EffectsPage) (effects.EffectsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(effects.EffectsPage), a.Error(1)
 }
 
+// PrevEffectsPageContext is a mocking method, which shares the expectations of PrevEffectsPage
+func (m *MockClient) PrevEffectsPageContext(ctx context.Context, page effects.EffectsPage) (effects.EffectsPage, error) {
+	return m.PrevEffectsPage(page)
+}
+
 // NextTransactionsPage is a mocking method
 func (m *MockClient) NextTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
 }
 
+// NextTransactionsPageContext is a mocking method, which shares the expectations of NextTransactionsPage
+func (m *MockClient) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	return m.NextTransactionsPage(page)
+}
+
 // PrevTransactionsPage is a mocking method
 func (m *MockClient) PrevTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
 }
 
+// PrevTransactionsPageContext is a mocking method, which shares the expectations of PrevTransactionsPage
+func (m *MockClient) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	return m.PrevTransactionsPage(page)
+}
+
 // NextOperationsPage is a mocking method
 func (m *MockClient) NextOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// NextOperationsPageContext is a mocking method, which shares the expectations of NextOperationsPage
+func (m *MockClient) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.NextOperationsPage(page)
+}
+
 // PrevOperationsPage is a mocking method
 func (m *MockClient) PrevOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// PrevOperationsPageContext is a mocking method, which shares the expectations of PrevOperationsPage
+func (m *MockClient) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.PrevOperationsPage(page)
+}
+
 // NextPaymentsPage is a mocking method
 func (m *MockClient) NextPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
 	return m.NextOperationsPage(page)
 }
 
+// NextPaymentsPageContext is a mocking method, which shares the expectations of NextPaymentsPage
+func (m *MockClient) NextPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.NextPaymentsPage(page)
+}
+
 // PrevPaymentsPage is a mocking method
 func (m *MockClient) PrevPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
 	return m.PrevOperationsPage(page)
 }
 
+// PrevPaymentsPageContext is a mocking method, which shares the expectations of PrevPaymentsPage
+func (m *MockClient) PrevPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.PrevPaymentsPage(page)
+}
+
 // NextOffersPage is a mocking method
 func (m *MockClient) NextOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
 }
 
+// NextOffersPageContext is a mocking method, which shares the expectations of NextOffersPage
+func (m *MockClient) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	return m.NextOffersPage(page)
+}
+
 // PrevOffersPage is a mocking method
 func (m *MockClient) PrevOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
 }
 
+// PrevOffersPageContext is a mocking method, which shares the expectations of PrevOffersPage
+func (m *MockClient) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	return m.PrevOffersPage(page)
+}
+
 // NextTradesPage is a mocking method
 func (m *MockClient) NextTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
 }
 
+// NextTradesPageContext is a mocking method, which shares the expectations of NextTradesPage
+func (m *MockClient) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	return m.NextTradesPage(page)
+}
+
 // PrevTradesPage is a mocking method
 func (m *MockClient) PrevTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
 }
 
+// PrevTradesPageContext is a mocking method, which shares the expectations of PrevTradesPage
+func (m *MockClient) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	return m.PrevTradesPage(page)
+}
+
 // HomeDomainForAccount is a mocking method
 func (m *MockClient) HomeDomainForAccount(aid string) (string, error) {
 	a := m.Called(aid)
 	return a.Get(0).(string), a.Error(1)
 }
 
+// HomeDomainForAccountContext is a mocking method, which shares the expectations of HomeDomainForAccount
+func (m *MockClient) HomeDomainForAccountContext(ctx context.Context, aid string) (string, error) {
+	return m.HomeDomainForAccount(aid)
+}
+
 // NextTradeAggregationsPage is a mocking method
 func (m *MockClient) NextTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TradeAggregationsPage), a.Error(1)
 }
 
+// NextTradeAggregationsPageContext is a mocking method, which shares the expectations of NextTradeAggregationsPage
+func (m *MockClient) NextTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
+	return m.NextTradeAggregationsPage(page)
+}
+
 // PrevTradeAggregationsPage is a mocking method
 func (m *MockClient) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
 	a := m.Called(page)
 	return a.Get(0).(hProtocol.TradeAggregationsPage), a.Error(1)
 }
 
+// PrevTradeAggregationsPageContext is a mocking method, which shares the expectations of PrevTradeAggregationsPage
+func (m *MockClient) PrevTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
+	return m.PrevTradeAggregationsPage(page)
+}
+
 // ensure that the MockClient implements ClientInterface
 var _ ClientInterface = &MockClient{}
@@ -0,0 +1,965 @@
+package horizonclient
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default HealthCheckInterval of a
+	// MultiClient.
+	DefaultHealthCheckInterval = 5 * time.Second
+	// DefaultConsistencyTimeout is the default ConsistencyTimeout of a
+	// MultiClient.
+	DefaultConsistencyTimeout = 30 * time.Second
+
+	// consistencyPollInterval is how often the nodes are checked while
+	// waiting for one of them to ingest a ledger.
+	consistencyPollInterval = time.Second
+)
+
+// MultiClient is a ClientInterface spreading the requests over several
+// Horizon nodes of the same network.
+//
+// Reads go to the healthy node which ingested the most recent ledger, and fail
+// over to the other nodes when it fails. Transactions are submitted to all the
+// healthy nodes at once, and the first success is returned. Once a transaction
+// was submitted, reads only go to the nodes which ingested its ledger, waiting
+// for one of them to catch up when needed, so that they see its effects.
+//
+// The links of the pages returned by Horizon point to the node which returned
+// them, so the Next and Prev page methods don't fail over to other nodes.
+type MultiClient struct {
+	// HealthCheckInterval is how often the latest ledger of the nodes is
+	// checked with Root, before sending reads. DefaultHealthCheckInterval is
+	// used when it's zero.
+	HealthCheckInterval time.Duration
+
+	// ConsistencyTimeout is how long reads wait for a node to ingest the
+	// ledger of the last submitted transaction before failing.
+	// DefaultConsistencyTimeout is used when it's zero.
+	ConsistencyTimeout time.Duration
+
+	nodes       []*node
+	mutex       sync.Mutex
+	checkedAt   time.Time
+	minLedger   int32
+	submissions map[string]*submission
+}
+
+// node is a Horizon node of a MultiClient.
+type node struct {
+	client  ClientInterface
+	healthy bool
+	ledger  int32
+}
+
+// submission is a transaction being submitted by a MultiClient, which
+// concurrent submissions of the same transaction wait for.
+type submission struct {
+	done      chan struct{}
+	txSuccess hProtocol.TransactionSuccess
+	err       error
+}
+
+// NewMultiClient returns a MultiClient sending requests with the clients of
+// the Horizon nodes.
+func NewMultiClient(clients ...ClientInterface) *MultiClient {
+	m := &MultiClient{submissions: map[string]*submission{}}
+	for _, client := range clients {
+		m.nodes = append(m.nodes, &node{client: client, healthy: true})
+	}
+	return m
+}
+
+// CheckHealth fetches the latest ledger ingested by every node. The nodes
+// which fail aren't sent requests until they are healthy again, unless all
+// the nodes fail.
+func (m *MultiClient) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, n := range m.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+			root, err := n.client.RootContext(ctx)
+
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			n.healthy = err == nil
+			if err == nil {
+				n.ledger = root.HorizonSequence
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	m.mutex.Lock()
+	m.checkedAt = time.Now()
+	m.mutex.Unlock()
+}
+
+// readNodes returns the nodes reads are sent to, the most up to date first.
+func (m *MultiClient) readNodes(ctx context.Context) ([]*node, error) {
+	interval := m.HealthCheckInterval
+	if interval == 0 {
+		interval = DefaultHealthCheckInterval
+	}
+	m.mutex.Lock()
+	due := time.Since(m.checkedAt) >= interval
+	m.mutex.Unlock()
+	if due {
+		m.CheckHealth(ctx)
+	}
+
+	timeout := m.ConsistencyTimeout
+	if timeout == 0 {
+		timeout = DefaultConsistencyTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		m.mutex.Lock()
+		minLedger := m.minLedger
+		var nodes []*node
+		for _, n := range m.nodes {
+			if n.healthy && n.ledger >= minLedger {
+				nodes = append(nodes, n)
+			}
+		}
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i].ledger > nodes[j].ledger
+		})
+		m.mutex.Unlock()
+
+		if len(nodes) > 0 {
+			return nodes, nil
+		}
+		if minLedger == 0 {
+			// all the nodes are unhealthy, they may still answer
+			return m.nodes, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, errors.Errorf("no Horizon node ingested ledger %d", minLedger)
+		}
+		if remaining > consistencyPollInterval {
+			remaining = consistencyPollInterval
+		}
+		if !wait(ctx, remaining) {
+			return nil, ctx.Err()
+		}
+		m.CheckHealth(ctx)
+	}
+}
+
+// read sends a read request with f to the most up to date node, and to the
+// next ones while the nodes fail.
+func (m *MultiClient) read(ctx context.Context, f func(ClientInterface) error) error {
+	nodes, err := m.readNodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		err = f(n.client)
+		if err == nil || !nodeFailed(err) || ctx.Err() != nil {
+			return err
+		}
+
+		m.mutex.Lock()
+		n.healthy = false
+		m.mutex.Unlock()
+	}
+	return err
+}
+
+// submit submits a transaction with f to all the healthy nodes. key
+// identifies the transaction, concurrent submissions of the same transaction
+// return the result of the first one.
+func (m *MultiClient) submit(
+	ctx context.Context,
+	key string,
+	f func(ClientInterface) (hProtocol.TransactionSuccess, error),
+) (hProtocol.TransactionSuccess, error) {
+	m.mutex.Lock()
+	if s, ok := m.submissions[key]; ok {
+		m.mutex.Unlock()
+		select {
+		case <-s.done:
+			return s.txSuccess, s.err
+		case <-ctx.Done():
+			return hProtocol.TransactionSuccess{}, ctx.Err()
+		}
+	}
+	s := &submission{done: make(chan struct{})}
+	m.submissions[key] = s
+	m.mutex.Unlock()
+
+	s.txSuccess, s.err = m.broadcast(f)
+
+	m.mutex.Lock()
+	delete(m.submissions, key)
+	if s.err == nil && s.txSuccess.Ledger > m.minLedger {
+		m.minLedger = s.txSuccess.Ledger
+	}
+	m.mutex.Unlock()
+	close(s.done)
+
+	return s.txSuccess, s.err
+}
+
+// broadcast submits a transaction with f to all the healthy nodes, or to all
+// the nodes when none is healthy. It returns the first success, or else the
+// error of the transaction, which tells more than the failures of the nodes.
+func (m *MultiClient) broadcast(f func(ClientInterface) (hProtocol.TransactionSuccess, error)) (hProtocol.TransactionSuccess, error) {
+	m.mutex.Lock()
+	var nodes []*node
+	for _, n := range m.nodes {
+		if n.healthy {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) == 0 {
+		nodes = m.nodes
+	}
+	m.mutex.Unlock()
+
+	type result struct {
+		txSuccess hProtocol.TransactionSuccess
+		err       error
+	}
+	results := make(chan result, len(nodes))
+	for _, n := range nodes {
+		go func(n *node) {
+			txSuccess, err := f(n.client)
+			if err == nil {
+				// Horizon returns once it ingested the ledger of the transaction
+				m.mutex.Lock()
+				if txSuccess.Ledger > n.ledger {
+					n.ledger = txSuccess.Ledger
+				}
+				m.mutex.Unlock()
+			}
+			results <- result{txSuccess, err}
+		}(n)
+	}
+
+	var err error
+	for range nodes {
+		r := <-results
+		if r.err == nil {
+			return r.txSuccess, nil
+		}
+		if err == nil || !nodeFailed(r.err) {
+			err = r.err
+		}
+	}
+	if err == nil {
+		err = errors.New("no Horizon node to submit the transaction to")
+	}
+	return hProtocol.TransactionSuccess{}, err
+}
+
+// nodeFailed returns true if err is a failure of a node, rather than an error
+// which all the nodes would return for the same request.
+func nodeFailed(err error) bool {
+	herr, ok := errors.Cause(err).(*Error)
+	if !ok {
+		return true
+	}
+	status := herr.Problem.Status
+	if herr.Response != nil {
+		status = herr.Response.StatusCode
+	}
+	return status == 429 || status >= 500
+}
+
+// SubmitTransactionXDR implements ClientInterface, see
+// Client.SubmitTransactionXDR.
+func (m *MultiClient) SubmitTransactionXDR(transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	return m.SubmitTransactionXDRContext(context.Background(), transactionXdr)
+}
+
+// SubmitTransactionXDRContext implements ClientInterface, see
+// Client.SubmitTransactionXDRContext.
+func (m *MultiClient) SubmitTransactionXDRContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	return m.submit(ctx, transactionXdr, func(c ClientInterface) (hProtocol.TransactionSuccess, error) {
+		return c.SubmitTransactionXDRContext(ctx, transactionXdr)
+	})
+}
+
+// SubmitTransaction implements ClientInterface, see Client.SubmitTransaction.
+func (m *MultiClient) SubmitTransaction(transaction txnbuild.Transaction) (hProtocol.TransactionSuccess, error) {
+	return m.SubmitTransactionContext(context.Background(), transaction)
+}
+
+// SubmitTransactionContext implements ClientInterface, see
+// Client.SubmitTransactionContext.
+func (m *MultiClient) SubmitTransactionContext(ctx context.Context, transaction txnbuild.Transaction) (hProtocol.TransactionSuccess, error) {
+	txeBase64, err := transaction.Base64()
+	if err != nil {
+		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "Unable to convert transaction object to base64 string")
+	}
+
+	return m.submit(ctx, txeBase64, func(c ClientInterface) (hProtocol.TransactionSuccess, error) {
+		return c.SubmitTransactionContext(ctx, transaction)
+	})
+}
+
+// StreamTransactions implements ClientInterface, see
+// Client.StreamTransactions. The stream continues on another node from the
+// last transaction when its node fails.
+func (m *MultiClient) StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamTransactions(ctx, request, func(tx hProtocol.Transaction) {
+			request.Cursor = tx.PagingToken()
+			handler(tx)
+		})
+	})
+}
+
+// StreamTrades implements ClientInterface, see Client.StreamTrades. The
+// stream continues on another node from the last trade when its node fails.
+func (m *MultiClient) StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamTrades(ctx, request, func(trade hProtocol.Trade) {
+			request.Cursor = trade.PagingToken()
+			handler(trade)
+		})
+	})
+}
+
+// StreamEffects implements ClientInterface, see Client.StreamEffects. The
+// stream continues on another node from the last effect when its node fails.
+func (m *MultiClient) StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamEffects(ctx, request, func(effect effects.Effect) {
+			request.Cursor = effect.PagingToken()
+			handler(effect)
+		})
+	})
+}
+
+// StreamOperations implements ClientInterface, see Client.StreamOperations.
+// The stream continues on another node from the last operation when its node
+// fails.
+func (m *MultiClient) StreamOperations(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamOperations(ctx, request, func(op operations.Operation) {
+			request.Cursor = op.PagingToken()
+			handler(op)
+		})
+	})
+}
+
+// StreamPayments implements ClientInterface, see Client.StreamPayments. The
+// stream continues on another node from the last payment when its node fails.
+func (m *MultiClient) StreamPayments(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamPayments(ctx, request, func(op operations.Operation) {
+			request.Cursor = op.PagingToken()
+			handler(op)
+		})
+	})
+}
+
+// StreamOffers implements ClientInterface, see Client.StreamOffers. The
+// stream continues on another node from the last offer when its node fails.
+func (m *MultiClient) StreamOffers(ctx context.Context, request OfferRequest, handler OfferHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamOffers(ctx, request, func(offer hProtocol.Offer) {
+			request.Cursor = offer.PagingToken()
+			handler(offer)
+		})
+	})
+}
+
+// StreamLedgers implements ClientInterface, see Client.StreamLedgers. The
+// stream continues on another node from the last ledger when its node fails.
+func (m *MultiClient) StreamLedgers(ctx context.Context, request LedgerRequest, handler LedgerHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamLedgers(ctx, request, func(ledger hProtocol.Ledger) {
+			request.Cursor = ledger.PagingToken()
+			handler(ledger)
+		})
+	})
+}
+
+// StreamOrderBooks implements ClientInterface, see Client.StreamOrderBooks.
+// The stream continues on another node when its node fails.
+func (m *MultiClient) StreamOrderBooks(ctx context.Context, request OrderBookRequest, handler OrderBookHandler) error {
+	return m.read(ctx, func(c ClientInterface) error {
+		return c.StreamOrderBooks(ctx, request, handler)
+	})
+}
+
+// AccountDetail implements ClientInterface, see Client.AccountDetail.
+func (m *MultiClient) AccountDetail(request AccountRequest) (hProtocol.Account, error) {
+	return m.AccountDetailContext(context.Background(), request)
+}
+
+// AccountDetailContext implements ClientInterface, see Client.AccountDetailContext.
+func (m *MultiClient) AccountDetailContext(ctx context.Context, request AccountRequest) (result hProtocol.Account, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.AccountDetailContext(ctx, request)
+		return
+	})
+	return
+}
+
+// AccountData implements ClientInterface, see Client.AccountData.
+func (m *MultiClient) AccountData(request AccountRequest) (hProtocol.AccountData, error) {
+	return m.AccountDataContext(context.Background(), request)
+}
+
+// AccountDataContext implements ClientInterface, see Client.AccountDataContext.
+func (m *MultiClient) AccountDataContext(ctx context.Context, request AccountRequest) (result hProtocol.AccountData, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.AccountDataContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Effects implements ClientInterface, see Client.Effects.
+func (m *MultiClient) Effects(request EffectRequest) (effects.EffectsPage, error) {
+	return m.EffectsContext(context.Background(), request)
+}
+
+// EffectsContext implements ClientInterface, see Client.EffectsContext.
+func (m *MultiClient) EffectsContext(ctx context.Context, request EffectRequest) (result effects.EffectsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.EffectsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Assets implements ClientInterface, see Client.Assets.
+func (m *MultiClient) Assets(request AssetRequest) (hProtocol.AssetsPage, error) {
+	return m.AssetsContext(context.Background(), request)
+}
+
+// AssetsContext implements ClientInterface, see Client.AssetsContext.
+func (m *MultiClient) AssetsContext(ctx context.Context, request AssetRequest) (result hProtocol.AssetsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.AssetsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Ledgers implements ClientInterface, see Client.Ledgers.
+func (m *MultiClient) Ledgers(request LedgerRequest) (hProtocol.LedgersPage, error) {
+	return m.LedgersContext(context.Background(), request)
+}
+
+// LedgersContext implements ClientInterface, see Client.LedgersContext.
+func (m *MultiClient) LedgersContext(ctx context.Context, request LedgerRequest) (result hProtocol.LedgersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.LedgersContext(ctx, request)
+		return
+	})
+	return
+}
+
+// LedgerDetail implements ClientInterface, see Client.LedgerDetail.
+func (m *MultiClient) LedgerDetail(sequence uint32) (hProtocol.Ledger, error) {
+	return m.LedgerDetailContext(context.Background(), sequence)
+}
+
+// LedgerDetailContext implements ClientInterface, see Client.LedgerDetailContext.
+func (m *MultiClient) LedgerDetailContext(ctx context.Context, sequence uint32) (result hProtocol.Ledger, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.LedgerDetailContext(ctx, sequence)
+		return
+	})
+	return
+}
+
+// Metrics implements ClientInterface, see Client.Metrics.
+func (m *MultiClient) Metrics() (hProtocol.Metrics, error) {
+	return m.MetricsContext(context.Background())
+}
+
+// MetricsContext implements ClientInterface, see Client.MetricsContext.
+func (m *MultiClient) MetricsContext(ctx context.Context) (result hProtocol.Metrics, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.MetricsContext(ctx)
+		return
+	})
+	return
+}
+
+// FeeStats implements ClientInterface, see Client.FeeStats.
+func (m *MultiClient) FeeStats() (hProtocol.FeeStats, error) {
+	return m.FeeStatsContext(context.Background())
+}
+
+// FeeStatsContext implements ClientInterface, see Client.FeeStatsContext.
+func (m *MultiClient) FeeStatsContext(ctx context.Context) (result hProtocol.FeeStats, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.FeeStatsContext(ctx)
+		return
+	})
+	return
+}
+
+// FeeInclusion implements ClientInterface, see Client.FeeInclusion.
+func (m *MultiClient) FeeInclusion(request FeeInclusionRequest) (hProtocol.FeeInclusion, error) {
+	return m.FeeInclusionContext(context.Background(), request)
+}
+
+// FeeInclusionContext implements ClientInterface, see Client.FeeInclusionContext.
+func (m *MultiClient) FeeInclusionContext(ctx context.Context, request FeeInclusionRequest) (result hProtocol.FeeInclusion, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.FeeInclusionContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Offers implements ClientInterface, see Client.Offers.
+func (m *MultiClient) Offers(request OfferRequest) (hProtocol.OffersPage, error) {
+	return m.OffersContext(context.Background(), request)
+}
+
+// OffersContext implements ClientInterface, see Client.OffersContext.
+func (m *MultiClient) OffersContext(ctx context.Context, request OfferRequest) (result hProtocol.OffersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.OffersContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Operations implements ClientInterface, see Client.Operations.
+func (m *MultiClient) Operations(request OperationRequest) (operations.OperationsPage, error) {
+	return m.OperationsContext(context.Background(), request)
+}
+
+// OperationsContext implements ClientInterface, see Client.OperationsContext.
+func (m *MultiClient) OperationsContext(ctx context.Context, request OperationRequest) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.OperationsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// OperationDetail implements ClientInterface, see Client.OperationDetail.
+func (m *MultiClient) OperationDetail(id string) (operations.Operation, error) {
+	return m.OperationDetailContext(context.Background(), id)
+}
+
+// OperationDetailContext implements ClientInterface, see Client.OperationDetailContext.
+func (m *MultiClient) OperationDetailContext(ctx context.Context, id string) (result operations.Operation, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.OperationDetailContext(ctx, id)
+		return
+	})
+	return
+}
+
+// Transactions implements ClientInterface, see Client.Transactions.
+func (m *MultiClient) Transactions(request TransactionRequest) (hProtocol.TransactionsPage, error) {
+	return m.TransactionsContext(context.Background(), request)
+}
+
+// TransactionsContext implements ClientInterface, see Client.TransactionsContext.
+func (m *MultiClient) TransactionsContext(ctx context.Context, request TransactionRequest) (result hProtocol.TransactionsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.TransactionsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// TransactionDetail implements ClientInterface, see Client.TransactionDetail.
+func (m *MultiClient) TransactionDetail(txHash string) (hProtocol.Transaction, error) {
+	return m.TransactionDetailContext(context.Background(), txHash)
+}
+
+// TransactionDetailContext implements ClientInterface, see Client.TransactionDetailContext.
+func (m *MultiClient) TransactionDetailContext(ctx context.Context, txHash string) (result hProtocol.Transaction, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.TransactionDetailContext(ctx, txHash)
+		return
+	})
+	return
+}
+
+// OrderBook implements ClientInterface, see Client.OrderBook.
+func (m *MultiClient) OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error) {
+	return m.OrderBookContext(context.Background(), request)
+}
+
+// OrderBookContext implements ClientInterface, see Client.OrderBookContext.
+func (m *MultiClient) OrderBookContext(ctx context.Context, request OrderBookRequest) (result hProtocol.OrderBookSummary, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.OrderBookContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Paths implements ClientInterface, see Client.Paths.
+func (m *MultiClient) Paths(request PathsRequest) (hProtocol.PathsPage, error) {
+	return m.PathsContext(context.Background(), request)
+}
+
+// PathsContext implements ClientInterface, see Client.PathsContext.
+func (m *MultiClient) PathsContext(ctx context.Context, request PathsRequest) (result hProtocol.PathsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PathsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Payments implements ClientInterface, see Client.Payments.
+func (m *MultiClient) Payments(request OperationRequest) (operations.OperationsPage, error) {
+	return m.PaymentsContext(context.Background(), request)
+}
+
+// PaymentsContext implements ClientInterface, see Client.PaymentsContext.
+func (m *MultiClient) PaymentsContext(ctx context.Context, request OperationRequest) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PaymentsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// TradeAggregations implements ClientInterface, see Client.TradeAggregations.
+func (m *MultiClient) TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
+	return m.TradeAggregationsContext(context.Background(), request)
+}
+
+// TradeAggregationsContext implements ClientInterface, see Client.TradeAggregationsContext.
+func (m *MultiClient) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (result hProtocol.TradeAggregationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.TradeAggregationsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Trades implements ClientInterface, see Client.Trades.
+func (m *MultiClient) Trades(request TradeRequest) (hProtocol.TradesPage, error) {
+	return m.TradesContext(context.Background(), request)
+}
+
+// TradesContext implements ClientInterface, see Client.TradesContext.
+func (m *MultiClient) TradesContext(ctx context.Context, request TradeRequest) (result hProtocol.TradesPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.TradesContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Fund implements ClientInterface, see Client.Fund.
+func (m *MultiClient) Fund(addr string) (hProtocol.TransactionSuccess, error) {
+	return m.FundContext(context.Background(), addr)
+}
+
+// FundContext implements ClientInterface, see Client.FundContext.
+func (m *MultiClient) FundContext(ctx context.Context, addr string) (result hProtocol.TransactionSuccess, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.FundContext(ctx, addr)
+		return
+	})
+	return
+}
+
+// Root implements ClientInterface, see Client.Root.
+func (m *MultiClient) Root() (hProtocol.Root, error) {
+	return m.RootContext(context.Background())
+}
+
+// RootContext implements ClientInterface, see Client.RootContext.
+func (m *MultiClient) RootContext(ctx context.Context) (result hProtocol.Root, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.RootContext(ctx)
+		return
+	})
+	return
+}
+
+// NextAssetsPage implements ClientInterface, see Client.NextAssetsPage.
+func (m *MultiClient) NextAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	return m.NextAssetsPageContext(context.Background(), page)
+}
+
+// NextAssetsPageContext implements ClientInterface, see Client.NextAssetsPageContext.
+func (m *MultiClient) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (result hProtocol.AssetsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextAssetsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevAssetsPage implements ClientInterface, see Client.PrevAssetsPage.
+func (m *MultiClient) PrevAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	return m.PrevAssetsPageContext(context.Background(), page)
+}
+
+// PrevAssetsPageContext implements ClientInterface, see Client.PrevAssetsPageContext.
+func (m *MultiClient) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (result hProtocol.AssetsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevAssetsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextLedgersPage implements ClientInterface, see Client.NextLedgersPage.
+func (m *MultiClient) NextLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	return m.NextLedgersPageContext(context.Background(), page)
+}
+
+// NextLedgersPageContext implements ClientInterface, see Client.NextLedgersPageContext.
+func (m *MultiClient) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (result hProtocol.LedgersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextLedgersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevLedgersPage implements ClientInterface, see Client.PrevLedgersPage.
+func (m *MultiClient) PrevLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	return m.PrevLedgersPageContext(context.Background(), page)
+}
+
+// PrevLedgersPageContext implements ClientInterface, see Client.PrevLedgersPageContext.
+func (m *MultiClient) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (result hProtocol.LedgersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevLedgersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextEffectsPage implements ClientInterface, see Client.NextEffectsPage.
+func (m *MultiClient) NextEffectsPage(page effects.EffectsPage) (effects.EffectsPage, error) {
+	return m.NextEffectsPageContext(context.Background(), page)
+}
+
+// NextEffectsPageContext implements ClientInterface, see Client.NextEffectsPageContext.
+func (m *MultiClient) NextEffectsPageContext(ctx context.Context, page effects.EffectsPage) (result effects.EffectsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextEffectsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevEffectsPage implements ClientInterface, see Client.PrevEffectsPage.
+func (m *MultiClient) PrevEffectsPage(page effects.EffectsPage) (effects.EffectsPage, error) {
+	return m.PrevEffectsPageContext(context.Background(), page)
+}
+
+// PrevEffectsPageContext implements ClientInterface, see Client.PrevEffectsPageContext.
+func (m *MultiClient) PrevEffectsPageContext(ctx context.Context, page effects.EffectsPage) (result effects.EffectsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevEffectsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextTransactionsPage implements ClientInterface, see Client.NextTransactionsPage.
+func (m *MultiClient) NextTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	return m.NextTransactionsPageContext(context.Background(), page)
+}
+
+// NextTransactionsPageContext implements ClientInterface, see Client.NextTransactionsPageContext.
+func (m *MultiClient) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (result hProtocol.TransactionsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextTransactionsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevTransactionsPage implements ClientInterface, see Client.PrevTransactionsPage.
+func (m *MultiClient) PrevTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	return m.PrevTransactionsPageContext(context.Background(), page)
+}
+
+// PrevTransactionsPageContext implements ClientInterface, see Client.PrevTransactionsPageContext.
+func (m *MultiClient) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (result hProtocol.TransactionsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevTransactionsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextOperationsPage implements ClientInterface, see Client.NextOperationsPage.
+func (m *MultiClient) NextOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.NextOperationsPageContext(context.Background(), page)
+}
+
+// NextOperationsPageContext implements ClientInterface, see Client.NextOperationsPageContext.
+func (m *MultiClient) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextOperationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevOperationsPage implements ClientInterface, see Client.PrevOperationsPage.
+func (m *MultiClient) PrevOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.PrevOperationsPageContext(context.Background(), page)
+}
+
+// PrevOperationsPageContext implements ClientInterface, see Client.PrevOperationsPageContext.
+func (m *MultiClient) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevOperationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextPaymentsPage implements ClientInterface, see Client.NextPaymentsPage.
+func (m *MultiClient) NextPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.NextPaymentsPageContext(context.Background(), page)
+}
+
+// NextPaymentsPageContext implements ClientInterface, see Client.NextPaymentsPageContext.
+func (m *MultiClient) NextPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextPaymentsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevPaymentsPage implements ClientInterface, see Client.PrevPaymentsPage.
+func (m *MultiClient) PrevPaymentsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	return m.PrevPaymentsPageContext(context.Background(), page)
+}
+
+// PrevPaymentsPageContext implements ClientInterface, see Client.PrevPaymentsPageContext.
+func (m *MultiClient) PrevPaymentsPageContext(ctx context.Context, page operations.OperationsPage) (result operations.OperationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevPaymentsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextOffersPage implements ClientInterface, see Client.NextOffersPage.
+func (m *MultiClient) NextOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	return m.NextOffersPageContext(context.Background(), page)
+}
+
+// NextOffersPageContext implements ClientInterface, see Client.NextOffersPageContext.
+func (m *MultiClient) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (result hProtocol.OffersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextOffersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevOffersPage implements ClientInterface, see Client.PrevOffersPage.
+func (m *MultiClient) PrevOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	return m.PrevOffersPageContext(context.Background(), page)
+}
+
+// PrevOffersPageContext implements ClientInterface, see Client.PrevOffersPageContext.
+func (m *MultiClient) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (result hProtocol.OffersPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevOffersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// NextTradesPage implements ClientInterface, see Client.NextTradesPage.
+func (m *MultiClient) NextTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	return m.NextTradesPageContext(context.Background(), page)
+}
+
+// NextTradesPageContext implements ClientInterface, see Client.NextTradesPageContext.
+func (m *MultiClient) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (result hProtocol.TradesPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextTradesPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevTradesPage implements ClientInterface, see Client.PrevTradesPage.
+func (m *MultiClient) PrevTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	return m.PrevTradesPageContext(context.Background(), page)
+}
+
+// PrevTradesPageContext implements ClientInterface, see Client.PrevTradesPageContext.
+func (m *MultiClient) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (result hProtocol.TradesPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevTradesPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// HomeDomainForAccount implements ClientInterface, see Client.HomeDomainForAccount.
+func (m *MultiClient) HomeDomainForAccount(aid string) (string, error) {
+	return m.HomeDomainForAccountContext(context.Background(), aid)
+}
+
+// HomeDomainForAccountContext implements ClientInterface, see Client.HomeDomainForAccountContext.
+func (m *MultiClient) HomeDomainForAccountContext(ctx context.Context, aid string) (result string, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.HomeDomainForAccountContext(ctx, aid)
+		return
+	})
+	return
+}
+
+// NextTradeAggregationsPage implements ClientInterface, see Client.NextTradeAggregationsPage.
+func (m *MultiClient) NextTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
+	return m.NextTradeAggregationsPageContext(context.Background(), page)
+}
+
+// NextTradeAggregationsPageContext implements ClientInterface, see Client.NextTradeAggregationsPageContext.
+func (m *MultiClient) NextTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (result hProtocol.TradeAggregationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.NextTradeAggregationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevTradeAggregationsPage implements ClientInterface, see Client.PrevTradeAggregationsPage.
+func (m *MultiClient) PrevTradeAggregationsPage(page hProtocol.TradeAggregationsPage) (hProtocol.TradeAggregationsPage, error) {
+	return m.PrevTradeAggregationsPageContext(context.Background(), page)
+}
+
+// PrevTradeAggregationsPageContext implements ClientInterface, see Client.PrevTradeAggregationsPageContext.
+func (m *MultiClient) PrevTradeAggregationsPageContext(ctx context.Context, page hProtocol.TradeAggregationsPage) (result hProtocol.TradeAggregationsPage, err error) {
+	err = m.read(ctx, func(c ClientInterface) (err error) {
+		result, err = c.PrevTradeAggregationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// ensure that the MultiClient implements ClientInterface
+var _ ClientInterface = &MultiClient{}
@@ -0,0 +1,113 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockNode(ledger int32) *MockClient {
+	hmock := &MockClient{}
+	hmock.On("Root").Return(hProtocol.Root{HorizonSequence: ledger}, nil)
+	return hmock
+}
+
+func TestMultiClientRead(t *testing.T) {
+	behind, ahead := mockNode(10), mockNode(12)
+	client := NewMultiClient(behind, ahead)
+	request := AccountRequest{AccountID: "GABC"}
+
+	// the most up to date node is used
+	ahead.On("AccountDetail", request).Return(hProtocol.Account{Sequence: "1"}, nil).Once()
+	account, err := client.AccountDetail(request)
+	require.NoError(t, err)
+	assert.Equal(t, "1", account.Sequence)
+
+	// failing nodes are replaced by the next ones
+	ahead.On("AccountDetail", request).Return(hProtocol.Account{}, errors.New("connection refused")).Once()
+	behind.On("AccountDetail", request).Return(hProtocol.Account{Sequence: "2"}, nil).Twice()
+	account, err = client.AccountDetail(request)
+	require.NoError(t, err)
+	assert.Equal(t, "2", account.Sequence)
+
+	// and aren't used until their next health check
+	account, err = client.AccountDetailContext(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "2", account.Sequence)
+
+	behind.AssertExpectations(t)
+	ahead.AssertExpectations(t)
+}
+
+func TestMultiClientReadError(t *testing.T) {
+	first, second := mockNode(12), mockNode(10)
+	client := NewMultiClient(first, second)
+	request := AccountRequest{AccountID: "GABC"}
+
+	notFound := &Error{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+		Problem:  problem.P{Status: http.StatusNotFound},
+	}
+	first.On("AccountDetail", request).Return(hProtocol.Account{}, notFound).Once()
+	_, err := client.AccountDetail(request)
+	assert.Equal(t, notFound, err)
+
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestMultiClientSubmit(t *testing.T) {
+	first, second := mockNode(10), mockNode(12)
+	client := NewMultiClient(first, second)
+	client.ConsistencyTimeout = time.Millisecond
+	client.CheckHealth(context.Background())
+
+	// the transaction is submitted to all the nodes, the first success wins
+	second.On("SubmitTransactionXDR", "AAAA").Return(hProtocol.TransactionSuccess{}, errors.New("timeout")).Once()
+	first.On("SubmitTransactionXDR", "AAAA").Return(hProtocol.TransactionSuccess{Hash: "abcd", Ledger: 15}, nil).Once()
+	txSuccess, err := client.SubmitTransactionXDR("AAAA")
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", txSuccess.Hash)
+
+	// reads go to the node which ingested the transaction
+	request := AccountRequest{AccountID: "GABC"}
+	first.On("AccountDetail", request).Return(hProtocol.Account{Sequence: "1"}, nil).Once()
+	account, err := client.AccountDetail(request)
+	require.NoError(t, err)
+	assert.Equal(t, "1", account.Sequence)
+
+	// or fail when none did in time
+	first.On("AccountDetail", request).Return(hProtocol.Account{}, errors.New("connection refused")).Once()
+	_, err = client.AccountDetail(request)
+	assert.EqualError(t, err, "connection refused")
+	_, err = client.AccountDetail(request)
+	assert.EqualError(t, err, "no Horizon node ingested ledger 15")
+
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestMultiClientSubmitFailure(t *testing.T) {
+	first, second := mockNode(10), mockNode(12)
+	client := NewMultiClient(first, second)
+	client.CheckHealth(context.Background())
+
+	txFailed := &Error{
+		Response: &http.Response{StatusCode: http.StatusBadRequest},
+		Problem:  problem.P{Title: "Transaction Failed", Status: http.StatusBadRequest},
+	}
+	first.On("SubmitTransactionXDR", "AAAA").Return(hProtocol.TransactionSuccess{}, txFailed).Once()
+	second.On("SubmitTransactionXDR", "AAAA").Return(hProtocol.TransactionSuccess{}, errors.New("timeout")).Once()
+	_, err := client.SubmitTransactionXDR("AAAA")
+	assert.Equal(t, txFailed, err)
+
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
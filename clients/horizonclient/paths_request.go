@@ -14,7 +14,7 @@ func (pr PathsRequest) BuildURL() (endpoint string, err error) {
 	// add the parameters to a map here so it is easier for addQueryParams to populate the parameter list
 	// We can't use assetCode and assetIssuer types here because the paremeter names are different
 	paramMap := make(map[string]string)
-	paramMap["destination_account"] = pr.DestinationAccount
+	paramMap["destination_account"] = demuxAccount(pr.DestinationAccount)
 	paramMap["destination_asset_type"] = string(pr.DestinationAssetType)
 	paramMap["destination_asset_code"] = pr.DestinationAssetCode
 	paramMap["destination_asset_issuer"] = pr.DestinationAssetIssuer
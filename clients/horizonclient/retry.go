@@ -0,0 +1,153 @@
+package horizonclient
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides when a failed request is sent again. The client only
+// asks it about failures after which sending the request again is safe: GET
+// requests failing with a network error or with one of the 429, 500, 502, 503
+// and 504 status codes, and transaction submissions which Horizon rejected
+// with 429 or 503. Submissions failing in any other of these ways are retried
+// only when the hash of the transaction is known, after checking that it
+// wasn't included in a ledger meanwhile.
+type RetryPolicy interface {
+	// Backoff returns how long to wait before sending a request which failed
+	// attempt times in a row again, or false to give up.
+	Backoff(attempt int) (time.Duration, bool)
+}
+
+// ExponentialBackoff is a RetryPolicy doubling the delay between two
+// attempts, with up to 50% of random jitter so that clients don't retry in
+// lockstep.
+type ExponentialBackoff struct {
+	// MaxRetries is the number of times a request is sent again before giving
+	// up.
+	MaxRetries int
+	// Delay is the delay before the first retry.
+	Delay time.Duration
+	// MaxDelay caps the delay between two attempts, there is no cap when it's
+	// zero.
+	MaxDelay time.Duration
+}
+
+// Backoff implements RetryPolicy.
+func (b ExponentialBackoff) Backoff(attempt int) (time.Duration, bool) {
+	if attempt < 1 || attempt > b.MaxRetries {
+		return 0, false
+	}
+
+	delay := b.Delay
+	for i := 1; i < attempt && (b.MaxDelay == 0 || delay < b.MaxDelay); i++ {
+		delay *= 2
+	}
+	if b.MaxDelay > 0 && delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+	if delay > 0 {
+		delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	}
+	return delay, true
+}
+
+// DefaultRetryPolicy retries a request up to 3 times, waiting about 1, 2 and
+// 4 seconds between the attempts.
+var DefaultRetryPolicy RetryPolicy = ExponentialBackoff{
+	MaxRetries: 3,
+	Delay:      time.Second,
+	MaxDelay:   30 * time.Second,
+}
+
+// RateLimit is the state of the rate limit of the client on a Horizon server,
+// as told by the X-Ratelimit-* headers of its last response.
+type RateLimit struct {
+	// Limit is the number of requests allowed per period.
+	Limit int
+	// Remaining is the number of requests left in the current period.
+	Remaining int
+	// Reset is when the current period ends.
+	Reset time.Time
+}
+
+// RateLimit returns the rate limit reported by the last response of Horizon,
+// or false if no response carried the rate limit headers yet.
+func (c *Client) RateLimit() (RateLimit, bool) {
+	rl, ok := c.rateLimit.Load().(RateLimit)
+	return rl, ok
+}
+
+// updateRateLimit records the rate limit headers of resp, if any.
+func (c *Client) updateRateLimit(resp *http.Response) {
+	limit, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	rl := RateLimit{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Reset")); err == nil {
+		rl.Reset = c.clock.Now().Add(time.Duration(reset) * time.Second)
+	}
+	c.rateLimit.Store(rl)
+}
+
+// retryDelay returns how long to wait before sending again a request which
+// failed attempt times in a row, the last time with resp, or with a nil resp
+// when no response was received. safe tells if the request can be sent again
+// when Horizon may have processed it.
+func (c *Client) retryDelay(attempt int, resp *http.Response, safe bool) (time.Duration, bool) {
+	if c.RetryPolicy == nil {
+		return 0, false
+	}
+
+	if resp == nil {
+		if !safe {
+			return 0, false
+		}
+	} else {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			// the request was rejected without being processed
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+			if !safe {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+	}
+
+	delay, ok := c.RetryPolicy.Backoff(attempt)
+	if !ok {
+		return 0, false
+	}
+	if after, ok := c.retryAfter(resp); ok && after > delay {
+		delay = after
+	}
+	return delay, true
+}
+
+// retryAfter parses the Retry-After header of resp, which is either a number
+// of seconds or a date.
+func (c *Client) retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(c.clock.Now()), true
+	}
+	return 0, false
+}
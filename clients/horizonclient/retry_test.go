@@ -0,0 +1,180 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	stdhttptest "net/http/httptest"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = ExponentialBackoff{MaxRetries: 2, Delay: time.Millisecond}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff{MaxRetries: 4, Delay: time.Second, MaxDelay: 3 * time.Second}
+
+	_, ok := b.Backoff(0)
+	assert.False(t, ok)
+	_, ok = b.Backoff(5)
+	assert.False(t, ok)
+
+	for attempt, min := range map[int]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 3 * time.Second,
+		4: 3 * time.Second,
+	} {
+		delay, ok := b.Backoff(attempt)
+		assert.True(t, ok)
+		assert.True(t, delay >= min && delay <= min+min/2, "attempt %d: %s", attempt, delay)
+	}
+}
+
+func TestRetryGet(t *testing.T) {
+	requests := 0
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-Ratelimit-Limit", "3600")
+		w.Header().Set("X-Ratelimit-Remaining", "3600")
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"title": "Service Unavailable"}`))
+			return
+		}
+		w.Header().Set("X-Ratelimit-Remaining", "3598")
+		w.Header().Set("X-Ratelimit-Reset", "60")
+		w.Write([]byte(`{"horizon_version": "1.0.0"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HorizonURL: server.URL}
+	_, err := client.Root()
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+
+	rl, ok := client.RateLimit()
+	require.True(t, ok)
+	assert.Equal(t, RateLimit{Limit: 3600, Remaining: 3600}, rl)
+
+	requests = 0
+	client.RetryPolicy = testRetryPolicy
+	root, err := client.RootContext(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", root.HorizonVersion)
+	assert.Equal(t, 2, requests)
+
+	rl, ok = client.RateLimit()
+	require.True(t, ok)
+	assert.Equal(t, 3598, rl.Remaining)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), rl.Reset, 5*time.Second)
+}
+
+func TestRetryGetGivesUp(t *testing.T) {
+	requests := 0
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"title": "Bad Gateway"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HorizonURL: server.URL, RetryPolicy: testRetryPolicy}
+	_, err := client.Root()
+	if assert.Error(t, err) {
+		assert.Equal(t, "Bad Gateway", err.(*Error).Problem.Title)
+	}
+	assert.Equal(t, 3, requests)
+
+	// a cancelled context stops the retries
+	requests = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.RetryPolicy = ExponentialBackoff{MaxRetries: 2, Delay: time.Hour}
+	_, err = client.RootContext(ctx)
+	assert.Error(t, err)
+	assert.True(t, requests <= 1)
+}
+
+func TestRetrySubmit(t *testing.T) {
+	submissions, lookups := 0, 0
+	status := http.StatusGatewayTimeout
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			lookups++
+			assert.Equal(t, "/transactions/abcd", r.URL.Path)
+			w.Write([]byte(`{
+				"_links": {"self": {"href": "https://localhost/transactions/abcd"}},
+				"successful": true,
+				"hash": "abcd",
+				"ledger": 7,
+				"envelope_xdr": "AAAA"
+			}`))
+			return
+		}
+		submissions++
+		w.WriteHeader(status)
+		w.Write([]byte(`{"title": "Timeout"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{HorizonURL: server.URL, RetryPolicy: testRetryPolicy}
+
+	// Horizon may have submitted the transaction, which can't be checked
+	// without its hash
+	_, err := client.SubmitTransactionXDR("AAAA")
+	assert.Error(t, err)
+	assert.Equal(t, 1, submissions)
+
+	// with the hash, the client finds the transaction instead of submitting
+	// it again
+	submissions = 0
+	txSuccess, err := client.submitTransactionXDR(context.Background(), "AAAA", "abcd")
+	require.NoError(t, err)
+	assert.Equal(t, 1, submissions)
+	assert.Equal(t, 1, lookups)
+	assert.Equal(t, "abcd", txSuccess.Hash)
+	assert.Equal(t, "AAAA", txSuccess.Env)
+	assert.Equal(t, int32(7), txSuccess.Ledger)
+	assert.Equal(t, "https://localhost/transactions/abcd", txSuccess.Links.Transaction.Href)
+
+	// rejected submissions are always retried
+	submissions = 0
+	status = http.StatusServiceUnavailable
+	_, err = client.SubmitTransactionXDR("AAAA")
+	assert.Error(t, err)
+	assert.Equal(t, 3, submissions)
+}
+
+func TestStreamReconnect(t *testing.T) {
+	requests := 0
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(ledgerStreamResponse))
+	}))
+	defer server.Close()
+
+	client := &Client{HorizonURL: server.URL}
+	err := client.StreamLedgers(context.Background(), LedgerRequest{}, func(hProtocol.Ledger) {})
+	assert.EqualError(t, err, "got bad HTTP status code 503")
+
+	requests = 0
+	client.RetryPolicy = testRetryPolicy
+	ctx, cancel := context.WithCancel(context.Background())
+	var ledgers []hProtocol.Ledger
+	err = client.StreamLedgers(ctx, LedgerRequest{}, func(ledger hProtocol.Ledger) {
+		ledgers = append(ledgers, ledger)
+		cancel()
+	})
+	require.NoError(t, err)
+	assert.Len(t, ledgers, 1)
+	assert.Equal(t, 2, requests)
+}
@@ -1,31 +1,54 @@
 package stellartoml
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/stellar/go/address"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/http/cachecontrol"
+	"github.com/stellar/go/support/log"
 )
 
 // GetStellarToml returns stellar.toml file for a given domain
 func (c *Client) GetStellarToml(domain string) (resp *Response, err error) {
+	return c.GetStellarTomlContext(context.Background(), domain)
+}
+
+// GetStellarTomlContext works like GetStellarToml, but the request is sent in
+// ctx, with the traceparent header of ctx when c.HTTP is able to send any
+// request like *http.Client.
+func (c *Client) GetStellarTomlContext(ctx context.Context, domain string) (resp *Response, err error) {
+	if cached := c.cached(domain); cached != nil {
+		return cached.response, nil
+	}
+
 	var hresp *http.Response
-	hresp, err = c.HTTP.Get(c.url(domain))
+	hresp, err = c.fetch(ctx, domain)
 	if err != nil {
-		err = errors.Wrap(err, "http request errored")
 		return
 	}
 	defer hresp.Body.Close()
 
-	if !(hresp.StatusCode >= 200 && hresp.StatusCode < 300) {
-		err = errors.New("http request failed with non-200 status code")
+	resp, err = Decode(hresp.Body)
+	if err != nil {
 		return
 	}
 
-	limitReader := io.LimitReader(hresp.Body, StellarTomlMaxSize)
+	if c.CacheTTL > 0 {
+		c.store(domain, cachedResponse{response: resp}, cachecontrol.TTL(hresp.Header, c.clock.Now(), c.CacheTTL))
+	}
+	return
+}
+
+// Decode reads a stellar.toml file from r, refusing files larger than
+// StellarTomlMaxSize.
+func Decode(r io.Reader) (resp *Response, err error) {
+	limitReader := io.LimitReader(r, StellarTomlMaxSize)
 	_, err = toml.DecodeReader(limitReader, &resp)
 
 	// There is one corner case not handled here: response is exactly
@@ -47,12 +70,93 @@ func (c *Client) GetStellarToml(domain string) (resp *Response, err error) {
 // GetStellarTomlByAddress returns stellar.toml file of a domain fetched from a
 // given address
 func (c *Client) GetStellarTomlByAddress(addy string) (*Response, error) {
+	return c.GetStellarTomlByAddressContext(context.Background(), addy)
+}
+
+// GetStellarTomlByAddressContext works like GetStellarTomlByAddress, but the
+// request is sent in ctx like GetStellarTomlContext.
+func (c *Client) GetStellarTomlByAddressContext(ctx context.Context, addy string) (*Response, error) {
 	_, domain, err := address.Split(addy)
 	if err != nil {
 		return nil, errors.Wrap(err, "parse address failed")
 	}
 
-	return c.GetStellarToml(domain)
+	return c.GetStellarTomlContext(ctx, domain)
+}
+
+// fetch requests domain's stellar.toml file, returning an error if the server
+// does not respond with a 2xx status code.
+func (c *Client) fetch(ctx context.Context, domain string) (*http.Response, error) {
+	hresp, err := c.get(ctx, c.url(domain))
+	if err != nil {
+		return nil, errors.Wrap(err, "http request errored")
+	}
+
+	if !(hresp.StatusCode >= 200 && hresp.StatusCode < 300) {
+		hresp.Body.Close()
+		return nil, errors.New("http request failed with non-200 status code")
+	}
+
+	return hresp, nil
+}
+
+// get sends a GET request to url in ctx, see GetStellarTomlContext.
+func (c *Client) get(ctx context.Context, url string) (*http.Response, error) {
+	doer, ok := c.HTTP.(interface {
+		Do(req *http.Request) (*http.Response, error)
+	})
+	if !ok {
+		return c.HTTP.Get(url)
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	log.SetTraceparent(ctx, req.Header)
+	return doer.Do(req.WithContext(ctx))
+}
+
+// cached returns a copy of the cache entry of domain's stellar.toml file, or
+// nil when it's not cached or expired.
+func (c *Client) cached(domain string) *cachedResponse {
+	if c.CacheTTL <= 0 {
+		return nil
+	}
+
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	entry, ok := c.cache[domain]
+	if !ok {
+		return nil
+	}
+	if !c.clock.Now().Before(entry.expiresAt) {
+		delete(c.cache, domain)
+		return nil
+	}
+
+	resp := *entry.response
+	entry.response = &resp
+	entry.errs = append(ValidationErrors(nil), entry.errs...)
+	return &entry
+}
+
+func (c *Client) store(domain string, entry cachedResponse, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.cache == nil {
+		c.cache = map[string]cachedResponse{}
+	}
+	stored := *entry.response
+	entry.response = &stored
+	entry.expiresAt = c.clock.Now().Add(ttl)
+	c.cache[domain] = entry
 }
 
 // url returns the appropriate url to load for resolving domain's stellar.toml
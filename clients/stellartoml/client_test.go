@@ -1,11 +1,17 @@
 package stellartoml
 
 import (
+	"context"
 	"net/http"
+	stdhttptest "net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/stellar/go/support/clock"
+	"github.com/stellar/go/support/clock/clocktest"
 	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/support/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,3 +69,64 @@ func TestClient(t *testing.T) {
 		assert.Contains(t, err.Error(), "toml decode failed")
 	}
 }
+
+func TestClientCache(t *testing.T) {
+	h := httptest.NewClient()
+	now := time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC)
+	c := &Client{HTTP: h, CacheTTL: time.Hour}
+	c.clock = &clock.Clock{Source: clocktest.FixedSource(now)}
+
+	h.
+		On("GET", "https://stellar.org/.well-known/stellar.toml").
+		ReturnStringWithHeader(http.StatusOK,
+			`FEDERATION_SERVER="https://localhost/federation"`,
+			http.Header{"Cache-Control": []string{"max-age=60"}},
+		)
+	stoml, err := c.GetStellarToml("stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost/federation", stoml.FederationServer)
+
+	h.
+		On("GET", "https://stellar.org/.well-known/stellar.toml").
+		ReturnString(http.StatusOK,
+			`FEDERATION_SERVER="https://localhost/federation2"`,
+		)
+
+	// cached for max-age
+	stoml, err = c.GetStellarToml("stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost/federation", stoml.FederationServer)
+
+	c.clock = &clock.Clock{Source: clocktest.FixedSource(now.Add(time.Minute))}
+	stoml, err = c.GetStellarToml("stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost/federation2", stoml.FederationServer)
+
+	// no-store responses are not cached
+	h.
+		On("GET", "https://nostore.org/.well-known/stellar.toml").
+		ReturnStringWithHeader(http.StatusOK,
+			`FEDERATION_SERVER="https://localhost/federation"`,
+			http.Header{"Cache-Control": []string{"no-store"}},
+		)
+	_, err = c.GetStellarToml("nostore.org")
+	require.NoError(t, err)
+	assert.NotContains(t, c.cache, "nostore.org")
+	assert.Contains(t, c.cache, "stellar.org")
+}
+
+func TestClientTraceparent(t *testing.T) {
+	trace := log.NewTrace()
+	server := stdhttptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, WellKnownPath, r.URL.Path)
+		assert.Equal(t, trace.Traceparent(), r.Header.Get(log.TraceparentHeader))
+		w.Write([]byte(`FEDERATION_SERVER="https://localhost/federation"`))
+	}))
+	defer server.Close()
+
+	c := &Client{HTTP: http.DefaultClient, UseHTTP: true}
+	domain := strings.TrimPrefix(server.URL, "http://")
+	stoml, err := c.GetStellarTomlContext(log.WithTrace(context.Background(), trace), domain)
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost/federation", stoml.FederationServer)
+}
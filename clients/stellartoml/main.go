@@ -1,6 +1,13 @@
 package stellartoml
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/support/clock"
+)
 
 // StellarTomlMaxSize is the maximum size of stellar.toml file
 const StellarTomlMaxSize = 100 * 1024
@@ -21,11 +28,31 @@ type Client struct {
 	// UseHTTP forces the client to resolve against servers using plain HTTP.
 	// Useful for debugging.
 	UseHTTP bool
+
+	// CacheTTL enables caching of stellar.toml files by GetStellarToml and
+	// ValidateStellarToml when greater than zero. Files are cached for CacheTTL, or for less when the
+	// Cache-Control or Expires headers of the response say so.
+	CacheTTL time.Duration
+
+	cache      map[string]cachedResponse
+	cacheMutex sync.Mutex
+	clock      *clock.Clock
+}
+
+type cachedResponse struct {
+	response  *Response
+	expiresAt time.Time
+	// validated is set when the response was fetched by ValidateStellarToml,
+	// errs are then the problems it found.
+	validated bool
+	errs      ValidationErrors
 }
 
 type ClientInterface interface {
 	GetStellarToml(domain string) (*Response, error)
 	GetStellarTomlByAddress(addy string) (*Response, error)
+	GetStellarTomlContext(ctx context.Context, domain string) (*Response, error)
+	GetStellarTomlByAddressContext(ctx context.Context, addy string) (*Response, error)
 }
 
 // HTTP represents the http client that a stellertoml resolver uses to make http
@@ -34,12 +61,95 @@ type HTTP interface {
 	Get(url string) (*http.Response, error)
 }
 
-// Response represents the results of successfully resolving a stellar.toml file
+// Response represents the results of successfully resolving a stellar.toml
+// file. The fields follow the layout of SEP-1:
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0001.md
 type Response struct {
-	AuthServer       string `toml:"AUTH_SERVER"`
-	FederationServer string `toml:"FEDERATION_SERVER"`
-	EncryptionKey    string `toml:"ENCRYPTION_KEY"`
-	SigningKey       string `toml:"SIGNING_KEY"`
+	Version              string        `toml:"VERSION"`
+	NetworkPassphrase    string        `toml:"NETWORK_PASSPHRASE"`
+	FederationServer     string        `toml:"FEDERATION_SERVER"`
+	AuthServer           string        `toml:"AUTH_SERVER"`
+	TransferServer       string        `toml:"TRANSFER_SERVER"`
+	KYCServer            string        `toml:"KYC_SERVER"`
+	WebAuthEndpoint      string        `toml:"WEB_AUTH_ENDPOINT"`
+	SigningKey           string        `toml:"SIGNING_KEY"`
+	HorizonURL           string        `toml:"HORIZON_URL"`
+	Accounts             []string      `toml:"ACCOUNTS"`
+	URIRequestSigningKey string        `toml:"URI_REQUEST_SIGNING_KEY"`
+	EncryptionKey        string        `toml:"ENCRYPTION_KEY"`
+	DepositServer        string        `toml:"DEPOSIT_SERVER"` // superseded by TRANSFER_SERVER
+	Documentation        Documentation `toml:"DOCUMENTATION"`
+	Principals           []Principal   `toml:"PRINCIPALS"`
+	Currencies           []Currency    `toml:"CURRENCIES"`
+	Validators           []Validator   `toml:"VALIDATORS"`
+}
+
+// Documentation represents the [DOCUMENTATION] table of a stellar.toml file,
+// describing the organization behind the domain.
+type Documentation struct {
+	OrgName                       string `toml:"ORG_NAME"`
+	OrgDBA                        string `toml:"ORG_DBA"`
+	OrgURL                        string `toml:"ORG_URL"`
+	OrgLogo                       string `toml:"ORG_LOGO"`
+	OrgDescription                string `toml:"ORG_DESCRIPTION"`
+	OrgPhysicalAddress            string `toml:"ORG_PHYSICAL_ADDRESS"`
+	OrgPhysicalAddressAttestation string `toml:"ORG_PHYSICAL_ADDRESS_ATTESTATION"`
+	OrgPhoneNumber                string `toml:"ORG_PHONE_NUMBER"`
+	OrgPhoneNumberAttestation     string `toml:"ORG_PHONE_NUMBER_ATTESTATION"`
+	OrgKeybase                    string `toml:"ORG_KEYBASE"`
+	OrgTwitter                    string `toml:"ORG_TWITTER"`
+	OrgGithub                     string `toml:"ORG_GITHUB"`
+	OrgOfficialEmail              string `toml:"ORG_OFFICIAL_EMAIL"`
+	OrgLicensingAuthority         string `toml:"ORG_LICENSING_AUTHORITY"`
+	OrgLicenseType                string `toml:"ORG_LICENSE_TYPE"`
+	OrgLicenseNumber              string `toml:"ORG_LICENSE_NUMBER"`
+}
+
+// Principal represents an entry of the [[PRINCIPALS]] array of a stellar.toml
+// file, describing a point of contact for the organization.
+type Principal struct {
+	Name                  string `toml:"name"`
+	Email                 string `toml:"email"`
+	Keybase               string `toml:"keybase"`
+	Telegram              string `toml:"telegram"`
+	Twitter               string `toml:"twitter"`
+	Github                string `toml:"github"`
+	IDPhotoHash           string `toml:"id_photo_hash"`
+	VerificationPhotoHash string `toml:"verification_photo_hash"`
+}
+
+// Currency represents an entry of the [[CURRENCIES]] array of a stellar.toml
+// file, describing an asset issued by the organization.
+type Currency struct {
+	Code                        string   `toml:"code"`
+	CodeTemplate                string   `toml:"code_template"`
+	Issuer                      string   `toml:"issuer"`
+	Status                      string   `toml:"status"`
+	DisplayDecimals             int      `toml:"display_decimals"`
+	Name                        string   `toml:"name"`
+	Desc                        string   `toml:"desc"`
+	Conditions                  string   `toml:"conditions"`
+	Image                       string   `toml:"image"`
+	FixedNumber                 int      `toml:"fixed_number"`
+	MaxNumber                   int      `toml:"max_number"`
+	IsUnlimited                 bool     `toml:"is_unlimited"`
+	IsAssetAnchored             bool     `toml:"is_asset_anchored"`
+	AnchorAssetType             string   `toml:"anchor_asset_type"`
+	AnchorAsset                 string   `toml:"anchor_asset"`
+	RedemptionInstructions      string   `toml:"redemption_instructions"`
+	CollateralAddresses         []string `toml:"collateral_addresses"`
+	CollateralAddressMessages   []string `toml:"collateral_address_messages"`
+	CollateralAddressSignatures []string `toml:"collateral_address_signatures"`
+}
+
+// Validator represents an entry of the [[VALIDATORS]] array of a stellar.toml
+// file, describing a stellar-core node run by the organization.
+type Validator struct {
+	Alias       string `toml:"ALIAS"`
+	DisplayName string `toml:"DISPLAY_NAME"`
+	PublicKey   string `toml:"PUBLIC_KEY"`
+	Host        string `toml:"HOST"`
+	History     string `toml:"HISTORY"`
 }
 
 // GetStellarToml returns stellar.toml file for a given domain
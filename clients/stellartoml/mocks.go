@@ -1,6 +1,10 @@
 package stellartoml
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
 
 // MockClient is a mockable stellartoml client.
 type MockClient struct {
@@ -18,3 +22,13 @@ func (m *MockClient) GetStellarTomlByAddress(address string) (*Response, error)
 	a := m.Called(address)
 	return a.Get(0).(*Response), a.Error(1)
 }
+
+// GetStellarTomlContext is a mocking method, which shares the expectations of GetStellarToml
+func (m *MockClient) GetStellarTomlContext(ctx context.Context, domain string) (*Response, error) {
+	return m.GetStellarToml(domain)
+}
+
+// GetStellarTomlByAddressContext is a mocking method, which shares the expectations of GetStellarTomlByAddress
+func (m *MockClient) GetStellarTomlByAddressContext(ctx context.Context, addy string) (*Response, error) {
+	return m.GetStellarTomlByAddress(addy)
+}
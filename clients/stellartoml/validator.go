@@ -0,0 +1,336 @@
+package stellartoml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/http/cachecontrol"
+)
+
+// ValidationError describes a single way in which a stellar.toml file, or the
+// way it is served, does not conform to SEP-1. Field names the offending key
+// using its stellar.toml spelling, e.g. "CURRENCIES[0].issuer".
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors is the list of problems found while validating a
+// stellar.toml file.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationErrors) add(field, format string, args ...interface{}) {
+	*e = append(*e, ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+var (
+	validCurrencyStatuses = map[string]bool{
+		"live":    true,
+		"dead":    true,
+		"test":    true,
+		"private": true,
+	}
+
+	validAnchorAssetTypes = map[string]bool{
+		"fiat":       true,
+		"crypto":     true,
+		"stock":      true,
+		"bond":       true,
+		"commodity":  true,
+		"realestate": true,
+		"other":      true,
+	}
+
+	assetCodeRegexp      = regexp.MustCompile(`^[a-zA-Z0-9]{1,12}$`)
+	validatorAliasRegexp = regexp.MustCompile(`^[a-z0-9-]{2,16}$`)
+)
+
+// Validate checks the contents of a stellar.toml file against SEP-1. It only
+// inspects the document itself; use Client.ValidateStellarToml to also check
+// how the file is served and whether its currencies exist on the network.
+func (r *Response) Validate() ValidationErrors {
+	var errs ValidationErrors
+
+	validateURL(&errs, "FEDERATION_SERVER", r.FederationServer)
+	validateURL(&errs, "AUTH_SERVER", r.AuthServer)
+	validateURL(&errs, "TRANSFER_SERVER", r.TransferServer)
+	validateURL(&errs, "KYC_SERVER", r.KYCServer)
+	validateURL(&errs, "WEB_AUTH_ENDPOINT", r.WebAuthEndpoint)
+	validateURL(&errs, "HORIZON_URL", r.HorizonURL)
+	validateAccountID(&errs, "SIGNING_KEY", r.SigningKey)
+	validateAccountID(&errs, "URI_REQUEST_SIGNING_KEY", r.URIRequestSigningKey)
+
+	if r.WebAuthEndpoint != "" && r.SigningKey == "" {
+		errs.add("SIGNING_KEY", "is required when WEB_AUTH_ENDPOINT is set")
+	}
+
+	for i, account := range r.Accounts {
+		validateAccountID(&errs, fmt.Sprintf("ACCOUNTS[%d]", i), account)
+	}
+
+	doc := r.Documentation
+	validateURL(&errs, "DOCUMENTATION.ORG_URL", doc.OrgURL)
+	validateURL(&errs, "DOCUMENTATION.ORG_LOGO", doc.OrgLogo)
+	validateURL(&errs, "DOCUMENTATION.ORG_PHYSICAL_ADDRESS_ATTESTATION", doc.OrgPhysicalAddressAttestation)
+	validateURL(&errs, "DOCUMENTATION.ORG_PHONE_NUMBER_ATTESTATION", doc.OrgPhoneNumberAttestation)
+	validateEmail(&errs, "DOCUMENTATION.ORG_OFFICIAL_EMAIL", doc.OrgOfficialEmail)
+
+	for i, principal := range r.Principals {
+		field := fmt.Sprintf("PRINCIPALS[%d]", i)
+		if principal.Name == "" {
+			errs.add(field+".name", "is required")
+		}
+		validateEmail(&errs, field+".email", principal.Email)
+	}
+
+	for i, currency := range r.Currencies {
+		validateCurrency(&errs, fmt.Sprintf("CURRENCIES[%d]", i), currency)
+	}
+
+	for i, validator := range r.Validators {
+		field := fmt.Sprintf("VALIDATORS[%d]", i)
+		if validator.Alias != "" && !validatorAliasRegexp.MatchString(validator.Alias) {
+			errs.add(field+".ALIAS", "must be 2-16 lowercase letters, digits or dashes")
+		}
+		if validator.PublicKey == "" {
+			errs.add(field+".PUBLIC_KEY", "is required")
+		}
+		validateAccountID(&errs, field+".PUBLIC_KEY", validator.PublicKey)
+		if validator.History != "" {
+			if _, err := url.ParseRequestURI(validator.History); err != nil {
+				errs.add(field+".HISTORY", "is not a valid URL")
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateCurrency(errs *ValidationErrors, field string, c Currency) {
+	switch {
+	case c.Code == "" && c.CodeTemplate == "":
+		errs.add(field+".code", "is required")
+	case c.Code != "" && c.CodeTemplate != "":
+		errs.add(field+".code_template", "cannot be set together with code")
+	case c.Code != "" && !assetCodeRegexp.MatchString(c.Code):
+		errs.add(field+".code", "must be 1-12 alphanumeric characters")
+	}
+
+	if c.Issuer == "" {
+		errs.add(field+".issuer", "is required")
+	}
+	validateAccountID(errs, field+".issuer", c.Issuer)
+
+	if c.Status != "" && !validCurrencyStatuses[c.Status] {
+		errs.add(field+".status", "must be one of live, dead, test or private")
+	}
+
+	if c.DisplayDecimals < 0 || c.DisplayDecimals > 7 {
+		errs.add(field+".display_decimals", "must be between 0 and 7")
+	}
+
+	if c.IsUnlimited && (c.FixedNumber != 0 || c.MaxNumber != 0) {
+		errs.add(field+".is_unlimited", "cannot be set together with fixed_number or max_number")
+	}
+
+	if c.FixedNumber != 0 && c.MaxNumber != 0 {
+		errs.add(field+".fixed_number", "cannot be set together with max_number")
+	}
+
+	if c.AnchorAssetType != "" && !validAnchorAssetTypes[strings.ToLower(c.AnchorAssetType)] {
+		errs.add(field+".anchor_asset_type", "must be one of fiat, crypto, stock, bond, commodity, realestate or other")
+	}
+
+	if c.IsAssetAnchored && c.AnchorAsset == "" {
+		errs.add(field+".anchor_asset", "is required when is_asset_anchored is true")
+	}
+
+	validateURL(errs, field+".image", c.Image)
+
+	if len(c.CollateralAddressSignatures) != 0 &&
+		len(c.CollateralAddressSignatures) != len(c.CollateralAddresses) {
+		errs.add(field+".collateral_address_signatures", "must have one entry per collateral address")
+	}
+
+	if len(c.CollateralAddressMessages) != 0 &&
+		len(c.CollateralAddressMessages) != len(c.CollateralAddresses) {
+		errs.add(field+".collateral_address_messages", "must have one entry per collateral address")
+	}
+}
+
+// validateURL reports an error if value is set but is not an absolute https
+// URL, as SEP-1 requires every service endpoint to be served over TLS.
+func validateURL(errs *ValidationErrors, field, value string) {
+	if value == "" {
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		errs.add(field, "is not a valid URL")
+		return
+	}
+
+	if u.Scheme != "https" {
+		errs.add(field, "must use https")
+	}
+}
+
+func validateAccountID(errs *ValidationErrors, field, value string) {
+	if value == "" {
+		return
+	}
+
+	if _, err := strkey.Decode(strkey.VersionByteAccountID, value); err != nil {
+		errs.add(field, "is not a valid account ID")
+	}
+}
+
+func validateEmail(errs *ValidationErrors, field, value string) {
+	if value == "" {
+		return
+	}
+
+	at := strings.Index(value, "@")
+	if at < 1 || at == len(value)-1 {
+		errs.add(field, "is not a valid email address")
+	}
+}
+
+// ValidateStellarToml fetches the stellar.toml file for domain and checks it
+// against SEP-1. Besides the checks performed by Response.Validate, it
+// reports files that exceed StellarTomlMaxSize and servers that do not send
+// the `Access-Control-Allow-Origin: *` header required for browser clients.
+//
+// If horizon is not nil, every currency is additionally checked against the
+// network: its issuer must exist and have domain as its home domain, and the
+// asset must have been issued.
+//
+// The returned error is only set when the file could not be retrieved at all.
+func (c *Client) ValidateStellarToml(
+	domain string,
+	horizon horizonclient.ClientInterface,
+) (*Response, ValidationErrors, error) {
+	return c.ValidateStellarTomlContext(context.Background(), domain, horizon)
+}
+
+// ValidateStellarTomlContext works like ValidateStellarToml, but the request
+// is sent in ctx like GetStellarTomlContext. When c.CacheTTL is set, the files
+// are cached along with the problems found in them, and a file validated
+// earlier is not fetched again unless horizon is set: the checks against the
+// network are never cached.
+func (c *Client) ValidateStellarTomlContext(
+	ctx context.Context,
+	domain string,
+	horizon horizonclient.ClientInterface,
+) (*Response, ValidationErrors, error) {
+	if horizon == nil {
+		if cached := c.cached(domain); cached != nil && cached.validated {
+			return cached.response, cached.errs, nil
+		}
+	}
+
+	hresp, err := c.fetch(ctx, domain)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer hresp.Body.Close()
+
+	var errs ValidationErrors
+
+	if hresp.Header.Get("Access-Control-Allow-Origin") != "*" {
+		errs.add("Access-Control-Allow-Origin", "header must be set to *")
+	}
+
+	// Read one byte more than allowed so oversized files can be told apart
+	// from files that are exactly StellarTomlMaxSize long.
+	body, err := ioutil.ReadAll(io.LimitReader(hresp.Body, StellarTomlMaxSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(body) > StellarTomlMaxSize {
+		errs.add("stellar.toml", "exceeds %d bytes limit", StellarTomlMaxSize)
+		return nil, errs, nil
+	}
+
+	resp, err := Decode(bytes.NewReader(body))
+	if err != nil {
+		errs.add("stellar.toml", "%s", err.Error())
+		return nil, errs, nil
+	}
+
+	errs = append(errs, resp.Validate()...)
+
+	if c.CacheTTL > 0 {
+		c.store(domain, cachedResponse{response: resp, validated: true, errs: errs}, cachecontrol.TTL(hresp.Header, c.clock.Now(), c.CacheTTL))
+	}
+
+	if horizon != nil {
+		for i, currency := range resp.Currencies {
+			validateCurrencyOnNetwork(&errs, fmt.Sprintf("CURRENCIES[%d]", i), domain, currency, horizon)
+		}
+	}
+
+	return resp, errs, nil
+}
+
+func validateCurrencyOnNetwork(
+	errs *ValidationErrors,
+	field, domain string,
+	c Currency,
+	horizon horizonclient.ClientInterface,
+) {
+	if c.Issuer == "" || !strkey.IsValidEd25519PublicKey(c.Issuer) {
+		// already reported by Response.Validate
+		return
+	}
+
+	account, err := horizon.AccountDetail(horizonclient.AccountRequest{AccountID: c.Issuer})
+	if err != nil {
+		errs.add(field+".issuer", "account could not be loaded from horizon: %s", err.Error())
+		return
+	}
+
+	if account.HomeDomain != domain {
+		errs.add(field+".issuer", "account home domain is %q, expected %q", account.HomeDomain, domain)
+	}
+
+	if c.Code == "" {
+		// code templates cannot be looked up
+		return
+	}
+
+	assets, err := horizon.Assets(horizonclient.AssetRequest{
+		ForAssetCode:   c.Code,
+		ForAssetIssuer: c.Issuer,
+	})
+	if err != nil {
+		errs.add(field+".code", "asset could not be loaded from horizon: %s", err.Error())
+		return
+	}
+
+	if len(assets.Embedded.Records) == 0 {
+		errs.add(field+".code", "asset %s:%s has not been issued", c.Code, c.Issuer)
+	}
+}
@@ -0,0 +1,223 @@
+package stellartoml
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/clients/horizonclient"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validIssuer  = "GCZJM35NKGVK47BB4SPBDV25477PZYIYPVVG453LPYFNXLS3FGHDXOCM"
+	validSigning = "GBBHQ7H4V6RRORKYLHTCAWP6MOHNORRFJSDPXDFYDGJB2LPZUFPXUEW3"
+)
+
+var validToml = `
+VERSION="2.0.0"
+NETWORK_PASSPHRASE="Public Global Stellar Network ; September 2015"
+FEDERATION_SERVER="https://stellar.example.com/federation"
+AUTH_SERVER="https://stellar.example.com/auth"
+TRANSFER_SERVER="https://stellar.example.com/transfer"
+WEB_AUTH_ENDPOINT="https://stellar.example.com/auth"
+SIGNING_KEY="` + validSigning + `"
+ACCOUNTS=["` + validIssuer + `"]
+
+[DOCUMENTATION]
+ORG_NAME="Example Anchor"
+ORG_URL="https://example.com"
+ORG_OFFICIAL_EMAIL="info@example.com"
+
+[[PRINCIPALS]]
+name="Jane Doe"
+email="jane@example.com"
+
+[[CURRENCIES]]
+code="USD"
+issuer="` + validIssuer + `"
+status="live"
+display_decimals=2
+is_asset_anchored=true
+anchor_asset_type="fiat"
+anchor_asset="USD"
+
+[[VALIDATORS]]
+ALIAS="example-1"
+DISPLAY_NAME="Example 1"
+HOST="core.example.com:11625"
+PUBLIC_KEY="` + validSigning + `"
+HISTORY="http://history.example.com/prd/core-live/core_live_001/"
+`
+
+func TestDecode(t *testing.T) {
+	resp, err := Decode(strings.NewReader(validToml))
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.0.0", resp.Version)
+	assert.Equal(t, "https://stellar.example.com/transfer", resp.TransferServer)
+	assert.Equal(t, []string{validIssuer}, resp.Accounts)
+	assert.Equal(t, "Example Anchor", resp.Documentation.OrgName)
+	require.Len(t, resp.Principals, 1)
+	assert.Equal(t, "Jane Doe", resp.Principals[0].Name)
+	require.Len(t, resp.Currencies, 1)
+	assert.Equal(t, "USD", resp.Currencies[0].Code)
+	assert.Equal(t, 2, resp.Currencies[0].DisplayDecimals)
+	assert.True(t, resp.Currencies[0].IsAssetAnchored)
+	require.Len(t, resp.Validators, 1)
+	assert.Equal(t, "example-1", resp.Validators[0].Alias)
+}
+
+func TestResponseValidate(t *testing.T) {
+	resp, err := Decode(strings.NewReader(validToml))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Validate())
+
+	resp = &Response{
+		FederationServer: "http://stellar.example.com/federation",
+		WebAuthEndpoint:  "https://stellar.example.com/auth",
+		Accounts:         []string{"GINVALID"},
+		Documentation: Documentation{
+			OrgOfficialEmail: "not-an-email",
+		},
+		Currencies: []Currency{
+			{
+				Code:            "TOOLONGASSETCODE",
+				Issuer:          validIssuer,
+				Status:          "unknown",
+				DisplayDecimals: 8,
+				IsUnlimited:     true,
+				MaxNumber:       100,
+			},
+			{Code: "EUR"},
+		},
+		Validators: []Validator{
+			{Alias: "Not Valid"},
+		},
+	}
+
+	errs := resp.Validate()
+	fields := map[string]bool{}
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+
+	for _, field := range []string{
+		"FEDERATION_SERVER",
+		"SIGNING_KEY",
+		"ACCOUNTS[0]",
+		"DOCUMENTATION.ORG_OFFICIAL_EMAIL",
+		"CURRENCIES[0].code",
+		"CURRENCIES[0].status",
+		"CURRENCIES[0].display_decimals",
+		"CURRENCIES[0].is_unlimited",
+		"CURRENCIES[1].issuer",
+		"VALIDATORS[0].ALIAS",
+		"VALIDATORS[0].PUBLIC_KEY",
+	} {
+		assert.True(t, fields[field], "expected error for %s", field)
+	}
+	assert.Contains(t, errs.Error(), "FEDERATION_SERVER: must use https")
+}
+
+func TestClientValidateStellarToml(t *testing.T) {
+	h := httptest.NewClient()
+	c := &Client{HTTP: h}
+
+	// valid file with CORS header
+	h.
+		On("GET", "https://example.com/.well-known/stellar.toml").
+		ReturnStringWithHeader(http.StatusOK, validToml, http.Header{
+			"Access-Control-Allow-Origin": []string{"*"},
+		})
+
+	horizon := &horizonclient.MockClient{}
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: validIssuer}).
+		Return(hProtocol.Account{HomeDomain: "example.com"}, nil).Once()
+	assets := hProtocol.AssetsPage{}
+	assets.Embedded.Records = []hProtocol.AssetStat{{}}
+	horizon.On("Assets", horizonclient.AssetRequest{ForAssetCode: "USD", ForAssetIssuer: validIssuer}).
+		Return(assets, nil).Once()
+
+	resp, errs, err := c.ValidateStellarToml("example.com", horizon)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+	assert.Equal(t, "Example Anchor", resp.Documentation.OrgName)
+	horizon.AssertExpectations(t)
+
+	// missing CORS header and issuer home domain mismatch
+	h.
+		On("GET", "https://other.com/.well-known/stellar.toml").
+		ReturnString(http.StatusOK, validToml)
+
+	horizon = &horizonclient.MockClient{}
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: validIssuer}).
+		Return(hProtocol.Account{HomeDomain: "example.com"}, nil).Once()
+	horizon.On("Assets", horizonclient.AssetRequest{ForAssetCode: "USD", ForAssetIssuer: validIssuer}).
+		Return(hProtocol.AssetsPage{}, nil).Once()
+
+	_, errs, err = c.ValidateStellarToml("other.com", horizon)
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+	assert.Equal(t, "Access-Control-Allow-Origin", errs[0].Field)
+	assert.Equal(t, "CURRENCIES[0].issuer", errs[1].Field)
+	assert.Equal(t, "CURRENCIES[0].code", errs[2].Field)
+
+	// stellar.toml exceeds limit
+	h.
+		On("GET", "https://toobig.org/.well-known/stellar.toml").
+		ReturnStringWithHeader(http.StatusOK,
+			`FEDERATION_SERVER="https://localhost/federation`+strings.Repeat("0", StellarTomlMaxSize)+`"`,
+			http.Header{"Access-Control-Allow-Origin": []string{"*"}},
+		)
+	resp, errs, err = c.ValidateStellarToml("toobig.org", nil)
+	require.NoError(t, err)
+	assert.Nil(t, resp)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "exceeds")
+
+	// not found
+	h.
+		On("GET", "https://missing.org/.well-known/stellar.toml").
+		ReturnNotFound()
+	_, _, err = c.ValidateStellarToml("missing.org", nil)
+	assert.EqualError(t, err, "http request failed with non-200 status code")
+}
+
+func TestClientValidateStellarTomlCache(t *testing.T) {
+	h := httptest.NewClient()
+	c := &Client{HTTP: h, CacheTTL: time.Hour}
+
+	h.
+		On("GET", "https://example.com/.well-known/stellar.toml").
+		ReturnString(http.StatusOK, validToml)
+
+	// files cached by GetStellarToml are validated when first asked for
+	_, err := c.GetStellarToml("example.com")
+	require.NoError(t, err)
+	resp, errs, err := c.ValidateStellarToml("example.com", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Example Anchor", resp.Documentation.OrgName)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "Access-Control-Allow-Origin", errs[0].Field)
+
+	// then served from the cache along with their errors
+	h.
+		On("GET", "https://example.com/.well-known/stellar.toml").
+		ReturnNotFound()
+	resp, errs, err = c.ValidateStellarToml("example.com", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Example Anchor", resp.Documentation.OrgName)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "Access-Control-Allow-Origin", errs[0].Field)
+	_, err = c.GetStellarToml("example.com")
+	require.NoError(t, err)
+
+	// unless they're checked against the network
+	_, _, err = c.ValidateStellarToml("example.com", &horizonclient.MockClient{})
+	assert.EqualError(t, err, "http request failed with non-200 status code")
+}
@@ -0,0 +1,5 @@
+// Package hdwallet implements SEP-5 hierarchical deterministic wallets:
+// BIP-39 mnemonics in all the BIP-39 wordlists, optional passphrases, and the
+// derivation, discovery and watch-only export of their stellar accounts. See
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0005.md
+package hdwallet
@@ -0,0 +1,160 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/exp/crypto/derivation"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	mnemonic12 = "illness spike retreat truth genius clock brain pass fit cave bargain toe"
+	mnemonic24 = "cable spray genius state float twenty onion head street palace net private method loan turn phrase state blanket interest dry amazing dress blast tube"
+)
+
+func TestMnemonicFromEntropy(t *testing.T) {
+	mnemonic, err := MnemonicFromEntropy(make([]byte, 16), English)
+	require.NoError(t, err)
+	assert.Equal(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", mnemonic)
+
+	entropy, err := hex.DecodeString("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	require.NoError(t, err)
+	mnemonic, err = MnemonicFromEntropy(entropy, English)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("zoo ", 23)+"vote", mnemonic)
+
+	mnemonic, err = MnemonicFromEntropy(make([]byte, 16), Japanese)
+	require.NoError(t, err)
+	// the wordlist stores the decomposed kana
+	assert.Equal(t, norm.NFKD.String(strings.Repeat("あいこくしん　", 11)+"あおぞら"), norm.NFKD.String(mnemonic))
+
+	_, err = MnemonicFromEntropy(make([]byte, 15), English)
+	assert.EqualError(t, err, "invalid entropy size 120")
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, wordlist := range Wordlists {
+		mnemonic, err := NewMnemonic(DefaultEntropySize, wordlist)
+		require.NoError(t, err)
+		assert.Len(t, strings.Fields(mnemonic), 24)
+
+		// the wordlists share some words, the first matching one is returned
+		detected, err := ValidateMnemonic(mnemonic)
+		require.NoError(t, err, wordlist.Name)
+		_, err = MnemonicFromEntropy(make([]byte, 16), detected)
+		require.NoError(t, err)
+	}
+
+	_, err := NewMnemonic(100, English)
+	assert.Error(t, err)
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	wordlist, err := ValidateMnemonic(mnemonic12)
+	require.NoError(t, err)
+	assert.Equal(t, English, wordlist)
+
+	// extra whitespace is ignored
+	_, err = ValidateMnemonic(" " + strings.Replace(mnemonic24, " ", "\n ", -1) + "\n")
+	assert.NoError(t, err)
+
+	wordlist, err = ValidateMnemonic(strings.Repeat("あいこくしん ", 11) + "あおぞら")
+	require.NoError(t, err)
+	assert.Equal(t, Japanese, wordlist)
+
+	_, err = ValidateMnemonic(strings.Replace(mnemonic12, "toe", "illness", 1))
+	assert.Equal(t, ErrInvalidMnemonic, err)
+
+	_, err = ValidateMnemonic(strings.Replace(mnemonic12, "toe", "toes", 1))
+	assert.Equal(t, ErrInvalidMnemonic, err)
+
+	_, err = ValidateMnemonic("illness spike retreat")
+	assert.EqualError(t, err, "invalid mnemonic: 3 words, allowed values: 12, 15, 18, 21, 24")
+
+	wordlist, err = WordlistByName("Spanish")
+	require.NoError(t, err)
+	assert.Equal(t, Spanish, wordlist)
+
+	_, err = WordlistByName("klingon")
+	assert.EqualError(t, err, "unknown wordlist klingon")
+}
+
+func TestSeed(t *testing.T) {
+	// BIP-39 test vectors
+	seed := Seed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "TREZOR")
+	assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+
+	seed = Seed(strings.Repeat("あいこくしん　", 11)+"あおぞら", "㍍ガバヴァぱばぐゞちぢ十人十色")
+	assert.Equal(t, "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55", hex.EncodeToString(seed))
+}
+
+func TestWallet(t *testing.T) {
+	wallet, err := New(mnemonic12, "")
+	require.NoError(t, err)
+
+	kp, err := wallet.Account(0)
+	require.NoError(t, err)
+	assert.Equal(t, "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6", kp.Address())
+	assert.Equal(t, "SBGWSG6BTNCKCOB3DIFBGCVMUPQFYPA2G4O34RMTB343OYPXU5DJDVMN", kp.Seed())
+
+	kps, err := wallet.Accounts(8, 2)
+	require.NoError(t, err)
+	require.Len(t, kps, 2)
+	assert.Equal(t, "GDJTCF62UUYSAFAVIXHPRBR4AUZV6NYJR75INVDXLLRZLZQ62S44443R", kps[0].Address())
+	assert.Equal(t, "GBTVYYDIYWGUQUTKX6ZMLGSZGMTESJYJKJWAATGZGITA25ZB6T5REF44", kps[1].Address())
+
+	_, err = wallet.Account(derivation.FirstHardenedIndex)
+	assert.EqualError(t, err, "invalid account index 2147483648")
+
+	wallet, err = New(mnemonic24, "p4ssphr4se")
+	require.NoError(t, err)
+	accounts, err := wallet.Export(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{Index: 1, Path: "m/44'/148'/1'", Address: "GDY47CJARRHHL66JH3RJURDYXAMIQ5DMXZLP3TDAUJ6IN2GUOFX4OJOC"},
+		{Index: 2, Path: "m/44'/148'/2'", Address: "GCLAQF5H5LGJ2A6ACOMNEHSWYDJ3VKVBUBHDWFGRBEPAVZ56L4D7JJID"},
+	}, accounts)
+
+	_, err = New(strings.Replace(mnemonic12, "toe", "illness", 1), "")
+	assert.Equal(t, ErrInvalidMnemonic, err)
+}
+
+func TestDiscover(t *testing.T) {
+	wallet, err := New(mnemonic12, "")
+	require.NoError(t, err)
+	accounts, err := wallet.Export(0, 6)
+	require.NoError(t, err)
+
+	notFound := &horizonclient.Error{Problem: problem.P{Status: http.StatusNotFound}}
+	client := &horizonclient.MockClient{}
+	for i, account := range accounts {
+		request := horizonclient.AccountRequest{AccountID: account.Address}
+		if i == 0 || i == 2 {
+			client.On("AccountDetail", request).Return(hProtocol.Account{}, nil).Once()
+		} else {
+			client.On("AccountDetail", request).Return(hProtocol.Account{}, notFound).Once()
+		}
+	}
+
+	funded, err := wallet.Discover(client, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []Account{accounts[0], accounts[2]}, funded)
+	client.AssertExpectations(t)
+
+	client = &horizonclient.MockClient{}
+	client.On("AccountDetail", mock.Anything).Return(hProtocol.Account{}, &horizonclient.Error{Problem: problem.P{Status: http.StatusTooManyRequests}})
+	_, err = wallet.Discover(client, 3)
+	assert.Contains(t, err.Error(), "error loading account "+accounts[0].Address)
+
+	_, err = wallet.Discover(client, 0)
+	assert.EqualError(t, err, "gap must be positive")
+}
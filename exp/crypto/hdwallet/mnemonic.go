@@ -0,0 +1,167 @@
+package hdwallet
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultEntropySize is the entropy size, in bits, of the mnemonics generated
+// by default. It gives 24 words.
+const DefaultEntropySize = 256
+
+// Wordlist is a BIP-39 wordlist.
+type Wordlist struct {
+	// Name is the lowercase name of the language of the wordlist.
+	Name string
+
+	words     []string
+	separator string
+
+	once  sync.Once
+	index map[string]int
+}
+
+// The BIP-39 wordlists.
+var (
+	English            = &Wordlist{Name: "english", words: wordlists.English, separator: " "}
+	ChineseSimplified  = &Wordlist{Name: "chinese_simplified", words: wordlists.ChineseSimplified, separator: " "}
+	ChineseTraditional = &Wordlist{Name: "chinese_traditional", words: wordlists.ChineseTraditional, separator: " "}
+	French             = &Wordlist{Name: "french", words: wordlists.French, separator: " "}
+	Italian            = &Wordlist{Name: "italian", words: wordlists.Italian, separator: " "}
+	Japanese           = &Wordlist{Name: "japanese", words: wordlists.Japanese, separator: "　"}
+	Korean             = &Wordlist{Name: "korean", words: wordlists.Korean, separator: " "}
+	Spanish            = &Wordlist{Name: "spanish", words: wordlists.Spanish, separator: " "}
+)
+
+// Wordlists are the supported wordlists, in the order they're tried by
+// `ValidateMnemonic`.
+var Wordlists = []*Wordlist{
+	English,
+	ChineseSimplified,
+	ChineseTraditional,
+	French,
+	Italian,
+	Japanese,
+	Korean,
+	Spanish,
+}
+
+var (
+	// ErrInvalidMnemonic is returned when a mnemonic isn't valid in any of the
+	// supported wordlists.
+	ErrInvalidMnemonic = errors.New("invalid mnemonic: unknown words or invalid checksum")
+
+	allowedWordCounts = map[int]bool{12: true, 15: true, 18: true, 21: true, 24: true}
+)
+
+// WordlistByName returns the wordlist called name, e.g. "english".
+func WordlistByName(name string) (*Wordlist, error) {
+	for _, wordlist := range Wordlists {
+		if wordlist.Name == strings.ToLower(name) {
+			return wordlist, nil
+		}
+	}
+	return nil, errors.Errorf("unknown wordlist %s", name)
+}
+
+// NewMnemonic generates a new mnemonic with entropySize bits of entropy, a
+// multiple of 32 between 128 and 256, using the words of wordlist.
+func NewMnemonic(entropySize int, wordlist *Wordlist) (string, error) {
+	entropy, err := bip39.NewEntropy(entropySize)
+	if err != nil {
+		return "", errors.Wrap(err, "error generating entropy")
+	}
+	return MnemonicFromEntropy(entropy, wordlist)
+}
+
+// MnemonicFromEntropy returns the mnemonic encoding entropy with the words of
+// wordlist.
+func MnemonicFromEntropy(entropy []byte, wordlist *Wordlist) (string, error) {
+	bitSize := len(entropy) * 8
+	if bitSize%32 != 0 || bitSize < 128 || bitSize > 256 {
+		return "", errors.Errorf("invalid entropy size %d", bitSize)
+	}
+
+	checksumSize := bitSize / 32
+	checksum := sha256.Sum256(entropy)
+
+	// the entropy followed by the first bits of its checksum
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, uint(checksumSize))
+	data.Or(data, big.NewInt(int64(checksum[0]>>uint(8-checksumSize))))
+
+	words := make([]string, (bitSize+checksumSize)/11)
+	mask := big.NewInt(2047)
+	for i := len(words) - 1; i >= 0; i-- {
+		index := new(big.Int).And(data, mask).Int64()
+		words[i] = wordlist.words[index]
+		data.Rsh(data, 11)
+	}
+
+	return strings.Join(words, wordlist.separator), nil
+}
+
+// ValidateMnemonic checks the words and the checksum of mnemonic, and returns
+// the first of `Wordlists` it's valid in.
+func ValidateMnemonic(mnemonic string) (*Wordlist, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	if !allowedWordCounts[len(words)] {
+		return nil, errors.Errorf("invalid mnemonic: %d words, allowed values: 12, 15, 18, 21, 24", len(words))
+	}
+
+	for _, wordlist := range Wordlists {
+		if wordlist.valid(words) {
+			return wordlist, nil
+		}
+	}
+	return nil, ErrInvalidMnemonic
+}
+
+// Seed returns the BIP-39 seed of mnemonic protected by the optional
+// passphrase. It doesn't validate the mnemonic, see `ValidateMnemonic`.
+func Seed(mnemonic, passphrase string) []byte {
+	normalized := strings.Join(strings.Fields(norm.NFKD.String(mnemonic)), " ")
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(normalized), []byte(salt), 2048, 64, sha512.New)
+}
+
+// valid returns true if the NFKD normalized words are a valid mnemonic in the
+// wordlist.
+func (wl *Wordlist) valid(words []string) bool {
+	wl.once.Do(func() {
+		wl.index = make(map[string]int, len(wl.words))
+		for i, word := range wl.words {
+			wl.index[norm.NFKD.String(word)] = i
+		}
+	})
+
+	data := new(big.Int)
+	for _, word := range words {
+		index, ok := wl.index[word]
+		if !ok {
+			return false
+		}
+		data.Lsh(data, 11)
+		data.Or(data, big.NewInt(int64(index)))
+	}
+
+	checksumSize := len(words) * 11 / 33
+	checksum := new(big.Int).And(data, big.NewInt(int64(1)<<uint(checksumSize)-1))
+	data.Rsh(data, uint(checksumSize))
+
+	// left pad the entropy, its first bytes may be zero
+	entropy := make([]byte, checksumSize*4)
+	raw := data.Bytes()
+	copy(entropy[len(entropy)-len(raw):], raw)
+	expected := sha256.Sum256(entropy)
+	return checksum.Int64() == int64(expected[0]>>uint(8-checksumSize))
+}
@@ -0,0 +1,122 @@
+package hdwallet
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/exp/crypto/derivation"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/errors"
+)
+
+// Wallet is a SEP-5 wallet, deriving the stellar accounts m/44'/148'/i' of a
+// BIP-39 seed.
+type Wallet struct {
+	key *derivation.Key
+}
+
+// Account is the public part of an account of a wallet, which can be
+// exported for watch-only setups.
+type Account struct {
+	Index   uint32 `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+// New returns the wallet of mnemonic protected by the optional passphrase.
+// The mnemonic must be valid in one of the supported wordlists.
+func New(mnemonic, passphrase string) (*Wallet, error) {
+	if _, err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return FromSeed(Seed(mnemonic, passphrase))
+}
+
+// FromSeed returns the wallet of a BIP-39 seed.
+func FromSeed(seed []byte) (*Wallet, error) {
+	key, err := derivation.DeriveForPath(derivation.StellarAccountPrefix, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "error deriving master key")
+	}
+	return &Wallet{key: key}, nil
+}
+
+// Account returns the key pair of the account at index.
+func (w *Wallet) Account(index uint32) (*keypair.Full, error) {
+	if index >= derivation.FirstHardenedIndex {
+		return nil, errors.Errorf("invalid account index %d", index)
+	}
+
+	key, err := w.key.Derive(derivation.FirstHardenedIndex + index)
+	if err != nil {
+		return nil, errors.Wrap(err, "error deriving child key")
+	}
+	return keypair.FromRawSeed(key.RawSeed())
+}
+
+// Accounts returns the key pairs of count accounts starting at index start.
+func (w *Wallet) Accounts(start, count uint32) ([]*keypair.Full, error) {
+	accounts := make([]*keypair.Full, 0, count)
+	for i := start; i < start+count; i++ {
+		kp, err := w.Account(i)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, kp)
+	}
+	return accounts, nil
+}
+
+// Export returns the public keys of count accounts starting at index start,
+// without any secret.
+func (w *Wallet) Export(start, count uint32) ([]Account, error) {
+	kps, err := w.Accounts(start, count)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]Account, len(kps))
+	for i, kp := range kps {
+		accounts[i] = newAccount(start+uint32(i), kp)
+	}
+	return accounts, nil
+}
+
+// Discover returns the funded accounts of the wallet. It looks up the
+// accounts in order from index 0 and stops after gap consecutive accounts
+// which don't exist.
+func (w *Wallet) Discover(client horizonclient.ClientInterface, gap uint32) ([]Account, error) {
+	if gap == 0 {
+		return nil, errors.New("gap must be positive")
+	}
+
+	var funded []Account
+	for i, unfunded := uint32(0), uint32(0); unfunded < gap; i++ {
+		kp, err := w.Account(i)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = client.AccountDetail(horizonclient.AccountRequest{AccountID: kp.Address()})
+		if err != nil {
+			if hError, ok := errors.Cause(err).(*horizonclient.Error); ok && hError.Problem.Status == http.StatusNotFound {
+				unfunded++
+				continue
+			}
+			return nil, errors.Wrapf(err, "error loading account %s", kp.Address())
+		}
+
+		unfunded = 0
+		funded = append(funded, newAccount(i, kp))
+	}
+	return funded, nil
+}
+
+func newAccount(index uint32, kp keypair.KP) Account {
+	return Account{
+		Index:   index,
+		Path:    fmt.Sprintf(derivation.StellarAccountPathFormat, index),
+		Address: kp.Address(),
+	}
+}
@@ -0,0 +1,160 @@
+package federation
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/address"
+)
+
+const (
+	// DefaultCacheTTL is the time records are cached for when
+	// `CachingDriver.TTL` is not set.
+	DefaultCacheTTL = 10 * time.Minute
+	// DefaultCacheNegativeTTL is the time "not found" results are cached for
+	// when `CachingDriver.NegativeTTL` is not set.
+	DefaultCacheNegativeTTL = time.Minute
+
+	// cacheMaxEntries is the number of entries after which expired entries are
+	// purged from the cache.
+	cacheMaxEntries = 10000
+)
+
+// LookupRecord implements `Driver` by returning the cached result or calling
+// `drv.Driver` when it's missing or expired. Errors are not cached.
+func (drv *CachingDriver) LookupRecord(name, domain string) (*Record, error) {
+	key := strings.ToLower(address.New(name, domain))
+
+	if value, ok := drv.cache.get(key); ok {
+		if value == nil {
+			return nil, nil
+		}
+		result := *value.(*Record)
+		return &result, nil
+	}
+
+	rec, err := drv.Driver.LookupRecord(name, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	if rec == nil {
+		drv.cache.set(key, nil, drv.negativeTTL())
+		return nil, nil
+	}
+
+	cached := *rec
+	drv.cache.set(key, &cached, drv.ttl())
+	return rec, nil
+}
+
+// LookupReverseRecord implements `ReverseDriver` by returning the cached result
+// or calling `drv.ReverseDriver` when it's missing or expired. Errors are not
+// cached.
+func (drv *CachingReverseDriver) LookupReverseRecord(
+	accountid string,
+) (*ReverseRecord, error) {
+	if value, ok := drv.reverseCache.get(accountid); ok {
+		if value == nil {
+			return nil, nil
+		}
+		result := *value.(*ReverseRecord)
+		return &result, nil
+	}
+
+	rec, err := drv.ReverseDriver.LookupReverseRecord(accountid)
+	if err != nil {
+		return nil, err
+	}
+
+	if rec == nil {
+		drv.reverseCache.set(accountid, nil, drv.negativeTTL())
+		return nil, nil
+	}
+
+	cached := *rec
+	drv.reverseCache.set(accountid, &cached, drv.ttl())
+	return rec, nil
+}
+
+var _ Driver = &CachingDriver{}
+var _ ReverseDriver = &CachingReverseDriver{}
+
+func (drv *CachingDriver) ttl() time.Duration {
+	if drv.TTL == 0 {
+		return DefaultCacheTTL
+	}
+	return drv.TTL
+}
+
+func (drv *CachingDriver) negativeTTL() time.Duration {
+	if drv.NegativeTTL == 0 {
+		return DefaultCacheNegativeTTL
+	}
+	return drv.NegativeTTL
+}
+
+// cache is a map of values with an expiration time. A nil value is a cached
+// "not found" result.
+type cache struct {
+	mutex   sync.Mutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func (c *cache) get(key string) (interface{}, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.currentTime().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *cache) set(key string, value interface{}, ttl time.Duration) {
+	if ttl < 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.entries == nil {
+		c.entries = map[string]cacheEntry{}
+	}
+
+	now := c.currentTime()
+	if len(c.entries) >= cacheMaxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		// All entries are still valid, start over rather than growing without
+		// bound.
+		if len(c.entries) >= cacheMaxEntries {
+			c.entries = map[string]cacheEntry{}
+		}
+	}
+
+	c.entries[key] = cacheEntry{value: value, expiresAt: now.Add(ttl)}
+}
+
+func (c *cache) currentTime() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
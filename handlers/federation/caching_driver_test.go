@@ -0,0 +1,94 @@
+package federation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingDriver struct {
+	records map[string]*Record
+	reverse map[string]*ReverseRecord
+	err     error
+	calls   int
+}
+
+func (drv *countingDriver) LookupRecord(name, domain string) (*Record, error) {
+	drv.calls++
+	return drv.records[name], drv.err
+}
+
+func (drv *countingDriver) LookupReverseRecord(accountid string) (*ReverseRecord, error) {
+	drv.calls++
+	return drv.reverse[accountid], drv.err
+}
+
+func TestCachingDriver(t *testing.T) {
+	now := time.Now()
+	backend := &countingDriver{
+		records: map[string]*Record{
+			"scott": {AccountID: "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG"},
+		},
+		reverse: map[string]*ReverseRecord{
+			"GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG": {Name: "scott", Domain: "stellar.org"},
+		},
+	}
+	driver := &CachingReverseDriver{
+		CachingDriver: CachingDriver{
+			Driver:      backend,
+			TTL:         time.Minute,
+			NegativeTTL: time.Second,
+		},
+		ReverseDriver: backend,
+	}
+	driver.cache.now = func() time.Time { return now }
+	driver.reverseCache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		rec, err := driver.LookupRecord("scott", "stellar.org")
+		require.NoError(t, err)
+		assert.Equal(t, "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG", rec.AccountID)
+	}
+	assert.Equal(t, 1, backend.calls)
+
+	// negative caching
+	for i := 0; i < 2; i++ {
+		rec, err := driver.LookupRecord("jed", "stellar.org")
+		require.NoError(t, err)
+		assert.Nil(t, rec)
+	}
+	assert.Equal(t, 2, backend.calls)
+
+	// reverse lookups
+	for i := 0; i < 2; i++ {
+		rrec, err := driver.LookupReverseRecord("GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG")
+		require.NoError(t, err)
+		assert.Equal(t, "scott", rrec.Name)
+	}
+	assert.Equal(t, 3, backend.calls)
+
+	// the negative entry expires first
+	now = now.Add(2 * time.Second)
+	_, err := driver.LookupRecord("jed", "stellar.org")
+	require.NoError(t, err)
+	_, err = driver.LookupRecord("scott", "stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, 4, backend.calls)
+
+	now = now.Add(time.Minute)
+	_, err = driver.LookupRecord("scott", "stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, 5, backend.calls)
+
+	// errors are not cached
+	backend.err = errors.New("connection refused")
+	_, err = driver.LookupRecord("bartek", "stellar.org")
+	assert.EqualError(t, err, "connection refused")
+	backend.err = nil
+	_, err = driver.LookupRecord("bartek", "stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, 7, backend.calls)
+}
@@ -0,0 +1,137 @@
+package federation
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stellar/go/address"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// fileRecords is the format of the files loaded by `LoadFileDriver`:
+//
+//	[[records]]
+//	name = "alice"
+//	domain = "example.com"
+//	account_id = "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG"
+//	memo_type = "id"
+//	memo = "1"
+//
+// YAML files use the same field names.
+type fileRecords struct {
+	Records []fileRecord `toml:"records" yaml:"records"`
+}
+
+type fileRecord struct {
+	Name      string `toml:"name" yaml:"name"`
+	Domain    string `toml:"domain" yaml:"domain"`
+	AccountID string `toml:"account_id" yaml:"account_id"`
+	MemoType  string `toml:"memo_type" yaml:"memo_type"`
+	Memo      string `toml:"memo" yaml:"memo"`
+}
+
+// LoadFileDriver loads the records from the TOML or YAML file at path and
+// returns a `FileDriver` serving them. The format is chosen by the file
+// extension: `.yaml` and `.yml` files are parsed as YAML, all other files as
+// TOML.
+func LoadFileDriver(path string) (*FileDriver, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read file")
+	}
+
+	var file fileRecords
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(data, &file)
+	default:
+		_, err = toml.Decode(string(data), &file)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "decode file")
+	}
+
+	return newFileDriver(file.Records)
+}
+
+// newFileDriver returns a `FileDriver` serving the provided records. Names and
+// domains are matched case-insensitively. When many records use the same
+// account ID, reverse lookups return the first one without a memo, or the
+// first one if they all have a memo.
+func newFileDriver(records []fileRecord) (*FileDriver, error) {
+	drv := &FileDriver{
+		records: map[string]*Record{},
+		reverse: map[string]*ReverseRecord{},
+	}
+	withMemo := map[string]bool{}
+
+	for i, rec := range records {
+		if rec.Name == "" || rec.Domain == "" {
+			return nil, errors.Errorf("record %d: name and domain are required", i)
+		}
+		if !strkey.IsValidEd25519PublicKey(rec.AccountID) {
+			return nil, errors.Errorf("record %d: invalid account_id", i)
+		}
+		switch rec.MemoType {
+		case "", "text", "id", "hash":
+		default:
+			return nil, errors.Errorf("record %d: invalid memo_type", i)
+		}
+		if (rec.MemoType == "") != (rec.Memo == "") {
+			return nil, errors.Errorf("record %d: memo and memo_type must be set together", i)
+		}
+
+		key := fileDriverKey(rec.Name, rec.Domain)
+		if _, exists := drv.records[key]; exists {
+			return nil, errors.Errorf("record %d: duplicate address %s", i, address.New(rec.Name, rec.Domain))
+		}
+		drv.records[key] = &Record{
+			AccountID: rec.AccountID,
+			MemoType:  rec.MemoType,
+			Memo:      rec.Memo,
+		}
+
+		_, exists := drv.reverse[rec.AccountID]
+		if !exists || (withMemo[rec.AccountID] && rec.Memo == "") {
+			drv.reverse[rec.AccountID] = &ReverseRecord{Name: rec.Name, Domain: rec.Domain}
+			withMemo[rec.AccountID] = rec.Memo != ""
+		}
+	}
+
+	return drv, nil
+}
+
+// LookupRecord implements `Driver` by looking up the address in the loaded
+// records
+func (drv *FileDriver) LookupRecord(name, domain string) (*Record, error) {
+	rec, ok := drv.records[fileDriverKey(name, domain)]
+	if !ok {
+		return nil, nil
+	}
+
+	result := *rec
+	return &result, nil
+}
+
+// LookupReverseRecord implements `ReverseDriver` by looking up the account ID
+// in the loaded records
+func (drv *FileDriver) LookupReverseRecord(accountid string) (*ReverseRecord, error) {
+	rec, ok := drv.reverse[accountid]
+	if !ok {
+		return nil, nil
+	}
+
+	result := *rec
+	return &result, nil
+}
+
+var _ Driver = &FileDriver{}
+var _ ReverseDriver = &FileDriver{}
+
+func fileDriverKey(name, domain string) string {
+	return strings.ToLower(address.New(name, domain))
+}
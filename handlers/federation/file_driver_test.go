@@ -0,0 +1,109 @@
+package federation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFileDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "federation")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tomlPath := filepath.Join(dir, "records.toml")
+	require.NoError(t, ioutil.WriteFile(tomlPath, []byte(`
+[[records]]
+name = "scott"
+domain = "stellar.org"
+account_id = "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG"
+memo_type = "id"
+memo = "1"
+
+[[records]]
+name = "scott-main"
+domain = "stellar.org"
+account_id = "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG"
+`), 0644))
+
+	yamlPath := filepath.Join(dir, "records.yml")
+	require.NoError(t, ioutil.WriteFile(yamlPath, []byte(`
+records:
+  - name: bartek
+    domain: stellar.org
+    account_id: GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3
+`), 0644))
+
+	driver, err := LoadFileDriver(tomlPath)
+	require.NoError(t, err)
+
+	rec, err := driver.LookupRecord("Scott", "Stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, &Record{
+		AccountID: "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG",
+		MemoType:  "id",
+		Memo:      "1",
+	}, rec)
+
+	rec, err = driver.LookupRecord("jed", "stellar.org")
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+
+	// the record without memo is preferred for reverse lookups
+	rrec, err := driver.LookupReverseRecord("GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG")
+	require.NoError(t, err)
+	assert.Equal(t, &ReverseRecord{Name: "scott-main", Domain: "stellar.org"}, rrec)
+
+	driver, err = LoadFileDriver(yamlPath)
+	require.NoError(t, err)
+
+	rrec, err = driver.LookupReverseRecord("GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3")
+	require.NoError(t, err)
+	assert.Equal(t, &ReverseRecord{Name: "bartek", Domain: "stellar.org"}, rrec)
+}
+
+func TestNewFileDriver_invalid(t *testing.T) {
+	valid := fileRecord{
+		Name:      "scott",
+		Domain:    "stellar.org",
+		AccountID: "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG",
+	}
+
+	testCases := []struct {
+		name    string
+		records []fileRecord
+		wantErr string
+	}{
+		{
+			name:    "no domain",
+			records: []fileRecord{{Name: "scott", AccountID: valid.AccountID}},
+			wantErr: "record 0: name and domain are required",
+		},
+		{
+			name:    "invalid account",
+			records: []fileRecord{{Name: "scott", Domain: "stellar.org", AccountID: "GABC"}},
+			wantErr: "record 0: invalid account_id",
+		},
+		{
+			name:    "memo without type",
+			records: []fileRecord{{Name: "scott", Domain: "stellar.org", AccountID: valid.AccountID, Memo: "1"}},
+			wantErr: "record 0: memo and memo_type must be set together",
+		},
+		{
+			name:    "duplicate",
+			records: []fileRecord{valid, valid},
+			wantErr: "record 1: duplicate address scott*stellar.org",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newFileDriver(tc.records)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
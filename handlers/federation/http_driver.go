@@ -0,0 +1,111 @@
+package federation
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/stellar/go/address"
+	proto "github.com/stellar/go/protocols/federation"
+	"github.com/stellar/go/support/errors"
+)
+
+// httpDriverResponseMaxSize is the maximum size of a response read from the
+// service behind an `HTTPDriver`.
+const httpDriverResponseMaxSize = 100 * 1024
+
+// LookupRecord implements `Driver` by sending a request to
+// `drv.LookupRecordURL` with the provided parameters
+func (drv *HTTPDriver) LookupRecord(name, domain string) (*Record, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("domain", domain)
+
+	var resp proto.NameResponse
+	found, err := drv.get(drv.LookupRecordURL, query, &resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "http get")
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return &Record{
+		AccountID: resp.AccountID,
+		MemoType:  resp.MemoType,
+		Memo:      resp.Memo.Value,
+	}, nil
+}
+
+// LookupReverseRecord implements `ReverseDriver` by sending a request to
+// `drv.LookupReverseRecordURL` with the provided parameter
+func (drv *ReverseHTTPDriver) LookupReverseRecord(
+	accountid string,
+) (*ReverseRecord, error) {
+	query := url.Values{}
+	query.Set("account_id", accountid)
+
+	var resp proto.IDResponse
+	found, err := drv.get(drv.LookupReverseRecordURL, query, &resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "http get")
+	}
+	if !found {
+		return nil, nil
+	}
+
+	name, domain, err := address.Split(resp.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid stellar_address in response")
+	}
+
+	return &ReverseRecord{Name: name, Domain: domain}, nil
+}
+
+var _ Driver = &HTTPDriver{}
+var _ ReverseDriver = &ReverseHTTPDriver{}
+
+// get sends a GET request to endpoint and decodes the JSON response into dest.
+// It returns false when the service responded with 404.
+func (drv *HTTPDriver) get(endpoint string, query url.Values, dest interface{}) (bool, error) {
+	if endpoint == "" {
+		return false, errors.New("no url specified")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false, errors.Wrap(err, "parse url")
+	}
+	values := u.Query()
+	for key := range query {
+		values.Set(key, query.Get(key))
+	}
+	u.RawQuery = values.Encode()
+
+	client := drv.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	hresp, err := client.Get(u.String())
+	if err != nil {
+		return false, errors.Wrap(err, "request failed")
+	}
+	defer hresp.Body.Close()
+
+	switch {
+	case hresp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case hresp.StatusCode != http.StatusOK:
+		return false, errors.Errorf("unexpected status code: %d", hresp.StatusCode)
+	}
+
+	limitReader := io.LimitReader(hresp.Body, httpDriverResponseMaxSize)
+	err = json.NewDecoder(limitReader).Decode(dest)
+	if err != nil {
+		return false, errors.Wrap(err, "decode response")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,71 @@
+package federation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPDriver(t *testing.T) {
+	h := httptest.NewClient()
+	driver := &ReverseHTTPDriver{
+		HTTPDriver: HTTPDriver{
+			HTTP:            h,
+			LookupRecordURL: "https://users.example.com/federation?key=secret",
+		},
+		LookupReverseRecordURL: "https://users.example.com/reverse",
+	}
+
+	h.
+		On("GET", "https://users.example.com/federation?domain=stellar.org&key=secret&name=scott").
+		ReturnJSON(http.StatusOK, map[string]interface{}{
+			"account_id": "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG",
+			"memo_type":  "id",
+			"memo":       123,
+		})
+
+	rec, err := driver.LookupRecord("scott", "stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, &Record{
+		AccountID: "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG",
+		MemoType:  "id",
+		Memo:      "123",
+	}, rec)
+
+	// not found
+	h.
+		On("GET", "https://users.example.com/federation?domain=stellar.org&key=secret&name=jed").
+		ReturnNotFound()
+
+	rec, err = driver.LookupRecord("jed", "stellar.org")
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+
+	// service error
+	h.
+		On("GET", "https://users.example.com/federation?domain=stellar.org&key=secret&name=bartek").
+		ReturnString(http.StatusInternalServerError, "boom")
+
+	_, err = driver.LookupRecord("bartek", "stellar.org")
+	assert.EqualError(t, err, "http get: unexpected status code: 500")
+
+	// reverse
+	h.
+		On("GET", "https://users.example.com/reverse?account_id=GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG").
+		ReturnJSON(http.StatusOK, map[string]string{"stellar_address": "scott*stellar.org"})
+
+	rrec, err := driver.LookupReverseRecord("GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG")
+	require.NoError(t, err)
+	assert.Equal(t, &ReverseRecord{Name: "scott", Domain: "stellar.org"}, rrec)
+
+	h.
+		On("GET", "https://users.example.com/reverse?account_id=GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3").
+		ReturnNotFound()
+
+	rrec, err = driver.LookupReverseRecord("GCYMGWPZ6NC2U7SO6SMXOP5ZLXOEC5SYPKITDMVEONLCHFSCCQR2J4S3")
+	require.NoError(t, err)
+	assert.Nil(t, rrec)
+}
@@ -8,12 +8,17 @@
 //
 // A pre-baked implementation of `Driver` and `ReverseDriver` that provides
 // simple access to SQL systems is included. See `SQLDriver` for more details.
+// `HTTPDriver` proxies queries to an HTTP service, `FileDriver` serves records
+// from a static TOML or YAML file and `CachingDriver` caches the results of any
+// other driver.
 package federation
 
 import (
 	"database/sql"
+	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/stellar/go/support/db"
 )
@@ -121,3 +126,77 @@ type SQLDriver struct {
 	init sync.Once
 	db   *db.Session
 }
+
+// HTTP represents the http client that an `HTTPDriver` uses to make http
+// requests.
+type HTTP interface {
+	Get(url string) (*http.Response, error)
+}
+
+// HTTPDriver represents an implementation of `Driver` that forwards federation
+// queries to an HTTP service, for example an internal user directory.
+//
+// For every query the driver sends a GET request to `LookupRecordURL` with the
+// `name` and `domain` query parameters. The service should respond with a JSON
+// object in the format of a federation name response (`account_id`,
+// `memo_type` and `memo` fields) or with a 404 status code when the record is
+// not found.
+type HTTPDriver struct {
+	// HTTP is the client used to send requests. http.DefaultClient is used when
+	// nil.
+	HTTP HTTP
+
+	// LookupRecordURL is the URL of the service endpoint used for "forward"
+	// federation queries.
+	LookupRecordURL string
+}
+
+// ReverseHTTPDriver provides a `ReverseDriver` implementation based upon an
+// HTTP service. See `HTTPDriver`, the forward only version, for more details.
+type ReverseHTTPDriver struct {
+	HTTPDriver
+
+	// LookupReverseRecordURL is the URL of the service endpoint used for
+	// "reverse" federation queries. The driver sends a GET request with the
+	// `account_id` query parameter. The service should respond with a JSON
+	// object in the format of a federation id response (`stellar_address` field)
+	// or with a 404 status code when the record is not found.
+	LookupReverseRecordURL string
+}
+
+// FileDriver represents an implementation of `Driver` and `ReverseDriver` that
+// serves records loaded from a static file. It's useful for small anchors that
+// only have a handful of addresses. Use `LoadFileDriver` to create it.
+type FileDriver struct {
+	records map[string]*Record
+	reverse map[string]*ReverseRecord
+}
+
+// CachingDriver is a `Driver` that caches the results of another `Driver`.
+// Records that were not found are cached as well, for `NegativeTTL`.
+type CachingDriver struct {
+	// Driver is the driver which results are cached.
+	Driver Driver
+
+	// TTL is the time records are cached for. Defaults to
+	// DefaultCacheTTL.
+	TTL time.Duration
+
+	// NegativeTTL is the time "not found" results are cached for. Defaults to
+	// DefaultCacheNegativeTTL, a negative value disables negative caching.
+	NegativeTTL time.Duration
+
+	cache cache
+}
+
+// CachingReverseDriver provides a `ReverseDriver` implementation caching the
+// results of another `ReverseDriver`. See `CachingDriver`, the forward only
+// version, for more details.
+type CachingReverseDriver struct {
+	CachingDriver
+
+	// ReverseDriver is the driver which reverse lookup results are cached.
+	ReverseDriver ReverseDriver
+
+	reverseCache cache
+}
@@ -0,0 +1,242 @@
+package keypair
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/stellar/go/strkey"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeyfileVersion is the version of the keyfiles written by this package.
+const KeyfileVersion = 1
+
+const (
+	// KDFScrypt is the name of the scrypt key derivation function.
+	KDFScrypt = "scrypt"
+	// KDFArgon2id is the name of the argon2id key derivation function.
+	KDFArgon2id = "argon2id"
+
+	cipherAES256GCM = "aes-256-gcm"
+	keySize         = 32
+	saltSize        = 32
+
+	// maxKDFMemory is the most memory, in bytes, deriving the key of a keyfile
+	// may use, so that a crafted keyfile can't exhaust the memory of the
+	// process decrypting it. It's four times the memory used by the defaults.
+	maxKDFMemory = 1 << 30
+	// maxScryptN and maxScryptP bound the cost of scrypt, maxArgon2Time and
+	// maxArgon2Threads the one of argon2id, for the same reason.
+	maxScryptN       = 1 << 20
+	maxScryptP       = 16
+	maxArgon2Time    = 16
+	maxArgon2Threads = 16
+)
+
+var (
+	// ErrInvalidPassword is returned when a keyfile can't be decrypted with
+	// the given password, or when its content has been tampered with.
+	ErrInvalidPassword = errors.New("invalid password or corrupted keyfile")
+
+	// DefaultScrypt are the scrypt parameters used by SaveEncrypted, they take
+	// about a second and 256MB of memory.
+	DefaultScrypt = KDF{Name: KDFScrypt, N: 1 << 18, R: 8, P: 1}
+
+	// DefaultArgon2id are the recommended argon2id parameters.
+	DefaultArgon2id = KDF{Name: KDFArgon2id, Time: 3, Memory: 256 * 1024, Threads: 4}
+)
+
+// KDF is a password-based key derivation function and its parameters. The
+// parameters are stored in the keyfile, so changing the defaults doesn't
+// break existing keyfiles.
+type KDF struct {
+	Name string `json:"name"`
+	// Salt is generated randomly when empty.
+	Salt []byte `json:"salt"`
+
+	// N, R and P are the cost parameters of scrypt.
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	// Time, Memory in KiB and Threads are the cost parameters of argon2id.
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// keyfile is the JSON envelope of an encrypted key. The address is stored in
+// clear so that keyfiles can be identified without their password, and is
+// authenticated as additional data.
+type keyfile struct {
+	Version    int    `json:"version"`
+	Address    string `json:"address"`
+	KDF        KDF    `json:"kdf"`
+	Cipher     string `json:"cipher"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptKey returns the keyfile of kp encrypted with a key derived from
+// password with kdf.
+func EncryptKey(kp *Full, password []byte, kdf KDF) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, errors.New("empty password")
+	}
+
+	if len(kdf.Salt) == 0 {
+		kdf.Salt = make([]byte, saltSize)
+		if _, err := io.ReadFull(rand.Reader, kdf.Salt); err != nil {
+			return nil, err
+		}
+	}
+
+	aead, err := kdf.aead(password)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	file := keyfile{
+		Version:    KeyfileVersion,
+		Address:    kp.Address(),
+		KDF:        kdf,
+		Cipher:     cipherAES256GCM,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, kp.rawSeed(), []byte(kp.Address())),
+	}
+	return json.MarshalIndent(file, "", "  ")
+}
+
+// DecryptKey returns the key pair of the keyfile data encrypted with
+// password.
+func DecryptKey(data, password []byte) (*Full, error) {
+	var file keyfile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid keyfile: %v", err)
+	}
+	if file.Version != KeyfileVersion {
+		return nil, fmt.Errorf("unsupported keyfile version %d", file.Version)
+	}
+	if file.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("unsupported keyfile cipher %s", file.Cipher)
+	}
+
+	aead, err := file.KDF.aead(password)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid keyfile nonce")
+	}
+
+	rawSeed, err := aead.Open(nil, file.Nonce, file.Ciphertext, []byte(file.Address))
+	if err != nil || len(rawSeed) != 32 {
+		return nil, ErrInvalidPassword
+	}
+
+	var seed [32]byte
+	copy(seed[:], rawSeed)
+	kp, err := FromRawSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	if kp.Address() != file.Address {
+		return nil, ErrInvalidPassword
+	}
+	return kp, nil
+}
+
+// KeyfileAddress returns the address of the keyfile data without decrypting
+// it.
+func KeyfileAddress(data []byte) (string, error) {
+	var file keyfile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return "", fmt.Errorf("invalid keyfile: %v", err)
+	}
+	if _, err := strkey.Decode(strkey.VersionByteAccountID, file.Address); err != nil {
+		return "", fmt.Errorf("invalid keyfile address: %v", err)
+	}
+	return file.Address, nil
+}
+
+// SaveEncrypted writes kp to a new keyfile at path, encrypted with password
+// using scrypt with the DefaultScrypt parameters. The file is readable by its
+// owner only and existing files aren't overwritten.
+func SaveEncrypted(path string, kp *Full, password []byte) error {
+	data, err := EncryptKey(kp, password, DefaultScrypt)
+	if err != nil {
+		return err
+	}
+	return writeNewFile(path, data)
+}
+
+// LoadEncrypted reads the key pair of the keyfile at path, encrypted with
+// password.
+func LoadEncrypted(path string, password []byte) (*Full, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptKey(data, password)
+}
+
+func (kdf KDF) aead(password []byte) (cipher.AEAD, error) {
+	if len(kdf.Salt) < 16 {
+		return nil, errors.New("keyfile salt is too short")
+	}
+
+	var key []byte
+	switch kdf.Name {
+	case KDFScrypt:
+		// scrypt uses 128*N*R bytes of memory
+		if kdf.N > maxScryptN || kdf.R <= 0 || kdf.P > maxScryptP || 128*int64(kdf.N)*int64(kdf.R) > maxKDFMemory {
+			return nil, errors.New("invalid scrypt parameters: cost too high")
+		}
+		var err error
+		key, err = scrypt.Key(password, kdf.Salt, kdf.N, kdf.R, kdf.P, keySize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scrypt parameters: %v", err)
+		}
+	case KDFArgon2id:
+		if kdf.Time == 0 || kdf.Threads == 0 || kdf.Memory < 8*uint32(kdf.Threads) {
+			return nil, errors.New("invalid argon2id parameters")
+		}
+		if kdf.Time > maxArgon2Time || kdf.Threads > maxArgon2Threads || 1024*int64(kdf.Memory) > maxKDFMemory {
+			return nil, errors.New("invalid argon2id parameters: cost too high")
+		}
+		key = argon2.IDKey(password, kdf.Salt, kdf.Time, kdf.Memory, kdf.Threads, keySize)
+	default:
+		return nil, fmt.Errorf("unsupported keyfile kdf %s", kdf.Name)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func writeNewFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,130 @@
+package keypair
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fastScrypt keeps the tests fast, real keyfiles use DefaultScrypt.
+var fastScrypt = KDF{Name: KDFScrypt, N: 1 << 10, R: 8, P: 1}
+
+var _ = Describe("keypair.EncryptKey()", func() {
+	var kp *Full
+	password := []byte("correct horse battery staple")
+
+	BeforeEach(func() {
+		kp = MustParse(seed).(*Full)
+	})
+
+	It("round trips through DecryptKey() with scrypt", func() {
+		data, err := EncryptKey(kp, password, fastScrypt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"address": "` + address + `"`))
+		Expect(string(data)).ToNot(ContainSubstring(seed))
+
+		decrypted, err := DecryptKey(data, password)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted.Seed()).To(Equal(seed))
+
+		addr, err := KeyfileAddress(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addr).To(Equal(address))
+	})
+
+	It("round trips through DecryptKey() with argon2id", func() {
+		data, err := EncryptKey(kp, password, KDF{Name: KDFArgon2id, Time: 1, Memory: 64, Threads: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		decrypted, err := DecryptKey(data, password)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted.Seed()).To(Equal(seed))
+	})
+
+	It("uses a random salt and nonce", func() {
+		a, err := EncryptKey(kp, password, fastScrypt)
+		Expect(err).ToNot(HaveOccurred())
+		b, err := EncryptKey(kp, password, fastScrypt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(a).ToNot(Equal(b))
+	})
+
+	It("fails with an empty password or unknown kdf", func() {
+		_, err := EncryptKey(kp, nil, fastScrypt)
+		Expect(err).To(MatchError("empty password"))
+
+		_, err = EncryptKey(kp, password, KDF{Name: "pbkdf2"})
+		Expect(err).To(MatchError("unsupported keyfile kdf pbkdf2"))
+	})
+})
+
+var _ = Describe("keypair.DecryptKey()", func() {
+	password := []byte("correct horse battery staple")
+	var data []byte
+
+	BeforeEach(func() {
+		var err error
+		data, err = EncryptKey(MustParse(seed).(*Full), password, fastScrypt)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("fails with the wrong password", func() {
+		_, err := DecryptKey(data, []byte("wrong"))
+		Expect(err).To(Equal(ErrInvalidPassword))
+	})
+
+	It("fails when the address is tampered with", func() {
+		other := MustRandom().Address()
+		_, err := DecryptKey([]byte(strings.Replace(string(data), address, other, 1)), password)
+		Expect(err).To(Equal(ErrInvalidPassword))
+	})
+
+	It("fails with unsupported versions", func() {
+		_, err := DecryptKey([]byte(strings.Replace(string(data), `"version": 1`, `"version": 2`, 1)), password)
+		Expect(err).To(MatchError("unsupported keyfile version 2"))
+
+		_, err = DecryptKey([]byte("{"), password)
+		Expect(err).To(MatchError("invalid keyfile: unexpected end of JSON input"))
+	})
+
+	It("fails when the kdf cost is too high", func() {
+		_, err := DecryptKey([]byte(strings.Replace(string(data), `"n": 1024`, `"n": 1073741824`, 1)), password)
+		Expect(err).To(MatchError("invalid scrypt parameters: cost too high"))
+
+		argon2, err := EncryptKey(MustParse(seed).(*Full), password, KDF{Name: KDFArgon2id, Time: 1, Memory: 64, Threads: 1})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = DecryptKey([]byte(strings.Replace(string(argon2), `"memory": 64`, `"memory": 4294967295`, 1)), password)
+		Expect(err).To(MatchError("invalid argon2id parameters: cost too high"))
+	})
+})
+
+var _ = Describe("keypair.SaveEncrypted()", func() {
+	It("writes a keyfile readable by LoadEncrypted()", func() {
+		dir, err := ioutil.TempDir("", "keyfile")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		defaultScrypt := DefaultScrypt
+		DefaultScrypt = fastScrypt
+		defer func() { DefaultScrypt = defaultScrypt }()
+
+		path := filepath.Join(dir, "key.json")
+		password := []byte("password")
+		Expect(SaveEncrypted(path, MustParse(seed).(*Full), password)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		kp, err := LoadEncrypted(path, password)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kp.Address()).To(Equal(address))
+
+		// existing files are not overwritten
+		Expect(SaveEncrypted(path, MustRandom(), password)).ToNot(Succeed())
+	})
+})
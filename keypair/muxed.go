@@ -0,0 +1,23 @@
+package keypair
+
+import (
+	"github.com/stellar/go/strkey"
+)
+
+// ParseMuxed constructs a new KP from the provided muxed account address
+// ("M..."), returning it along with the ID it's made of. The resulting KP can
+// only verify signatures, as a muxed account address doesn't contain a seed.
+func ParseMuxed(muxedAddress string) (*FromAddress, uint64, error) {
+	accountID, id, err := strkey.DecodeMuxedAccount(muxedAddress)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &FromAddress{accountID}, id, nil
+}
+
+// MuxedAddress returns the muxed account address ("M...") made of the address
+// of kp and the provided ID.
+func MuxedAddress(kp KP, id uint64) (string, error) {
+	return strkey.EncodeMuxedAccount(kp.Address(), id)
+}
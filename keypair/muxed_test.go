@@ -0,0 +1,31 @@
+package keypair
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("keypair.MuxedAddress()", func() {
+	It("round trips through ParseMuxed()", func() {
+		muxed, err := MuxedAddress(MustParse(seed), 42)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(muxed).To(HavePrefix("M"))
+
+		kp, id, err := ParseMuxed(muxed)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kp.Address()).To(Equal(address))
+		Expect(id).To(Equal(uint64(42)))
+	})
+})
+
+var _ = Describe("keypair.ParseMuxed()", func() {
+	It("fails with an account ID", func() {
+		_, _, err := ParseMuxed(address)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails with a seed", func() {
+		_, _, err := ParseMuxed(seed)
+		Expect(err).To(HaveOccurred())
+	})
+})
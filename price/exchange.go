@@ -0,0 +1,253 @@
+package price
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/stellar/go/xdr"
+)
+
+// ExchangeRounding is the rounding used by stellar-core when crossing an
+// offer, which depends on the operation crossing it.
+type ExchangeRounding int
+
+const (
+	// RoundingNormal is used by offers crossing offers: ManageSellOffer,
+	// ManageBuyOffer and CreatePassiveSellOffer.
+	RoundingNormal ExchangeRounding = iota
+	// RoundingPathPaymentStrictReceive is used by PathPaymentStrictReceive.
+	RoundingPathPaymentStrictReceive
+	// RoundingPathPaymentStrictSend is used by PathPaymentStrictSend.
+	RoundingPathPaymentStrictSend
+)
+
+// priceErrorThreshold is the maximum relative error, in percent, between the
+// price of an offer and the price of a trade crossing it.
+const priceErrorThreshold = 1
+
+// ExchangeResult is the result of crossing an offer selling wheat for sheep.
+type ExchangeResult struct {
+	// WheatReceived is the amount of wheat received by the party crossing the
+	// offer.
+	WheatReceived int64
+	// SheepSent is the amount of sheep sent by the party crossing the offer.
+	SheepSent int64
+	// WheatStays is true when the offer isn't entirely consumed.
+	WheatStays bool
+}
+
+// Invert returns the inverse of p, e.g. the price of an offer expressed in
+// units of the selling asset.
+func Invert(p xdr.Price) (xdr.Price, error) {
+	if p.N == 0 {
+		return xdr.Price{}, ErrDivisionByZero
+	}
+	return xdr.Price{N: p.D, D: p.N}, nil
+}
+
+// Cmp compares the prices a and b exactly and returns -1 if a < b, 0 if a == b
+// and +1 if a > b. The denominators must be positive.
+func Cmp(a, b xdr.Price) int {
+	l := int64(a.N) * int64(b.D)
+	r := int64(b.N) * int64(a.D)
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	}
+	return 0
+}
+
+// Crosses returns true if an offer at the price taker crosses the offer at
+// the price maker selling the asset the taker buys, i.e. if the taker price
+// times the maker price is at most 1.
+func Crosses(taker, maker xdr.Price) bool {
+	l := uint128Mul(uint64(taker.N), uint64(maker.N))
+	r := uint128Mul(uint64(taker.D), uint64(maker.D))
+	return l.cmp(r) <= 0
+}
+
+// Exchange computes the amounts traded when crossing an offer selling wheat
+// for sheep at the price p, in sheep per wheat, exactly as stellar-core does
+// since protocol 10.
+//
+// maxWheatSend is the amount of wheat the offer sells and maxSheepReceive the
+// amount of sheep its seller can receive. maxSheepSend is the amount of sheep
+// the party crossing the offer can send and maxWheatReceive the amount of
+// wheat it can receive.
+//
+// With RoundingNormal, trades whose price is more than 1% away from p result
+// in no exchange. With the path payment roundings, such trades return an
+// error, as stellar-core fails the operation.
+func Exchange(
+	p xdr.Price,
+	maxWheatSend, maxWheatReceive, maxSheepSend, maxSheepReceive int64,
+	round ExchangeRounding,
+) (ExchangeResult, error) {
+	if p.N <= 0 || p.D <= 0 {
+		return ExchangeResult{}, errors.New("invalid price")
+	}
+	if maxWheatSend < 0 || maxWheatReceive < 0 || maxSheepSend < 0 || maxSheepReceive < 0 {
+		return ExchangeResult{}, errors.New("negative amount")
+	}
+
+	n, d := int64(p.N), int64(p.D)
+	wheatValue := offerValue(n, d, maxWheatSend, maxSheepReceive)
+	sheepValue := offerValue(d, n, maxSheepSend, maxWheatReceive)
+
+	var result ExchangeResult
+	var err error
+	result.WheatStays = wheatValue.cmp(sheepValue) > 0
+
+	switch {
+	case result.WheatStays && round == RoundingPathPaymentStrictSend:
+		result.WheatReceived, err = bigDivide(sheepValue, n, false)
+		result.SheepSent = min(maxSheepSend, maxSheepReceive)
+	case result.WheatStays && (n > d || round == RoundingPathPaymentStrictReceive):
+		result.WheatReceived, err = bigDivide(sheepValue, n, false)
+		if err == nil {
+			result.SheepSent, err = bigDivide(uint128Mul(uint64(result.WheatReceived), uint64(n)), d, true)
+		}
+	case result.WheatStays:
+		result.SheepSent, err = bigDivide(sheepValue, d, false)
+		if err == nil {
+			result.WheatReceived, err = bigDivide(uint128Mul(uint64(result.SheepSent), uint64(d)), n, false)
+		}
+	case n > d:
+		result.WheatReceived, err = bigDivide(wheatValue, n, false)
+		if err == nil {
+			result.SheepSent, err = bigDivide(uint128Mul(uint64(result.WheatReceived), uint64(n)), d, false)
+		}
+	default:
+		result.SheepSent, err = bigDivide(wheatValue, d, false)
+		if err == nil {
+			result.WheatReceived, err = bigDivide(uint128Mul(uint64(result.SheepSent), uint64(d)), n, true)
+		}
+	}
+	if err != nil {
+		return ExchangeResult{}, err
+	}
+
+	if result.WheatReceived > min(maxWheatReceive, maxWheatSend) ||
+		result.SheepSent > min(maxSheepReceive, maxSheepSend) {
+		return ExchangeResult{}, errors.New("exchange exceeds the maximum amounts")
+	}
+
+	return applyPriceErrorThreshold(p, result, round)
+}
+
+// applyPriceErrorThreshold checks that the price of the trade is close enough
+// to the price of the offer, see `Exchange`.
+func applyPriceErrorThreshold(p xdr.Price, result ExchangeResult, round ExchangeRounding) (ExchangeResult, error) {
+	if result.WheatReceived == 0 || result.SheepSent == 0 {
+		if round != RoundingPathPaymentStrictSend {
+			result.WheatReceived = 0
+			result.SheepSent = 0
+		}
+		return result, nil
+	}
+
+	wheatReceivedValue := uint128Mul(uint64(result.WheatReceived), uint64(p.N))
+	sheepSentValue := uint128Mul(uint64(result.SheepSent), uint64(p.D))
+
+	// the rounding never favors the party receiving wheat
+	if result.WheatStays && sheepSentValue.cmp(wheatReceivedValue) < 0 ||
+		!result.WheatStays && sheepSentValue.cmp(wheatReceivedValue) > 0 {
+		return ExchangeResult{}, errors.New("exchange favors the party receiving wheat")
+	}
+
+	if round == RoundingNormal {
+		if !checkPriceErrorBound(p, result, false) {
+			result.WheatReceived = 0
+			result.SheepSent = 0
+		}
+		return result, nil
+	}
+
+	if !checkPriceErrorBound(p, result, true) {
+		return ExchangeResult{}, errors.New("exchange exceeds the price error bound")
+	}
+	return result, nil
+}
+
+// checkPriceErrorBound returns true if the price of the trade is within
+// priceErrorThreshold percent of p. When canFavorWheat is true, trades more
+// favorable to the seller of wheat are always accepted.
+func checkPriceErrorBound(p xdr.Price, result ExchangeResult, canFavorWheat bool) bool {
+	const k = 100 / priceErrorThreshold
+
+	lhs := uint128Mul(uint64(k)*uint64(p.N), uint64(result.WheatReceived))
+	rhs := uint128Mul(uint64(k)*uint64(p.D), uint64(result.SheepSent))
+	if canFavorWheat && rhs.cmp(lhs) > 0 {
+		return true
+	}
+
+	var diff uint128
+	if lhs.cmp(rhs) > 0 {
+		diff = lhs.sub(rhs)
+	} else {
+		diff = rhs.sub(lhs)
+	}
+	bound := uint128Mul(uint64(p.N), uint64(result.WheatReceived))
+	return diff.cmp(bound) <= 0
+}
+
+// offerValue returns the value of what a party can exchange:
+// min(maxSend * n, maxReceive * d).
+func offerValue(n, d, maxSend, maxReceive int64) uint128 {
+	send := uint128Mul(uint64(maxSend), uint64(n))
+	receive := uint128Mul(uint64(maxReceive), uint64(d))
+	if send.cmp(receive) < 0 {
+		return send
+	}
+	return receive
+}
+
+// uint128 is an unsigned 128-bit integer.
+type uint128 struct {
+	hi, lo uint64
+}
+
+func uint128Mul(x, y uint64) uint128 {
+	hi, lo := bits.Mul64(x, y)
+	return uint128{hi, lo}
+}
+
+func (x uint128) cmp(y uint128) int {
+	switch {
+	case x.hi < y.hi, x.hi == y.hi && x.lo < y.lo:
+		return -1
+	case x == y:
+		return 0
+	}
+	return 1
+}
+
+func (x uint128) sub(y uint128) uint128 {
+	lo, borrow := bits.Sub64(x.lo, y.lo, 0)
+	hi, _ := bits.Sub64(x.hi, y.hi, borrow)
+	return uint128{hi, lo}
+}
+
+// bigDivide returns x / d, rounded up if roundUp is true and down otherwise.
+// d must be positive.
+func bigDivide(x uint128, d int64, roundUp bool) (int64, error) {
+	if d <= 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	denominator := uint64(d)
+	if denominator <= x.hi {
+		return 0, ErrOverflow
+	}
+	q, r := bits.Div64(x.hi, x.lo, denominator)
+	if roundUp && r != 0 {
+		q++
+	}
+	if q > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(q), nil
+}
@@ -0,0 +1,103 @@
+package price
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchange(t *testing.T) {
+	for _, testCase := range []struct {
+		name                                                         string
+		price                                                        xdr.Price
+		maxWheatSend, maxWheatReceive, maxSheepSend, maxSheepReceive int64
+		round                                                        ExchangeRounding
+		expected                                                     ExchangeResult
+	}{
+		{
+			"partially consumed offer",
+			xdr.Price{N: 1, D: 1}, 100, 1000, 50, math.MaxInt64, RoundingNormal,
+			ExchangeResult{WheatReceived: 50, SheepSent: 50, WheatStays: true},
+		},
+		{
+			"entirely consumed offer",
+			xdr.Price{N: 3, D: 2}, 10, math.MaxInt64, 100, math.MaxInt64, RoundingNormal,
+			ExchangeResult{WheatReceived: 10, SheepSent: 15, WheatStays: false},
+		},
+		{
+			"wheat received rounded down",
+			xdr.Price{N: 1, D: 3}, 10, math.MaxInt64, 1, math.MaxInt64, RoundingNormal,
+			ExchangeResult{WheatReceived: 3, SheepSent: 1, WheatStays: true},
+		},
+		{
+			"nothing to exchange",
+			xdr.Price{N: 2, D: 3}, 1, math.MaxInt64, 100, math.MaxInt64, RoundingNormal,
+			ExchangeResult{WheatReceived: 0, SheepSent: 0, WheatStays: false},
+		},
+		{
+			"price error above threshold",
+			xdr.Price{N: 3, D: 2}, 1, math.MaxInt64, 100, math.MaxInt64, RoundingNormal,
+			ExchangeResult{WheatReceived: 0, SheepSent: 0, WheatStays: false},
+		},
+		{
+			"strict receive",
+			xdr.Price{N: 1, D: 3}, 10, 3, 100, math.MaxInt64, RoundingPathPaymentStrictReceive,
+			ExchangeResult{WheatReceived: 3, SheepSent: 1, WheatStays: true},
+		},
+		{
+			"strict send",
+			xdr.Price{N: 1, D: 1}, 100, math.MaxInt64, 10, math.MaxInt64, RoundingPathPaymentStrictSend,
+			ExchangeResult{WheatReceived: 10, SheepSent: 10, WheatStays: true},
+		},
+		{
+			"strict send without wheat received",
+			xdr.Price{N: 3, D: 1}, 100, math.MaxInt64, 2, math.MaxInt64, RoundingPathPaymentStrictSend,
+			ExchangeResult{WheatReceived: 0, SheepSent: 2, WheatStays: true},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Exchange(
+				testCase.price,
+				testCase.maxWheatSend,
+				testCase.maxWheatReceive,
+				testCase.maxSheepSend,
+				testCase.maxSheepReceive,
+				testCase.round,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestExchangeErrors(t *testing.T) {
+	_, err := Exchange(xdr.Price{N: 3, D: 2}, 1, math.MaxInt64, 100, math.MaxInt64, RoundingPathPaymentStrictReceive)
+	assert.EqualError(t, err, "exchange exceeds the price error bound")
+
+	_, err = Exchange(xdr.Price{N: 0, D: 2}, 1, 1, 1, 1, RoundingNormal)
+	assert.EqualError(t, err, "invalid price")
+
+	_, err = Exchange(xdr.Price{N: 1, D: 2}, -1, 1, 1, 1, RoundingNormal)
+	assert.EqualError(t, err, "negative amount")
+}
+
+func TestInvertCmpCrosses(t *testing.T) {
+	inverted, err := Invert(xdr.Price{N: 2, D: 3})
+	require.NoError(t, err)
+	assert.Equal(t, xdr.Price{N: 3, D: 2}, inverted)
+
+	_, err = Invert(xdr.Price{N: 0, D: 3})
+	assert.Equal(t, ErrDivisionByZero, err)
+
+	assert.Equal(t, 0, Cmp(xdr.Price{N: 1, D: 2}, xdr.Price{N: 2, D: 4}))
+	assert.Equal(t, -1, Cmp(xdr.Price{N: 1, D: 3}, xdr.Price{N: 1, D: 2}))
+	assert.Equal(t, 1, Cmp(xdr.Price{N: math.MaxInt32, D: 1}, xdr.Price{N: math.MaxInt32 - 1, D: 1}))
+
+	assert.True(t, Crosses(xdr.Price{N: 2, D: 1}, xdr.Price{N: 1, D: 2}))
+	assert.True(t, Crosses(xdr.Price{N: 2, D: 1}, xdr.Price{N: 1, D: 3}))
+	assert.False(t, Crosses(xdr.Price{N: 2, D: 1}, xdr.Price{N: 2, D: 3}))
+	assert.True(t, Crosses(xdr.Price{N: math.MaxInt32, D: 1}, xdr.Price{N: 1, D: math.MaxInt32}))
+}
@@ -181,6 +181,61 @@ func (res AssetStat) PagingToken() string {
 	return res.PT
 }
 
+// AssetHolder represents an account holding an asset, with the state of its
+// trust line.
+type AssetHolder struct {
+	Links struct {
+		Account hal.Link `json:"account"`
+	} `json:"_links"`
+
+	PT                 string `json:"paging_token"`
+	AccountID          string `json:"account_id"`
+	Balance            string `json:"balance"`
+	Limit              string `json:"limit"`
+	BuyingLiabilities  string `json:"buying_liabilities"`
+	SellingLiabilities string `json:"selling_liabilities"`
+	IsAuthorized       bool   `json:"is_authorized"`
+	LastModifiedLedger uint32 `json:"last_modified_ledger"`
+}
+
+// PagingToken implementation for hal.Pageable
+func (res AssetHolder) PagingToken() string {
+	return res.PT
+}
+
+// AssetDistribution represents the distribution of the balances of an asset
+// among its holders.
+type AssetDistribution struct {
+	Links struct {
+		Self    hal.Link `json:"self"`
+		Holders hal.Link `json:"holders"`
+	} `json:"_links"`
+
+	base.Asset
+	NumHolders         int32  `json:"num_holders"`
+	NumAuthorized      int32  `json:"num_authorized"`
+	NumUnauthorized    int32  `json:"num_unauthorized"`
+	Amount             string `json:"amount"`
+	AuthorizedAmount   string `json:"authorized_amount"`
+	UnauthorizedAmount string `json:"unauthorized_amount"`
+	// Histogram counts the holders by order of magnitude of their balance.
+	Histogram []AssetBalanceBucket `json:"histogram"`
+}
+
+// PagingToken implementation for hal.Pageable. Not used.
+func (res AssetDistribution) PagingToken() string {
+	return ""
+}
+
+// AssetBalanceBucket counts the holders of an asset whose balance is in
+// [MinBalance, MaxBalance).
+type AssetBalanceBucket struct {
+	MinBalance string `json:"min_balance"`
+	MaxBalance string `json:"max_balance"`
+	NumHolders int32  `json:"num_holders"`
+	Amount     string `json:"amount"`
+}
+
 // Balance represents an account's holdings for a single currency type
 type Balance struct {
 	Balance            string `json:"balance"`
@@ -255,6 +310,34 @@ type OrderBookSummary struct {
 	Buying  Asset        `json:"counter"`
 }
 
+// OrderBookSample represents the depth of an order book after a ledger.
+// Prices are in units of the counter asset per unit of the base asset and are
+// null when a side of the book is empty. Bid depths are amounts of the counter
+// asset and ask depths amounts of the base asset offered within 1% and 5% of
+// the mid price.
+type OrderBookSample struct {
+	Links struct {
+		Ledger hal.Link `json:"ledger"`
+	} `json:"_links"`
+
+	PT             string    `json:"paging_token"`
+	LedgerSequence uint32    `json:"ledger"`
+	ClosedAt       time.Time `json:"closed_at"`
+	BestBid        *string   `json:"best_bid"`
+	BestAsk        *string   `json:"best_ask"`
+	MidPrice       *string   `json:"mid_price"`
+	Spread         *string   `json:"spread"`
+	BidDepth1      string    `json:"bid_depth_1_percent"`
+	AskDepth1      string    `json:"ask_depth_1_percent"`
+	BidDepth5      string    `json:"bid_depth_5_percent"`
+	AskDepth5      string    `json:"ask_depth_5_percent"`
+}
+
+// PagingToken implementation for hal.Pageable
+func (res OrderBookSample) PagingToken() string {
+	return res.PT
+}
+
 // Path represents a single payment path.
 type Path struct {
 	SourceAssetType        string  `json:"source_asset_type"`
@@ -645,6 +728,17 @@ type FeeStats struct {
 	P99AcceptedFee      int     `json:"p99_accepted_fee,string"`
 }
 
+// FeeInclusion represents Horizon's estimate of the probability that a
+// transaction bidding Fee stroops per operation is included in the next ledger,
+// from the last Ledgers ledgers.
+type FeeInclusion struct {
+	Fee                  int     `json:"fee,string"`
+	Ledgers              int     `json:"ledgers,string"`
+	LastLedger           int     `json:"last_ledger,string"`
+	FullLedgers          int     `json:"full_ledgers,string"`
+	InclusionProbability float64 `json:"inclusion_probability,string"`
+}
+
 // TransactionsPage contains records of transaction information returned by Horizon
 type TransactionsPage struct {
 	Links    hal.Links `json:"_links"`
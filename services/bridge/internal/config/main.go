@@ -7,6 +7,7 @@ import (
 
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols"
+	"github.com/stellar/go/support/config"
 )
 
 // Config contains config params of the bridge server
@@ -23,6 +24,9 @@ type Config struct {
 	Database          *Database         `valid:"optional"`
 	Accounts          Accounts          `valid:"optional" toml:"accounts"`
 	Callbacks         Callbacks         `valid:"optional" toml:"callbacks"`
+	// FederationCacheTTL is the number of seconds stellar.toml files and
+	// federation responses are cached for. Caching is disabled when 0.
+	FederationCacheTTL int `valid:"optional" toml:"federation_cache_ttl"`
 }
 
 // Accounts contains values of `accounts` config group
@@ -31,14 +35,30 @@ type Accounts struct {
 	BaseSeed           string `valid:"optional" toml:"base_seed"`
 	IssuingAccountID   string `valid:"optional" toml:"issuing_account_id"`
 	ReceivingAccountID string `valid:"optional" toml:"receiving_account_id"`
+	// AuthorizingKeyfile and BaseKeyfile are encrypted keyfiles used instead
+	// of AuthorizingSeed and BaseSeed, see LoadKeyfiles.
+	AuthorizingKeyfile *config.Keyfile `valid:"optional" toml:"authorizing_keyfile"`
+	BaseKeyfile        *config.Keyfile `valid:"optional" toml:"base_keyfile"`
+	// ChannelSeeds are the seeds of channel accounts used as the source of
+	// transactions sent by the bridge server. Channels are created and funded by
+	// the base account when they don't exist.
+	ChannelSeeds []string `valid:"optional" toml:"channel_seeds"`
+	// ChannelKeyfiles are encrypted keyfiles used instead of ChannelSeeds.
+	ChannelKeyfiles []config.Keyfile `valid:"optional" toml:"channel_keyfiles"`
 }
 
 // Callbacks contains values of `callbacks` config group
 type Callbacks struct {
 	Receive string `valid:"optional"`
 	Error   string `valid:"optional"`
+	// MaxAttempts is the number of times a receive callback is tried before it's
+	// moved to the dead-letter state. Defaults to DefaultCallbackMaxAttempts.
+	MaxAttempts int `valid:"optional" toml:"max_attempts"`
 }
 
+// DefaultCallbackMaxAttempts is used when `callbacks.max_attempts` is not set
+const DefaultCallbackMaxAttempts = 20
+
 // Database contains values of `database` config group
 type Database struct {
 	Type string `valid:"required"`
@@ -47,6 +67,49 @@ type Database struct {
 
 var assetCodeMatch = regexp.MustCompile("^[a-zA-Z0-9]{1,12}$")
 
+// LoadKeyfiles decrypts the keyfiles of the accounts config group and sets
+// the seeds they contain. It must be called before Validate.
+func (c *Config) LoadKeyfiles() error {
+	keyfiles := []struct {
+		name    string
+		keyfile *config.Keyfile
+		seed    *string
+	}{
+		{"authorizing", c.Accounts.AuthorizingKeyfile, &c.Accounts.AuthorizingSeed},
+		{"base", c.Accounts.BaseKeyfile, &c.Accounts.BaseSeed},
+	}
+
+	for _, k := range keyfiles {
+		if k.keyfile == nil {
+			continue
+		}
+		if *k.seed != "" {
+			return errors.New("accounts." + k.name + "_seed and accounts." + k.name + "_keyfile can't be both set")
+		}
+
+		kp, err := k.keyfile.Load()
+		if err != nil {
+			return err
+		}
+		*k.seed = kp.Seed()
+	}
+
+	if len(c.Accounts.ChannelKeyfiles) == 0 {
+		return nil
+	}
+	if len(c.Accounts.ChannelSeeds) > 0 {
+		return errors.New("accounts.channel_seeds and accounts.channel_keyfiles can't be both set")
+	}
+	for i := range c.Accounts.ChannelKeyfiles {
+		kp, err := c.Accounts.ChannelKeyfiles[i].Load()
+		if err != nil {
+			return err
+		}
+		c.Accounts.ChannelSeeds = append(c.Accounts.ChannelSeeds, kp.Seed())
+	}
+	return nil
+}
+
 // Validate validates config and returns error if any of config values is incorrect
 func (c *Config) Validate() (err error) {
 	if c.Port == nil {
@@ -128,6 +191,20 @@ func (c *Config) Validate() (err error) {
 		}
 	}
 
+	if len(c.Accounts.ChannelSeeds) > 0 && c.Accounts.BaseSeed == "" {
+		err = errors.New("accounts.base_seed is required when accounts.channel_seeds is set")
+		return
+	}
+
+	for _, seed := range c.Accounts.ChannelSeeds {
+		var kp keypair.KP
+		kp, err = keypair.Parse(seed)
+		if _, ok := kp.(*keypair.Full); err != nil || !ok {
+			err = errors.New("accounts.channel_seeds contains an invalid seed")
+			return
+		}
+	}
+
 	if c.Accounts.IssuingAccountID != "" {
 		_, err = keypair.Parse(c.Accounts.IssuingAccountID)
 		if err != nil {
@@ -152,6 +229,16 @@ func (c *Config) Validate() (err error) {
 		}
 	}
 
+	if c.Callbacks.MaxAttempts < 0 {
+		err = errors.New("callbacks.max_attempts param must be positive")
+		return
+	}
+
+	if c.FederationCacheTTL < 0 {
+		err = errors.New("federation_cache_ttl param must be positive")
+		return
+	}
+
 	if c.Callbacks.Error != "" {
 		_, err = url.Parse(c.Callbacks.Error)
 		if err != nil {
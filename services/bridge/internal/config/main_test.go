@@ -2,8 +2,13 @@ package config
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,3 +80,92 @@ func TestConfig_Validate_db_url(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Validate_channel_seeds(t *testing.T) {
+	c := Config{
+		Port:              func(i int) *int { return &i }(8001),
+		Horizon:           "https://example.com",
+		NetworkPassphrase: "Test SDF Network ; September 2015",
+		Database:          &Database{},
+	}
+
+	testCases := []struct {
+		name     string
+		baseSeed string
+		seeds    []string
+		wantErr  error
+	}{
+		{name: "none", wantErr: nil},
+		{
+			name:     "valid",
+			baseSeed: "SAW74LFCXQU7TYOB3LU4J7FKVKM2FFEAR2OXYPEJDIR5OAW36ZO2T67E",
+			seeds:    []string{"SBLEN2RFOV7EFRLLRIIAU5Q4PECCZOTL77LKJPKFONONNYYPDFWSNUNE"},
+			wantErr:  nil,
+		},
+		{
+			name:    "no base seed",
+			seeds:   []string{"SBLEN2RFOV7EFRLLRIIAU5Q4PECCZOTL77LKJPKFONONNYYPDFWSNUNE"},
+			wantErr: errors.New("accounts.base_seed is required when accounts.channel_seeds is set"),
+		},
+		{
+			name:     "address instead of seed",
+			baseSeed: "SAW74LFCXQU7TYOB3LU4J7FKVKM2FFEAR2OXYPEJDIR5OAW36ZO2T67E",
+			seeds:    []string{"GCZJM35NKGVK47BB4SPBDV25477PZYIYPVVG453LPYFNXLS3FGHDXOCM"},
+			wantErr:  errors.New("accounts.channel_seeds contains an invalid seed"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Accounts.BaseSeed = tc.baseSeed
+			c.Accounts.ChannelSeeds = tc.seeds
+			err := c.Validate()
+			if tc.wantErr == nil {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestConfig_LoadKeyfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bridge")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kp := keypair.MustRandom()
+	path := filepath.Join(dir, "base.json")
+	data, err := keypair.EncryptKey(kp, []byte("secret"), keypair.KDF{Name: keypair.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+	os.Setenv("BRIDGE_TEST_PASSWORD", "secret")
+	defer os.Unsetenv("BRIDGE_TEST_PASSWORD")
+
+	c := Config{Accounts: Accounts{
+		BaseKeyfile: &config.Keyfile{Path: path, Password: "env:BRIDGE_TEST_PASSWORD"},
+	}}
+	require.NoError(t, c.LoadKeyfiles())
+	assert.Equal(t, kp.Seed(), c.Accounts.BaseSeed)
+	assert.Equal(t, "", c.Accounts.AuthorizingSeed)
+
+	// the seed is now set too
+	err = c.LoadKeyfiles()
+	assert.EqualError(t, err, "accounts.base_seed and accounts.base_keyfile can't be both set")
+
+	channel := keypair.MustRandom()
+	path = filepath.Join(dir, "channel.json")
+	data, err = keypair.EncryptKey(channel, []byte("secret"), keypair.KDF{Name: keypair.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	c = Config{Accounts: Accounts{
+		ChannelKeyfiles: []config.Keyfile{{Path: path, Password: "env:BRIDGE_TEST_PASSWORD"}},
+	}}
+	require.NoError(t, c.LoadKeyfiles())
+	assert.Equal(t, []string{channel.Seed()}, c.Accounts.ChannelSeeds)
+
+	err = c.LoadKeyfiles()
+	assert.EqualError(t, err, "accounts.channel_seeds and accounts.channel_keyfiles can't be both set")
+}
@@ -5,6 +5,7 @@
 // migrations/02_payment_id.sql (235B)
 // migrations/03_transaction_id.sql (156B)
 // migrations/04_table_names.sql (257B)
+// migrations/05_receive_callback.sql (564B)
 
 package db
 
@@ -173,6 +174,26 @@ func migrations04_table_namesSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations05_receive_callbackSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xc1\x6e\xea\x40\x0c\xbc\xe7\x2b\x7c\x23\xd1\x03\x89\x77\xe6\x94\x92\x45\x8d\x9a\x2e\x74\x9b\xa8\xe5\xb4\x32\x59\x2b\x5d\x35\x59\xa2\x8d\xa1\xf4\xef\xab\x14\x82\x0a\x88\xa3\x3d\xe3\x19\xcb\x9e\xc9\x04\xfe\x35\xb6\xf2\xc8\x04\x45\x1b\xcc\x95\x88\x73\x01\x79\xfc\x90\x09\xf0\x54\x92\xdd\x93\x2e\xb1\xae\x37\x58\x7e\x42\x18\x00\x58\x03\x1b\x5b\x75\xe4\x2d\xd6\xe3\x00\x06\x92\xd1\x2d\x7e\x37\xe4\x58\x1f\x09\xd6\x31\x14\x32\x7d\x29\x04\xc8\x65\x0e\xb2\xc8\x32\x50\x62\x21\x94\x90\x73\xf1\x7a\x33\x05\xa1\x35\x51\x2f\x37\xd4\x4c\x07\x3e\x4f\xf6\x40\xc7\xc8\xbb\x0e\xf6\xe8\xcb\x0f\xf4\xe1\xff\x69\x74\x01\x23\x33\x35\x2d\x77\x60\x1d\x53\x45\xfe\x0c\x42\x22\x16\x71\x91\xe5\x30\xed\x55\x6a\xec\x58\x93\xf7\x5b\x7f\x74\x18\xc0\x41\xc6\xd1\x81\xf5\x49\x4b\x23\x03\xdb\x86\x3a\xc6\xa6\xbd\x30\x2b\x3d\x21\x93\xb9\x4f\x30\x54\xdb\x3d\xf9\x6b\xca\xb5\xdb\x4a\xa5\xcf\xb1\x5a\xc3\x93\x58\xff\x1e\x20\x88\x66\xc1\xf0\x82\x54\x26\xe2\xfd\xe6\x05\xda\xec\x08\x96\xf2\xa6\x0f\xe1\xd5\xe6\x11\xbc\x3d\x0a\x25\x86\xb3\xa5\x12\xc2\x51\x4b\xce\x58\x57\x8d\xc6\x30\x3a\x2d\xd8\x57\xbd\xe7\xdf\x14\x24\xdb\x2f\x17\x24\x6a\xb9\xba\x93\x82\x59\xf0\x33\x00\x9a\x70\xdf\xe8\x34\x02\x00\x00")
+
+func migrations05_receive_callbackSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations05_receive_callbackSql,
+		"migrations/05_receive_callback.sql",
+	)
+}
+
+func migrations05_receive_callbackSql() (*asset, error) {
+	bytes, err := migrations05_receive_callbackSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/05_receive_callback.sql", size: 564, mode: os.FileMode(0644), modTime: time.Unix(1559692126, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9c, 0xcf, 0xdb, 0x55, 0xfe, 0xbc, 0x49, 0x76, 0x69, 0x61, 0x48, 0x65, 0x18, 0x8a, 0xa1, 0x8a, 0x45, 0x60, 0xc6, 0x9d, 0xa9, 0x87, 0x2a, 0x55, 0x46, 0x69, 0x93, 0xff, 0xf1, 0x7b, 0x3e, 0xcd}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -273,6 +294,8 @@ var _bindata = map[string]func() (*asset, error){
 	"migrations/03_transaction_id.sql": migrations03_transaction_idSql,
 
 	"migrations/04_table_names.sql": migrations04_table_namesSql,
+
+	"migrations/05_receive_callback.sql": migrations05_receive_callbackSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -318,10 +341,11 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"latest.sql": &bintree{latestSql, map[string]*bintree{}},
 	"migrations": &bintree{nil, map[string]*bintree{
-		"01_init.sql":           &bintree{migrations01_initSql, map[string]*bintree{}},
-		"02_payment_id.sql":     &bintree{migrations02_payment_idSql, map[string]*bintree{}},
-		"03_transaction_id.sql": &bintree{migrations03_transaction_idSql, map[string]*bintree{}},
-		"04_table_names.sql":    &bintree{migrations04_table_namesSql, map[string]*bintree{}},
+		"01_init.sql":             &bintree{migrations01_initSql, map[string]*bintree{}},
+		"02_payment_id.sql":       &bintree{migrations02_payment_idSql, map[string]*bintree{}},
+		"03_transaction_id.sql":   &bintree{migrations03_transaction_idSql, map[string]*bintree{}},
+		"04_table_names.sql":      &bintree{migrations04_table_namesSql, map[string]*bintree{}},
+		"05_receive_callback.sql": &bintree{migrations05_receive_callbackSql, map[string]*bintree{}},
 	}},
 }}
 
@@ -30,6 +30,13 @@ type Database interface {
 	UpdateSentTransaction(transaction *SentTransaction) error
 	GetSentTransactionByPaymentID(paymentID string) (*SentTransaction, error)
 	GetSentTransactions(page, limit uint64) ([]*SentTransaction, error)
+
+	InsertReceivedPaymentWithCallback(payment *ReceivedPayment, callback *ReceiveCallback) error
+	UpdateReceiveCallback(callback *ReceiveCallback) error
+	ClaimReceiveCallback(callback *ReceiveCallback, until time.Time) (bool, error)
+	GetReceiveCallbackByID(id int64) (*ReceiveCallback, error)
+	GetDueReceiveCallbacks(now time.Time, limit uint64) ([]*ReceiveCallback, error)
+	GetReceiveCallbacks(status ReceiveCallbackStatus, page, limit uint64) ([]*ReceiveCallback, error)
 }
 
 type PostgresDatabase struct {
@@ -71,3 +78,35 @@ type SentTransaction struct {
 	EnvelopeXdr   string                `db:"envelope_xdr" json:"envelope_xdr"`
 	ResultXdr     *string               `db:"result_xdr" json:"result_xdr"`
 }
+
+// ReceiveCallbackStatus type represents receive callback delivery status
+type ReceiveCallbackStatus string
+
+const (
+	// ReceiveCallbackStatusPending is a status indicating that the callback has not been
+	// delivered yet and will be (re)tried at NextAttemptAt
+	ReceiveCallbackStatusPending ReceiveCallbackStatus = "pending"
+	// ReceiveCallbackStatusDelivering is a status indicating that the callback is being
+	// delivered. It's tried again at NextAttemptAt if the delivery did not finish by then.
+	ReceiveCallbackStatusDelivering ReceiveCallbackStatus = "delivering"
+	// ReceiveCallbackStatusDelivered is a status indicating that the receive callback
+	// responded with 200 OK
+	ReceiveCallbackStatusDelivered ReceiveCallbackStatus = "delivered"
+	// ReceiveCallbackStatusDead is a status indicating that all delivery attempts failed
+	// and the callback will not be retried unless replayed
+	ReceiveCallbackStatusDead ReceiveCallbackStatus = "dead"
+)
+
+// ReceiveCallback represents a delivery of a received payment to the receive callback.
+// Payment contains JSON encoded bridge.PaymentResponse sent to the callback.
+type ReceiveCallback struct {
+	ID                int64                 `db:"id" json:"id"`
+	ReceivedPaymentID int64                 `db:"received_payment_id" json:"received_payment_id"`
+	Payment           string                `db:"payment" json:"payment"`
+	Status            ReceiveCallbackStatus `db:"status" json:"status"` // pending/delivering/delivered/dead
+	Attempts          int                   `db:"attempts" json:"attempts"`
+	LastError         *string               `db:"last_error" json:"last_error"`
+	NextAttemptAt     time.Time             `db:"next_attempt_at" json:"next_attempt_at"`
+	CreatedAt         time.Time             `db:"created_at" json:"created_at"`
+	DeliveredAt       *time.Time            `db:"delivered_at" json:"delivered_at"`
+}
@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE receive_callback (
+  id bigserial,
+  received_payment_id bigint UNIQUE NOT NULL REFERENCES received_payment (id),
+  payment text NOT NULL,
+  status varchar(10) NOT NULL,
+  attempts integer NOT NULL DEFAULT 0,
+  last_error text DEFAULT NULL,
+  next_attempt_at timestamp NOT NULL,
+  created_at timestamp NOT NULL,
+  delivered_at timestamp DEFAULT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX receive_callback_due ON receive_callback (next_attempt_at) WHERE status IN ('pending', 'delivering');
+
+-- +migrate Down
+DROP TABLE receive_callback;
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"time"
 
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
@@ -10,6 +11,7 @@ import (
 const (
 	receivedPaymentTableName = "received_payment"
 	sentTransactionTableName = "sent_transaction"
+	receiveCallbackTableName = "receive_callback"
 )
 
 func (d *PostgresDatabase) Open(dsn string) error {
@@ -231,6 +233,174 @@ func (d *PostgresDatabase) GetSentTransactions(page, limit uint64) ([]*SentTrans
 	return transactions, nil
 }
 
+// InsertReceivedPaymentWithCallback inserts a new payment and its receive callback
+// into DB in a single transaction. After successful insert ID fields on `payment`
+// and `callback` will be updated to IDs of new rows.
+func (d *PostgresDatabase) InsertReceivedPaymentWithCallback(payment *ReceivedPayment, callback *ReceiveCallback) error {
+	session := d.session.Clone()
+	err := session.Begin()
+	if err != nil {
+		return errors.Wrap(err, "Error starting a transaction")
+	}
+	defer session.Rollback()
+
+	receivedPaymentTable := d.getTable(receivedPaymentTableName, session)
+	_, err = receivedPaymentTable.Insert(payment).IgnoreCols("id").Exec()
+	if err != nil {
+		return errors.Wrap(err, "Error inserting received payment")
+	}
+
+	var newPayment ReceivedPayment
+	err = receivedPaymentTable.Get(&newPayment, map[string]interface{}{"operation_id": payment.OperationID}).Exec()
+	if err != nil {
+		return errors.Wrap(err, "Error getting new operation")
+	}
+
+	callback.ReceivedPaymentID = newPayment.ID
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, session)
+	_, err = receiveCallbackTable.Insert(callback).IgnoreCols("id").Exec()
+	if err != nil {
+		return errors.Wrap(err, "Error inserting receive callback")
+	}
+
+	var newCallback ReceiveCallback
+	err = receiveCallbackTable.Get(&newCallback, map[string]interface{}{"received_payment_id": newPayment.ID}).Exec()
+	if err != nil {
+		return errors.Wrap(err, "Error getting new receive callback")
+	}
+
+	err = session.Commit()
+	if err != nil {
+		return errors.Wrap(err, "Error committing a transaction")
+	}
+
+	payment.ID = newPayment.ID
+	callback.ID = newCallback.ID
+	return nil
+}
+
+func (d *PostgresDatabase) UpdateReceiveCallback(callback *ReceiveCallback) error {
+	if callback.ID == 0 {
+		return errors.New("ID equals 0")
+	}
+
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, nil)
+	_, err := receiveCallbackTable.Update(nil, map[string]interface{}{"id": callback.ID}).
+		SetStruct(callback, []string{"id"}).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "Error updating receive callback")
+	}
+
+	return nil
+}
+
+// ClaimReceiveCallback moves the callback to the delivering state until `until`, so that
+// it's delivered by the caller only. It returns false if the callback was changed since
+// it was loaded, e.g. when it's claimed by another delivery.
+func (d *PostgresDatabase) ClaimReceiveCallback(callback *ReceiveCallback, until time.Time) (bool, error) {
+	if callback.ID == 0 {
+		return false, errors.New("ID equals 0")
+	}
+
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, nil)
+	result, err := receiveCallbackTable.Update(nil, map[string]interface{}{
+		"id":              callback.ID,
+		"status":          callback.Status,
+		"next_attempt_at": callback.NextAttemptAt,
+	}).
+		Set("status", ReceiveCallbackStatusDelivering).
+		Set("next_attempt_at", until).
+		Exec()
+	if err != nil {
+		return false, errors.Wrap(err, "Error claiming receive callback")
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "Error claiming receive callback")
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	callback.Status = ReceiveCallbackStatusDelivering
+	callback.NextAttemptAt = until
+	return true, nil
+}
+
+// GetReceiveCallbackByID returns receive callback by id
+func (d *PostgresDatabase) GetReceiveCallbackByID(id int64) (*ReceiveCallback, error) {
+	return d.getReceiveCallback(map[string]interface{}{"id": id})
+}
+
+func (d *PostgresDatabase) getReceiveCallback(params map[string]interface{}) (*ReceiveCallback, error) {
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, nil)
+	var callback ReceiveCallback
+	err := receiveCallbackTable.Get(&callback, params).Exec()
+	if err != nil {
+		switch errors.Cause(err) {
+		case sql.ErrNoRows:
+			return nil, nil
+		default:
+			return nil, errors.Wrap(err, "Error getting receive callback")
+		}
+	}
+
+	return &callback, nil
+}
+
+// GetDueReceiveCallbacks returns pending receive callbacks that should be retried at `now`,
+// and callbacks whose delivery did not finish in time, oldest first.
+func (d *PostgresDatabase) GetDueReceiveCallbacks(now time.Time, limit uint64) ([]*ReceiveCallback, error) {
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, nil)
+	callbacks := []*ReceiveCallback{}
+
+	err := receiveCallbackTable.Select(&callbacks, "status IN (?, ?) AND next_attempt_at <= ?", ReceiveCallbackStatusPending, ReceiveCallbackStatusDelivering, now).
+		Limit(limit).
+		OrderBy("next_attempt_at asc").
+		Exec()
+	if err != nil {
+		switch errors.Cause(err) {
+		case sql.ErrNoRows:
+			return callbacks, nil
+		default:
+			return callbacks, errors.Wrap(err, "Error getting due receive callbacks")
+		}
+	}
+
+	return callbacks, nil
+}
+
+// GetReceiveCallbacks returns receive callbacks, optionally filtered by status
+func (d *PostgresDatabase) GetReceiveCallbacks(status ReceiveCallbackStatus, page, limit uint64) ([]*ReceiveCallback, error) {
+	receiveCallbackTable := d.getTable(receiveCallbackTableName, nil)
+	callbacks := []*ReceiveCallback{}
+
+	if page == 0 {
+		page = 1
+	}
+
+	offset := (page - 1) * limit
+
+	var where interface{} = "1=1"
+	if status != "" {
+		where = map[string]interface{}{"status": status}
+	}
+
+	err := receiveCallbackTable.Select(&callbacks, where).Limit(limit).Offset(offset).OrderBy("id desc").Exec()
+	if err != nil {
+		switch errors.Cause(err) {
+		case sql.ErrNoRows:
+			return callbacks, nil
+		default:
+			return callbacks, errors.Wrap(err, "Error getting receive callbacks")
+		}
+	}
+
+	return callbacks, nil
+}
+
 // getLastReceivedPayment returns the last received payment
 func (d *PostgresDatabase) getLastReceivedPayment() (*ReceivedPayment, error) {
 	receivedPaymentTable := d.getTable(receivedPaymentTableName, nil)
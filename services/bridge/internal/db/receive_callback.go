@@ -0,0 +1,24 @@
+package db
+
+import (
+	"database/sql/driver"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// Scan implements database/sql.Scanner interface
+func (s *ReceiveCallbackStatus) Scan(src interface{}) error {
+	value, ok := src.(string)
+	if !ok {
+		return errors.New("Cannot convert value to ReceiveCallbackStatus")
+	}
+	*s = ReceiveCallbackStatus(value)
+	return nil
+}
+
+// Value implements driver.Valuer
+func (status ReceiveCallbackStatus) Value() (driver.Value, error) {
+	return driver.Value(string(status)), nil
+}
+
+var _ driver.Valuer = ReceiveCallbackStatus("")
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -8,13 +9,14 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi"
-	log "github.com/sirupsen/logrus"
 	"github.com/stellar/go/protocols/compliance"
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
 	"github.com/stellar/go/support/errors"
+	supportHttp "github.com/stellar/go/support/http"
+	log "github.com/stellar/go/support/log"
 )
 
 // AdminReceivedPayment implements /admin/received-payments/{id} endpoint
@@ -22,7 +24,7 @@ func (rh *RequestHandler) AdminReceivedPayment(w http.ResponseWriter, r *http.Re
 	id, _ := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	payment, err := rh.Database.GetReceivedPaymentByID(id)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error getting ReceivedPayments")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error getting ReceivedPayments")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -32,25 +34,25 @@ func (rh *RequestHandler) AdminReceivedPayment(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	paymentResponse, err := rh.Horizon.OperationDetail(payment.OperationID)
+	paymentResponse, err := rh.Horizon.OperationDetailContext(r.Context(), payment.OperationID)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error getting operation from Horizon")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error getting operation from Horizon")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
-	bridgePayment, err := rh.PaymentListener.ConvertToBridgePayment(paymentResponse)
+	bridgePayment, err := rh.PaymentListener.ConvertToBridgePayment(r.Context(), paymentResponse)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error converting operation to bridge payment type")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error converting operation to bridge payment type")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	var authData *compliance.AuthData
 	if bridgePayment.MemoType == "hash" && rh.Config.Compliance != "" {
-		authData, err = rh.getComplianceData(bridgePayment.Memo)
+		authData, err = rh.getComplianceData(r.Context(), bridgePayment.Memo)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error("Error loading compliance data")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error loading compliance data")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -65,18 +67,18 @@ func (rh *RequestHandler) AdminReceivedPayment(w http.ResponseWriter, r *http.Re
 	encoder := json.NewEncoder(w)
 	err = encoder.Encode(response)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "payments": payment}).Error("Error encoding ReceivedPayment")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "payments": payment}).Error("Error encoding ReceivedPayment")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 }
 
-func (rh *RequestHandler) getComplianceData(memo string) (*compliance.AuthData, error) {
+func (rh *RequestHandler) getComplianceData(ctx context.Context, memo string) (*compliance.AuthData, error) {
 	complianceRequestURL := rh.Config.Compliance + "/receive"
 	complianceRequestBody := url.Values{"memo": {string(memo)}}
 
-	log.WithFields(log.Fields{"url": complianceRequestURL, "body": complianceRequestBody}).Info("Sending request to compliance server")
-	resp, err := rh.Client.PostForm(complianceRequestURL, complianceRequestBody)
+	log.Ctx(ctx).WithFields(log.F{"url": complianceRequestURL, "body": complianceRequestBody}).Info("Sending request to compliance server")
+	resp, err := supportHttp.PostFormContext(ctx, rh.Client, complianceRequestURL, complianceRequestBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error sending request to compliance server")
 	}
@@ -92,7 +94,7 @@ func (rh *RequestHandler) getComplianceData(memo string) (*compliance.AuthData,
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		log.WithFields(log.Fields{"status": resp.StatusCode, "body": string(body)}).Error("Error response from compliance server")
+		log.Ctx(ctx).WithFields(log.F{"status": resp.StatusCode, "body": string(body)}).Error("Error response from compliance server")
 		return nil, errors.New("Error response from compliance server")
 	}
 
@@ -118,7 +120,7 @@ func (rh *RequestHandler) AdminReceivedPayments(w http.ResponseWriter, r *http.R
 
 	payments, err := rh.Database.GetReceivedPayments(uint64(page), uint64(limit))
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error loading ReceivedPayments")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error loading ReceivedPayments")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -126,7 +128,7 @@ func (rh *RequestHandler) AdminReceivedPayments(w http.ResponseWriter, r *http.R
 	encoder := json.NewEncoder(w)
 	err = encoder.Encode(payments)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "payments": payments}).Error("Error encoding ReceivedPayments")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "payments": payments}).Error("Error encoding ReceivedPayments")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -139,7 +141,7 @@ func (rh *RequestHandler) AdminSentTransactions(w http.ResponseWriter, r *http.R
 
 	transactions, err := rh.Database.GetSentTransactions(uint64(page), uint64(limit))
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error loading SentTransactions")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error loading SentTransactions")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -147,7 +149,62 @@ func (rh *RequestHandler) AdminSentTransactions(w http.ResponseWriter, r *http.R
 	encoder := json.NewEncoder(w)
 	err = encoder.Encode(transactions)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "transactions": transactions}).Error("Error encoding SentTransactions")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "transactions": transactions}).Error("Error encoding SentTransactions")
+		helpers.Write(w, helpers.InternalServerError)
+		return
+	}
+}
+
+// AdminReceiveCallbacks implements /admin/receive-callbacks endpoint
+func (rh *RequestHandler) AdminReceiveCallbacks(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit := 10
+
+	status := db.ReceiveCallbackStatus(r.URL.Query().Get("status"))
+	switch status {
+	case "", db.ReceiveCallbackStatusPending, db.ReceiveCallbackStatusDelivering, db.ReceiveCallbackStatusDelivered, db.ReceiveCallbackStatusDead:
+	default:
+		helpers.Write(w, helpers.NewInvalidParameterError("status", "Invalid status."))
+		return
+	}
+
+	callbacks, err := rh.Database.GetReceiveCallbacks(status, uint64(page), uint64(limit))
+	if err != nil {
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error loading ReceiveCallbacks")
+		helpers.Write(w, helpers.InternalServerError)
+		return
+	}
+
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(callbacks)
+	if err != nil {
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "callbacks": callbacks}).Error("Error encoding ReceiveCallbacks")
+		helpers.Write(w, helpers.InternalServerError)
+		return
+	}
+}
+
+// AdminReplayReceiveCallback implements /admin/receive-callbacks/{id}/replay endpoint
+func (rh *RequestHandler) AdminReplayReceiveCallback(w http.ResponseWriter, r *http.Request) {
+	id, _ := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	force := r.PostFormValue("force") == "true"
+
+	callback, err := rh.PaymentListener.ReplayCallback(r.Context(), id, force)
+	if err != nil {
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error replaying ReceiveCallback")
+		helpers.Write(w, &bridge.ReprocessResponse{Status: "error", Message: err.Error()})
+		return
+	}
+
+	if callback == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	encoder := json.NewEncoder(w)
+	err = encoder.Encode(callback)
+	if err != nil {
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "callback": callback}).Error("Error encoding ReceiveCallback")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -4,10 +4,10 @@ import (
 	"encoding/json"
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
+	log "github.com/stellar/go/support/log"
 	"github.com/stellar/go/txnbuild"
 )
 
@@ -16,7 +16,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	request := &bridge.AuthorizeRequest{}
 	err := helpers.FromRequest(r, request)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InvalidParameterError)
 		return
 	}
@@ -27,7 +27,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
@@ -48,6 +48,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	operationBuilder := allowTrustOp.Build()
 
 	submitResponse, err := rh.TransactionSubmitter.SubmitTransaction(
+		r.Context(),
 		nil,
 		rh.Config.Accounts.AuthorizingSeed,
 		[]txnbuild.Operation{operationBuilder},
@@ -59,7 +60,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		herr, isHorizonError := err.(*hc.Error)
 		if !isHorizonError {
-			log.WithFields(log.Fields{"err": err}).Error("Error submitting transaction")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error submitting transaction")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -67,7 +68,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(herr.Problem.Status)
 		err = jsonEncoder.Encode(herr.Problem)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error("Error encoding response")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error encoding response")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -77,7 +78,7 @@ func (rh *RequestHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 
 	err = jsonEncoder.Encode(submitResponse)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error encoding response")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error encoding response")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
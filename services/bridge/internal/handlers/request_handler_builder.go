@@ -5,12 +5,12 @@ import (
 	"net/http"
 	"strconv"
 
-	log "github.com/sirupsen/logrus"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
 	protocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
+	log "github.com/stellar/go/support/log"
 	"github.com/stellar/go/txnbuild"
 )
 
@@ -22,7 +22,7 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&request)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error decoding request")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error decoding request")
 		helpers.Write(w, helpers.NewInvalidParameterError("", "Request body is not a valid JSON"))
 		return
 	}
@@ -33,18 +33,19 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
 	}
 
+	err = request.Validate()
 	if err != nil {
 		switch err := err.(type) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
@@ -53,9 +54,9 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 	if request.SequenceNumber == "" {
 		accountRequest := hc.AccountRequest{AccountID: request.Source}
 		var accountResponse protocol.Account
-		accountResponse, err = rh.Horizon.AccountDetail(accountRequest)
+		accountResponse, err = rh.Horizon.AccountDetailContext(r.Context(), accountRequest)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error("Error when loading account")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error when loading account")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -89,7 +90,7 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 
 	err = tx.Build()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "request": request}).Error("TransactionBuilder returned error")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "request": request}).Error("TransactionBuilder returned error")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -98,14 +99,14 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 		var kp keypair.KP
 		kp, err = keypair.Parse(s)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err, "request": request}).Error("Error converting signers to keypairs")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err, "request": request}).Error("Error converting signers to keypairs")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
 
 		err = tx.Sign(kp.(*keypair.Full))
 		if err != nil {
-			log.WithFields(log.Fields{"err": err, "request": request}).Error("Error signing transaction")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err, "request": request}).Error("Error signing transaction")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -113,7 +114,7 @@ func (rh *RequestHandler) Builder(w http.ResponseWriter, r *http.Request) {
 
 	txeBase64, err := tx.Base64()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "request": request}).Error("Error encoding transaction envelope")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "request": request}).Error("Error encoding transaction envelope")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -357,4 +357,35 @@ func TestRequestHandlerBuilder(t *testing.T) {
 		}`)
 	assert.Equal(t, expected, test.StringToJSONMap(responseString))
 
+	// negative amounts are invalid
+	data = test.StringToJSONMap(`{
+		"source": "GBWJES3WOKK7PRLJKZVGIPVFGQSSGCRMY7H3GCZ7BEG6ZTDB4FZXTPJ5",
+		"sequence_number": "123",
+		"operations": [
+			{
+					"type": "payment",
+					"body": {
+						"destination": "GCOEGO43PFSLE4K7WRZQNRO3PIOTRLKRASP32W7DSPBF65XFT4V6PSV3",
+						"amount": "-100",
+						"asset": {
+							"code": "USD",
+							"issuer": "GACETOPHMOLSZLG5IQ3D6KQDKCAAYUYTTQHIEY6IGZE4VOBDD2YY6YAO"
+						}
+					}
+			}
+		],
+		"signers": ["SABY7FRMMJWPBTKQQ2ZN43AUJQ3Z2ZAK36VYSG2SPE2ABNQXA66H5E5G"]}`,
+	)
+
+	statusCode, response = mocks.JSONGetResponse(testServer, data)
+	responseString = strings.TrimSpace(string(response))
+	assert.Equal(t, 400, statusCode)
+	expected = test.StringToJSONMap(`{
+		"code": "invalid_parameter",
+		"message": "Invalid parameter.",
+		"data": {
+			"name": "amount"
+		}
+	}`)
+	assert.Equal(t, expected, test.StringToJSONMap(responseString, "more_info"))
 }
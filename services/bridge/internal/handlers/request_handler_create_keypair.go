@@ -4,10 +4,9 @@ import (
 	"encoding/json"
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
+	log "github.com/stellar/go/support/log"
 )
 
 // KeyPair struct contains key pair public and private key
@@ -20,13 +19,13 @@ type KeyPair struct {
 func (rh *RequestHandler) CreateKeypair(w http.ResponseWriter, r *http.Request) {
 	kp, err := keypair.Random()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error generating random keypair")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error generating random keypair")
 		helpers.Write(w, helpers.InternalServerError)
 	}
 
 	response, err := json.Marshal(KeyPair{kp.Address(), kp.Seed()})
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error marshalling random keypair")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error marshalling random keypair")
 		helpers.Write(w, helpers.InternalServerError)
 	}
 
@@ -1,14 +1,13 @@
 package handlers
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/stellar/go/address"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
@@ -22,6 +21,8 @@ import (
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
+	supportHttp "github.com/stellar/go/support/http"
+	log "github.com/stellar/go/support/log"
 	"github.com/stellar/go/xdr"
 )
 
@@ -30,7 +31,7 @@ func (rh *RequestHandler) Payment(w http.ResponseWriter, r *http.Request) {
 	request := &bridge.PaymentRequest{}
 	err := helpers.FromRequest(r, request)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InvalidParameterError)
 		return
 	}
@@ -41,7 +42,7 @@ func (rh *RequestHandler) Payment(w http.ResponseWriter, r *http.Request) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
@@ -56,13 +57,13 @@ func (rh *RequestHandler) Payment(w http.ResponseWriter, r *http.Request) {
 	// * User explicitly wants to use compliance protocol
 	if rh.Config.Compliance != "" &&
 		(request.ExtraMemo != "" || (request.ExtraMemo == "" && request.UseCompliance)) {
-		rh.complianceProtocolPayment(w, request)
+		rh.complianceProtocolPayment(r.Context(), w, request)
 	} else {
-		rh.standardPayment(w, request)
+		rh.standardPayment(r.Context(), w, request)
 	}
 }
 
-func (rh *RequestHandler) complianceProtocolPayment(w http.ResponseWriter, request *bridge.PaymentRequest) {
+func (rh *RequestHandler) complianceProtocolPayment(ctx context.Context, w http.ResponseWriter, request *bridge.PaymentRequest) {
 	var paymentID *string
 	if request.ID != "" {
 		paymentID = &request.ID
@@ -71,12 +72,14 @@ func (rh *RequestHandler) complianceProtocolPayment(w http.ResponseWriter, reque
 	// Compliance server part
 	sendRequest := request.ToComplianceSendRequest()
 
-	resp, err := rh.Client.PostForm(
+	resp, err := supportHttp.PostFormContext(
+		ctx,
+		rh.Client,
 		rh.Config.Compliance+"/send",
 		helpers.ToValues(sendRequest),
 	)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error sending request to compliance server")
+		log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error sending request to compliance server")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -84,13 +87,13 @@ func (rh *RequestHandler) complianceProtocolPayment(w http.ResponseWriter, reque
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error("Error reading compliance server response")
+		log.Ctx(ctx).Error("Error reading compliance server response")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	if resp.StatusCode != 200 {
-		log.WithFields(log.Fields{
+		log.Ctx(ctx).WithFields(log.F{
 			"status": resp.StatusCode,
 			"body":   string(body),
 		}).Error("Error response from compliance server")
@@ -101,21 +104,21 @@ func (rh *RequestHandler) complianceProtocolPayment(w http.ResponseWriter, reque
 	var callbackSendResponse callback.SendResponse
 	err = json.Unmarshal(body, &callbackSendResponse)
 	if err != nil {
-		log.Error("Error unmarshalling from compliance server")
+		log.Ctx(ctx).Error("Error unmarshalling from compliance server")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	if callbackSendResponse.AuthResponse.InfoStatus == compliance.AuthStatusPending ||
 		callbackSendResponse.AuthResponse.TxStatus == compliance.AuthStatusPending {
-		log.WithFields(log.Fields{"response": callbackSendResponse}).Info("Compliance response pending")
+		log.Ctx(ctx).WithFields(log.F{"response": callbackSendResponse}).Info("Compliance response pending")
 		helpers.Write(w, bridge.NewPaymentPendingError(callbackSendResponse.AuthResponse.Pending))
 		return
 	}
 
 	if callbackSendResponse.AuthResponse.InfoStatus == compliance.AuthStatusDenied ||
 		callbackSendResponse.AuthResponse.TxStatus == compliance.AuthStatusDenied {
-		log.WithFields(log.Fields{"response": callbackSendResponse}).Info("Compliance response denied")
+		log.Ctx(ctx).WithFields(log.F{"response": callbackSendResponse}).Info("Compliance response denied")
 		helpers.Write(w, bridge.PaymentDenied)
 		return
 	}
@@ -123,22 +126,22 @@ func (rh *RequestHandler) complianceProtocolPayment(w http.ResponseWriter, reque
 	var tx xdr.Transaction
 	err = xdr.SafeUnmarshalBase64(callbackSendResponse.TransactionXdr, &tx)
 	if err != nil {
-		log.Error("Error unmarshalling transaction returned by compliance server")
+		log.Ctx(ctx).Error("Error unmarshalling transaction returned by compliance server")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
-	submitResponse, err := rh.TransactionSubmitter.SignAndSubmitRawTransaction(paymentID, request.Source, &tx)
-	rh.handleTransactionSubmitResponse(w, submitResponse, err)
+	submitResponse, err := rh.TransactionSubmitter.SignAndSubmitRawTransaction(ctx, paymentID, request.Source, &tx)
+	rh.handleTransactionSubmitResponse(ctx, w, submitResponse, err)
 }
 
-func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge.PaymentRequest) {
+func (rh *RequestHandler) standardPayment(ctx context.Context, w http.ResponseWriter, request *bridge.PaymentRequest) {
 	var paymentID *string
 
 	if request.ID != "" {
 		sentTransaction, err := rh.Database.GetSentTransactionByPaymentID(request.ID)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error("Error getting sent transaction")
+			log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error getting sent transaction")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -146,15 +149,15 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		if sentTransaction == nil {
 			paymentID = &request.ID
 		} else {
-			log.WithFields(log.Fields{"paymentID": request.ID, "tx": sentTransaction.EnvelopeXdr}).Info("Transaction with given ID already exists, resubmitting...")
-			submitResponse, err := rh.Horizon.SubmitTransactionXDR(sentTransaction.EnvelopeXdr)
+			log.Ctx(ctx).WithFields(log.F{"paymentID": request.ID, "tx": sentTransaction.EnvelopeXdr}).Info("Transaction with given ID already exists, resubmitting...")
+			submitResponse, err := rh.Horizon.SubmitTransactionXDRContext(ctx, sentTransaction.EnvelopeXdr)
 			if err != nil {
-				log.WithFields(log.Fields{"error": err}).Error("Error submitting transaction")
+				log.Ctx(ctx).WithFields(log.F{"error": err}).Error("Error submitting transaction")
 				helpers.Write(w, helpers.InternalServerError)
 				return
 			}
 
-			rh.handleTransactionSubmitResponse(w, submitResponse, err)
+			rh.handleTransactionSubmitResponse(ctx, w, submitResponse, err)
 			return
 		}
 	}
@@ -167,24 +170,24 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		if err != nil {
 			destinationObject.AccountID = request.Destination
 		} else {
-			destinationObject, err = rh.FederationResolver.LookupByAddress(request.Destination)
+			destinationObject, err = rh.FederationResolver.LookupByAddressContext(ctx, request.Destination)
 			if err != nil {
-				log.WithFields(log.Fields{"destination": request.Destination, "err": err}).Print("Cannot resolve address")
+				log.Ctx(ctx).WithFields(log.F{"destination": request.Destination, "err": err}).Info("Cannot resolve address")
 				helpers.Write(w, bridge.PaymentCannotResolveDestination)
 				return
 			}
 		}
 	} else {
-		destinationObject, err = rh.FederationResolver.ForwardRequest(request.ForwardDestination.Domain, request.ForwardDestination.Fields)
+		destinationObject, err = rh.FederationResolver.ForwardRequestContext(ctx, request.ForwardDestination.Domain, request.ForwardDestination.Fields)
 		if err != nil {
-			log.WithFields(log.Fields{"destination": request.Destination, "err": err}).Print("Cannot resolve address")
+			log.Ctx(ctx).WithFields(log.F{"destination": request.Destination, "err": err}).Info("Cannot resolve address")
 			helpers.Write(w, bridge.PaymentCannotResolveDestination)
 			return
 		}
 	}
 
 	if !shared.IsValidAccountID(destinationObject.AccountID) {
-		log.WithFields(log.Fields{"AccountId": destinationObject.AccountID}).Print("Invalid AccountId in destination")
+		log.Ctx(ctx).WithFields(log.F{"AccountId": destinationObject.AccountID}).Info("Invalid AccountId in destination")
 		helpers.Write(w, helpers.NewInvalidParameterError("destination", "Destination public key must start with `G`."))
 		return
 	}
@@ -194,7 +197,7 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		var kp keypair.KP
 		kp, err = keypair.Parse(request.Source)
 		if err != nil {
-			log.WithFields(log.Fields{"error": err}).Error("Unable to convert seed to keypair")
+			log.Ctx(ctx).WithFields(log.F{"error": err}).Error("Unable to convert seed to keypair")
 			helpers.Write(w, helpers.NewInvalidParameterError("source", "Source must be a valid secret seed."))
 		}
 		kpAddress := kp.Address()
@@ -205,14 +208,14 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 
 	// Check if destination account exist
 	accountRequest := hc.AccountRequest{AccountID: destinationObject.AccountID}
-	_, err = rh.Horizon.AccountDetail(accountRequest)
+	_, err = rh.Horizon.AccountDetailContext(ctx, accountRequest)
 	if err != nil {
-		log.WithFields(log.Fields{"error": err}).Error("Error loading destination account")
+		log.Ctx(ctx).WithFields(log.F{"error": err}).Error("Error loading destination account")
 
 		// if pathpayment or custom asset
 		if request.SendMax != "" || (request.AssetCode != "" && request.AssetIssuer != "") {
 			// return error instead of creating account
-			log.WithFields(log.Fields{"destination": request.Destination}).Error("can not send custom asset or path payment to inactive destination")
+			log.Ctx(ctx).WithFields(log.F{"destination": request.Destination}).Error("can not send custom asset or path payment to inactive destination")
 			helpers.Write(w, helpers.NewInvalidParameterError("destination", "Can not send custom asset or path payment to inactive destination."))
 			return
 		}
@@ -254,7 +257,7 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 
 	if destinationObject.MemoType != "" {
 		if request.MemoType != "" {
-			log.Print("Memo given in request but federation returned memo fields.")
+			log.Ctx(ctx).Info("Memo given in request but federation returned memo fields.")
 			helpers.Write(w, bridge.PaymentCannotUseMemo)
 			return
 		}
@@ -272,7 +275,7 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		var id uint64
 		id, err = strconv.ParseUint(memo, 10, 64)
 		if err != nil {
-			log.WithFields(log.Fields{"memo": memo}).Print("Cannot convert memo_id value to uint64")
+			log.Ctx(ctx).WithFields(log.F{"memo": memo}).Info("Cannot convert memo_id value to uint64")
 			helpers.Write(w, helpers.NewInvalidParameterError("memo", "Memo.id must be a number"))
 			return
 		}
@@ -283,7 +286,7 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		var memoBytes []byte
 		memoBytes, err = hex.DecodeString(memo)
 		if err != nil || len(memoBytes) != 32 {
-			log.WithFields(log.Fields{"memo": memo}).Print("Cannot decode hash memo value")
+			log.Ctx(ctx).WithFields(log.F{"memo": memo}).Info("Cannot decode hash memo value")
 			helpers.Write(w, helpers.NewInvalidParameterError("memo", "Memo.hash must be 32 bytes and hex encoded."))
 			return
 		}
@@ -291,22 +294,22 @@ func (rh *RequestHandler) standardPayment(w http.ResponseWriter, request *bridge
 		copy(b32[:], memoBytes[0:32])
 		txMemo = txnbuild.MemoHash(b32)
 	default:
-		log.Print("Not supported memo type: ", memoType)
+		log.Ctx(ctx).Info("Not supported memo type: ", memoType)
 		helpers.Write(w, helpers.NewInvalidParameterError("memo", "Memo type not supported"))
 		return
 	}
 
-	submitResponse, err := rh.TransactionSubmitter.SubmitTransaction(paymentID, request.Source, []txnbuild.Operation{operationBuilder}, txMemo)
-	rh.handleTransactionSubmitResponse(w, submitResponse, err)
+	submitResponse, err := rh.TransactionSubmitter.SubmitTransaction(ctx, paymentID, request.Source, []txnbuild.Operation{operationBuilder}, txMemo)
+	rh.handleTransactionSubmitResponse(ctx, w, submitResponse, err)
 }
 
-func (rh *RequestHandler) handleTransactionSubmitResponse(w http.ResponseWriter, submitResponse hProtocol.TransactionSuccess, err error) {
+func (rh *RequestHandler) handleTransactionSubmitResponse(ctx context.Context, w http.ResponseWriter, submitResponse hProtocol.TransactionSuccess, err error) {
 	jsonEncoder := json.NewEncoder(w)
 
 	if err != nil {
 		herr, isHorizonError := err.(*hc.Error)
 		if !isHorizonError {
-			log.WithFields(log.Fields{"err": err}).Error("Error submitting transaction")
+			log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error submitting transaction")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -314,7 +317,7 @@ func (rh *RequestHandler) handleTransactionSubmitResponse(w http.ResponseWriter,
 		w.WriteHeader(herr.Problem.Status)
 		err = jsonEncoder.Encode(herr.Problem)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error("Error encoding response")
+			log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error encoding response")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -324,7 +327,7 @@ func (rh *RequestHandler) handleTransactionSubmitResponse(w http.ResponseWriter,
 
 	err = jsonEncoder.Encode(submitResponse)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error encoding response")
+		log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error encoding response")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
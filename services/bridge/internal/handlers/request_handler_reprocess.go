@@ -3,9 +3,9 @@ package handlers
 import (
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
+	log "github.com/stellar/go/support/log"
 )
 
 // Authorize implements /reprocess endpoint
@@ -13,7 +13,7 @@ func (rh *RequestHandler) Reprocess(w http.ResponseWriter, r *http.Request) {
 	request := &bridge.ReprocessRequest{}
 	err := helpers.FromRequest(r, request)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InvalidParameterError)
 		return
 	}
@@ -24,25 +24,25 @@ func (rh *RequestHandler) Reprocess(w http.ResponseWriter, r *http.Request) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
 	}
 
-	operation, err := rh.Horizon.OperationDetail(request.OperationID)
+	operation, err := rh.Horizon.OperationDetailContext(r.Context(), request.OperationID)
 	if err != nil {
 		helpers.Write(w, &bridge.ReprocessResponse{Status: "error", Message: err.Error()})
 		return
 	}
 
-	bridgePayment, err := rh.PaymentListener.ConvertToBridgePayment(operation)
+	bridgePayment, err := rh.PaymentListener.ConvertToBridgePayment(r.Context(), operation)
 	if err != nil {
 		helpers.Write(w, &bridge.ReprocessResponse{Status: "error", Message: err.Error()})
 		return
 	}
 
-	err = rh.PaymentListener.ReprocessPayment(bridgePayment, request.Force)
+	err = rh.PaymentListener.ReprocessPayment(r.Context(), bridgePayment, request.Force)
 
 	if err != nil {
 		helpers.Write(w, &bridge.ReprocessResponse{Status: "error", Message: err.Error()})
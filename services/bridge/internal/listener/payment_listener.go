@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -13,7 +14,6 @@ import (
 
 	"encoding/base64"
 
-	"github.com/sirupsen/logrus"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/protocols/compliance"
 	"github.com/stellar/go/protocols/horizon/effects"
@@ -24,6 +24,7 @@ import (
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
 	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
 )
 
 // PaymentListener is listening for a new payments received by ReceivingAccount
@@ -32,7 +33,7 @@ type PaymentListener struct {
 	config   *config.Config
 	database db.Database
 	horizon  hc.ClientInterface
-	log      *logrus.Entry
+	log      *log.Entry
 	now      func() time.Time
 }
 
@@ -42,7 +43,25 @@ type HTTP interface {
 	Do(req *http.Request) (resp *http.Response, err error)
 }
 
-const callbackTimeout = 60 * time.Second
+const (
+	callbackTimeout = 60 * time.Second
+	// callbackDeliveryTimeout bounds a delivery, which sends a request to the
+	// compliance server and one to the receive callback.
+	callbackDeliveryTimeout = 2 * callbackTimeout
+	// callbackLease is how long a delivery claims a callback. It outlives the
+	// delivery, so a callback is only delivered again when the bridge stopped
+	// while delivering it.
+	callbackLease = callbackDeliveryTimeout + time.Minute
+
+	callbackRetryInterval  = 10 * time.Second
+	callbackRetryBatchSize = 50
+	callbackMinBackoff     = 10 * time.Second
+	callbackMaxBackoff     = time.Hour
+)
+
+// errCallbackDelivering is returned when replaying a callback that is being
+// delivered.
+var errCallbackDelivering = errors.New("Receive callback is being delivered")
 
 // NewPaymentListener creates a new PaymentListener
 func NewPaymentListener(
@@ -58,7 +77,7 @@ func NewPaymentListener(
 	pl.database = database
 	pl.horizon = horizon
 	pl.now = now
-	pl.log = logrus.WithFields(logrus.Fields{
+	pl.log = log.WithFields(log.F{
 		"service": "PaymentListener",
 	})
 	return
@@ -66,18 +85,24 @@ func NewPaymentListener(
 
 // Listen starts listening for new payments
 func (pl *PaymentListener) Listen() (err error) {
+	ctx := log.StartTrace(context.Background())
 	accountID := pl.config.Accounts.ReceivingAccountID
 	accountRequest := hc.AccountRequest{AccountID: accountID}
-	_, err = pl.horizon.AccountDetail(accountRequest)
+	_, err = pl.horizon.AccountDetailContext(ctx, accountRequest)
 	if err != nil {
 		return
 	}
 
+	go pl.retryCallbacks()
+
 	go func() {
 		for {
+			// Every stream is a new trace, each payment a span of it.
+			ctx := log.StartTrace(context.Background())
+
 			cursorValue, err := pl.database.GetLastCursorValue()
 			if err != nil {
-				pl.log.WithFields(logrus.Fields{"error": err}).Error("Could not load last cursor from the DB")
+				pl.log.Ctx(ctx).WithFields(log.F{"error": err}).Error("Could not load last cursor from the DB")
 				return
 			}
 
@@ -89,16 +114,36 @@ func (pl *PaymentListener) Listen() (err error) {
 				cursor = "now"
 			}
 
-			pl.log.WithFields(logrus.Fields{
+			pl.log.Ctx(ctx).WithFields(log.F{
 				"accountId": accountID,
 				"cursor":    cursor,
 			}).Info("Started listening for new payments")
 
+			// The cursor is only advanced when a payment has been saved in the DB. If
+			// that fails the stream is stopped so it can be restarted from the last
+			// saved cursor and no payments are skipped.
+			streamCtx, cancel := context.WithCancel(ctx)
+			failed := false
 			paymentRequest := hc.OperationRequest{ForAccount: accountID, Cursor: cursor}
-			err = pl.horizon.StreamPayments(context.Background(), paymentRequest, pl.onPayment)
-			if err != nil {
-				pl.log.Error("Error while streaming: ", err)
-				pl.log.Info("Sleeping...")
+			err = pl.horizon.StreamPayments(streamCtx, paymentRequest, func(payment operations.Operation) {
+				if failed {
+					return
+				}
+
+				if err := pl.onPayment(log.StartTrace(ctx), payment); err != nil {
+					pl.log.Ctx(ctx).WithFields(log.F{"id": payment.GetID(), "err": err}).
+						Error("Error saving payment, restarting from the last saved cursor")
+					failed = true
+					cancel()
+				}
+			})
+			cancel()
+
+			if err != nil || failed {
+				if err != nil {
+					pl.log.Ctx(ctx).Error("Error while streaming: ", err)
+				}
+				pl.log.Ctx(ctx).Info("Sleeping...")
 				time.Sleep(10 * time.Second)
 			}
 		}
@@ -107,22 +152,22 @@ func (pl *PaymentListener) Listen() (err error) {
 	return
 }
 
-func (pl *PaymentListener) ReprocessPayment(payment bridge.PaymentResponse, force bool) error {
-	pl.log.WithFields(logrus.Fields{"id": payment.ID}).Info("Reprocessing a payment")
+func (pl *PaymentListener) ReprocessPayment(ctx context.Context, payment bridge.PaymentResponse, force bool) error {
+	pl.log.Ctx(ctx).WithFields(log.F{"id": payment.ID}).Info("Reprocessing a payment")
 
 	existingPayment, err := pl.database.GetReceivedPaymentByOperationID(payment.ID)
 	if err != nil {
-		pl.log.WithFields(logrus.Fields{"err": err}).Error("Error checking if receive payment exists")
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error checking if receive payment exists")
 		return err
 	}
 
 	if existingPayment == nil {
-		pl.log.WithFields(logrus.Fields{"id": payment.ID}).Info("Payment has not been processed yet")
+		pl.log.Ctx(ctx).WithFields(log.F{"id": payment.ID}).Info("Payment has not been processed yet")
 		return errors.New("Payment has not been processed yet")
 	}
 
 	if existingPayment.Status == "Success" && !force {
-		pl.log.WithFields(logrus.Fields{"id": payment.ID}).Info("Trying to reprocess successful transaction without force")
+		pl.log.Ctx(ctx).WithFields(log.F{"id": payment.ID}).Info("Trying to reprocess successful transaction without force")
 		return errors.New("Trying to reprocess successful transaction without force")
 	}
 
@@ -134,31 +179,33 @@ func (pl *PaymentListener) ReprocessPayment(payment bridge.PaymentResponse, forc
 		return err
 	}
 
-	err = pl.process(payment)
+	err = pl.process(ctx, payment)
 
 	if err != nil {
-		pl.log.WithFields(logrus.Fields{"err": err}).Error("Payment reprocessed with errors")
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Payment reprocessed with errors")
 		existingPayment.Status = err.Error()
 	} else {
-		pl.log.Info("Payment successfully reprocessed")
+		pl.log.Ctx(ctx).Info("Payment successfully reprocessed")
 		existingPayment.Status = "Success"
 	}
 
 	return pl.database.UpdateReceivedPayment(existingPayment)
 }
 
-func (pl *PaymentListener) onPayment(payment operations.Operation) {
-	pl.log.WithFields(logrus.Fields{"id": payment.GetID()}).Info("New received payment")
+// onPayment saves a new payment and sends it to the receive callback. It only
+// returns an error when the payment could not be saved in the DB.
+func (pl *PaymentListener) onPayment(ctx context.Context, payment operations.Operation) error {
+	pl.log.Ctx(ctx).WithFields(log.F{"id": payment.GetID()}).Info("New received payment")
 
 	existingPayment, err := pl.database.GetReceivedPaymentByOperationID(payment.GetID())
 	if err != nil {
-		pl.log.WithFields(logrus.Fields{"err": err}).Error("Error checking if receive payment exists")
-		return
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error checking if receive payment exists")
+		return err
 	}
 
 	if existingPayment != nil {
-		pl.log.WithFields(logrus.Fields{"id": payment.GetID()}).Info("Payment already exists")
-		return
+		pl.log.Ctx(ctx).WithFields(log.F{"id": payment.GetID()}).Info("Payment already exists")
+		return nil
 	}
 
 	dbPayment := &db.ReceivedPayment{
@@ -169,44 +216,248 @@ func (pl *PaymentListener) onPayment(payment operations.Operation) {
 		Status:        "Processing...",
 	}
 
-	err = pl.database.InsertReceivedPayment(dbPayment)
+	bPayment, err := pl.ConvertToBridgePayment(ctx, payment)
 	if err != nil {
-		return
-	}
-
-	bPayment, err := pl.ConvertToBridgePayment(payment)
-	if err != nil {
-		pl.log.WithFields(logrus.Fields{"err": err}).Error("Error when converting operation to bridge payment type")
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error when converting operation to bridge payment type")
 		dbPayment.Status = err.Error()
-		err = pl.database.UpdateReceivedPayment(dbPayment)
-		if err != nil {
-			pl.log.WithFields(logrus.Fields{"err": err}).Error("Error updating payment")
-			return
-		}
-		return
+		return pl.database.InsertReceivedPayment(dbPayment)
 	}
 
 	process, status := pl.shouldProcessPayment(bPayment)
 	if !process {
 		dbPayment.Status = status
-		pl.log.Info(status)
+		pl.log.Ctx(ctx).Info(status)
+		return pl.database.InsertReceivedPayment(dbPayment)
+	}
+
+	callback, err := pl.newCallback(bPayment)
+	if err != nil {
+		return err
+	}
+
+	// The payment is saved along with its pending callback in a single DB
+	// transaction so a payment is never recorded without a callback.
+	err = pl.database.InsertReceivedPaymentWithCallback(dbPayment, callback)
+	if err != nil {
+		return err
+	}
+
+	// The first delivery is attempted right away, failed deliveries are
+	// retried by retryCallbacks.
+	err = pl.deliverCallback(ctx, callback, dbPayment)
+	if err != nil {
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error updating receive callback")
+	}
+	return nil
+}
+
+// newCallback builds a receive callback for the payment, delivered as soon as
+// it's saved in the DB. It's saved in the delivering state, so it's not
+// retried while that first delivery is running.
+func (pl *PaymentListener) newCallback(payment bridge.PaymentResponse) (*db.ReceiveCallback, error) {
+	encoded, err := json.Marshal(payment)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot marshal payment")
+	}
+
+	now := pl.now()
+	return &db.ReceiveCallback{
+		Payment:       string(encoded),
+		Status:        db.ReceiveCallbackStatusDelivering,
+		NextAttemptAt: now.Add(callbackLease),
+		CreatedAt:     now,
+	}, nil
+}
+
+// deliverCallback sends the payment to the receive callback and saves the
+// result. Failed deliveries are rescheduled with an exponential backoff until
+// `callbacks.max_attempts` is reached, then the callback is moved to the
+// dead-letter state and can only be delivered by replaying it. The callback
+// must be in the delivering state, see ClaimReceiveCallback.
+func (pl *PaymentListener) deliverCallback(ctx context.Context, callback *db.ReceiveCallback, dbPayment *db.ReceivedPayment) error {
+	var payment bridge.PaymentResponse
+	err := json.Unmarshal([]byte(callback.Payment), &payment)
+	if err != nil {
+		return errors.Wrap(err, "Cannot unmarshal payment")
+	}
+
+	callback.Attempts++
+	processCtx, cancel := context.WithTimeout(ctx, callbackDeliveryTimeout)
+	err = pl.process(processCtx, payment)
+	cancel()
+	now := pl.now()
+
+	if err != nil {
+		pl.log.Ctx(ctx).WithFields(log.F{"err": err, "attempts": callback.Attempts}).Error("Payment processed with errors")
+		message := err.Error()
+		callback.LastError = &message
+		dbPayment.Status = message
+
+		if callback.Attempts >= pl.maxCallbackAttempts() {
+			pl.log.Ctx(ctx).WithFields(log.F{"id": payment.ID}).Error("Receive callback moved to dead-letter state")
+			callback.Status = db.ReceiveCallbackStatusDead
+		} else {
+			callback.Status = db.ReceiveCallbackStatusPending
+			callback.NextAttemptAt = now.Add(callbackBackoff(callback.Attempts))
+		}
 	} else {
-		err = pl.process(bPayment)
+		pl.log.Ctx(ctx).Info("Payment successfully processed")
+		callback.Status = db.ReceiveCallbackStatusDelivered
+		callback.DeliveredAt = &now
+		callback.LastError = nil
+		dbPayment.Status = "Success"
+	}
+
+	err = pl.database.UpdateReceiveCallback(callback)
+	if err != nil {
+		return err
+	}
+
+	return pl.database.UpdateReceivedPayment(dbPayment)
+}
+
+// retryCallbacks periodically delivers pending receive callbacks that are due.
+func (pl *PaymentListener) retryCallbacks() {
+	for {
+		time.Sleep(callbackRetryInterval)
 
+		ctx := log.StartTrace(context.Background())
+		err := pl.retryDueCallbacks(ctx)
 		if err != nil {
-			pl.log.WithFields(logrus.Fields{"err": err}).Error("Payment processed with errors")
-			dbPayment.Status = err.Error()
-		} else {
-			pl.log.Info("Payment successfully processed")
-			dbPayment.Status = "Success"
+			pl.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error retrying receive callbacks")
 		}
 	}
+}
 
-	err = pl.database.UpdateReceivedPayment(dbPayment)
+// retryDueCallbacks delivers the pending receive callbacks that are due. A
+// callback that cannot be delivered is logged and skipped so it does not block
+// the rest of the batch.
+func (pl *PaymentListener) retryDueCallbacks(ctx context.Context) error {
+	callbacks, err := pl.database.GetDueReceiveCallbacks(pl.now(), callbackRetryBatchSize)
 	if err != nil {
-		pl.log.WithFields(logrus.Fields{"err": err}).Error("Error updating payment")
-		return
+		return err
+	}
+
+	for _, callback := range callbacks {
+		err = pl.retryCallback(ctx, callback)
+		if err != nil {
+			pl.log.Ctx(ctx).WithFields(log.F{"callback_id": callback.ID, "err": err}).
+				Error("Error retrying receive callback")
+		}
+	}
+
+	return nil
+}
+
+func (pl *PaymentListener) retryCallback(ctx context.Context, callback *db.ReceiveCallback) error {
+	claimed, err := pl.database.ClaimReceiveCallback(callback, pl.now().Add(callbackLease))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		// Delivered or replayed since it was loaded
+		return nil
+	}
+
+	dbPayment, err := pl.database.GetReceivedPaymentByID(callback.ReceivedPaymentID)
+	if err != nil {
+		return err
+	}
+
+	if dbPayment == nil {
+		// The callback can never be delivered, move it to the dead-letter state
+		// so it's not due again.
+		message := fmt.Sprintf("received payment %d not found", callback.ReceivedPaymentID)
+		callback.Status = db.ReceiveCallbackStatusDead
+		callback.LastError = &message
+		err = pl.database.UpdateReceiveCallback(callback)
+		if err != nil {
+			return err
+		}
+		return errors.New(message)
+	}
+
+	pl.log.Ctx(ctx).WithFields(log.F{"id": dbPayment.OperationID, "attempts": callback.Attempts}).
+		Info("Retrying receive callback")
+
+	return pl.deliverCallback(ctx, callback, dbPayment)
+}
+
+// ReplayCallback immediately delivers the receive callback with a given ID,
+// resetting its attempts count. It's used to deliver callbacks moved to the
+// dead-letter state. Delivered callbacks are only replayed when `force` is true.
+// Returns nil if the callback does not exist.
+func (pl *PaymentListener) ReplayCallback(ctx context.Context, id int64, force bool) (*db.ReceiveCallback, error) {
+	callback, err := pl.database.GetReceiveCallbackByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if callback == nil {
+		return nil, nil
+	}
+
+	if callback.Status == db.ReceiveCallbackStatusDelivered && !force {
+		return nil, errors.New("Trying to replay delivered callback without force")
+	}
+
+	now := pl.now()
+	if callback.Status == db.ReceiveCallbackStatusDelivering && callback.NextAttemptAt.After(now) {
+		return nil, errCallbackDelivering
+	}
+
+	dbPayment, err := pl.database.GetReceivedPaymentByID(callback.ReceivedPaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbPayment == nil {
+		return nil, errors.Errorf("received payment %d not found", callback.ReceivedPaymentID)
+	}
+
+	claimed, err := pl.database.ClaimReceiveCallback(callback, now.Add(callbackLease))
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errCallbackDelivering
+	}
+
+	pl.log.Ctx(ctx).WithFields(log.F{"id": dbPayment.OperationID}).Info("Replaying receive callback")
+
+	callback.Attempts = 0
+
+	err = pl.deliverCallback(ctx, callback, dbPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+func (pl *PaymentListener) maxCallbackAttempts() int {
+	if pl.config.Callbacks.MaxAttempts > 0 {
+		return pl.config.Callbacks.MaxAttempts
+	}
+	return config.DefaultCallbackMaxAttempts
+}
+
+// callbackBackoff returns the delay before the next delivery of a callback
+// that failed `attempts` times.
+func callbackBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return callbackMinBackoff
+	}
+
+	backoff := callbackMinBackoff
+	for i := 1; i < attempts && backoff < callbackMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > callbackMaxBackoff {
+		return callbackMaxBackoff
 	}
+	return backoff
 }
 
 // shouldProcessPayment returns false and text status if payment should not be processed
@@ -227,8 +478,8 @@ func (pl *PaymentListener) shouldProcessPayment(payment bridge.PaymentResponse)
 	return true, ""
 }
 
-func (pl *PaymentListener) process(payment bridge.PaymentResponse) error {
-	pl.log.WithFields(logrus.Fields{"memo": payment.Memo, "type": payment.MemoType}).Info("Loaded memo")
+func (pl *PaymentListener) process(ctx context.Context, payment bridge.PaymentResponse) error {
+	pl.log.Ctx(ctx).WithFields(log.F{"memo": payment.Memo, "type": payment.MemoType}).Info("Loaded memo")
 
 	var receiveResponse callback.ReceiveResponse
 	var route string
@@ -238,9 +489,9 @@ func (pl *PaymentListener) process(payment bridge.PaymentResponse) error {
 		complianceRequestURL := pl.config.Compliance + "/receive"
 		complianceRequestBody := url.Values{"memo": {string(payment.Memo)}}
 
-		pl.log.WithFields(logrus.Fields{"url": complianceRequestURL, "body": complianceRequestBody}).Info("Sending request to compliance server")
+		pl.log.Ctx(ctx).WithFields(log.F{"url": complianceRequestURL, "body": complianceRequestBody}).Info("Sending request to compliance server")
 		var resp *http.Response
-		resp, err := pl.postForm(complianceRequestURL, complianceRequestBody)
+		resp, err := pl.postForm(ctx, complianceRequestURL, complianceRequestBody)
 		if err != nil {
 			return errors.Wrap(err, "Error sending request to compliance server")
 		}
@@ -253,7 +504,7 @@ func (pl *PaymentListener) process(payment bridge.PaymentResponse) error {
 		}
 
 		if resp.StatusCode != 200 {
-			pl.log.WithFields(logrus.Fields{
+			pl.log.Ctx(ctx).WithFields(log.F{
 				"status": resp.StatusCode,
 				"body":   string(body),
 			}).Error("Error response from compliance server")
@@ -282,7 +533,7 @@ func (pl *PaymentListener) process(payment bridge.PaymentResponse) error {
 		route = payment.Memo
 	}
 
-	resp, err := pl.postForm(
+	resp, err := pl.postForm(ctx,
 		pl.config.Callbacks.Receive,
 		url.Values{
 			"id":             {payment.ID},
@@ -308,7 +559,7 @@ func (pl *PaymentListener) process(payment bridge.PaymentResponse) error {
 			return errors.Wrap(err, "Error reading receive callback response")
 		}
 
-		pl.log.WithFields(logrus.Fields{
+		pl.log.Ctx(ctx).WithFields(log.F{
 			"status": resp.StatusCode,
 			"body":   string(body),
 		}).Error("Error response from receive callback")
@@ -332,6 +583,7 @@ func (pl *PaymentListener) isAssetAllowed(assetType string, code string, issuer
 }
 
 func (pl *PaymentListener) postForm(
+	ctx context.Context,
 	url string,
 	form url.Values,
 ) (*http.Response, error) {
@@ -343,6 +595,7 @@ func (pl *PaymentListener) postForm(
 		return nil, errors.Wrap(err, "configure http request failed")
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	log.SetTraceparent(ctx, req.Header)
 
 	if pl.config.MACKey != "" {
 		var rawMAC []byte
@@ -356,7 +609,7 @@ func (pl *PaymentListener) postForm(
 		req.Header.Set("X-Payload-Mac", encMAC)
 	}
 
-	resp, err := pl.client.Do(req)
+	resp, err := pl.client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, errors.Wrap(err, "http request errored")
 	}
@@ -379,7 +632,7 @@ func (pl *PaymentListener) getMAC(key string, raw []byte) ([]byte, error) {
 // ConvertToBridgePayment constructs a bridge.PaymentResponse struct from the operation received from horizon.
 // This is done in order to have a standard response because the response from horizon can either be a
 // payment, path_payment or account_merge operation; all of which have different fields.
-func (pl *PaymentListener) ConvertToBridgePayment(op operations.Operation) (bridge.PaymentResponse, error) {
+func (pl *PaymentListener) ConvertToBridgePayment(ctx context.Context, op operations.Operation) (bridge.PaymentResponse, error) {
 
 	payment := bridge.PaymentResponse{
 		ID:              op.GetID(),
@@ -411,7 +664,7 @@ func (pl *PaymentListener) ConvertToBridgePayment(op operations.Operation) (brid
 		payment.To = v.Into
 		// get amount
 		effectRequest := hc.EffectRequest{ForOperation: payment.ID}
-		page, err := pl.horizon.Effects(effectRequest)
+		page, err := pl.horizon.EffectsContext(ctx, effectRequest)
 		if err != nil {
 			return bridge.PaymentResponse{}, errors.Wrap(err, "unable to get account merge effect")
 		}
@@ -430,7 +683,7 @@ func (pl *PaymentListener) ConvertToBridgePayment(op operations.Operation) (brid
 		return bridge.PaymentResponse{}, errors.New("operation type not permitted")
 	}
 
-	transaction, err := pl.horizon.TransactionDetail(payment.TransactionHash)
+	transaction, err := pl.horizon.TransactionDetailContext(ctx, payment.TransactionHash)
 	if err != nil {
 		return bridge.PaymentResponse{}, errors.Wrap(err, "unable to get transaction details")
 	}
@@ -1,6 +1,7 @@
 package listener
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
@@ -22,6 +23,7 @@ import (
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stellar/go/services/bridge/internal/mocks"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols"
+	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
 	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
@@ -59,6 +61,28 @@ func ensurePaymentStatus(t *testing.T, operation operations.Operation, status st
 	}
 }
 
+func expectReceiveCallback(t *testing.T, status db.ReceiveCallbackStatus) {
+	mockDatabase.On(
+		"InsertReceivedPaymentWithCallback",
+		mock.AnythingOfType("*db.ReceivedPayment"),
+		mock.AnythingOfType("*db.ReceiveCallback"),
+	).
+		Run(func(args mock.Arguments) {
+			payment := args.Get(0).(*db.ReceivedPayment)
+			assert.Equal(t, "Processing...", payment.Status)
+			callback := args.Get(1).(*db.ReceiveCallback)
+			assert.Equal(t, db.ReceiveCallbackStatusDelivering, callback.Status)
+			assert.Equal(t, mocks.PredefinedTime.Add(callbackLease), callback.NextAttemptAt)
+		}).Return(nil).Once()
+
+	mockDatabase.On("UpdateReceiveCallback", mock.AnythingOfType("*db.ReceiveCallback")).
+		Run(func(args mock.Arguments) {
+			callback := args.Get(0).(*db.ReceiveCallback)
+			assert.Equal(t, status, callback.Status)
+			assert.Equal(t, 1, callback.Attempts)
+		}).Return(nil).Once()
+}
+
 func setDefaultPaymentOperation() operations.Payment {
 	baseOp := operations.Base{
 		ID:                    "1",
@@ -118,7 +142,7 @@ func TestPaymentListener(t *testing.T) {
 	// When operation exists it should save the status
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(&db.ReceivedPayment{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -127,16 +151,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Base.Type = "create_account"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Not a payment operation")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -146,16 +167,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.To = "GDNXBMIJLLLXZYKZBHXJ45WQ4AJQBRVT776YKGQTDBHTSPMNAFO3OZOS"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Operation type not permitted")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -167,16 +185,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Issuer = "GC4WWLMUGZJMRVJM7JUVVZBY3LJ5HL4RKIPADEGKEMLAAJEDRONUGYG7"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Asset not allowed")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -188,16 +203,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Asset not allowed")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -211,16 +223,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Type = "native"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Asset not allowed")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 
@@ -234,12 +243,11 @@ func TestPaymentListener(t *testing.T) {
 	plconfig.Assets[1].Code = "XLM"
 	plconfig.Assets[1].Issuer = ""
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Success")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusDelivered)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{MemoType: "book", Memo: "testing"}, nil).Once()
@@ -254,7 +262,7 @@ func TestPaymentListener(t *testing.T) {
 		nil,
 	).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -268,16 +276,13 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
 	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
-	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "unable to get transaction details: Connection error")).Return(nil).Once()
 
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, errors.New("Connection error")).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -290,12 +295,11 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Code = "USD"
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Error response from receive callback")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusPending)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{MemoType: "text", Memo: "testing"}, nil).Once()
@@ -310,7 +314,7 @@ func TestPaymentListener(t *testing.T) {
 		nil,
 	).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -325,12 +329,11 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Code = "USD"
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Success")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusDelivered)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{MemoType: "text", Memo: "testing"}, nil).Once()
@@ -355,7 +358,7 @@ func TestPaymentListener(t *testing.T) {
 		assert.Equal(t, "testing", req.PostFormValue("memo"))
 	}).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -370,12 +373,11 @@ func TestPaymentListener(t *testing.T) {
 	}
 	accountMergeOp.Base.Type = "account_merge"
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, accountMergeOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, accountMergeOp, "Success")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusDelivered)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{MemoType: "text", Memo: "testing"}, nil).Once()
@@ -412,7 +414,7 @@ func TestPaymentListener(t *testing.T) {
 		assert.Equal(t, "testing", req.PostFormValue("memo"))
 	}).Once()
 
-	paymentListener.onPayment(accountMergeOp)
+	err = paymentListener.onPayment(context.Background(), accountMergeOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -426,12 +428,11 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Code = "USD"
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Success")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusDelivered)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{}, nil).Once()
@@ -446,7 +447,7 @@ func TestPaymentListener(t *testing.T) {
 		nil,
 	).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
@@ -460,12 +461,11 @@ func TestPaymentListener(t *testing.T) {
 	paymentOp.Asset.Code = "USD"
 	paymentOp.Asset.Issuer = "GD4I7AFSLZGTDL34TQLWJOM2NHLIIOEKD5RHHZUW54HERBLSIRKUOXRR"
 
-	mockDatabase.On("InsertReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
-		Run(ensurePaymentStatus(t, paymentOp, "Processing...")).Return(nil).Once()
-
 	mockDatabase.On("UpdateReceivedPayment", mock.AnythingOfType("*db.ReceivedPayment")).
 		Run(ensurePaymentStatus(t, paymentOp, "Success")).Return(nil).Once()
 
+	expectReceiveCallback(t, db.ReceiveCallbackStatusDelivered)
+
 	mockDatabase.On("GetReceivedPaymentByOperationID", "1").Return(nil, nil).Once()
 
 	mockHorizon.On("TransactionDetail", mock.AnythingOfType("string")).Return(hProtocol.Transaction{MemoType: "hash", Memo: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"}, nil).Once()
@@ -510,12 +510,156 @@ func TestPaymentListener(t *testing.T) {
 		nil,
 	).Once()
 
-	paymentListener.onPayment(paymentOp)
+	err = paymentListener.onPayment(context.Background(), paymentOp)
 	assert.Nil(t, err)
 	mockDatabase.AssertExpectations(t)
 	mockHorizon.AssertExpectations(t)
 }
 
+func TestRetryDueCallbacks(t *testing.T) {
+	mockDatabase := new(mocks.MockDatabase)
+	mockHTTPClient := new(mocks.MockHTTPClient)
+
+	cfg := resetConfig()
+	cfg.Callbacks.MaxAttempts = 3
+	paymentListener, err := NewPaymentListener(cfg, mockDatabase, nil, mocks.Now)
+	require.NoError(t, err)
+	paymentListener.client = mockHTTPClient
+
+	mocks.PredefinedTime = time.Now()
+	lease := mocks.PredefinedTime.Add(callbackLease)
+
+	payment, err := json.Marshal(bridge.PaymentResponse{ID: "1", MemoType: "text", Memo: "testing"})
+	require.NoError(t, err)
+
+	// failed delivery is rescheduled
+	callback := &db.ReceiveCallback{ID: 1, ReceivedPaymentID: 2, Payment: string(payment), Status: db.ReceiveCallbackStatusPending, Attempts: 1}
+	dbPayment := &db.ReceivedPayment{ID: 2, OperationID: "1"}
+
+	mockDatabase.On("GetDueReceiveCallbacks", mocks.PredefinedTime, uint64(callbackRetryBatchSize)).
+		Return([]*db.ReceiveCallback{callback}, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", callback, lease).Return(true, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(2)).Return(dbPayment, nil).Once()
+	mockDatabase.On("UpdateReceiveCallback", callback).Return(nil).Once()
+	mockDatabase.On("UpdateReceivedPayment", dbPayment).Return(nil).Once()
+	mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(mocks.BuildHTTPResponse(503, "error"), nil).Once()
+
+	err = paymentListener.retryDueCallbacks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, db.ReceiveCallbackStatusPending, callback.Status)
+	assert.Equal(t, 2, callback.Attempts)
+	assert.Equal(t, mocks.PredefinedTime.Add(20*time.Second), callback.NextAttemptAt)
+	assert.Equal(t, "Error response from receive callback", *callback.LastError)
+	assert.Equal(t, "Error response from receive callback", dbPayment.Status)
+
+	// last failed attempt moves the callback to the dead-letter state
+	mockDatabase.On("GetDueReceiveCallbacks", mocks.PredefinedTime, uint64(callbackRetryBatchSize)).
+		Return([]*db.ReceiveCallback{callback}, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", callback, lease).Return(true, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(2)).Return(dbPayment, nil).Once()
+	mockDatabase.On("UpdateReceiveCallback", callback).Return(nil).Once()
+	mockDatabase.On("UpdateReceivedPayment", dbPayment).Return(nil).Once()
+	mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(mocks.BuildHTTPResponse(503, "error"), nil).Once()
+
+	err = paymentListener.retryDueCallbacks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, db.ReceiveCallbackStatusDead, callback.Status)
+	assert.Equal(t, 3, callback.Attempts)
+
+	// a callback that fails does not block the rest of the batch
+	orphan := &db.ReceiveCallback{ID: 3, ReceivedPaymentID: 4, Payment: string(payment), Status: db.ReceiveCallbackStatusPending}
+	broken := &db.ReceiveCallback{ID: 4, ReceivedPaymentID: 5, Payment: string(payment), Status: db.ReceiveCallbackStatusPending}
+	due := &db.ReceiveCallback{ID: 5, ReceivedPaymentID: 6, Payment: string(payment), Status: db.ReceiveCallbackStatusPending}
+	duePayment := &db.ReceivedPayment{ID: 6, OperationID: "3"}
+
+	mockDatabase.On("GetDueReceiveCallbacks", mocks.PredefinedTime, uint64(callbackRetryBatchSize)).
+		Return([]*db.ReceiveCallback{orphan, broken, due}, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", orphan, lease).Return(true, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(4)).Return(nil, nil).Once()
+	mockDatabase.On("UpdateReceiveCallback", orphan).Return(nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", broken, lease).Return(true, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(5)).Return(nil, errors.New("connection error")).Once()
+	mockDatabase.On("ClaimReceiveCallback", due, lease).Return(true, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(6)).Return(duePayment, nil).Once()
+	mockDatabase.On("UpdateReceiveCallback", due).Return(nil).Once()
+	mockDatabase.On("UpdateReceivedPayment", duePayment).Return(nil).Once()
+	mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(mocks.BuildHTTPResponse(200, "ok"), nil).Once()
+
+	err = paymentListener.retryDueCallbacks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, db.ReceiveCallbackStatusDead, orphan.Status)
+	assert.Equal(t, "received payment 4 not found", *orphan.LastError)
+	assert.Equal(t, db.ReceiveCallbackStatusPending, broken.Status)
+	assert.Equal(t, db.ReceiveCallbackStatusDelivered, due.Status)
+
+	// a callback claimed by another delivery since it was loaded is skipped
+	claimed := &db.ReceiveCallback{ID: 6, ReceivedPaymentID: 7, Payment: string(payment), Status: db.ReceiveCallbackStatusPending}
+
+	mockDatabase.On("GetDueReceiveCallbacks", mocks.PredefinedTime, uint64(callbackRetryBatchSize)).
+		Return([]*db.ReceiveCallback{claimed}, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", claimed, lease).Return(false, nil).Once()
+
+	err = paymentListener.retryDueCallbacks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, claimed.Attempts)
+
+	// replaying a dead callback delivers it
+	mockDatabase.On("GetReceiveCallbackByID", int64(1)).Return(callback, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(2)).Return(dbPayment, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", callback, lease).Return(true, nil).Once()
+	mockDatabase.On("UpdateReceiveCallback", callback).Return(nil).Once()
+	mockDatabase.On("UpdateReceivedPayment", dbPayment).Return(nil).Once()
+	mockHTTPClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(mocks.BuildHTTPResponse(200, "ok"), nil).Once()
+
+	replayed, err := paymentListener.ReplayCallback(context.Background(), 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, db.ReceiveCallbackStatusDelivered, replayed.Status)
+	assert.Equal(t, 1, replayed.Attempts)
+	assert.Nil(t, replayed.LastError)
+	assert.Equal(t, &mocks.PredefinedTime, replayed.DeliveredAt)
+	assert.Equal(t, "Success", dbPayment.Status)
+
+	// delivered callback is not replayed without force
+	mockDatabase.On("GetReceiveCallbackByID", int64(1)).Return(callback, nil).Once()
+	_, err = paymentListener.ReplayCallback(context.Background(), 1, false)
+	assert.EqualError(t, err, "Trying to replay delivered callback without force")
+
+	// a callback that is being delivered is not replayed
+	delivering := &db.ReceiveCallback{ID: 7, ReceivedPaymentID: 8, Payment: string(payment), Status: db.ReceiveCallbackStatusDelivering, NextAttemptAt: lease}
+	mockDatabase.On("GetReceiveCallbackByID", int64(7)).Return(delivering, nil).Once()
+	_, err = paymentListener.ReplayCallback(context.Background(), 7, false)
+	assert.Equal(t, errCallbackDelivering, err)
+
+	// nor one claimed by another replay since it was loaded
+	dead := &db.ReceiveCallback{ID: 8, ReceivedPaymentID: 9, Payment: string(payment), Status: db.ReceiveCallbackStatusDead}
+	mockDatabase.On("GetReceiveCallbackByID", int64(8)).Return(dead, nil).Once()
+	mockDatabase.On("GetReceivedPaymentByID", int64(9)).Return(&db.ReceivedPayment{ID: 9, OperationID: "4"}, nil).Once()
+	mockDatabase.On("ClaimReceiveCallback", dead, lease).Return(false, nil).Once()
+	_, err = paymentListener.ReplayCallback(context.Background(), 8, false)
+	assert.Equal(t, errCallbackDelivering, err)
+
+	// unknown callback
+	mockDatabase.On("GetReceiveCallbackByID", int64(5)).Return(nil, nil).Once()
+	replayed, err = paymentListener.ReplayCallback(context.Background(), 5, false)
+	require.NoError(t, err)
+	assert.Nil(t, replayed)
+
+	mockDatabase.AssertExpectations(t)
+	mockHTTPClient.AssertExpectations(t)
+}
+
+func TestCallbackBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, callbackBackoff(1))
+	assert.Equal(t, 20*time.Second, callbackBackoff(2))
+	assert.Equal(t, 40*time.Second, callbackBackoff(3))
+	assert.Equal(t, time.Hour, callbackBackoff(10))
+	assert.Equal(t, time.Hour, callbackBackoff(100))
+}
+
 func TestPostForm_MACKey(t *testing.T) {
 	validKey := "SABLR5HOI2IUOYB27TR4TO7HWDJIGSRJTT4UUTXXZOFVVPGQKJ5ME43J"
 	rawkey, err := strkey.Decode(strkey.VersionByteSeed, validKey)
@@ -545,17 +689,17 @@ func TestPostForm_MACKey(t *testing.T) {
 	require.NoError(t, err)
 
 	// no mac if the key is not set
-	_, err = pl.postForm(srv.URL+"/no_mac", url.Values{"foo": []string{"base"}})
+	_, err = pl.postForm(context.Background(), srv.URL+"/no_mac", url.Values{"foo": []string{"base"}})
 	require.NoError(t, err)
 
 	// generates a valid mac if a key is set.
 	cfg.MACKey = validKey
-	_, err = pl.postForm(srv.URL+"/mac", url.Values{"foo": []string{"base"}})
+	_, err = pl.postForm(context.Background(), srv.URL+"/mac", url.Values{"foo": []string{"base"}})
 	require.NoError(t, err)
 
 	// errors is the key is invalid
 	cfg.MACKey = "broken"
-	_, err = pl.postForm(srv.URL+"/mac", url.Values{"foo": []string{"base"}})
+	_, err = pl.postForm(context.Background(), srv.URL+"/mac", url.Values{"foo": []string{"base"}})
 
 	if assert.Error(t, err) {
 		assert.Contains(t, err.Error(), "invalid MAC key")
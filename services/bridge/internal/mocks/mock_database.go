@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stretchr/testify/mock"
 )
@@ -81,3 +83,48 @@ func (m *MockDatabase) GetSentTransactionByPaymentID(paymentID string) (*db.Sent
 	}
 	return a.Get(0).(*db.SentTransaction), a.Error(1)
 }
+
+// InsertReceivedPaymentWithCallback is a mocking a method
+func (m *MockDatabase) InsertReceivedPaymentWithCallback(payment *db.ReceivedPayment, callback *db.ReceiveCallback) error {
+	a := m.Called(payment, callback)
+	return a.Error(0)
+}
+
+// UpdateReceiveCallback is a mocking a method
+func (m *MockDatabase) UpdateReceiveCallback(callback *db.ReceiveCallback) error {
+	a := m.Called(callback)
+	return a.Error(0)
+}
+
+// ClaimReceiveCallback is a mocking a method
+func (m *MockDatabase) ClaimReceiveCallback(callback *db.ReceiveCallback, until time.Time) (bool, error) {
+	a := m.Called(callback, until)
+	return a.Bool(0), a.Error(1)
+}
+
+// GetReceiveCallbackByID is a mocking a method
+func (m *MockDatabase) GetReceiveCallbackByID(id int64) (*db.ReceiveCallback, error) {
+	a := m.Called(id)
+	if a.Get(0) == nil {
+		return nil, a.Error(1)
+	}
+	return a.Get(0).(*db.ReceiveCallback), a.Error(1)
+}
+
+// GetDueReceiveCallbacks is a mocking a method
+func (m *MockDatabase) GetDueReceiveCallbacks(now time.Time, limit uint64) ([]*db.ReceiveCallback, error) {
+	a := m.Called(now, limit)
+	if a.Get(0) == nil {
+		return nil, a.Error(1)
+	}
+	return a.Get(0).([]*db.ReceiveCallback), a.Error(1)
+}
+
+// GetReceiveCallbacks is a mocking a method
+func (m *MockDatabase) GetReceiveCallbacks(status db.ReceiveCallbackStatus, page, limit uint64) ([]*db.ReceiveCallback, error) {
+	a := m.Called(status, page, limit)
+	if a.Get(0) == nil {
+		return nil, a.Error(1)
+	}
+	return a.Get(0).([]*db.ReceiveCallback), a.Error(1)
+}
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/stellar/go/clients/stellartoml"
 	"github.com/stretchr/testify/mock"
 )
@@ -21,3 +23,13 @@ func (m *MockStellartomlResolver) GetStellarTomlByAddress(addy string) (*stellar
 	a := m.Called(addy)
 	return a.Get(0).(*stellartoml.Response), a.Error(1)
 }
+
+// GetStellarTomlContext is a mocking method, which shares the expectations of GetStellarToml
+func (m *MockStellartomlResolver) GetStellarTomlContext(ctx context.Context, domain string) (*stellartoml.Response, error) {
+	return m.GetStellarToml(domain)
+}
+
+// GetStellarTomlByAddressContext is a mocking method, which shares the expectations of GetStellarTomlByAddress
+func (m *MockStellartomlResolver) GetStellarTomlByAddressContext(ctx context.Context, addy string) (*stellartoml.Response, error) {
+	return m.GetStellarTomlByAddress(addy)
+}
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/txnbuild"
 	"github.com/stellar/go/xdr"
@@ -13,13 +15,13 @@ type MockTransactionSubmitter struct {
 }
 
 // SubmitTransaction is a mocking a method
-func (ts *MockTransactionSubmitter) SubmitTransaction(paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (hProtocol.TransactionSuccess, error) {
+func (ts *MockTransactionSubmitter) SubmitTransaction(ctx context.Context, paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (hProtocol.TransactionSuccess, error) {
 	a := ts.Called(paymentID, seed, operation, memo)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
 
 // SignAndSubmitRawTransaction is a mocking a method
-func (ts *MockTransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, seed string, tx *xdr.Transaction) (hProtocol.TransactionSuccess, error) {
+func (ts *MockTransactionSubmitter) SignAndSubmitRawTransaction(ctx context.Context, paymentID *string, seed string, tx *xdr.Transaction) (hProtocol.TransactionSuccess, error) {
 	a := ts.Called(paymentID, seed, tx)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
@@ -1,27 +1,29 @@
 package submitter
 
 import (
+	"context"
 	"database/sql"
 	"encoding/hex"
 	"strconv"
 	"sync"
 	"time"
 
-	"github.com/sirupsen/logrus"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/bridge/internal/db"
 	shared "github.com/stellar/go/services/internal/bridge-compliance-shared"
+	"github.com/stellar/go/services/internal/channels"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
 	"github.com/stellar/go/txnbuild"
 	"github.com/stellar/go/xdr"
 )
 
 // TransactionSubmitterInterface helps mocking TransactionSubmitter
 type TransactionSubmitterInterface interface {
-	SubmitTransaction(paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (response hProtocol.TransactionSuccess, err error)
-	SignAndSubmitRawTransaction(paymentID *string, seed string, tx *xdr.Transaction) (response hProtocol.TransactionSuccess, err error)
+	SubmitTransaction(ctx context.Context, paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (response hProtocol.TransactionSuccess, err error)
+	SignAndSubmitRawTransaction(ctx context.Context, paymentID *string, seed string, tx *xdr.Transaction) (response hProtocol.TransactionSuccess, err error)
 }
 
 // TransactionSubmitter submits transactions to Stellar Network
@@ -31,8 +33,13 @@ type TransactionSubmitter struct {
 	AccountsMutex sync.Mutex
 	Database      db.Database
 	Network       string
-	log           *logrus.Entry
-	now           func() time.Time
+	// Channels is an optional pool of channel accounts. When set, every transaction
+	// uses a channel as its source while the account submitting it stays the
+	// source of the operations, so transactions are not serialized on the
+	// account's sequence number.
+	Channels *channels.Pool
+	log      *log.Entry
+	now      func() time.Time
 }
 
 // Account represents account used to signing and sending transactions
@@ -54,7 +61,7 @@ func NewTransactionSubmitter(
 	ts.Database = database
 	ts.Accounts = make(map[string]*Account)
 	ts.Network = networkPassphrase
-	ts.log = logrus.WithFields(logrus.Fields{
+	ts.log = log.WithFields(log.F{
 		"service": "TransactionSubmitter",
 	})
 	ts.now = now
@@ -62,7 +69,7 @@ func NewTransactionSubmitter(
 }
 
 // LoadAccount loads current state of Stellar account and creates a map entry if it didn't exist
-func (ts *TransactionSubmitter) LoadAccount(seed string) (*Account, error) {
+func (ts *TransactionSubmitter) LoadAccount(ctx context.Context, seed string) (*Account, error) {
 	ts.AccountsMutex.Lock()
 
 	account, exist := ts.Accounts[seed]
@@ -73,7 +80,7 @@ func (ts *TransactionSubmitter) LoadAccount(seed string) (*Account, error) {
 
 	kp, err := keypair.Parse(seed)
 	if err != nil {
-		ts.log.Print("Invalid seed")
+		ts.log.Ctx(ctx).Info("Invalid seed")
 		ts.AccountsMutex.Unlock()
 		return nil, err
 	}
@@ -93,7 +100,7 @@ func (ts *TransactionSubmitter) LoadAccount(seed string) (*Account, error) {
 	}
 
 	accountRequest := hc.AccountRequest{AccountID: ts.Accounts[seed].Keypair.Address()}
-	accountResponse, err := ts.Horizon.AccountDetail(accountRequest)
+	accountResponse, err := ts.Horizon.AccountDetailContext(ctx, accountRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +114,8 @@ func (ts *TransactionSubmitter) LoadAccount(seed string) (*Account, error) {
 }
 
 // InitAccount loads an account and returns error if it fails
-func (ts *TransactionSubmitter) InitAccount(seed string) (err error) {
-	_, err = ts.LoadAccount(seed)
+func (ts *TransactionSubmitter) InitAccount(ctx context.Context, seed string) (err error) {
+	_, err = ts.LoadAccount(ctx, seed)
 	return
 }
 
@@ -116,13 +123,17 @@ func (ts *TransactionSubmitter) InitAccount(seed string) (err error) {
 // - update sequence number of the transaction to the current one,
 // - sign it,
 // - submit it to the network.
-func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, seed string, tx *xdr.Transaction) (response hProtocol.TransactionSuccess, err error) {
-	account, err := ts.LoadAccount(seed)
+func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(ctx context.Context, paymentID *string, seed string, tx *xdr.Transaction) (response hProtocol.TransactionSuccess, err error) {
+	account, err := ts.LoadAccount(ctx, seed)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Error loading account")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error loading account")
 		return
 	}
 
+	if ts.Channels != nil {
+		return ts.signAndSubmitWithChannel(ctx, paymentID, account, tx)
+	}
+
 	account.Mutex.Lock()
 	account.SequenceNumber++
 	tx.SeqNum = xdr.SequenceNumber(account.SequenceNumber)
@@ -130,13 +141,13 @@ func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, s
 
 	hash, err := shared.TransactionHash(tx, ts.Network)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Error calculating transaction hash")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error calculating transaction hash")
 		return
 	}
 
 	sig, err := account.Keypair.SignDecorated(hash[:])
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Error signing a transaction")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error signing a transaction")
 		return
 	}
 
@@ -147,23 +158,23 @@ func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, s
 
 	txeB64, err := xdr.MarshalBase64(envelopeXdr)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Cannot encode transaction envelope")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Cannot encode transaction envelope")
 		return
 	}
 
 	transactionHashBytes, err := shared.TransactionHash(tx, ts.Network)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Warn("Error calculating tx hash")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Warn("Error calculating tx hash")
 		return
 	}
 
 	var herr *hc.Error
-	response, err = ts.SubmitAndSave(paymentID, account.Keypair.Address(), txeB64, hex.EncodeToString(transactionHashBytes[:]))
+	response, err = ts.SubmitAndSave(ctx, paymentID, account.Keypair.Address(), txeB64, hex.EncodeToString(transactionHashBytes[:]))
 	if err != nil {
 		var isHorizonError bool
 		herr, isHorizonError = err.(*hc.Error)
 		if !isHorizonError {
-			ts.log.WithFields(logrus.Fields{"err": err}).Error("Error submitting transaction ", err)
+			ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error submitting transaction ", err)
 			return
 		}
 	}
@@ -180,12 +191,12 @@ func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, s
 		}
 
 		account.Mutex.Lock()
-		ts.log.Print("Syncing sequence number for ", account.Keypair.Address())
+		ts.log.Ctx(ctx).Info("Syncing sequence number for ", account.Keypair.Address())
 
 		accountRequest := hc.AccountRequest{AccountID: account.Keypair.Address()}
-		accountResponse, err := ts.Horizon.AccountDetail(accountRequest)
+		accountResponse, err := ts.Horizon.AccountDetailContext(ctx, accountRequest)
 		if err != nil {
-			ts.log.Error("Error updating sequence number ", err)
+			ts.log.Ctx(ctx).Error("Error updating sequence number ", err)
 		} else {
 			account.SequenceNumber, _ = strconv.ParseUint(accountResponse.Sequence, 10, 64)
 		}
@@ -197,8 +208,8 @@ func (ts *TransactionSubmitter) SignAndSubmitRawTransaction(paymentID *string, s
 }
 
 // SubmitTransaction builds and submits transaction to Stellar network
-func (ts *TransactionSubmitter) SubmitTransaction(paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (hProtocol.TransactionSuccess, error) {
-	account, err := ts.LoadAccount(seed)
+func (ts *TransactionSubmitter) SubmitTransaction(ctx context.Context, paymentID *string, seed string, operation []txnbuild.Operation, memo txnbuild.Memo) (hProtocol.TransactionSuccess, error) {
+	account, err := ts.LoadAccount(ctx, seed)
 	if err != nil {
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "Error loading an account")
 	}
@@ -213,39 +224,106 @@ func (ts *TransactionSubmitter) SubmitTransaction(paymentID *string, seed string
 
 	err = tx.Build()
 	if err != nil {
-		ts.log.Error("Unable to build transaction")
+		ts.log.Ctx(ctx).Error("Unable to build transaction")
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "unable to build transaction")
 	}
 
+	if ts.Channels != nil {
+		xdrTx := tx.TxEnvelope().Tx
+		return ts.signAndSubmitWithChannel(ctx, paymentID, account, &xdrTx)
+	}
+
 	kp, err := keypair.Parse(seed)
 	if err != nil {
-		ts.log.Error("Unable to convert seed to keypair")
+		ts.log.Ctx(ctx).Error("Unable to convert seed to keypair")
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "unable to convert seed to keypair")
 	}
 
 	err = tx.Sign(kp.(*keypair.Full))
 	if err != nil {
-		ts.log.Error("Unable to sign transaction")
+		ts.log.Ctx(ctx).Error("Unable to sign transaction")
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "unable to sign transaction")
 	}
 
 	txe, err := tx.Base64()
 	if err != nil {
-		ts.log.Error("Unable to encode transaction")
+		ts.log.Ctx(ctx).Error("Unable to encode transaction")
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "unable to encode transaction")
 	}
 
 	txHashBytes, err := tx.Hash()
 	if err != nil {
-		ts.log.Error("Unable to get transaction hash")
+		ts.log.Ctx(ctx).Error("Unable to get transaction hash")
 		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "unable to get transaction hash")
 	}
 
-	return ts.SubmitAndSave(paymentID, tx.SourceAccount.GetAccountID(), txe, hex.EncodeToString(txHashBytes[:]))
+	return ts.SubmitAndSave(ctx, paymentID, tx.SourceAccount.GetAccountID(), txe, hex.EncodeToString(txHashBytes[:]))
+}
+
+// signAndSubmitWithChannel replaces the source of the transaction with a channel
+// from the pool, moving the original source to the operations that don't have
+// one, signs it with both the account and the channel and submits it.
+func (ts *TransactionSubmitter) signAndSubmitWithChannel(ctx context.Context, paymentID *string, account *Account, tx *xdr.Transaction) (response hProtocol.TransactionSuccess, err error) {
+	channel, err := ts.Channels.Acquire(ctx)
+	if err != nil {
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error acquiring channel account")
+		return
+	}
+	defer func() {
+		// SubmitAndSave does not return horizon errors, a failed submission is
+		// detected by the missing ledger.
+		if err == nil && response.Ledger == 0 {
+			ts.Channels.Release(channel, errors.New("transaction failed"))
+			return
+		}
+		ts.Channels.Release(channel, err)
+	}()
+
+	for i := range tx.Operations {
+		if tx.Operations[i].SourceAccount == nil {
+			source := tx.SourceAccount
+			tx.Operations[i].SourceAccount = &source
+		}
+	}
+
+	err = tx.SourceAccount.SetAddress(channel.GetAccountID())
+	if err != nil {
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error setting channel as transaction source")
+		return
+	}
+	tx.SeqNum, err = channel.IncrementSequenceNumber()
+	if err != nil {
+		return
+	}
+
+	hash, err := shared.TransactionHash(tx, ts.Network)
+	if err != nil {
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error calculating transaction hash")
+		return
+	}
+
+	envelopeXdr := xdr.TransactionEnvelope{Tx: *tx}
+	for _, kp := range []keypair.KP{account.Keypair, channel.Keypair} {
+		var sig xdr.DecoratedSignature
+		sig, err = kp.SignDecorated(hash[:])
+		if err != nil {
+			ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error signing a transaction")
+			return
+		}
+		envelopeXdr.Signatures = append(envelopeXdr.Signatures, sig)
+	}
+
+	txeB64, err := xdr.MarshalBase64(envelopeXdr)
+	if err != nil {
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Cannot encode transaction envelope")
+		return
+	}
+
+	return ts.SubmitAndSave(ctx, paymentID, account.Keypair.Address(), txeB64, hex.EncodeToString(hash[:]))
 }
 
 // SubmitAndSave sumbits a transaction to horizon and saves the details in the bridge server database.
-func (ts *TransactionSubmitter) SubmitAndSave(paymentID *string, sourceAccount, txeB64, txHash string) (response hProtocol.TransactionSuccess, err error) {
+func (ts *TransactionSubmitter) SubmitAndSave(ctx context.Context, paymentID *string, sourceAccount, txeB64, txHash string) (response hProtocol.TransactionSuccess, err error) {
 	nullPaymentID := sql.NullString{Valid: false}
 	if paymentID != nil {
 		nullPaymentID = sql.NullString{
@@ -265,14 +343,14 @@ func (ts *TransactionSubmitter) SubmitAndSave(paymentID *string, sourceAccount,
 
 	err = ts.Database.InsertSentTransaction(sentTransaction)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Error inserting sent transaction")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error inserting sent transaction")
 		return
 	}
 
-	ts.log.WithFields(logrus.Fields{"tx": txeB64}).Info("Submitting transaction")
+	ts.log.Ctx(ctx).WithFields(log.F{"tx": txeB64}).Info("Submitting transaction")
 
 	var herr *hc.Error
-	response, err = ts.Horizon.SubmitTransactionXDR(txeB64)
+	response, err = ts.Horizon.SubmitTransactionXDRContext(ctx, txeB64)
 	if err == nil {
 		sentTransaction.Status = db.SentTransactionStatusSuccess
 		sentTransaction.Ledger = &response.Ledger
@@ -282,7 +360,7 @@ func (ts *TransactionSubmitter) SubmitAndSave(paymentID *string, sourceAccount,
 		var isHorizonError bool
 		herr, isHorizonError = err.(*hc.Error)
 		if !isHorizonError {
-			ts.log.WithFields(logrus.Fields{"err": err}).Error("Error submitting transaction ", err)
+			ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error submitting transaction ", err)
 		} else {
 			var result string
 			result, err = herr.ResultString()
@@ -296,7 +374,7 @@ func (ts *TransactionSubmitter) SubmitAndSave(paymentID *string, sourceAccount,
 
 	err = ts.Database.UpdateSentTransaction(sentTransaction)
 	if err != nil {
-		ts.log.WithFields(logrus.Fields{"err": err}).Error("Error updating sent transaction")
+		ts.log.Ctx(ctx).WithFields(log.F{"err": err}).Error("Error updating sent transaction")
 		return
 	}
 
@@ -1,16 +1,21 @@
 package submitter
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	hc "github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
 	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stellar/go/services/bridge/internal/mocks"
+	"github.com/stellar/go/services/internal/channels"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -24,7 +29,7 @@ func TestTransactionSubmitter(t *testing.T) {
 	transactionSubmitter := NewTransactionSubmitter(mockHorizon, mockDatabase, "Test SDF Network ; September 2015", mocks.Now)
 
 	// When seed is invalid
-	_, err := transactionSubmitter.LoadAccount("invalidSeed")
+	_, err := transactionSubmitter.LoadAccount(context.Background(), "invalidSeed")
 	assert.NotNil(t, err)
 
 	// When there is an error loading account
@@ -36,7 +41,7 @@ func TestTransactionSubmitter(t *testing.T) {
 		errors.New("Account not found"),
 	).Once()
 
-	_, err = transactionSubmitter.LoadAccount(seed)
+	_, err = transactionSubmitter.LoadAccount(context.Background(), seed)
 	assert.NotNil(t, err)
 	mockHorizon.AssertExpectations(t)
 
@@ -55,7 +60,7 @@ func TestTransactionSubmitter(t *testing.T) {
 		nil,
 	).Once()
 
-	account, err := transactionSubmitter.LoadAccount(seed)
+	account, err := transactionSubmitter.LoadAccount(context.Background(), seed)
 	assert.Nil(t, err)
 	assert.Equal(t, account.Keypair.Address(), accountID)
 	assert.Equal(t, account.Seed, seed)
@@ -77,7 +82,7 @@ func TestTransactionSubmitter(t *testing.T) {
 		nil,
 	).Once()
 
-	err = transactionSubmitter.InitAccount(seed)
+	err = transactionSubmitter.InitAccount(context.Background(), seed)
 	assert.Nil(t, err)
 
 	txB64 := "AAAAAJbmB/pwwloZXCaCr9WR3Fue2lNhHGaDWKVOWO7MPq4QAAAAZAAk2eQAAAABAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAABAAAAAHdv1hoGkOgiXF0LRkRaHa7m0GfXIuWT0ZcaajT1ldQgAAAAAAAAAAA7msoAAAAAAAAAAAHMPq4QAAAAQMk5tSJngfsKsfYxK5VqfFCSwgqGatSnp54Lm+WVrMD5wNVFMaHHflIJrzUDS0+/uTeh6lzpIRHRYRUOTAfKpAc="
@@ -124,7 +129,7 @@ func TestTransactionSubmitter(t *testing.T) {
 		Asset:       txnbuild.NativeAsset{},
 	}
 
-	_, err = transactionSubmitter.SubmitTransaction((*string)(nil), seed, []txnbuild.Operation{txnOp}, nil)
+	_, err = transactionSubmitter.SubmitTransaction(context.Background(), (*string)(nil), seed, []txnbuild.Operation{txnOp}, nil)
 	assert.Nil(t, err)
 	mockHorizon.AssertExpectations(t)
 
@@ -143,7 +148,7 @@ func TestTransactionSubmitter(t *testing.T) {
 		nil,
 	).Once()
 
-	err = transactionSubmitter.InitAccount(seed)
+	err = transactionSubmitter.InitAccount(context.Background(), seed)
 	assert.Nil(t, err)
 
 	txB64 = "AAAAAJbmB/pwwloZXCaCr9WR3Fue2lNhHGaDWKVOWO7MPq4QAAAAZAAk2eQAAAABAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAHdv1hoGkOgiXF0LRkRaHa7m0GfXIuWT0ZcaajT1ldQgAAAAADuaygAAAAAAAAAAAcw+rhAAAABAPgwRbiJH9d4zukMq8ULwe88YCLniYFbq9YgryxS+VmYIJ7N6KKbsRMWi2LDMovRY2I6f3GG8eBHUh0JCwTbdCg=="
@@ -187,8 +192,123 @@ func TestTransactionSubmitter(t *testing.T) {
 		Amount:      "100",
 	}
 
-	_, err = transactionSubmitter.SubmitTransaction((*string)(nil), seed, []txnbuild.Operation{txnOp2}, nil)
+	_, err = transactionSubmitter.SubmitTransaction(context.Background(), (*string)(nil), seed, []txnbuild.Operation{txnOp2}, nil)
 	assert.Nil(t, err)
 	mockHorizon.AssertExpectations(t)
+}
+
+func TestTransactionSubmitterWithChannels(t *testing.T) {
+	var mockHorizon = new(hc.MockClient)
+	var mockDatabase = new(mocks.MockDatabase)
+	mocks.PredefinedTime = time.Now()
+	seed := "SDZT3EJZ7FZRYNTLOZ7VH6G5UYBFO2IO3Q5PGONMILPCZU3AL7QNZHTE"
+	accountID := "GCLOMB72ODBFUGK4E2BK7VMR3RNZ5WSTMEOGNA2YUVHFR3WMH2XBAB6H"
+	channelSeed := "SBLEN2RFOV7EFRLLRIIAU5Q4PECCZOTL77LKJPKFONONNYYPDFWSNUNE"
+	channelID := keypair.MustParse(channelSeed).Address()
+	network := "Test SDF Network ; September 2015"
+
+	transactionSubmitter := NewTransactionSubmitter(mockHorizon, mockDatabase, network, mocks.Now)
+	pool, err := channels.NewPool(mockHorizon, network, nil, []string{channelSeed})
+	assert.Nil(t, err)
+	transactionSubmitter.Channels = pool
+
+	mockHorizon.On("AccountDetail", hc.AccountRequest{AccountID: accountID}).Return(
+		hProtocol.Account{AccountID: accountID, Sequence: "10372672437354496"},
+		nil,
+	).Once()
+
+	err = transactionSubmitter.InitAccount(context.Background(), seed)
+	assert.Nil(t, err)
+
+	channelAccount := hProtocol.Account{
+		AccountID: channelID,
+		Sequence:  "200",
+		Balances:  []hProtocol.Balance{{Balance: "10", Asset: base.Asset{Type: "native"}}},
+	}
+	mockHorizon.On("AccountDetail", hc.AccountRequest{AccountID: channelID}).Return(channelAccount, nil).Once()
+
+	// The channel is the transaction source and the account is the operation source
+	mockDatabase.On(
+		"InsertSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once().Run(func(args mock.Arguments) {
+		transaction := args.Get(0).(*db.SentTransaction)
+		assert.Equal(t, accountID, transaction.Source)
 
+		var txe xdr.TransactionEnvelope
+		err := xdr.SafeUnmarshalBase64(transaction.EnvelopeXdr, &txe)
+		assert.Nil(t, err)
+		assert.Equal(t, channelID, txe.Tx.SourceAccount.Address())
+		assert.Equal(t, xdr.SequenceNumber(201), txe.Tx.SeqNum)
+		assert.Equal(t, accountID, txe.Tx.Operations[0].SourceAccount.Address())
+		assert.Len(t, txe.Signatures, 2)
+	})
+	mockDatabase.On(
+		"UpdateSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once()
+	mockHorizon.On("SubmitTransactionXDR", mock.AnythingOfType("string")).Return(
+		hProtocol.TransactionSuccess{Ledger: int32(123)},
+		nil,
+	).Once()
+
+	txnOp := &txnbuild.Payment{
+		Destination: "GB3W7VQ2A2IOQIS4LUFUMRC2DWXONUDH24ROLE6RS4NGUNHVSXKCABOM",
+		Amount:      "100",
+		Asset:       txnbuild.NativeAsset{},
+	}
+
+	_, err = transactionSubmitter.SubmitTransaction(context.Background(), (*string)(nil), seed, []txnbuild.Operation{txnOp}, nil)
+	assert.Nil(t, err)
+	mockHorizon.AssertExpectations(t)
+
+	// Failed transaction, the channel sequence number is reloaded on next use
+	mockDatabase.On(
+		"InsertSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once().Run(func(args mock.Arguments) {
+		transaction := args.Get(0).(*db.SentTransaction)
+		var txe xdr.TransactionEnvelope
+		err := xdr.SafeUnmarshalBase64(transaction.EnvelopeXdr, &txe)
+		assert.Nil(t, err)
+		assert.Equal(t, xdr.SequenceNumber(202), txe.Tx.SeqNum)
+	})
+	mockDatabase.On(
+		"UpdateSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once()
+	mockHorizon.On("SubmitTransactionXDR", mock.AnythingOfType("string")).Return(
+		hProtocol.TransactionSuccess{},
+		errors.New("tx failed"),
+	).Once()
+
+	_, err = transactionSubmitter.SubmitTransaction(context.Background(), (*string)(nil), seed, []txnbuild.Operation{txnOp}, nil)
+	assert.Nil(t, err)
+	mockHorizon.AssertExpectations(t)
+
+	channelAccount.Sequence = "300"
+	mockHorizon.On("AccountDetail", hc.AccountRequest{AccountID: channelID}).Return(channelAccount, nil).Once()
+	mockDatabase.On(
+		"InsertSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once().Run(func(args mock.Arguments) {
+		transaction := args.Get(0).(*db.SentTransaction)
+		var txe xdr.TransactionEnvelope
+		err := xdr.SafeUnmarshalBase64(transaction.EnvelopeXdr, &txe)
+		assert.Nil(t, err)
+		assert.Equal(t, xdr.SequenceNumber(301), txe.Tx.SeqNum)
+	})
+	mockDatabase.On(
+		"UpdateSentTransaction",
+		mock.AnythingOfType("*db.SentTransaction"),
+	).Return(nil).Once()
+	mockHorizon.On("SubmitTransactionXDR", mock.AnythingOfType("string")).Return(
+		hProtocol.TransactionSuccess{Ledger: int32(124)},
+		nil,
+	).Once()
+
+	_, err = transactionSubmitter.SubmitTransaction(context.Background(), (*string)(nil), seed, []txnbuild.Operation{txnOp}, nil)
+	assert.Nil(t, err)
+	mockHorizon.AssertExpectations(t)
+	mockDatabase.AssertExpectations(t)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -14,15 +15,18 @@ import (
 	"github.com/stellar/go/clients/federation"
 	hc "github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/services/bridge/internal/config"
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stellar/go/services/bridge/internal/handlers"
 	"github.com/stellar/go/services/bridge/internal/listener"
 	"github.com/stellar/go/services/bridge/internal/submitter"
+	"github.com/stellar/go/services/internal/channels"
 	supportConfig "github.com/stellar/go/support/config"
 	"github.com/stellar/go/support/db/schema"
 	"github.com/stellar/go/support/errors"
 	supportHttp "github.com/stellar/go/support/http"
+	supportLog "github.com/stellar/go/support/log"
 )
 
 var app *App
@@ -64,14 +68,24 @@ func run(cmd *cobra.Command, args []string) {
 		os.Exit(-1)
 	}
 
+	err = cfg.LoadKeyfiles()
+	if err != nil {
+		log.Fatal(err.Error())
+		return
+	}
+
 	err = cfg.Validate()
 	if err != nil {
 		log.Fatal(err.Error())
 		return
 	}
 
+	// The handlers log through support/log, which starts at the warn level.
+	supportLog.SetLevel(log.InfoLevel)
+
 	if cfg.LogFormat == "json" {
-		log.SetFormatter(&log.JSONFormatter{})
+		log.SetFormatter(supportLog.NewJSONFormatter())
+		supportLog.UseJSONFormatter()
 	}
 
 	app, err = NewApp(cfg, migrateFlag, versionFlag, version)
@@ -139,11 +153,14 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 	}
 
 	h := hc.Client{
-		HorizonURL: config.Horizon,
-		HTTP:       http.DefaultClient,
-		AppName:    "bridge-server",
+		HorizonURL:  config.Horizon,
+		HTTP:        http.DefaultClient,
+		AppName:     "bridge-server",
+		RetryPolicy: hc.DefaultRetryPolicy,
 	}
 
+	ctx := supportLog.StartTrace(context.Background())
+
 	log.Print("Creating and initializing TransactionSubmitter")
 	ts := submitter.NewTransactionSubmitter(&h, &database, config.NetworkPassphrase, time.Now)
 	if err != nil {
@@ -154,7 +171,7 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 		log.Warning("No accounts.authorizing_seed param. Skipping...")
 	} else {
 		log.Print("Initializing Authorizing account")
-		err = ts.InitAccount(config.Accounts.AuthorizingSeed)
+		err = ts.InitAccount(ctx, config.Accounts.AuthorizingSeed)
 		if err != nil {
 			return
 		}
@@ -164,7 +181,16 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 		log.Warning("No accounts.base_seed param. Skipping...")
 	} else {
 		log.Print("Initializing Base account")
-		err = ts.InitAccount(config.Accounts.BaseSeed)
+		err = ts.InitAccount(ctx, config.Accounts.BaseSeed)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(config.Accounts.ChannelSeeds) > 0 {
+		log.Print("Creating channel accounts pool")
+		funder := keypair.MustParse(config.Accounts.BaseSeed).(*keypair.Full)
+		ts.Channels, err = channels.NewPool(&h, config.NetworkPassphrase, funder, config.Accounts.ChannelSeeds)
 		if err != nil {
 			return
 		}
@@ -193,13 +219,15 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 		log.Print("PaymentListener created")
 	}
 
-	stellartomlClient := stellartoml.Client{
-		HTTP: &httpClientWithTimeout,
-	}
+	stellartomlClient := validatingStellarToml{&stellartoml.Client{
+		HTTP:     &httpClientWithTimeout,
+		CacheTTL: time.Duration(config.FederationCacheTTL) * time.Second,
+	}}
 
 	federationClient := federation.Client{
 		HTTP:        &httpClientWithTimeout,
-		StellarTOML: &stellartomlClient,
+		StellarTOML: stellartomlClient,
+		CacheTTL:    time.Duration(config.FederationCacheTTL) * time.Second,
 	}
 
 	err = g.Provide(
@@ -258,6 +286,8 @@ func (a *App) Serve() {
 	mux.Get("/admin/received-payments", a.requestHandler.AdminReceivedPayments)
 	mux.Get("/admin/received-payments/{id}", a.requestHandler.AdminReceivedPayment)
 	mux.Get("/admin/sent-transactions", a.requestHandler.AdminSentTransactions)
+	mux.Get("/admin/receive-callbacks", a.requestHandler.AdminReceiveCallbacks)
+	mux.Post("/admin/receive-callbacks/{id}/replay", a.requestHandler.AdminReplayReceiveCallback)
 
 	supportHttp.Run(supportHttp.Config{
 		ListenAddr: fmt.Sprintf(":%d", *a.config.Port),
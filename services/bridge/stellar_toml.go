@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+
+	"github.com/stellar/go/address"
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+)
+
+// validatingStellarToml resolves stellar.toml files with ValidateStellarToml,
+// so the files of the domains the bridge sends payments to are checked against
+// SEP-1. Files that don't conform are still used, their problems are logged,
+// unless they can't be decoded at all.
+type validatingStellarToml struct {
+	*stellartoml.Client
+}
+
+var _ stellartoml.ClientInterface = validatingStellarToml{}
+
+// GetStellarToml returns the stellar.toml file of domain.
+func (c validatingStellarToml) GetStellarToml(domain string) (*stellartoml.Response, error) {
+	return c.GetStellarTomlContext(context.Background(), domain)
+}
+
+// GetStellarTomlContext works like GetStellarToml, but the request is sent in
+// ctx.
+func (c validatingStellarToml) GetStellarTomlContext(ctx context.Context, domain string) (*stellartoml.Response, error) {
+	resp, errs, err := c.ValidateStellarTomlContext(ctx, domain, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.Wrap(errs, "invalid stellar.toml")
+	}
+
+	if len(errs) > 0 {
+		log.Ctx(ctx).WithFields(log.F{
+			"domain": domain,
+			"err":    errs,
+		}).Warn("stellar.toml does not conform to SEP-1")
+	}
+	return resp, nil
+}
+
+// GetStellarTomlByAddress returns the stellar.toml file of the domain of addy.
+func (c validatingStellarToml) GetStellarTomlByAddress(addy string) (*stellartoml.Response, error) {
+	return c.GetStellarTomlByAddressContext(context.Background(), addy)
+}
+
+// GetStellarTomlByAddressContext works like GetStellarTomlByAddress, but the
+// request is sent in ctx.
+func (c validatingStellarToml) GetStellarTomlByAddressContext(ctx context.Context, addy string) (*stellartoml.Response, error) {
+	_, domain, err := address.Split(addy)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse address failed")
+	}
+
+	return c.GetStellarTomlContext(ctx, domain)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatingStellarToml(t *testing.T) {
+	h := httptest.NewClient()
+	c := validatingStellarToml{&stellartoml.Client{HTTP: h}}
+
+	// files which don't conform to SEP-1 are used
+	h.
+		On("GET", "https://stellar.org/.well-known/stellar.toml").
+		ReturnString(http.StatusOK, `FEDERATION_SERVER="http://stellar.org/federation"`)
+	resp, err := c.GetStellarTomlByAddress("alice*stellar.org")
+	require.NoError(t, err)
+	assert.Equal(t, "http://stellar.org/federation", resp.FederationServer)
+
+	// unless they can't be decoded
+	h.
+		On("GET", "https://broken.org/.well-known/stellar.toml").
+		ReturnString(http.StatusOK, `FEDERATION_SERVER=`)
+	_, err = c.GetStellarToml("broken.org")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid stellar.toml")
+	}
+
+	h.
+		On("GET", "https://missing.org/.well-known/stellar.toml").
+		ReturnNotFound()
+	_, err = c.GetStellarToml("missing.org")
+	assert.EqualError(t, err, "http request failed with non-200 status code")
+}
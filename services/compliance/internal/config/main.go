@@ -20,6 +20,9 @@ type Config struct {
 	Callbacks         Callbacks     `valid:"optional" toml:"callbacks"`
 	TLS               *config.TLS   `valid:"optional"`
 	TxStatusAuth      *TxStatusAuth `valid:"optional" toml:"tx_status_auth"`
+	// FederationCacheTTL is the number of seconds stellar.toml files and
+	// federation responses are cached for. Caching is disabled when 0.
+	FederationCacheTTL int `valid:"optional" toml:"federation_cache_ttl"`
 }
 
 type TxStatusAuth struct {
@@ -29,7 +32,10 @@ type TxStatusAuth struct {
 
 // Keys contains values of `keys` config group
 type Keys struct {
-	SigningSeed string `valid:"required" toml:"signing_seed"`
+	SigningSeed string `valid:"optional" toml:"signing_seed"`
+	// SigningKeyfile is an encrypted keyfile used instead of SigningSeed, see
+	// LoadKeyfiles.
+	SigningKeyfile *config.Keyfile `valid:"optional" toml:"signing_keyfile"`
 }
 
 // Callbacks contains values of `callbacks` config group
@@ -46,6 +52,24 @@ type Database struct {
 	URL  string `valid:"required"`
 }
 
+// LoadKeyfiles decrypts keys.signing_keyfile, when set, and sets the seed it
+// contains. It must be called before Validate.
+func (c *Config) LoadKeyfiles() error {
+	if c.Keys.SigningKeyfile == nil {
+		return nil
+	}
+	if c.Keys.SigningSeed != "" {
+		return errors.New("keys.signing_seed and keys.signing_keyfile can't be both set")
+	}
+
+	kp, err := c.Keys.SigningKeyfile.Load()
+	if err != nil {
+		return err
+	}
+	c.Keys.SigningSeed = kp.Seed()
+	return nil
+}
+
 // Validate validates config and returns error if any of config values is incorrect
 func (c *Config) Validate() (err error) {
 	if c.ExternalPort == nil {
@@ -64,7 +88,7 @@ func (c *Config) Validate() (err error) {
 	}
 
 	if c.Keys.SigningSeed == "" {
-		err = errors.New("keys.signing_seed and keys.encryption_key params are required")
+		err = errors.New("keys.signing_seed or keys.signing_keyfile param is required")
 		return
 	}
 
@@ -76,6 +100,11 @@ func (c *Config) Validate() (err error) {
 		}
 	}
 
+	if c.FederationCacheTTL < 0 {
+		err = errors.New("federation_cache_ttl param must be positive")
+		return
+	}
+
 	_, err = url.Parse(c.Database.URL)
 	if err != nil {
 		err = errors.New("Cannot parse database.url param")
@@ -2,8 +2,13 @@ package config
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +88,28 @@ func TestConfig_Validate_db_url(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_LoadKeyfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kp := keypair.MustRandom()
+	path := filepath.Join(dir, "signing.json")
+	data, err := keypair.EncryptKey(kp, []byte("secret"), keypair.KDF{Name: keypair.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	passwordPath := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(passwordPath, []byte("secret\n"), 0600))
+
+	c := Config{Keys: Keys{
+		SigningKeyfile: &config.Keyfile{Path: path, Password: "file:" + passwordPath},
+	}}
+	require.NoError(t, c.LoadKeyfiles())
+	assert.Equal(t, kp.Seed(), c.Keys.SigningSeed)
+
+	// the seed is now set too
+	err = c.LoadKeyfiles()
+	assert.EqualError(t, err, "keys.signing_seed and keys.signing_keyfile can't be both set")
+}
@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	log "github.com/sirupsen/logrus"
+	log "github.com/stellar/go/support/log"
 	"net/http"
 	"time"
 
@@ -40,7 +40,7 @@ func (rh *RequestHandler) HandlerAllowAccess(w http.ResponseWriter, r *http.Requ
 	}
 
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Warn("Error persisting /allow entity")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error persisting /allow entity")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -12,14 +12,14 @@ import (
 	"strings"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
 	baseAmount "github.com/stellar/go/amount"
 	"github.com/stellar/go/protocols/compliance"
 	"github.com/stellar/go/services/compliance/internal/db"
 	shared "github.com/stellar/go/services/internal/bridge-compliance-shared"
 	httpHelpers "github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
+	supportHttp "github.com/stellar/go/support/http"
+	log "github.com/stellar/go/support/log"
 	"github.com/stellar/go/xdr"
 )
 
@@ -30,25 +30,25 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		Signature: r.PostFormValue("sig"),
 	}
 
-	log.WithFields(log.Fields{"data": authreq.DataJSON, "sig": authreq.Signature}).Info("HandlerAuth")
+	log.Ctx(r.Context()).WithFields(log.F{"data": authreq.DataJSON, "sig": authreq.Signature}).Info("HandlerAuth")
 
 	err := authreq.Validate()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Info(err.Error())
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Info(err.Error())
 		httpHelpers.Write(w, httpHelpers.NewInvalidParameterError("", err.Error()))
 		return
 	}
 
 	authData, err := authreq.Data()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error(err.Error())
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error(err.Error())
 		httpHelpers.Write(w, httpHelpers.InternalServerError)
 		return
 	}
 
-	senderStellarToml, err := rh.StellarTomlResolver.GetStellarTomlByAddress(authData.Sender)
+	senderStellarToml, err := rh.StellarTomlResolver.GetStellarTomlByAddressContext(r.Context(), authData.Sender)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err, "sender": authData.Sender}).Warn("Cannot get stellar.toml of sender")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err, "sender": authData.Sender}).Warn("Cannot get stellar.toml of sender")
 		errorResponse := httpHelpers.NewInvalidParameterError("data.sender", "Cannot get stellar.toml of sender")
 		httpHelpers.Write(w, errorResponse)
 		return
@@ -73,7 +73,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 	}
 	err = rh.SignatureSignerVerifier.Verify(senderStellarToml.SigningKey, []byte(authreq.DataJSON), signatureBytes)
 	if err != nil {
-		log.WithFields(log.Fields{
+		log.Ctx(r.Context()).WithFields(log.F{
 			"signing_key": senderStellarToml.SigningKey,
 			"data":        authreq.Data,
 			"sig":         authreq.Signature,
@@ -88,7 +88,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 	_, err = xdr.Unmarshal(b64r, &tx)
 	if err != nil {
 		errorResponse := httpHelpers.NewInvalidParameterError("data.tx", "Error decoding Transaction XDR")
-		log.WithFields(log.Fields{
+		log.Ctx(r.Context()).WithFields(log.F{
 			"err": err,
 			"tx":  authData.Tx,
 		}).Warn("Error decoding Transaction XDR")
@@ -98,7 +98,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 
 	if tx.Memo.Hash == nil {
 		errorResponse := httpHelpers.NewInvalidParameterError("data.tx", "Transaction does not contain Memo.Hash")
-		log.WithFields(log.Fields{"tx": authData.Tx}).Warn("Transaction does not contain Memo.Hash")
+		log.Ctx(r.Context()).WithFields(log.F{"tx": authData.Tx}).Warn("Transaction does not contain Memo.Hash")
 		httpHelpers.Write(w, errorResponse)
 		return
 	}
@@ -114,7 +114,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		var txBytes bytes.Buffer
 		_, err = xdr.Marshal(&txBytes, tx)
 		if err != nil {
-			log.Error("Error mashaling transaction")
+			log.Ctx(r.Context()).Error("Error mashaling transaction")
 			errorResponse := httpHelpers.NewInvalidParameterError("data.tx", "Error marshaling transaction")
 			httpHelpers.Write(w, errorResponse)
 			return
@@ -122,7 +122,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 
 		expectedTx := base64.StdEncoding.EncodeToString(txBytes.Bytes())
 
-		log.WithFields(log.Fields{"tx": authData.Tx, "expected_tx": expectedTx}).Warn("Memo preimage hash does not equal tx Memo.Hash")
+		log.Ctx(r.Context()).WithFields(log.F{"tx": authData.Tx, "expected_tx": expectedTx}).Warn("Memo preimage hash does not equal tx Memo.Hash")
 		errorResponse := httpHelpers.NewInvalidParameterError("data.tx", "Memo preimage hash does not equal tx Memo.Hash")
 		httpHelpers.Write(w, errorResponse)
 		return
@@ -130,14 +130,14 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 
 	attachment, err := authData.Attachment()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error getting attachment")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error getting attachment")
 		httpHelpers.Write(w, httpHelpers.InternalServerError)
 		return
 	}
 
 	transactionHash, err := shared.TransactionHash(&tx, rh.Config.NetworkPassphrase)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Warn("Error calculating tx hash")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error calculating tx hash")
 		httpHelpers.Write(w, httpHelpers.InternalServerError)
 		return
 	}
@@ -151,18 +151,20 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		var senderInfo []byte
 		senderInfo, err = json.Marshal(attachment.Transaction.SenderInfo)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Error(err.Error())
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error(err.Error())
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 		}
 
 		var resp *http.Response
-		resp, err = rh.Client.PostForm(
+		resp, err = supportHttp.PostFormContext(
+			r.Context(),
+			rh.Client,
 			rh.Config.Callbacks.Sanctions,
 			url.Values{"sender": {string(senderInfo)}},
 		)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"sanctions": rh.Config.Callbacks.Sanctions,
 				"err":       err,
 			}).Error("Error sending request to sanctions server")
@@ -174,7 +176,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		var body []byte
 		body, err = ioutil.ReadAll(resp.Body)
 		if err != nil {
-			log.Error("Error reading sanctions server response")
+			log.Ctx(r.Context()).Error("Error reading sanctions server response")
 			httpHelpers.Write(w, httpHelpers.InternalServerError)
 			return
 		}
@@ -199,7 +201,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			callbackResponse := callback.CallbackResponse{}
 			err = json.Unmarshal(body, &callbackResponse)
 			if err != nil {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"status": resp.StatusCode,
 					"body":   string(body),
 				}).Error("Error response from sanctions server")
@@ -209,7 +211,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		case http.StatusForbidden: // AuthStatusDenied
 			response.TxStatus = compliance.AuthStatusDenied
 		default:
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"status": resp.StatusCode,
 				"body":   string(body),
 			}).Error("Error response from sanctions server")
@@ -226,7 +228,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			// Check AllowedFi
 			tokens := strings.Split(authData.Sender, "*")
 			if len(tokens) != 2 {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"sender": authData.Sender,
 				}).Warn("Invalid stellar address")
 				httpHelpers.Write(w, httpHelpers.InternalServerError)
@@ -235,7 +237,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 
 			allowedFi, err2 := rh.Database.GetAllowedFIByDomain(tokens[1])
 			if err2 != nil {
-				log.WithFields(log.Fields{"err": err2}).Error("Error getting AllowedFi from DB")
+				log.Ctx(r.Context()).WithFields(log.F{"err": err2}).Error("Error getting AllowedFi from DB")
 				httpHelpers.Write(w, httpHelpers.InternalServerError)
 				return
 			}
@@ -244,7 +246,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 				// FI not found check AllowedUser
 				allowedUser, err2 := rh.Database.GetAllowedUserByDomainAndUserID(tokens[1], tokens[0])
 				if err2 != nil {
-					log.WithFields(log.Fields{"err": err2}).Error("Error getting AllowedUser from DB")
+					log.Ctx(r.Context()).WithFields(log.F{"err": err2}).Error("Error getting AllowedUser from DB")
 					httpHelpers.Write(w, httpHelpers.InternalServerError)
 					return
 				}
@@ -276,13 +278,15 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			var senderInfo []byte
 			senderInfo, err = json.Marshal(attachment.Transaction.SenderInfo)
 			if err != nil {
-				log.WithFields(log.Fields{"err": err}).Error(err.Error())
+				log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error(err.Error())
 				w.WriteHeader(http.StatusInternalServerError)
 				w.Write([]byte(err.Error()))
 			}
 
 			var resp *http.Response
-			resp, err = rh.Client.PostForm(
+			resp, err = supportHttp.PostFormContext(
+				r.Context(),
+				rh.Client,
 				rh.Config.Callbacks.AskUser,
 				url.Values{
 					"amount":       {amount},
@@ -293,7 +297,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 				},
 			)
 			if err != nil {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"ask_user": rh.Config.Callbacks.AskUser,
 					"err":      err,
 				}).Error("Error sending request to ask_user server")
@@ -305,7 +309,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			var body []byte
 			body, err = ioutil.ReadAll(resp.Body)
 			if err != nil {
-				log.Error("Error reading ask_user server response")
+				log.Ctx(r.Context()).Error("Error reading ask_user server response")
 				httpHelpers.Write(w, httpHelpers.InternalServerError)
 				return
 			}
@@ -330,7 +334,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 				callbackResponse := callback.CallbackResponse{}
 				err = json.Unmarshal(body, &callbackResponse)
 				if err != nil {
-					log.WithFields(log.Fields{
+					log.Ctx(r.Context()).WithFields(log.F{
 						"status": resp.StatusCode,
 						"body":   string(body),
 					}).Error("Error response from sanctions server")
@@ -340,7 +344,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			case http.StatusForbidden: // AuthStatusDenied
 				response.InfoStatus = compliance.AuthStatusDenied
 			default:
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"status": resp.StatusCode,
 					"body":   string(body),
 				}).Error("Error response from ask_user server")
@@ -353,12 +357,14 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			// Fetch Info
 			fetchInfoRequest := &callback.FetchInfoRequest{Address: string(attachment.Transaction.Route)}
 			var resp *http.Response
-			resp, err = rh.Client.PostForm(
+			resp, err = supportHttp.PostFormContext(
+				r.Context(),
+				rh.Client,
 				rh.Config.Callbacks.FetchInfo,
 				httpHelpers.ToValues(fetchInfoRequest),
 			)
 			if err != nil {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"fetch_info": rh.Config.Callbacks.FetchInfo,
 					"err":        err,
 				}).Error("Error sending request to fetch_info server")
@@ -370,7 +376,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			var body []byte
 			body, err = ioutil.ReadAll(resp.Body)
 			if err != nil {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"fetch_info": rh.Config.Callbacks.FetchInfo,
 					"err":        err,
 				}).Error("Error reading fetch_info server response")
@@ -379,7 +385,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 			}
 
 			if resp.StatusCode != http.StatusOK {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"fetch_info": rh.Config.Callbacks.FetchInfo,
 					"status":     resp.StatusCode,
 					"body":       string(body),
@@ -405,7 +411,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 		}
 		err = rh.Database.InsertAuthorizedTransaction(authorizedTransaction)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Warn("Error persisting AuthorizedTransaction")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error persisting AuthorizedTransaction")
 			httpHelpers.Write(w, httpHelpers.InternalServerError)
 			return
 		}
@@ -419,7 +425,7 @@ func (rh *RequestHandler) HandlerAuth(w http.ResponseWriter, r *http.Request) {
 
 	responseBody, err := response.Marshal()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error(err.Error())
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error(err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte(err.Error()))
 		return
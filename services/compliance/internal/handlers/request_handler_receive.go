@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	log "github.com/sirupsen/logrus"
+	log "github.com/stellar/go/support/log"
 	"net/http"
 
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
@@ -13,7 +13,7 @@ func (rh *RequestHandler) HandlerReceive(w http.ResponseWriter, r *http.Request)
 	request := &callback.ReceiveRequest{}
 	err := helpers.FromRequest(r, request)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InvalidParameterError)
 		return
 	}
@@ -24,7 +24,7 @@ func (rh *RequestHandler) HandlerReceive(w http.ResponseWriter, r *http.Request)
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
@@ -32,13 +32,13 @@ func (rh *RequestHandler) HandlerReceive(w http.ResponseWriter, r *http.Request)
 
 	authorizedTransaction, err := rh.Database.GetAuthorizedTransactionByMemo(request.Memo)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error getting authorizedTransaction")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error getting authorizedTransaction")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	if authorizedTransaction == nil {
-		log.WithFields(log.Fields{"memo": request.Memo}).Warn("authorizedTransaction not found")
+		log.Ctx(r.Context()).WithFields(log.F{"memo": request.Memo}).Warn("authorizedTransaction not found")
 		helpers.Write(w, callback.TransactionNotFoundError)
 		return
 	}
@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	log "github.com/sirupsen/logrus"
+	log "github.com/stellar/go/support/log"
 	"net/http"
 
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
@@ -17,14 +17,14 @@ func (rh *RequestHandler) HandlerRemoveAccess(w http.ResponseWriter, r *http.Req
 	if userID != "" {
 		err := rh.Database.DeleteAllowedUserByDomainAndUserID(domain, userID)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Warn("Error removing allowed user")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error removing allowed user")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
 	} else {
 		err := rh.Database.DeleteAllowedFIByDomain(domain)
 		if err != nil {
-			log.WithFields(log.Fields{"err": err}).Warn("Error removing allowed FI")
+			log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error removing allowed FI")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -1,11 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/stellar/go/address"
 	"github.com/stellar/go/clients/stellartoml"
 	"github.com/stellar/go/protocols/compliance"
@@ -16,6 +16,8 @@ import (
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/bridge"
 	callback "github.com/stellar/go/services/internal/bridge-compliance-shared/protocols/compliance"
+	supportHttp "github.com/stellar/go/support/http"
+	log "github.com/stellar/go/support/log"
 	"github.com/stellar/go/txnbuild"
 )
 
@@ -24,7 +26,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 	request := &callback.SendRequest{}
 	err := helpers.FromRequest(r, request)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InvalidParameterError)
 		return
 	}
@@ -35,7 +37,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		case *helpers.ErrorResponse:
 			helpers.Write(w, err)
 		default:
-			log.Error(err)
+			log.Ctx(r.Context()).Error(err)
 			helpers.Write(w, helpers.InternalServerError)
 		}
 		return
@@ -43,30 +45,30 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 
 	authDataEntity, err := rh.Database.GetAuthData(request.ID)
 	if err != nil {
-		log.Error(err.Error())
+		log.Ctx(r.Context()).Error(err.Error())
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	if authDataEntity != nil {
 		var stellarToml *stellartoml.Response
-		stellarToml, err = rh.StellarTomlResolver.GetStellarToml(authDataEntity.Domain)
+		stellarToml, err = rh.StellarTomlResolver.GetStellarTomlContext(r.Context(), authDataEntity.Domain)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"destination": request.Destination,
 				"err":         err,
-			}).Print("Cannot resolve address")
+			}).Info("Cannot resolve address")
 			helpers.Write(w, callback.CannotResolveDestination)
 			return
 		}
 
 		if stellarToml.AuthServer == "" {
-			log.Print("No AUTH_SERVER in stellar.toml")
+			log.Ctx(r.Context()).Info("No AUTH_SERVER in stellar.toml")
 			helpers.Write(w, callback.AuthServerNotDefined)
 			return
 		}
 
-		rh.sendAuthData(w, stellarToml.AuthServer, []byte(authDataEntity.AuthData))
+		rh.sendAuthData(r.Context(), w, stellarToml.AuthServer, []byte(authDataEntity.AuthData))
 		return
 	}
 
@@ -74,32 +76,32 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 	var destinationObject *federation.NameResponse
 
 	if request.ForwardDestination == nil {
-		destinationObject, err = rh.FederationResolver.LookupByAddress(request.Destination)
+		destinationObject, err = rh.FederationResolver.LookupByAddressContext(r.Context(), request.Destination)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"destination": request.Destination,
 				"err":         err,
-			}).Print("Cannot resolve address")
+			}).Info("Cannot resolve address")
 			helpers.Write(w, callback.CannotResolveDestination)
 			return
 		}
 
 		_, domain, err = address.Split(request.Destination)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"destination": request.Destination,
 				"err":         err,
-			}).Print("Cannot resolve address")
+			}).Info("Cannot resolve address")
 			helpers.Write(w, callback.CannotResolveDestination)
 			return
 		}
 	} else {
-		destinationObject, err = rh.FederationResolver.ForwardRequest(request.ForwardDestination.Domain, request.ForwardDestination.Fields)
+		destinationObject, err = rh.FederationResolver.ForwardRequestContext(r.Context(), request.ForwardDestination.Domain, request.ForwardDestination.Fields)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"destination": request.Destination,
 				"err":         err,
-			}).Print("Cannot resolve address")
+			}).Info("Cannot resolve address")
 			helpers.Write(w, callback.CannotResolveDestination)
 			return
 		}
@@ -107,22 +109,29 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		domain = request.ForwardDestination.Domain
 	}
 
-	stellarToml, err := rh.StellarTomlResolver.GetStellarToml(domain)
+	stellarToml, err := rh.StellarTomlResolver.GetStellarTomlContext(r.Context(), domain)
 	if err != nil {
-		log.WithFields(log.Fields{
+		log.Ctx(r.Context()).WithFields(log.F{
 			"destination": request.Destination,
 			"err":         err,
-		}).Print("Cannot resolve address")
+		}).Info("Cannot resolve address")
 		helpers.Write(w, callback.CannotResolveDestination)
 		return
 	}
 
 	if stellarToml.AuthServer == "" {
-		log.Print("No AUTH_SERVER in stellar.toml")
+		log.Ctx(r.Context()).Info("No AUTH_SERVER in stellar.toml")
 		helpers.Write(w, callback.AuthServerNotDefined)
 		return
 	}
 
+	if errs := stellarToml.Validate(); len(errs) > 0 {
+		log.Ctx(r.Context()).WithFields(log.F{
+			"domain": domain,
+			"err":    errs,
+		}).Warn("stellar.toml does not conform to SEP-1")
+	}
+
 	var rSource *string
 	if request.Source != "" {
 		rSource = &request.Source
@@ -138,7 +147,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		} else if request.SendAssetCode == "" && request.SendAssetIssuer == "" {
 			sendAsset = protocols.Asset{}
 		} else {
-			log.Print("Missing send asset param.")
+			log.Ctx(r.Context()).Info("Missing send asset param.")
 			helpers.Write(w, helpers.NewMissingParameter("send asset"))
 			return
 		}
@@ -171,12 +180,14 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 	if rh.Config.Callbacks.FetchInfo != "" {
 		fetchInfoRequest := &callback.FetchInfoRequest{Address: request.Sender}
 		var resp *http.Response
-		resp, err = rh.Client.PostForm(
+		resp, err = supportHttp.PostFormContext(
+			r.Context(),
+			rh.Client,
 			rh.Config.Callbacks.FetchInfo,
 			helpers.ToValues(fetchInfoRequest),
 		)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"fetch_info": rh.Config.Callbacks.FetchInfo,
 				"err":        err,
 			}).Error("Error sending request to fetch_info server")
@@ -188,7 +199,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		var body []byte
 		body, err = ioutil.ReadAll(resp.Body)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"fetch_info": rh.Config.Callbacks.FetchInfo,
 				"err":        err,
 			}).Error("Error reading fetch_info server response")
@@ -197,7 +208,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if resp.StatusCode != http.StatusOK {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"fetch_info": rh.Config.Callbacks.FetchInfo,
 				"status":     resp.StatusCode,
 				"body":       string(body),
@@ -208,7 +219,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 
 		err = json.Unmarshal(body, &senderInfo)
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"fetch_info": rh.Config.Callbacks.FetchInfo,
 				"err":        err,
 			}).Error("Error unmarshalling sender_info server response")
@@ -228,13 +239,13 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 
 	attachmentJSON, err := attachment.Marshal()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error marshalling attachment")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error marshalling attachment")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 	attachmentHashBytes, err := attachment.Hash()
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error hashing attachment")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error hashing attachment")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -248,7 +259,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 		memo,
 	)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Error("Error building transaction")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Error("Error building transaction")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -262,7 +273,7 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 
 	data, err := authData.Marshal()
 	if err != nil {
-		log.Error("Error mashaling authData")
+		log.Ctx(r.Context()).Error("Error mashaling authData")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -274,26 +285,26 @@ func (rh *RequestHandler) HandlerSend(w http.ResponseWriter, r *http.Request) {
 	}
 	err = rh.Database.InsertAuthData(authDataEntity)
 	if err != nil {
-		log.WithFields(log.Fields{"err": err}).Warn("Error persisting authDataEntity")
+		log.Ctx(r.Context()).WithFields(log.F{"err": err}).Warn("Error persisting authDataEntity")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
-	rh.sendAuthData(w, stellarToml.AuthServer, data)
+	rh.sendAuthData(r.Context(), w, stellarToml.AuthServer, data)
 }
 
-func (rh *RequestHandler) sendAuthData(w http.ResponseWriter, authServer string, data []byte) {
+func (rh *RequestHandler) sendAuthData(ctx context.Context, w http.ResponseWriter, authServer string, data []byte) {
 	var authData compliance.AuthData
 	err := json.Unmarshal(data, &authData)
 	if err != nil {
-		log.Error(err)
+		log.Ctx(ctx).Error(err)
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	sig, err := rh.SignatureSignerVerifier.Sign(rh.Config.Keys.SigningSeed, data)
 	if err != nil {
-		log.Error("Error signing authData")
+		log.Ctx(ctx).Error("Error signing authData")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -302,12 +313,14 @@ func (rh *RequestHandler) sendAuthData(w http.ResponseWriter, authServer string,
 		DataJSON:  string(data),
 		Signature: sig,
 	}
-	resp, err := rh.Client.PostForm(
+	resp, err := supportHttp.PostFormContext(
+		ctx,
+		rh.Client,
 		authServer,
 		authRequest.ToURLValues(),
 	)
 	if err != nil {
-		log.WithFields(log.Fields{
+		log.Ctx(ctx).WithFields(log.F{
 			"auth_server": authServer,
 			"err":         err,
 		}).Error("Error sending request to auth server")
@@ -318,13 +331,13 @@ func (rh *RequestHandler) sendAuthData(w http.ResponseWriter, authServer string,
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Error("Error reading auth server response")
+		log.Ctx(ctx).Error("Error reading auth server response")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 202 && resp.StatusCode != 403 {
-		log.WithFields(log.Fields{
+		log.Ctx(ctx).WithFields(log.F{
 			"status": resp.StatusCode,
 			"body":   string(body),
 		}).Error("Error response from auth server")
@@ -335,7 +348,7 @@ func (rh *RequestHandler) sendAuthData(w http.ResponseWriter, authServer string,
 	var authResponse compliance.AuthResponse
 	err = json.Unmarshal(body, &authResponse)
 	if err != nil {
-		log.WithFields(log.Fields{
+		log.Ctx(ctx).WithFields(log.F{
 			"status": resp.StatusCode,
 			"body":   string(body),
 		}).Error("Error unmarshalling auth response")
@@ -6,16 +6,16 @@ import (
 	"net/http"
 	"net/url"
 
-	log "github.com/sirupsen/logrus"
 	"github.com/stellar/go/protocols/compliance"
 	"github.com/stellar/go/services/internal/bridge-compliance-shared/http/helpers"
+	log "github.com/stellar/go/support/log"
 )
 
 // HandlerTxStatus implements /tx_status endpoint
 func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request) {
 	txid := r.URL.Query().Get("id")
 	if txid == "" {
-		log.Info("unable to get query parameter")
+		log.Ctx(r.Context()).Info("unable to get query parameter")
 		helpers.Write(w, helpers.NewMissingParameter("id"))
 		return
 	}
@@ -27,7 +27,7 @@ func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request
 
 		u, err := url.Parse(rh.Config.Callbacks.TxStatus)
 		if err != nil {
-			log.Error(err, "failed to parse tx status endpoint")
+			log.Ctx(r.Context()).Error(err, "failed to parse tx status endpoint")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -37,7 +37,7 @@ func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request
 		u.RawQuery = q.Encode()
 		resp, err := rh.Client.Get(u.String())
 		if err != nil {
-			log.WithFields(log.Fields{
+			log.Ctx(r.Context()).WithFields(log.F{
 				"tx_status": u.String(),
 				"err":       err,
 			}).Error("Error sending request to tx_status server")
@@ -48,7 +48,7 @@ func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request
 		defer resp.Body.Close()
 		body, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
-			log.Error("Error reading tx_status server response")
+			log.Ctx(r.Context()).Error("Error reading tx_status server response")
 			helpers.Write(w, helpers.InternalServerError)
 			return
 		}
@@ -57,7 +57,7 @@ func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request
 		case http.StatusOK:
 			err := json.Unmarshal(body, &response)
 			if err != nil {
-				log.WithFields(log.Fields{
+				log.Ctx(r.Context()).WithFields(log.F{
 					"tx_status": rh.Config.Callbacks.TxStatus,
 					"body":      string(body),
 				}).Error("Unable to decode tx_status response")
@@ -76,7 +76,7 @@ func (rh *RequestHandler) HandlerTxStatus(w http.ResponseWriter, r *http.Request
 	w.Header().Set("Content-Type", "application/json")
 	err := json.NewEncoder(w).Encode(response)
 	if err != nil {
-		log.Error("Error encoding tx status response")
+		log.Ctx(r.Context()).Error("Error encoding tx status response")
 		helpers.Write(w, helpers.InternalServerError)
 		return
 	}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"net/url"
 
 	fprotocol "github.com/stellar/go/protocols/federation"
@@ -29,3 +30,18 @@ func (m *MockFederationResolver) ForwardRequest(domain string, fields url.Values
 	a := m.Called(domain, fields)
 	return a.Get(0).(*fprotocol.NameResponse), a.Error(1)
 }
+
+// LookupByAddressContext is a mocking method, which shares the expectations of LookupByAddress
+func (m *MockFederationResolver) LookupByAddressContext(ctx context.Context, addy string) (*fprotocol.NameResponse, error) {
+	return m.LookupByAddress(addy)
+}
+
+// LookupByAccountIDContext is a mocking method, which shares the expectations of LookupByAccountID
+func (m *MockFederationResolver) LookupByAccountIDContext(ctx context.Context, aid string) (*fprotocol.IDResponse, error) {
+	return m.LookupByAccountID(aid)
+}
+
+// ForwardRequestContext is a mocking method, which shares the expectations of ForwardRequest
+func (m *MockFederationResolver) ForwardRequestContext(ctx context.Context, domain string, fields url.Values) (*fprotocol.NameResponse, error) {
+	return m.ForwardRequest(domain, fields)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/stellar/go/support/db/schema"
 	"github.com/stellar/go/support/errors"
 	supportHttp "github.com/stellar/go/support/http"
+	supportLog "github.com/stellar/go/support/log"
 )
 
 var app *App
@@ -63,14 +64,24 @@ func run(cmd *cobra.Command, args []string) {
 		os.Exit(-1)
 	}
 
+	err = cfg.LoadKeyfiles()
+	if err != nil {
+		log.Fatal(err.Error())
+		return
+	}
+
 	err = cfg.Validate()
 	if err != nil {
 		log.Fatal(err.Error())
 		return
 	}
 
+	// The handlers log through support/log, which starts at the warn level.
+	supportLog.SetLevel(log.InfoLevel)
+
 	if cfg.LogFormat == "json" {
-		log.SetFormatter(&log.JSONFormatter{})
+		log.SetFormatter(supportLog.NewJSONFormatter())
+		supportLog.UseJSONFormatter()
 	}
 
 	app, err = NewApp(cfg, migrateFlag, versionFlag, version)
@@ -133,12 +144,14 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 	}
 
 	stellartomlClient := stellartoml.Client{
-		HTTP: &httpClientWithTimeout,
+		HTTP:     &httpClientWithTimeout,
+		CacheTTL: time.Duration(config.FederationCacheTTL) * time.Second,
 	}
 
 	federationClient := federation.Client{
 		HTTP:        &httpClientWithTimeout,
 		StellarTOML: &stellartomlClient,
+		CacheTTL:    time.Duration(config.FederationCacheTTL) * time.Second,
 	}
 
 	err = g.Provide(
@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	stdhttp "net/http"
 	"os"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/spf13/cobra"
@@ -17,15 +19,30 @@ import (
 
 // Config represents the configuration of a federation server
 type Config struct {
-	Port     int `valid:"required"`
+	Port int `valid:"required"`
+	// Driver is the backend federation queries are executed against: "sql"
+	// (default), "http" or "file".
+	Driver   string `valid:"matches(^sql|http|file$)"`
 	Database struct {
 		Type string `valid:"matches(^sqlite3|postgres$)"`
-		DSN  string `valid:"required"`
-	} `valid:"required"`
+		DSN  string `valid:"optional"`
+	} `valid:"optional"`
 	Queries struct {
-		Federation        string `valid:"required"`
+		Federation        string `valid:"optional"`
 		ReverseFederation string `toml:"reverse-federation" valid:"optional"`
-	} `valid:"required"`
+	} `valid:"optional"`
+	HTTP struct {
+		Federation        string `valid:"optional"`
+		ReverseFederation string `toml:"reverse-federation" valid:"optional"`
+		Timeout           string `valid:"optional"`
+	} `toml:"http" valid:"optional"`
+	File struct {
+		Path string `valid:"optional"`
+	} `valid:"optional"`
+	Cache struct {
+		TTL         string `toml:"ttl" valid:"optional"`
+		NegativeTTL string `toml:"negative-ttl" valid:"optional"`
+	} `valid:"optional"`
 	TLS *config.TLS `valid:"optional"`
 }
 
@@ -37,7 +54,8 @@ func main() {
 The stellar federation server let's you easily integrate the stellar federation
 protocol with your organization.  This is achieved by connecting the
 application to your customer database and providing the appropriate queries in
-the config file.
+the config file, by proxying queries to an HTTP service or by serving records
+from a static file.
     `,
 		Run: run,
 	}
@@ -85,6 +103,32 @@ func run(cmd *cobra.Command, args []string) {
 }
 
 func initDriver(cfg Config) (federation.Driver, error) {
+	var (
+		driver federation.Driver
+		err    error
+	)
+
+	switch cfg.Driver {
+	case "", "sql":
+		driver, err = initSQLDriver(cfg)
+	case "http":
+		driver, err = initHTTPDriver(cfg)
+	case "file":
+		driver, err = federation.LoadFileDriver(cfg.File.Path)
+		if err != nil {
+			err = errors.Wrap(err, "load file failed")
+		}
+	default:
+		err = errors.Errorf("Invalid driver: %s", cfg.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return initCache(cfg, driver)
+}
+
+func initSQLDriver(cfg Config) (federation.Driver, error) {
 	var dialect string
 
 	switch cfg.Database.Type {
@@ -98,6 +142,10 @@ func initDriver(cfg Config) (federation.Driver, error) {
 		return nil, errors.Errorf("Invalid db type: %s", cfg.Database.Type)
 	}
 
+	if cfg.Queries.Federation == "" {
+		return nil, errors.New("queries.federation is required")
+	}
+
 	repo, err := db.Open(dialect, cfg.Database.DSN)
 	if err != nil {
 		return nil, errors.Wrap(err, "db open failed")
@@ -125,6 +173,73 @@ func initDriver(cfg Config) (federation.Driver, error) {
 	return &rsqld, nil
 }
 
+func initHTTPDriver(cfg Config) (federation.Driver, error) {
+	if cfg.HTTP.Federation == "" {
+		return nil, errors.New("http.federation is required")
+	}
+
+	timeout := 10 * time.Second
+	if cfg.HTTP.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(cfg.HTTP.Timeout)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid http.timeout")
+		}
+	}
+
+	httpd := federation.HTTPDriver{
+		HTTP:            &stdhttp.Client{Timeout: timeout},
+		LookupRecordURL: cfg.HTTP.Federation,
+	}
+
+	if cfg.HTTP.ReverseFederation == "" {
+		return &httpd, nil
+	}
+
+	return &federation.ReverseHTTPDriver{
+		HTTPDriver:             httpd,
+		LookupReverseRecordURL: cfg.HTTP.ReverseFederation,
+	}, nil
+}
+
+// initCache wraps driver in a caching driver when `cache.ttl` is set.
+func initCache(cfg Config, driver federation.Driver) (federation.Driver, error) {
+	if cfg.Cache.TTL == "" {
+		return driver, nil
+	}
+
+	ttl, err := time.ParseDuration(cfg.Cache.TTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cache.ttl")
+	}
+
+	var negativeTTL time.Duration
+	if cfg.Cache.NegativeTTL != "" {
+		negativeTTL, err = time.ParseDuration(cfg.Cache.NegativeTTL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid cache.negative-ttl")
+		}
+	}
+
+	rd, ok := driver.(federation.ReverseDriver)
+	if !ok {
+		return &federation.CachingDriver{
+			Driver:      driver,
+			TTL:         ttl,
+			NegativeTTL: negativeTTL,
+		}, nil
+	}
+
+	return &federation.CachingReverseDriver{
+		CachingDriver: federation.CachingDriver{
+			Driver:      driver,
+			TTL:         ttl,
+			NegativeTTL: negativeTTL,
+		},
+		ReverseDriver: rd,
+	}, nil
+}
+
 func initMux(driver federation.Driver) *chi.Mux {
 	mux := http.NewAPIMux(false)
 
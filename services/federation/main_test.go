@@ -1,8 +1,11 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
+	"github.com/stellar/go/handlers/federation"
 	"github.com/stellar/go/support/db/dbtest"
 	"github.com/stellar/go/support/errors"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +14,7 @@ import (
 
 func TestInitDriver_dialect(t *testing.T) {
 	c := Config{}
+	c.Queries.Federation = "SELECT id FROM people WHERE name = ? AND domain = ?"
 
 	testCases := []struct {
 		dbType  string
@@ -37,3 +41,54 @@ func TestInitDriver_dialect(t *testing.T) {
 		})
 	}
 }
+
+func TestInitDriver_driver(t *testing.T) {
+	file, err := ioutil.TempFile("", "federation*.toml")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`
+[[records]]
+name = "scott"
+domain = "stellar.org"
+account_id = "GD2GJPL3UOK5LX7TWXOACK2ZPWPFSLBNKL3GTGH6BLBNISK4BGWMFBBG"
+`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	c := Config{Driver: "file"}
+	c.File.Path = file.Name()
+	driver, err := initDriver(c)
+	require.NoError(t, err)
+	assert.IsType(t, &federation.FileDriver{}, driver)
+
+	c.Cache.TTL = "1m"
+	c.Cache.NegativeTTL = "10s"
+	driver, err = initDriver(c)
+	require.NoError(t, err)
+	assert.IsType(t, &federation.CachingReverseDriver{}, driver)
+
+	c.Cache.NegativeTTL = "bogus"
+	_, err = initDriver(c)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid cache.negative-ttl")
+
+	c = Config{Driver: "http"}
+	_, err = initDriver(c)
+	assert.EqualError(t, err, "http.federation is required")
+
+	c.HTTP.Federation = "https://users.example.com/federation"
+	driver, err = initDriver(c)
+	require.NoError(t, err)
+	assert.IsType(t, &federation.HTTPDriver{}, driver)
+
+	c.HTTP.ReverseFederation = "https://users.example.com/reverse"
+	c.Cache.TTL = "1m"
+	driver, err = initDriver(c)
+	require.NoError(t, err)
+	assert.IsType(t, &federation.CachingReverseDriver{}, driver)
+
+	c.HTTP.ReverseFederation = ""
+	driver, err = initDriver(c)
+	require.NoError(t, err)
+	assert.IsType(t, &federation.CachingDriver{}, driver)
+}
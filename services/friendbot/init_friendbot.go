@@ -8,6 +8,7 @@ import (
 	"github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/services/friendbot/internal"
+	"github.com/stellar/go/services/internal/channels"
 	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/txnbuild"
@@ -20,6 +21,7 @@ func initFriendbot(
 	startingBalance string,
 	numMinions int,
 	baseFee uint32,
+	channelSeeds []string,
 ) (*internal.Bot, error) {
 	if friendbotSecret == "" || networkPassphrase == "" || horizonURL == "" || startingBalance == "" || numMinions < 0 {
 		return nil, errors.New("invalid input param(s)")
@@ -44,6 +46,16 @@ func initFriendbot(
 	botKeypair := botKP.(*keypair.Full)
 	botAccount := internal.Account{AccountID: botKeypair.Address()}
 	minionBalance := "101.00"
+	if len(channelSeeds) > 0 {
+		log.Printf("Found all valid params, now creating minions for %d channels", len(channelSeeds))
+		pool, err := channels.NewPool(hclient, networkPassphrase, botKeypair, channelSeeds)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating channels pool")
+		}
+		pool.BaseFee = baseFee
+		pool.StartingBalance = minionBalance
+		return &internal.Bot{Minions: createPoolMinions(pool, botAccount, botKeypair, networkPassphrase, startingBalance, baseFee, hclient)}, nil
+	}
 	if numMinions == 0 {
 		numMinions = 1000
 	}
@@ -56,6 +68,26 @@ func initFriendbot(
 	return &internal.Bot{Minions: minions}, nil
 }
 
+// createPoolMinions creates one minion per channel of the pool. The minions
+// don't have accounts of their own, every transaction uses a free channel from
+// the pool as its source.
+func createPoolMinions(pool *channels.Pool, botAccount internal.Account, botKeypair *keypair.Full, networkPassphrase, newAccountBalance string, baseFee uint32, hclient *horizonclient.Client) []internal.Minion {
+	minions := make([]internal.Minion, pool.Size())
+	for i := range minions {
+		minions[i] = internal.Minion{
+			BotAccount:        botAccount,
+			BotKeypair:        botKeypair,
+			Horizon:           hclient,
+			Network:           networkPassphrase,
+			StartingBalance:   newAccountBalance,
+			SubmitTransaction: internal.SubmitTransaction,
+			BaseFee:           baseFee,
+			Pool:              pool,
+		}
+	}
+	return minions
+}
+
 func createMinionAccounts(botAccount internal.Account, botKeypair *keypair.Full, networkPassphrase, newAccountBalance, minionBalance string, numMinions int, baseFee uint32, hclient *horizonclient.Client) ([]internal.Minion, error) {
 	var minions []internal.Minion
 	numRemainingMinions := numMinions
@@ -7,7 +7,11 @@ import (
 	"github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
 	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/services/internal/channels"
+	"github.com/stellar/go/xdr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFriendbot_Pay(t *testing.T) {
@@ -69,3 +73,55 @@ func TestFriendbot_Pay(t *testing.T) {
 	}()
 	wg.Wait()
 }
+
+func TestFriendbot_PayWithChannels(t *testing.T) {
+	mockSubmitTransaction := func(minion *Minion, hclient *horizonclient.Client, tx string) (*hProtocol.TransactionSuccess, error) {
+		txSuccess := hProtocol.TransactionSuccess{Env: tx}
+		return &txSuccess, nil
+	}
+
+	botSeed := "SCWNLYELENPBXN46FHYXETT5LJCYBZD5VUQQVW4KZPHFO2YTQJUWT4D5"
+	botKeypair := keypair.MustParse(botSeed).(*keypair.Full)
+	botAccount := Account{AccountID: botKeypair.Address()}
+
+	channelSeed := "SDTNSEERJPJFUE2LSDNYBFHYGVTPIWY7TU2IOJZQQGLWO2THTGB7NU5A"
+	channelID := keypair.MustParse(channelSeed).Address()
+	network := "Test SDF Network ; September 2015"
+
+	horizon := &horizonclient.MockClient{}
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).Return(
+		hProtocol.Account{
+			AccountID: channelID,
+			Sequence:  "1",
+			Balances:  []hProtocol.Balance{{Balance: "100", Asset: base.Asset{Type: "native"}}},
+		},
+		nil,
+	).Once()
+
+	pool, err := channels.NewPool(horizon, network, botKeypair, []string{channelSeed})
+	require.NoError(t, err)
+
+	minion := Minion{
+		BotAccount:        botAccount,
+		BotKeypair:        botKeypair,
+		Network:           network,
+		StartingBalance:   "10000.00",
+		SubmitTransaction: mockSubmitTransaction,
+		Pool:              pool,
+	}
+	fb := &Bot{Minions: []Minion{minion, minion}}
+
+	recipientAddress := "GDJIN6W6PLTPKLLM57UW65ZH4BITUXUMYQHIMAZFYXF45PZVAWDBI77Z"
+	for _, expectedSeq := range []xdr.SequenceNumber{2, 3} {
+		txSuccess, err := fb.Pay(recipientAddress)
+		require.NoError(t, err)
+
+		var txe xdr.TransactionEnvelope
+		require.NoError(t, xdr.SafeUnmarshalBase64(txSuccess.Env, &txe))
+		assert.Equal(t, channelID, txe.Tx.SourceAccount.Address())
+		assert.Equal(t, expectedSeq, txe.Tx.SeqNum)
+		assert.Equal(t, botAccount.AccountID, txe.Tx.Operations[0].SourceAccount.Address())
+		assert.Len(t, txe.Signatures, 2)
+	}
+	horizon.AssertExpectations(t)
+}
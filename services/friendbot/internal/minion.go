@@ -1,11 +1,13 @@
 package internal
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stellar/go/clients/horizonclient"
 	"github.com/stellar/go/keypair"
 	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/internal/channels"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/txnbuild"
 )
@@ -25,6 +27,9 @@ type Minion struct {
 	StartingBalance   string
 	SubmitTransaction func(minion *Minion, hclient *horizonclient.Client, tx string) (*hProtocol.TransactionSuccess, error)
 	BaseFee           uint32
+	// Pool is an optional pool of channel accounts. When set, transactions use a
+	// channel from the pool as their source instead of the minion's Account.
+	Pool *channels.Pool
 
 	// Uninitialized.
 	forceRefreshSequence bool
@@ -33,6 +38,11 @@ type Minion struct {
 // Run reads a payment destination address and an output channel. It attempts
 // to pay that address and submits the result to the channel.
 func (minion *Minion) Run(destAddress string, resultChan chan SubmitResult) {
+	if minion.Pool != nil {
+		minion.runWithChannel(destAddress, resultChan)
+		return
+	}
+
 	err := minion.checkSequenceRefresh(minion.Horizon)
 	if err != nil {
 		resultChan <- SubmitResult{
@@ -54,6 +64,34 @@ func (minion *Minion) Run(destAddress string, resultChan chan SubmitResult) {
 	}
 }
 
+// runWithChannel pays the destination address using a channel from the pool as
+// the transaction source.
+func (minion *Minion) runWithChannel(destAddress string, resultChan chan SubmitResult) {
+	channel, err := minion.Pool.Acquire(context.Background())
+	if err != nil {
+		resultChan <- SubmitResult{
+			maybeTransactionSuccess: nil,
+			maybeErr:                errors.Wrap(err, "acquiring channel"),
+		}
+		return
+	}
+	txStr, err := minion.makeChannelTx(channel, destAddress)
+	if err != nil {
+		minion.Pool.Release(channel, err)
+		resultChan <- SubmitResult{
+			maybeTransactionSuccess: nil,
+			maybeErr:                errors.Wrap(err, "making payment tx"),
+		}
+		return
+	}
+	succ, err := minion.SubmitTransaction(minion, minion.Horizon, txStr)
+	minion.Pool.Release(channel, err)
+	resultChan <- SubmitResult{
+		maybeTransactionSuccess: succ,
+		maybeErr:                errors.Wrap(err, "submitting tx to minion"),
+	}
+}
+
 // SubmitTransaction should be passed to the Minion.
 func SubmitTransaction(minion *Minion, hclient *horizonclient.Client, tx string) (*hProtocol.TransactionSuccess, error) {
 	result, err := hclient.SubmitTransactionXDR(tx)
@@ -124,3 +162,25 @@ func (minion *Minion) makeTx(destAddress string) (string, error) {
 	}
 	return txe, err
 }
+
+func (minion *Minion) makeChannelTx(channel *channels.Channel, destAddress string) (string, error) {
+	createAccountOp := txnbuild.CreateAccount{
+		Destination:   destAddress,
+		SourceAccount: minion.BotAccount,
+		Amount:        minion.StartingBalance,
+	}
+	// Building the tx increments the channel's sequence number.
+	txn := txnbuild.Transaction{
+		SourceAccount: channel,
+		Operations:    []txnbuild.Operation{&createAccountOp},
+		Network:       minion.Network,
+		Timebounds:    txnbuild.NewInfiniteTimeout(),
+		BaseFee:       minion.BaseFee,
+	}
+
+	txe, err := txn.BuildSignEncode(channel.Keypair, minion.BotKeypair)
+	if err != nil {
+		return "", errors.Wrap(err, "making account payment tx")
+	}
+	return txe, nil
+}
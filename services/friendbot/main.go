@@ -20,13 +20,19 @@ import (
 // Config represents the configuration of a friendbot server
 type Config struct {
 	Port              int         `toml:"port" valid:"required"`
-	FriendbotSecret   string      `toml:"friendbot_secret" valid:"required"`
+	FriendbotSecret   string      `toml:"friendbot_secret" valid:"optional"`
 	NetworkPassphrase string      `toml:"network_passphrase" valid:"required"`
 	HorizonURL        string      `toml:"horizon_url" valid:"required"`
 	StartingBalance   string      `toml:"starting_balance" valid:"required"`
 	TLS               *config.TLS `valid:"optional"`
 	NumMinions        int         `toml:"num_minions" valid:"optional"`
 	BaseFee           uint32      `toml:"base_fee" valid:"optional"`
+	ChannelSeeds      []string    `toml:"channel_seeds" valid:"optional"`
+	// FriendbotKeyfile is an encrypted keyfile used instead of
+	// FriendbotSecret.
+	FriendbotKeyfile *config.Keyfile `toml:"friendbot_keyfile" valid:"optional"`
+	// ChannelKeyfiles are encrypted keyfiles used instead of ChannelSeeds.
+	ChannelKeyfiles []config.Keyfile `toml:"channel_keyfiles" valid:"optional"`
 }
 
 func main() {
@@ -60,7 +66,35 @@ func run(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
-	fb, err := initFriendbot(cfg.FriendbotSecret, cfg.NetworkPassphrase, cfg.HorizonURL, cfg.StartingBalance, cfg.NumMinions, cfg.BaseFee)
+	if cfg.FriendbotKeyfile != nil {
+		if cfg.FriendbotSecret != "" {
+			log.Error("friendbot_secret and friendbot_keyfile can't be both set")
+			os.Exit(1)
+		}
+		kp, err := cfg.FriendbotKeyfile.Load()
+		if err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+		cfg.FriendbotSecret = kp.Seed()
+	}
+
+	if len(cfg.ChannelKeyfiles) > 0 {
+		if len(cfg.ChannelSeeds) > 0 {
+			log.Error("channel_seeds and channel_keyfiles can't be both set")
+			os.Exit(1)
+		}
+		for i := range cfg.ChannelKeyfiles {
+			kp, err := cfg.ChannelKeyfiles[i].Load()
+			if err != nil {
+				log.Error(err)
+				os.Exit(1)
+			}
+			cfg.ChannelSeeds = append(cfg.ChannelSeeds, kp.Seed())
+		}
+	}
+
+	fb, err := initFriendbot(cfg.FriendbotSecret, cfg.NetworkPassphrase, cfg.HorizonURL, cfg.StartingBalance, cfg.NumMinions, cfg.BaseFee, cfg.ChannelSeeds)
 	if err != nil {
 		log.Error(err)
 		os.Exit(1)
@@ -14,10 +14,11 @@ import (
 	"github.com/spf13/viper"
 	horizon "github.com/stellar/go/services/horizon/internal"
 	"github.com/stellar/go/services/horizon/internal/db2/schema"
+	"github.com/stellar/go/services/horizon/internal/expingest"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	apkg "github.com/stellar/go/support/app"
 	support "github.com/stellar/go/support/config"
 	"github.com/stellar/go/support/log"
-	"github.com/stellar/throttled"
 )
 
 var (
@@ -142,6 +143,19 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: uint(8000),
 		Usage:       "tcp port to listen on for http requests",
 	},
+	&support.ConfigOption{
+		Name:        "admin-port",
+		ConfigKey:   &config.AdminPort,
+		OptType:     types.Uint,
+		FlagDefault: uint(0),
+		Usage:       "tcp port to listen on for admin http requests, 0 (default) disables the admin server. it must not be exposed publicly",
+	},
+	&support.ConfigOption{
+		Name:      "admin-auth-token",
+		ConfigKey: &config.AdminAuthToken,
+		OptType:   types.String,
+		Usage:     "bearer token required by the admin server, in the 'Authorization: Bearer <token>' header of admin requests",
+	},
 	&support.ConfigOption{
 		Name:        "max-db-connections",
 		ConfigKey:   &config.MaxDBConnections,
@@ -199,17 +213,51 @@ var configOpts = []*support.ConfigOption{
 		OptType:     types.Int,
 		FlagDefault: 3600,
 		CustomSetValue: func(co *support.ConfigOption) {
-			var rateLimit *throttled.RateQuota = nil
+			var rateLimit *ratelimit.Quota = nil
 			perHourRateLimit := viper.GetInt(co.Name)
 			if perHourRateLimit != 0 {
-				rateLimit = &throttled.RateQuota{
-					MaxRate:  throttled.PerHour(perHourRateLimit),
-					MaxBurst: 100,
+				rateLimit = &ratelimit.Quota{
+					PerHour:  perHourRateLimit,
+					MaxBurst: ratelimit.DefaultMaxBurst,
 				}
-				*(co.ConfigKey.(**throttled.RateQuota)) = rateLimit
+				*(co.ConfigKey.(**ratelimit.Quota)) = rateLimit
+			}
+		},
+		Usage: "max count of requests allowed in a one hour period, by rate limit bucket, for requests without a known api key",
+	},
+	&support.ConfigOption{
+		Name:        "rate-limit-vary-by",
+		ConfigKey:   &config.RateLimitVaryBy,
+		OptType:     types.String,
+		FlagDefault: "ip",
+		CustomSetValue: func(co *support.ConfigOption) {
+			scopes, err := ratelimit.ParseScopes(viper.GetString(co.Name))
+			if err != nil {
+				stdLog.Fatalf("Invalid `%s` value: %v", co.Name, err)
 			}
+			*(co.ConfigKey.(*[]ratelimit.Scope)) = scopes
 		},
-		Usage: "max count of requests allowed in a one hour period, by remote ip address",
+		Usage: "comma-separated list of scopes the rate limit buckets are keyed by: ip, api_key, account and asset. requests to which none of them applies are limited by ip. requests with a known api key are always counted by key, in place of ip when api_key is not listed",
+	},
+	&support.ConfigOption{
+		Name:        "rate-limit-api-key-header",
+		ConfigKey:   &config.RateLimitAPIKeyHeader,
+		OptType:     types.String,
+		FlagDefault: "X-Api-Key",
+		Usage:       "header clients send their rate limit api key in",
+	},
+	&support.ConfigOption{
+		Name:      "rate-limit-api-keys-file",
+		ConfigKey: &config.RateLimitAPIKeysFile,
+		OptType:   types.String,
+		Usage:     "path to a toml file listing the rate limit api keys and their quotas",
+	},
+	&support.ConfigOption{
+		Name:        "rate-limit-stream-cost",
+		ConfigKey:   &config.RateLimitStreamCost,
+		OptType:     types.Int,
+		FlagDefault: 1,
+		Usage:       "count of requests each update of a stream is rate limited as, 0 to not rate limit stream updates",
 	},
 	&support.ConfigOption{
 		Name:      "rate-limit-redis-key",
@@ -366,6 +414,20 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: false,
 		Usage:       "experimental ingestion system runs a verification routing to compare state in local database with history buckets, this can be disabled however it's not recommended",
 	},
+	&support.ConfigOption{
+		Name:        "order-book-history-pairs",
+		ConfigKey:   &config.OrderBookHistoryPairs,
+		OptType:     types.String,
+		FlagDefault: "",
+		CustomSetValue: func(co *support.ConfigOption) {
+			pairs, err := expingest.ParseAssetPairs(viper.GetString(co.Name))
+			if err != nil {
+				stdLog.Fatalf("Invalid `%s` value: %v", co.Name, err)
+			}
+			*(co.ConfigKey.(*[]expingest.AssetPair)) = pairs
+		},
+		Usage: "[EXPERIMENTAL] comma-separated list of base/counter asset pairs (each asset `native` or `Code:Issuer`) whose order book depth is recorded after every ledger and served by `/order_book/history`",
+	},
 	&support.ConfigOption{
 		Name:        "apply-migrations",
 		ConfigKey:   &config.ApplyMigrations,
@@ -427,6 +489,14 @@ func initConfig() {
 		log.Fatal("Invalid `ingest-state-reader-temp-set` value: " + config.IngestStateReaderTempSet)
 	}
 
+	if len(config.OrderBookHistoryPairs) > 0 && !config.EnableExperimentalIngestion {
+		log.Fatal("`order-book-history-pairs` requires `enable-experimental-ingestion`")
+	}
+
+	if config.AdminPort != 0 && config.AdminAuthToken == "" {
+		log.Fatal("`admin-port` requires `admin-auth-token`")
+	}
+
 	// Configure DB params. When config.MaxDBConnections is set, set other
 	// DB params to that value for backward compatibility.
 	if config.MaxDBConnections != 0 {
@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"net/http"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+// getIssuedAsset parses the `asset` url parameter of the asset holders
+// endpoints, an issued asset in the format Code:Issuer.
+func getIssuedAsset(r *http.Request) (xdr.Asset, error) {
+	s, err := GetString(r, "asset")
+	if err != nil {
+		return xdr.Asset{}, err
+	}
+
+	assets, err := xdr.BuildAssets(s)
+	if err != nil {
+		return xdr.Asset{}, problem.MakeInvalidFieldProblem("asset", err)
+	}
+	if len(assets) != 1 {
+		return xdr.Asset{}, problem.MakeInvalidFieldProblem(
+			"asset",
+			errors.New("asset must be a single asset in the format Code:Issuer"),
+		)
+	}
+	if assets[0].Type == xdr.AssetTypeAssetTypeNative {
+		return xdr.Asset{}, problem.MakeInvalidFieldProblem(
+			"asset",
+			errors.New("native asset is held without trust lines"),
+		)
+	}
+
+	return assets[0], nil
+}
+
+// AssetHoldersHandler is the action handler for the
+// /assets/{asset}/holders endpoint
+type AssetHoldersHandler struct {
+}
+
+// GetResourcePage returns a page of the holders of an asset, sorted by
+// balance.
+func (handler AssetHoldersHandler) GetResourcePage(
+	w HeaderWriter,
+	r *http.Request,
+) ([]hal.Pageable, error) {
+	ctx := r.Context()
+	asset, err := getIssuedAsset(r)
+	if err != nil {
+		return nil, err
+	}
+
+	pq, err := GetPageQuery(r, DisableCursorValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	if pq.Cursor != "" {
+		if _, _, err = history.ParseHolderCursor(pq.Cursor); err != nil {
+			return nil, problem.MakeInvalidFieldProblem("cursor", err)
+		}
+	}
+
+	historyQ, err := historyQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	trustLines, err := historyQ.GetAssetHolders(asset, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []hal.Pageable
+	for _, record := range trustLines {
+		var holder horizon.AssetHolder
+		resourceadapter.PopulateAssetHolder(ctx, &holder, record)
+		response = append(response, holder)
+	}
+
+	return response, nil
+}
+
+// AssetDistributionHandler is the action handler for the
+// /assets/{asset}/distribution endpoint
+type AssetDistributionHandler struct {
+}
+
+// GetResource returns the distribution of the balances of an asset.
+func (handler AssetDistributionHandler) GetResource(
+	w HeaderWriter,
+	r *http.Request,
+) (hal.Pageable, error) {
+	ctx := r.Context()
+	asset, err := getIssuedAsset(r)
+	if err != nil {
+		return nil, err
+	}
+
+	historyQ, err := historyQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	distribution, err := historyQ.GetAssetDistribution(asset)
+	if err != nil {
+		return nil, err
+	}
+
+	var response horizon.AssetDistribution
+	err = resourceadapter.PopulateAssetDistribution(ctx, &response, asset, distribution)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,176 @@
+package actions
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+func TestAssetHoldersValidation(t *testing.T) {
+	handler := AssetHoldersHandler{}
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+	for _, testCase := range []struct {
+		name               string
+		asset              string
+		queryParams        map[string]string
+		expectedErrorField string
+		expectedError      string
+	}{
+		{
+			"invalid asset",
+			"USD",
+			map[string]string{},
+			"asset",
+			"not a valid asset",
+		},
+		{
+			"native asset",
+			"native",
+			map[string]string{},
+			"asset",
+			"native asset is held without trust lines",
+		},
+		{
+			"several assets",
+			"USD:" + issuer + ",EUR:" + issuer,
+			map[string]string{},
+			"asset",
+			"must be a single asset",
+		},
+		{
+			"invalid cursor balance",
+			"USD:" + issuer,
+			map[string]string{"cursor": "abc_" + issuer},
+			"cursor",
+			"invalid balance",
+		},
+		{
+			"invalid cursor account",
+			"USD:" + issuer,
+			map[string]string{"cursor": "10_invalid"},
+			"cursor",
+			"invalid account",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := makeRequest(t, testCase.queryParams, map[string]string{"asset": testCase.asset}, nil)
+			_, err := handler.GetResourcePage(httptest.NewRecorder(), r)
+			if err == nil {
+				t.Fatalf("expected error %v but got %v", testCase.expectedError, err)
+			}
+
+			problem := err.(*problem.P)
+			if field := problem.Extras["invalid_field"]; field != testCase.expectedErrorField {
+				t.Fatalf(
+					"expected error field %v but got %v",
+					testCase.expectedErrorField,
+					field,
+				)
+			}
+
+			reason := problem.Extras["reason"]
+			if !strings.Contains(reason.(string), testCase.expectedError) {
+				t.Fatalf("expected reason %v but got %v", testCase.expectedError, reason)
+			}
+		})
+	}
+}
+
+func TestAssetHolders(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+	q := &history.Q{tt.HorizonSession()}
+
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	usd := xdr.MustNewCreditAsset("USD", issuer)
+	for _, trustLine := range []xdr.TrustLineEntry{
+		{
+			AccountId: xdr.MustAddress("GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"),
+			Asset:     usd,
+			Balance:   20000000,
+			Limit:     100000000,
+			Flags:     xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag),
+		},
+		{
+			AccountId: xdr.MustAddress("GCYVFGI3SEQJGBNQQG7YCMFWEYOHK3XPVOVPA6C566PXWN4SN7LILZSM"),
+			Asset:     usd,
+			Balance:   30000000,
+			Limit:     100000000,
+			Flags:     xdr.Uint32(xdr.TrustLineFlagsAuthorizedFlag),
+		},
+		{
+			AccountId: xdr.MustAddress("GBYSBDAJZMHL5AMD7QXQ3JEP3Q4GLKADWIJURAAHQALNAWD6Z5XF2RAC"),
+			Asset:     usd,
+			Balance:   0,
+			Limit:     100000000,
+		},
+		{
+			AccountId: xdr.MustAddress("GBYSBDAJZMHL5AMD7QXQ3JEP3Q4GLKADWIJURAAHQALNAWD6Z5XF2RAC"),
+			Asset:     xdr.MustNewCreditAsset("EUR", issuer),
+			Balance:   10,
+			Limit:     100000000,
+		},
+	} {
+		numChanged, err := q.InsertTrustLine(trustLine, 3)
+		tt.Assert.NoError(err)
+		tt.Assert.Equal(int64(1), numChanged)
+	}
+
+	handler := AssetHoldersHandler{}
+	r := makeRequest(
+		t,
+		map[string]string{"order": "desc", "limit": "2"},
+		map[string]string{"asset": "USD:" + issuer},
+		q.Session,
+	)
+	results, err := handler.GetResourcePage(httptest.NewRecorder(), r)
+	tt.Assert.NoError(err)
+	if tt.Assert.Len(results, 2) {
+		first := results[0].(horizon.AssetHolder)
+		tt.Assert.Equal("GCYVFGI3SEQJGBNQQG7YCMFWEYOHK3XPVOVPA6C566PXWN4SN7LILZSM", first.AccountID)
+		tt.Assert.Equal("3.0000000", first.Balance)
+		tt.Assert.True(first.IsAuthorized)
+		tt.Assert.Equal(
+			"20000000_GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB",
+			results[1].PagingToken(),
+		)
+	}
+
+	r = makeRequest(
+		t,
+		map[string]string{"order": "desc", "cursor": results[1].PagingToken()},
+		map[string]string{"asset": "USD:" + issuer},
+		q.Session,
+	)
+	results, err = handler.GetResourcePage(httptest.NewRecorder(), r)
+	tt.Assert.NoError(err)
+	if tt.Assert.Len(results, 1) {
+		last := results[0].(horizon.AssetHolder)
+		tt.Assert.Equal("0.0000000", last.Balance)
+		tt.Assert.False(last.IsAuthorized)
+	}
+
+	r = makeRequest(t, map[string]string{}, map[string]string{"asset": "USD:" + issuer}, q.Session)
+	resource, err := AssetDistributionHandler{}.GetResource(httptest.NewRecorder(), r)
+	tt.Assert.NoError(err)
+	distribution := resource.(horizon.AssetDistribution)
+	tt.Assert.Equal("USD", distribution.Code)
+	tt.Assert.Equal(int32(3), distribution.NumHolders)
+	tt.Assert.Equal(int32(2), distribution.NumAuthorized)
+	tt.Assert.Equal(int32(1), distribution.NumUnauthorized)
+	tt.Assert.Equal("5.0000000", distribution.Amount)
+	tt.Assert.Equal("5.0000000", distribution.AuthorizedAmount)
+	tt.Assert.Equal("0.0000000", distribution.UnauthorizedAmount)
+	tt.Assert.Equal([]horizon.AssetBalanceBucket{
+		{MinBalance: "0.0000000", MaxBalance: "0.0000001", NumHolders: 1, Amount: "0.0000000"},
+		{MinBalance: "1.0000000", MaxBalance: "10.0000000", NumHolders: 2, Amount: "5.0000000"},
+	}, distribution.Histogram)
+}
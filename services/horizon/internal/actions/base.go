@@ -78,7 +78,7 @@ func (base *Base) Execute(action interface{}) {
 			app := base.R.Context().Value(&horizonContext.AppContextKey)
 			rateLimiter := app.(RateLimiterProvider).GetRateLimiter()
 			if rateLimiter != nil {
-				limited, _, err := rateLimiter.RateLimiter.RateLimit(rateLimiter.VaryBy.Key(base.R), 1)
+				limited, err := rateLimiter.RateLimitStream(base.R)
 				if err != nil {
 					stream.Err(errors.Wrap(err, "RateLimiter error"))
 					return
@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	supportTime "github.com/stellar/go/support/time"
+)
+
+// OrderBookHistoryHandler is the action handler for the /order_book/history
+// endpoint
+type OrderBookHistoryHandler struct {
+}
+
+// GetResourcePage returns a page of the samples of the order book of the
+// selling (base) and buying (counter) assets, recorded after every ledger.
+func (handler OrderBookHistoryHandler) GetResourcePage(
+	w HeaderWriter,
+	r *http.Request,
+) ([]hal.Pageable, error) {
+	ctx := r.Context()
+	selling, err := GetAsset(r, "selling_")
+	if err != nil {
+		return nil, err
+	}
+	buying, err := GetAsset(r, "buying_")
+	if err != nil {
+		return nil, err
+	}
+	if selling.Equals(buying) {
+		return nil, problem.MakeInvalidFieldProblem(
+			"buying_asset_type",
+			errors.New("buying asset must be different from selling asset"),
+		)
+	}
+
+	startTime, err := getTime(r, "start_time")
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := getTime(r, "end_time")
+	if err != nil {
+		return nil, err
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime) {
+		return nil, problem.MakeInvalidFieldProblem(
+			"end_time",
+			errors.New("end_time must be after start_time"),
+		)
+	}
+
+	pq, err := GetPageQuery(r)
+	if err != nil {
+		return nil, err
+	}
+
+	historyQ, err := historyQFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := historyQ.GetOrderBookSamples(selling, buying, startTime, endTime, pq)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []hal.Pageable
+	for _, record := range samples {
+		var sample horizon.OrderBookSample
+		if err = resourceadapter.PopulateOrderBookSample(ctx, &sample, record); err != nil {
+			return nil, err
+		}
+		response = append(response, sample)
+	}
+
+	return response, nil
+}
+
+// getTime parses a time in milliseconds since epoch, returning the zero time
+// when the parameter is missing.
+func getTime(r *http.Request, name string) (time.Time, error) {
+	s, err := GetString(r, name)
+	if err != nil || s == "" {
+		return time.Time{}, err
+	}
+
+	millis, err := supportTime.MillisFromString(s)
+	if err != nil {
+		return time.Time{}, problem.MakeInvalidFieldProblem(name, err)
+	}
+
+	return millis.ToTime(), nil
+}
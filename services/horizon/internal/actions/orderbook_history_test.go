@@ -0,0 +1,86 @@
+package actions
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/support/render/problem"
+)
+
+func TestOrderBookHistoryValidation(t *testing.T) {
+	handler := OrderBookHistoryHandler{}
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	pair := func(extra map[string]string) map[string]string {
+		params := map[string]string{
+			"selling_asset_type":  "native",
+			"buying_asset_type":   "credit_alphanum4",
+			"buying_asset_code":   "USD",
+			"buying_asset_issuer": issuer,
+		}
+		for k, v := range extra {
+			params[k] = v
+		}
+		return params
+	}
+
+	for _, testCase := range []struct {
+		name               string
+		queryParams        map[string]string
+		expectedErrorField string
+		expectedError      string
+	}{
+		{
+			"missing buying asset",
+			map[string]string{"selling_asset_type": "native"},
+			"buying_asset_type",
+			"",
+		},
+		{
+			"same assets",
+			map[string]string{"selling_asset_type": "native", "buying_asset_type": "native"},
+			"buying_asset_type",
+			"must be different",
+		},
+		{
+			"invalid start time",
+			pair(map[string]string{"start_time": "yesterday"}),
+			"start_time",
+			"",
+		},
+		{
+			"end time before start time",
+			pair(map[string]string{"start_time": "1000", "end_time": "1000"}),
+			"end_time",
+			"must be after start_time",
+		},
+		{
+			"invalid cursor",
+			pair(map[string]string{"cursor": "abc"}),
+			"cursor",
+			"",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := makeRequest(t, testCase.queryParams, map[string]string{}, nil)
+			_, err := handler.GetResourcePage(httptest.NewRecorder(), r)
+			if err == nil {
+				t.Fatalf("expected error %v but got %v", testCase.expectedError, err)
+			}
+
+			problem := err.(*problem.P)
+			if field := problem.Extras["invalid_field"]; field != testCase.expectedErrorField {
+				t.Fatalf(
+					"expected error field %v but got %v",
+					testCase.expectedErrorField,
+					field,
+				)
+			}
+
+			reason := problem.Extras["reason"]
+			if !strings.Contains(reason.(string), testCase.expectedError) {
+				t.Fatalf("expected reason %v but got %v", testCase.expectedError, reason)
+			}
+		})
+	}
+}
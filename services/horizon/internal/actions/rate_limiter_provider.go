@@ -1,8 +1,8 @@
 package actions
 
-import "github.com/stellar/throttled"
+import "github.com/stellar/go/services/horizon/internal/ratelimit"
 
 // RateLimiterProvider is an interface that provides access to the type's HTTPRateLimiter.
 type RateLimiterProvider interface {
-	GetRateLimiter() *throttled.HTTPRateLimiter
+	GetRateLimiter() *ratelimit.HTTPRateLimiter
 }
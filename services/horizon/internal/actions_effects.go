@@ -33,6 +33,8 @@ type EffectIndexAction struct {
 	LedgerFilter      int32
 	TransactionFilter string
 	OperationFilter   int64
+	TypeFilter        []history.EffectType
+	Filters           historyFilters
 
 	PagingParams db2.PageQuery
 	Records      []history.Effect
@@ -111,6 +113,11 @@ func (action *EffectIndexAction) loadParams() {
 	action.LedgerFilter = action.GetInt32("ledger_id")
 	action.TransactionFilter = action.GetString("tx_id")
 	action.OperationFilter = action.GetInt64("op_id")
+	action.TypeFilter = getEffectTypes(&action.Action.Base)
+	action.Filters = loadHistoryFilters(&action.Action.Base)
+	if action.Err != nil {
+		return
+	}
 
 	filters, err := countNonEmpty(
 		action.AccountFilter,
@@ -145,6 +152,11 @@ func (action *EffectIndexAction) loadRecords() {
 		effects.ForTransaction(action.TransactionFilter)
 	}
 
+	if len(action.TypeFilter) > 0 {
+		effects.OfTypes(action.TypeFilter...)
+	}
+	action.Filters.applyToEffects(effects)
+
 	action.Err = effects.Page(action.PagingParams).Select(&action.Records)
 }
 
@@ -102,3 +102,37 @@ func TestEffectActions_Index(t *testing.T) {
 		}
 	})
 }
+
+func TestEffectActions_Filters(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	w := ht.Get("/effects?type=account_created,signer_created&limit=20")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(6, w.Body)
+	}
+
+	w = ht.Get("/effects?type=unknown")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/effects?asset_type=native&limit=20")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(5, w.Body)
+	}
+
+	w = ht.Get("/accounts/GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON/effects?min_amount=5")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	w = ht.Get("/effects?memo=hello")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	// ledger 3 closed at 2019-10-31T13:19:46Z
+	w = ht.Get("/effects?start_time=1572527986000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+}
@@ -0,0 +1,111 @@
+package horizon
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+)
+
+// This file contains the actions:
+//
+// FeeInclusionAction: projected inclusion probability of a fee per operation
+
+var _ actions.JSONer = (*FeeInclusionAction)(nil)
+
+// FeeInclusionAction estimates the probability that a transaction bidding
+// `fee` stroops per operation is included in the next ledger, from the
+// capacity usage and the fees accepted by the last `ledgers` ledgers.
+type FeeInclusionAction struct {
+	Action
+	Fee         int64
+	Ledgers     uint64
+	Records     []history.LedgerFeeStats
+	FullLedgers int
+	Probability float64
+}
+
+// JSON is a method for actions.JSON
+func (action *FeeInclusionAction) JSON() error {
+	if !action.App.config.IngestFailedTransactions {
+		// Failed transactions take ledger capacity too, without them the
+		// estimate would be wrong.
+		p := problem.P{
+			Type:   "endpoint_not_available",
+			Title:  "Endpoint Not Available",
+			Status: http.StatusNotImplemented,
+			Detail: "/fee_stats/inclusion is unavailable when Horizon is not ingesting failed " +
+				"transactions. Set `INGEST_FAILED_TRANSACTIONS=true` to start ingesting them.",
+		}
+		problem.Render(action.R.Context(), action.W, p)
+		return nil
+	}
+
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		func() {
+			action.FullLedgers, action.Probability = estimateFeeInclusion(action.Fee, action.Records)
+		},
+		func() {
+			var lastLedger int32
+			if len(action.Records) > 0 {
+				lastLedger = action.Records[0].Sequence
+			}
+			hal.Render(action.W, map[string]string{
+				"fee":                   fmt.Sprint(action.Fee),
+				"ledgers":               fmt.Sprint(len(action.Records)),
+				"last_ledger":           fmt.Sprint(lastLedger),
+				"full_ledgers":          fmt.Sprint(action.FullLedgers),
+				"inclusion_probability": fmt.Sprintf("%.2f", action.Probability),
+			})
+		},
+	)
+	return action.Err
+}
+
+func (action *FeeInclusionAction) loadParams() {
+	action.Fee = action.GetInt64("fee")
+	if action.Err == nil && action.Fee <= 0 {
+		action.SetInvalidField("fee", errors.New("fee must be positive"))
+		return
+	}
+	action.Ledgers = action.GetLimit("ledgers", 5, 100)
+}
+
+func (action *FeeInclusionAction) loadRecords() {
+	action.Err = action.HistoryQ().LedgerFeeStats(
+		ledger.CurrentState().HistoryLatest,
+		int32(action.Ledgers),
+		&action.Records,
+	)
+}
+
+// estimateFeeInclusion returns how many of the ledgers were full, and the
+// fraction of them which would have included a transaction bidding fee per
+// operation: it must pay the ledger base fee, and, when the ledger was full,
+// at least the lowest fee per operation the ledger accepted.
+func estimateFeeInclusion(fee int64, ledgers []history.LedgerFeeStats) (int, float64) {
+	if len(ledgers) == 0 {
+		return 0, 0
+	}
+
+	full, included := 0, 0
+	for _, l := range ledgers {
+		if l.OperationCount >= int64(l.MaxTxSetSize) {
+			full++
+			if l.MinAcceptedFee.Valid && fee < l.MinAcceptedFee.Int64 {
+				continue
+			}
+		}
+		if fee >= int64(l.BaseFee) {
+			included++
+		}
+	}
+	return full, float64(included) / float64(len(ledgers))
+}
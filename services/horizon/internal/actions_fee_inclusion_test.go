@@ -0,0 +1,73 @@
+package horizon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateFeeInclusion(t *testing.T) {
+	ledgers := []history.LedgerFeeStats{
+		// spare capacity, the base fee is enough
+		{Sequence: 4, BaseFee: 100, MaxTxSetSize: 50, OperationCount: 10, MinAcceptedFee: null.IntFrom(100)},
+		// full, the lowest accepted fee must be paid
+		{Sequence: 3, BaseFee: 100, MaxTxSetSize: 50, OperationCount: 50, MinAcceptedFee: null.IntFrom(300)},
+		{Sequence: 2, BaseFee: 100, MaxTxSetSize: 50, OperationCount: 60, MinAcceptedFee: null.IntFrom(200)},
+		// empty
+		{Sequence: 1, BaseFee: 100, MaxTxSetSize: 50},
+	}
+
+	for _, kase := range []struct {
+		fee         int64
+		probability float64
+	}{
+		{50, 0},
+		{100, 0.5},
+		{200, 0.75},
+		{300, 1},
+	} {
+		full, probability := estimateFeeInclusion(kase.fee, ledgers)
+		assert.Equal(t, 2, full)
+		assert.Equal(t, kase.probability, probability, "fee %d", kase.fee)
+	}
+
+	full, probability := estimateFeeInclusion(100, nil)
+	assert.Equal(t, 0, full)
+	assert.Equal(t, 0.0, probability)
+}
+
+func TestFeeInclusionActions_Show(t *testing.T) {
+	ht := StartHTTPTest(t, "operation_fee_stats_1")
+	defer ht.Finish()
+
+	// every ledger is full
+	_, err := ht.HorizonSession().ExecRaw("UPDATE history_ledgers SET max_tx_set_size = 1")
+	ht.Require.NoError(err)
+
+	w := ht.Get("/fee_stats/inclusion?fee=100&ledgers=3")
+	if ht.Assert.Equal(200, w.Code) {
+		var result map[string]string
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("100", result["fee"])
+		ht.Assert.Equal("3", result["ledgers"])
+		ht.Assert.Equal("3", result["full_ledgers"])
+		ht.Assert.Equal("1.00", result["inclusion_probability"])
+	}
+
+	w = ht.Get("/fee_stats/inclusion?fee=50")
+	if ht.Assert.Equal(200, w.Code) {
+		var result map[string]string
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.Equal("0.00", result["inclusion_probability"])
+	}
+
+	w = ht.Get("/fee_stats/inclusion")
+	ht.Assert.Equal(400, w.Code)
+	w = ht.Get("/fee_stats/inclusion?fee=100&ledgers=1000")
+	ht.Assert.Equal(400, w.Code)
+}
@@ -0,0 +1,152 @@
+package horizon
+
+import (
+	"fmt"
+	"strings"
+	goTime "time"
+
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/time"
+	"github.com/stellar/go/xdr"
+)
+
+// historyFilters are the filters shared by the operations, payments and
+// effects endpoints, on top of the type filter of each of them.
+type historyFilters struct {
+	Asset     *xdr.Asset
+	MinAmount xdr.Int64
+	Memo      string
+	StartTime time.Millis
+	EndTime   time.Millis
+}
+
+// loadHistoryFilters reads the `asset_type`, `asset_code`, `asset_issuer`,
+// `min_amount`, `memo`, `start_time` and `end_time` parameters.
+func loadHistoryFilters(base *actions.Base) (filters historyFilters) {
+	if base.GetString("asset_type") != "" {
+		asset := base.GetAsset("")
+		if base.Err != nil {
+			return
+		}
+		filters.Asset = &asset
+	}
+
+	if base.GetString("min_amount") != "" {
+		filters.MinAmount, base.Err = actions.GetPositiveAmount(base.R, "min_amount")
+	}
+
+	filters.Memo = base.GetString("memo")
+	filters.StartTime = base.GetTimeMillis("start_time")
+	filters.EndTime = base.GetTimeMillis("end_time")
+	if base.Err != nil {
+		return
+	}
+
+	if !filters.StartTime.IsNil() && !filters.EndTime.IsNil() &&
+		filters.EndTime.ToInt64() <= filters.StartTime.ToInt64() {
+		base.SetInvalidField("end_time", errors.New("end_time must be after start_time"))
+	}
+	return
+}
+
+// timeRange returns the bounds of the time range filter, the zero time for
+// a missing bound.
+func (f historyFilters) timeRange() (start, end goTime.Time) {
+	if !f.StartTime.IsNil() {
+		start = f.StartTime.ToTime()
+	}
+	if !f.EndTime.IsNil() {
+		end = f.EndTime.ToTime()
+	}
+	return
+}
+
+// applyToOperations filters the operations query.
+func (f historyFilters) applyToOperations(q *history.OperationsQ) {
+	if f.Asset != nil {
+		q.ForAsset(*f.Asset)
+	}
+	if f.MinAmount > 0 {
+		q.WithMinAmount(f.MinAmount)
+	}
+	if f.Memo != "" {
+		q.WithMemo(f.Memo)
+	}
+	q.ClosedBetween(f.timeRange())
+}
+
+// applyToEffects filters the effects query.
+func (f historyFilters) applyToEffects(q *history.EffectsQ) {
+	if f.Asset != nil {
+		q.ForAsset(*f.Asset)
+	}
+	if f.MinAmount > 0 {
+		q.WithMinAmount(f.MinAmount)
+	}
+	if f.Memo != "" {
+		q.WithMemo(f.Memo)
+	}
+	q.ClosedBetween(f.timeRange())
+}
+
+// getOperationTypes reads the comma separated operation type names of the
+// `type` parameter.
+func getOperationTypes(base *actions.Base) []xdr.OperationType {
+	var types []xdr.OperationType
+	for _, name := range splitTypeNames(base.GetString("type")) {
+		typ, ok := operationTypeByName(name)
+		if !ok {
+			base.SetInvalidField("type", fmt.Errorf("unknown operation type %q", name))
+			return nil
+		}
+		types = append(types, typ)
+	}
+	return types
+}
+
+// getEffectTypes reads the comma separated effect type names of the `type`
+// parameter.
+func getEffectTypes(base *actions.Base) []history.EffectType {
+	var types []history.EffectType
+	for _, name := range splitTypeNames(base.GetString("type")) {
+		typ, ok := effectTypeByName(name)
+		if !ok {
+			base.SetInvalidField("type", fmt.Errorf("unknown effect type %q", name))
+			return nil
+		}
+		types = append(types, typ)
+	}
+	return types
+}
+
+func splitTypeNames(s string) []string {
+	var names []string
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func operationTypeByName(name string) (xdr.OperationType, bool) {
+	for typ, typName := range operations.TypeNames {
+		if typName == name {
+			return typ, true
+		}
+	}
+	return 0, false
+}
+
+func effectTypeByName(name string) (history.EffectType, bool) {
+	for typ, typName := range resourceadapter.EffectTypeNames {
+		if typName == name {
+			return typ, true
+		}
+	}
+	return 0, false
+}
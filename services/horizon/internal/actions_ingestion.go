@@ -0,0 +1,283 @@
+package horizon
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/sirupsen/logrus"
+
+	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/reap"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+	"github.com/stellar/go/support/render/httpjson"
+	"github.com/stellar/go/support/render/problem"
+)
+
+// ingestStatus is the representation of the state of the ingestion system on
+// the admin server.
+type ingestStatus struct {
+	Enabled    bool   `json:"enabled"`
+	Paused     bool   `json:"paused"`
+	InProgress bool   `json:"in_progress"`
+	LastError  string `json:"last_error,omitempty"`
+}
+
+// expingestStatus is the representation of the state of the experimental
+// ingestion system on the admin server.
+type expingestStatus struct {
+	Enabled            bool                    `json:"enabled"`
+	Paused             bool                    `json:"paused"`
+	StateReady         bool                    `json:"state_ready"`
+	StateInvalid       bool                    `json:"state_invalid"`
+	LastIngestedLedger uint32                  `json:"last_ingested_ledger"`
+	StateVerification  stateVerificationStatus `json:"state_verification"`
+}
+
+type stateVerificationStatus struct {
+	Running   bool `json:"running"`
+	Disabled  bool `json:"disabled"`
+	Requested bool `json:"requested"`
+}
+
+// reaperStatus is the representation of the state of the reaper on the admin
+// server.
+type reaperStatus struct {
+	RetentionCount uint       `json:"retention_count"`
+	Paused         bool       `json:"paused"`
+	NextRun        *time.Time `json:"next_run,omitempty"`
+	LastRun        *time.Time `json:"last_run,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+type txsubStatus struct {
+	Pending int `json:"pending"`
+}
+
+type ledgerStatus struct {
+	CoreLatest       int32  `json:"core_latest"`
+	HistoryLatest    int32  `json:"history_latest"`
+	HistoryElder     int32  `json:"history_elder"`
+	ExpHistoryLatest uint32 `json:"exp_history_latest"`
+}
+
+// pausable is a subsystem whose work can be paused on the admin server.
+type pausable interface {
+	Pause()
+	Resume()
+}
+
+// ingestionStatusHandler renders the state of the ingestion subsystems: the
+// ingestion system, the experimental ingestion system, the reaper and the
+// transaction submission queue.
+func (a *App) ingestionStatusHandler(rw http.ResponseWriter, r *http.Request) {
+	state := ledger.CurrentState()
+
+	ingest := ingestStatus{Enabled: a.ingester != nil}
+	if a.ingester != nil {
+		status := a.ingester.Status()
+		ingest.Paused = status.Paused
+		ingest.InProgress = status.InProgress
+		ingest.LastError = errorString(status.LastError)
+	}
+
+	expingest := expingestStatus{Enabled: a.expingester != nil}
+	if a.expingester != nil {
+		status := a.expingester.Status()
+		expingest.Paused = status.Paused
+		expingest.StateReady = status.StateReady
+		expingest.LastIngestedLedger = state.ExpHistoryLatest
+		expingest.StateVerification = stateVerificationStatus{
+			Running:   status.StateVerificationRunning,
+			Disabled:  status.StateVerificationDisabled,
+			Requested: status.StateVerificationRequested,
+		}
+
+		stateInvalid, err := a.HistoryQ().GetExpStateInvalid()
+		if err != nil {
+			problem.Render(r.Context(), rw, err)
+			return
+		}
+		expingest.StateInvalid = stateInvalid
+	}
+
+	status := a.reaper.Status()
+	reaper := reaperStatus{
+		RetentionCount: status.RetentionCount,
+		Paused:         status.Paused,
+		NextRun:        timeOrNil(status.NextRun),
+		LastRun:        timeOrNil(status.LastRun),
+		LastError:      errorString(status.LastError),
+	}
+
+	httpjson.Render(rw, map[string]interface{}{
+		"ledger": ledgerStatus{
+			CoreLatest:       state.CoreLatest,
+			HistoryLatest:    state.HistoryLatest,
+			HistoryElder:     state.HistoryElder,
+			ExpHistoryLatest: state.ExpHistoryLatest,
+		},
+		"ingest":    ingest,
+		"expingest": expingest,
+		"reaper":    reaper,
+		"txsub":     txsubStatus{Pending: len(a.submitter.Pending.Pending(r.Context()))},
+	}, httpjson.JSON)
+}
+
+// pauseIngestionHandler pauses the subsystem in the URL.
+func (a *App) pauseIngestionHandler(rw http.ResponseWriter, r *http.Request) {
+	subsystem, err := a.pausableSubsystem(r)
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+
+	subsystem.Pause()
+	log.Ctx(r.Context()).WithField("subsystem", chi.URLParam(r, "subsystem")).Info("Paused by admin")
+	httpjson.Render(rw, map[string]interface{}{"paused": true}, httpjson.JSON)
+}
+
+// resumeIngestionHandler resumes the subsystem in the URL.
+func (a *App) resumeIngestionHandler(rw http.ResponseWriter, r *http.Request) {
+	subsystem, err := a.pausableSubsystem(r)
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+
+	subsystem.Resume()
+	log.Ctx(r.Context()).WithField("subsystem", chi.URLParam(r, "subsystem")).Info("Resumed by admin")
+	httpjson.Render(rw, map[string]interface{}{"paused": false}, httpjson.JSON)
+}
+
+func (a *App) pausableSubsystem(r *http.Request) (pausable, error) {
+	name := chi.URLParam(r, "subsystem")
+	switch name {
+	case "ingest":
+		if a.ingester != nil {
+			return a.ingester, nil
+		}
+	case "expingest":
+		if a.expingester != nil {
+			return a.expingester, nil
+		}
+	case "reaper":
+		return a.reaper, nil
+	default:
+		return nil, problem.NotFound
+	}
+
+	return nil, problem.MakeInvalidFieldProblem(
+		"subsystem",
+		errors.Errorf("%s is not enabled", name),
+	)
+}
+
+// verifyStateHandler makes the experimental ingestion system verify the state
+// at the next checkpoint ledger.
+func (a *App) verifyStateHandler(rw http.ResponseWriter, r *http.Request) {
+	if a.expingester == nil {
+		problem.Render(r.Context(), rw, problem.MakeInvalidFieldProblem(
+			"subsystem",
+			errors.New("expingest is not enabled"),
+		))
+		return
+	}
+
+	a.expingester.RequestStateVerification()
+	httpjson.Render(rw, map[string]interface{}{"requested": true}, httpjson.JSON)
+}
+
+// reapHandler removes the history of the ledgers from `start`, which must be
+// the elder ledger, to `end`, inclusive.
+func (a *App) reapHandler(rw http.ResponseWriter, r *http.Request) {
+	start, err := getLedgerParam(r, "start")
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+	end, err := getLedgerParam(r, "end")
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+	if end < start {
+		problem.Render(r.Context(), rw, problem.MakeInvalidFieldProblem(
+			"end",
+			errors.New("end must not be before start"),
+		))
+		return
+	}
+
+	err = a.reaper.DeleteRange(start, end)
+	if rangeErr, ok := errors.Cause(err).(*reap.InvalidRangeError); ok {
+		problem.Render(r.Context(), rw, problem.MakeInvalidFieldProblem(
+			rangeErr.Field,
+			errors.New(rangeErr.Reason),
+		))
+		return
+	}
+	if cause := errors.Cause(err); cause == reap.ErrPaused || cause == reap.ErrRunning {
+		problem.Render(r.Context(), rw, problem.P{
+			Type:   "reaper_busy",
+			Title:  "Reaper Busy",
+			Status: http.StatusConflict,
+			Detail: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+	a.UpdateLedgerState()
+
+	log.Ctx(r.Context()).WithField("start", start).WithField("end", end).Info("Reaped by admin")
+	httpjson.Render(rw, map[string]interface{}{"start": start, "end": end}, httpjson.JSON)
+}
+
+func getLedgerParam(r *http.Request, name string) (int32, error) {
+	seq, err := strconv.ParseInt(r.URL.Query().Get(name), 10, 32)
+	if err != nil || seq < 1 {
+		return 0, problem.MakeInvalidFieldProblem(
+			name,
+			errors.New("must be a ledger sequence greater than 0"),
+		)
+	}
+	return int32(seq), nil
+}
+
+// logLevelHandler renders the current log level.
+func (a *App) logLevelHandler(rw http.ResponseWriter, r *http.Request) {
+	level := log.DefaultLogger.Logger.GetLevel()
+	httpjson.Render(rw, map[string]interface{}{"level": level.String()}, httpjson.JSON)
+}
+
+// setLogLevelHandler changes the log level to the `level` parameter.
+func (a *App) setLogLevelHandler(rw http.ResponseWriter, r *http.Request) {
+	level, err := logrus.ParseLevel(r.URL.Query().Get("level"))
+	if err != nil {
+		problem.Render(r.Context(), rw, problem.MakeInvalidFieldProblem("level", err))
+		return
+	}
+
+	log.DefaultLogger.Logger.SetLevel(level)
+	log.Ctx(r.Context()).WithField("level", level.String()).Info("Log level changed by admin")
+	httpjson.Render(rw, map[string]interface{}{"level": level.String()}, httpjson.JSON)
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,82 @@
+package horizon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stellar/go/services/horizon/internal/reap"
+	"github.com/stellar/go/support/log"
+)
+
+func TestPauseIngestionHandler(t *testing.T) {
+	app := &App{reaper: reap.New(0, nil)}
+	r := chi.NewRouter()
+	r.Post("/ingestion/{subsystem}/pause", app.pauseIngestionHandler)
+	r.Post("/ingestion/{subsystem}/resume", app.resumeIngestionHandler)
+
+	serve := func(path string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", path, nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("/ingestion/reaper/pause"))
+	assert.True(t, app.reaper.Status().Paused)
+	assert.Equal(t, http.StatusOK, serve("/ingestion/reaper/resume"))
+	assert.False(t, app.reaper.Status().Paused)
+
+	// the ingestion systems are disabled
+	assert.Equal(t, http.StatusBadRequest, serve("/ingestion/ingest/pause"))
+	assert.Equal(t, http.StatusBadRequest, serve("/ingestion/expingest/pause"))
+	assert.Equal(t, http.StatusNotFound, serve("/ingestion/unknown/pause"))
+}
+
+func TestReapHandlerValidation(t *testing.T) {
+	app := &App{reaper: reap.New(0, nil)}
+	for _, query := range []string{
+		"",
+		"start=1",
+		"start=0&end=10",
+		"start=a&end=10",
+		"start=10&end=9",
+		// not starting at the elder ledger
+		"start=2&end=3",
+	} {
+		w := httptest.NewRecorder()
+		app.reapHandler(w, httptest.NewRequest("POST", "/reap?"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+
+	app.reaper.Pause()
+	w := httptest.NewRecorder()
+	app.reapHandler(w, httptest.NewRequest("POST", "/reap?start=1&end=2", nil))
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestLogLevelHandlers(t *testing.T) {
+	app := &App{}
+	defer log.DefaultLogger.Logger.SetLevel(log.DefaultLogger.Logger.GetLevel())
+	log.DefaultLogger.Logger.SetLevel(logrus.InfoLevel)
+
+	w := httptest.NewRecorder()
+	app.setLogLevelHandler(w, httptest.NewRequest("PUT", "/log_level?level=loud", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, logrus.InfoLevel, log.DefaultLogger.Logger.GetLevel())
+
+	w = httptest.NewRecorder()
+	app.setLogLevelHandler(w, httptest.NewRequest("PUT", "/log_level?level=debug", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, logrus.DebugLevel, log.DefaultLogger.Logger.GetLevel())
+
+	w = httptest.NewRecorder()
+	app.logLevelHandler(w, httptest.NewRequest("GET", "/log_level", nil))
+	var response map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "debug", response["level"])
+}
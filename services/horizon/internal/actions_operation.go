@@ -47,6 +47,8 @@ type OperationIndexAction struct {
 	IncludeFailed       bool
 	IncludeTransactions bool
 	OnlyPayments        bool
+	TypeFilter          []xdr.OperationType
+	Filters             historyFilters
 }
 
 // JSON is a method for actions.JSON
@@ -140,6 +142,11 @@ func (action *OperationIndexAction) loadParams() {
 		return
 	}
 	action.IncludeTransactions = parsed[joinTransactions]
+	action.TypeFilter = getOperationTypes(&action.Action.Base)
+	action.Filters = loadHistoryFilters(&action.Action.Base)
+	if action.Err != nil {
+		return
+	}
 
 	filters, err := countNonEmpty(
 		action.AccountFilter,
@@ -232,6 +239,11 @@ func (action *OperationIndexAction) loadRecords() {
 		ops.OnlyPayments()
 	}
 
+	if len(action.TypeFilter) > 0 {
+		ops.OfTypes(action.TypeFilter...)
+	}
+	action.Filters.applyToOperations(ops)
+
 	action.OperationRecords, action.TransactionRecords, action.Err = ops.Page(action.PagingParams).Fetch()
 	if action.Err != nil {
 		return
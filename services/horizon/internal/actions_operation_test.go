@@ -382,3 +382,69 @@ func TestOperationActions_Show_Extra_TxID(t *testing.T) {
 		ht.Assert.Equal(0, failed)
 	}
 }
+
+func TestOperationActions_Filters(t *testing.T) {
+	ht := StartHTTPTest(t, "base")
+	defer ht.Finish()
+
+	w := ht.Get("/operations?type=payment")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/operations?type=payment,create_account")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+	}
+
+	w = ht.Get("/operations?type=unknown")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/operations?asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/accounts/GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H/operations?min_amount=100")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/operations?min_amount=-1")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/operations?memo=hello")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(0, w.Body)
+	}
+
+	// ledger 3 closed at 2019-10-31T13:19:46Z
+	w = ht.Get("/operations?start_time=1572527986000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	w = ht.Get("/operations?end_time=1572527986000")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	w = ht.Get("/operations?start_time=1572527986000&end_time=1572527985000")
+	ht.Assert.Equal(400, w.Code)
+
+	// the filters apply to payments and streams as well
+	w = ht.Get("/payments?type=create_account&min_amount=100")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(3, w.Body)
+	}
+
+	ctx := context.Background()
+	stream := sse.NewStream(ctx, httptest.NewRecorder())
+	oa := OperationIndexAction{
+		Action: *NewTestAction(ctx, "/operations?type=payment"),
+	}
+	oa.SSE(stream)
+	if ht.Assert.NoError(oa.Err) && ht.Assert.Len(oa.OperationRecords, 1) {
+		ht.Assert.Equal(int64(12884905985), oa.OperationRecords[0].ID)
+	}
+}
@@ -0,0 +1,46 @@
+package horizon
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/stellar/go/support/render/httpjson"
+	"github.com/stellar/go/support/render/problem"
+)
+
+// rateLimitBucket is the representation of a rate limit bucket on the admin
+// server.
+type rateLimitBucket struct {
+	Key        string `json:"key"`
+	ResetAfter int64  `json:"reset_after"`
+}
+
+// rateLimitBucketsHandler renders the rate limit buckets currently counting
+// requests, the most used first.
+func (w *web) rateLimitBucketsHandler(rw http.ResponseWriter, r *http.Request) {
+	if w.rateLimiter == nil {
+		httpjson.Render(rw, map[string]interface{}{"enabled": false, "buckets": []rateLimitBucket{}}, httpjson.JSON)
+		return
+	}
+
+	buckets, err := w.rateLimiter.Buckets()
+	if err != nil {
+		problem.Render(r.Context(), rw, err)
+		return
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].ResetAfter != buckets[j].ResetAfter {
+			return buckets[i].ResetAfter > buckets[j].ResetAfter
+		}
+		return buckets[i].Key < buckets[j].Key
+	})
+
+	resources := make([]rateLimitBucket, len(buckets))
+	for i, bucket := range buckets {
+		resources[i] = rateLimitBucket{
+			Key:        bucket.Key,
+			ResetAfter: int64(bucket.ResetAfter.Seconds() + 0.5),
+		}
+	}
+	httpjson.Render(rw, map[string]interface{}{"enabled": true, "buckets": resources}, httpjson.JSON)
+}
@@ -23,13 +23,13 @@ import (
 	"github.com/stellar/go/services/horizon/internal/logmetrics"
 	"github.com/stellar/go/services/horizon/internal/operationfeestats"
 	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	"github.com/stellar/go/services/horizon/internal/reap"
 	"github.com/stellar/go/services/horizon/internal/txsub"
 	"github.com/stellar/go/support/app"
 	"github.com/stellar/go/support/db"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/log"
-	"github.com/stellar/throttled"
 	graceful "gopkg.in/tylerb/graceful.v1"
 )
 
@@ -99,6 +99,10 @@ func (a *App) Serve() {
 
 	go a.run()
 
+	if a.config.AdminPort != 0 {
+		go a.serveAdmin()
+	}
+
 	if a.expingester != nil {
 		go a.expingester.Run()
 	}
@@ -119,6 +123,18 @@ func (a *App) Serve() {
 	log.Info("stopped")
 }
 
+// serveAdmin serves the admin actions on the admin port, which must not be
+// exposed publicly.
+func (a *App) serveAdmin() {
+	addr := fmt.Sprintf(":%d", a.config.AdminPort)
+	log.Infof("Starting horizon admin server on %s", addr)
+
+	err := http.ListenAndServe(addr, a.web.adminRouter)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 // Close cancels the app. It does not close DB connections - use App.CloseDB().
 func (a *App) Close() {
 	a.cancel()
@@ -418,8 +434,11 @@ func (a *App) init() {
 	// web.init
 	a.web = mustInitWeb(a.ctx, a.historyQ, a.coreQ, a.config.SSEUpdateFrequency, a.config.StaleThreshold, a.config.IngestFailedTransactions)
 
+	// redis
+	initRedis(a)
+
 	// web.rate-limiter
-	a.web.rateLimiter = maybeInitWebRateLimiter(a.config.RateQuota)
+	a.web.rateLimiter = maybeInitWebRateLimiter(a.config, a.redis)
 
 	// web.middleware
 	// Note that we passed in `a` here for putting the whole App in the context.
@@ -439,6 +458,9 @@ func (a *App) init() {
 	// web.actions
 	a.web.mustInstallActions(a.config, a.paths, orderBookGraph, requiresExperimentalIngestion)
 
+	// web.admin-actions
+	a.web.mustInstallAdminActions(a)
+
 	// metrics and log.metrics
 	a.metrics = metrics.NewRegistry()
 	for level, meter := range *logmetrics.DefaultMetrics {
@@ -456,9 +478,6 @@ func (a *App) init() {
 
 	// ingester.metrics
 	initIngesterMetrics(a)
-
-	// redis
-	initRedis(a)
 }
 
 // run is the function that runs in the background that triggers Tick each
@@ -481,7 +500,7 @@ func withAppContext(ctx context.Context, a *App) context.Context {
 }
 
 // GetRateLimiter returns the HTTPRateLimiter of the App.
-func (a *App) GetRateLimiter() *throttled.HTTPRateLimiter {
+func (a *App) GetRateLimiter() *ratelimit.HTTPRateLimiter {
 	return a.web.rateLimiter
 }
 
@@ -5,7 +5,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
-	"github.com/stellar/throttled"
+	"github.com/stellar/go/services/horizon/internal/expingest"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 )
 
 // Config is the configuration for horizon.  It gets populated by the
@@ -16,6 +17,10 @@ type Config struct {
 	StellarCoreURL         string
 	HistoryArchiveURLs     []string
 	Port                   uint
+	// AdminPort is the port of the admin server, which is disabled when 0.
+	AdminPort uint
+	// AdminAuthToken is the bearer token required by the admin server.
+	AdminAuthToken string
 
 	// MaxDBConnections has a priority over all 4 values below.
 	MaxDBConnections            int
@@ -26,12 +31,22 @@ type Config struct {
 
 	SSEUpdateFrequency time.Duration
 	ConnectionTimeout  time.Duration
-	RateQuota          *throttled.RateQuota
+	RateQuota          *ratelimit.Quota
 	RateLimitRedisKey  string
 	RedisURL           string
 	FriendbotURL       *url.URL
 	LogLevel           logrus.Level
 	LogFile            string
+	// RateLimitVaryBy are the scopes the rate limit buckets are keyed by.
+	RateLimitVaryBy []ratelimit.Scope
+	// RateLimitAPIKeyHeader is the header clients send their API key in.
+	RateLimitAPIKeyHeader string
+	// RateLimitAPIKeysFile is the path to a TOML file listing the API keys
+	// and their quotas.
+	RateLimitAPIKeysFile string
+	// RateLimitStreamCost is the number of requests each update of a stream
+	// counts for.
+	RateLimitStreamCost int
 	// MaxPathLength is the maximum length of the path returned by `/paths` endpoint.
 	MaxPathLength     uint
 	NetworkPassphrase string
@@ -79,6 +94,10 @@ type Config struct {
 	// IngestDisableStateVerification disables state verification
 	// `System.verifyState()` when set to `true`.
 	IngestDisableStateVerification bool
+	// OrderBookHistoryPairs are the asset pairs whose order book the
+	// experimental ingestion system samples after every ledger, served by
+	// `/order_book/history`.
+	OrderBookHistoryPairs []expingest.AssetPair
 	// ApplyMigrations will apply pending migrations to the horizon database
 	// before starting the horizon service
 	ApplyMigrations bool
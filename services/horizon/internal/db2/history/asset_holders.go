@@ -0,0 +1,128 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// HolderPagingToken returns the cursor of the trust line in the holders of
+// its asset, which are sorted by balance.
+func (trustLine TrustLine) HolderPagingToken() string {
+	return fmt.Sprintf("%d_%s", trustLine.Balance, trustLine.AccountID)
+}
+
+// ParseHolderCursor parses a cursor returned by TrustLine.HolderPagingToken.
+func ParseHolderCursor(cursor string) (int64, string, error) {
+	parts := strings.SplitN(cursor, "_", 2)
+	if len(parts) != 2 {
+		return 0, "", errors.Errorf("invalid asset holders cursor: %v", cursor)
+	}
+
+	balance, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || balance < 0 {
+		return 0, "", errors.Errorf("invalid balance in asset holders cursor: %v", cursor)
+	}
+
+	if _, err := xdr.AddressToAccountId(parts[1]); err != nil {
+		return 0, "", errors.Errorf("invalid account in asset holders cursor: %v", cursor)
+	}
+
+	return balance, parts[1], nil
+}
+
+// GetAssetHolders returns a page of the trust lines of asset, sorted by
+// balance.
+func (q *Q) GetAssetHolders(asset xdr.Asset, page db2.PageQuery) ([]TrustLine, error) {
+	sql, err := trustLinesOfAsset(selectTrustLines, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursorComparison, orderBy string
+	switch page.Order {
+	case "asc":
+		cursorComparison, orderBy = ">", "asc"
+	case "desc":
+		cursorComparison, orderBy = "<", "desc"
+	default:
+		return nil, errors.Errorf("invalid page order %s", page.Order)
+	}
+
+	if page.Cursor != "" {
+		balance, accountID, err := ParseHolderCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		sql = sql.Where("((balance, account_id) "+cursorComparison+" (?,?))", balance, accountID)
+	}
+
+	sql = sql.OrderBy("balance "+orderBy, "account_id "+orderBy).Limit(page.Limit)
+
+	var results []TrustLine
+	if err := q.Select(&results, sql); err != nil {
+		return nil, errors.Wrap(err, "could not run select query")
+	}
+
+	return results, nil
+}
+
+// GetAssetDistribution summarizes the trust lines of asset.
+func (q *Q) GetAssetDistribution(asset xdr.Asset) (AssetDistribution, error) {
+	var distribution AssetDistribution
+
+	authorized := fmt.Sprintf("flags & %d <> 0", xdr.TrustLineFlagsAuthorizedFlag)
+	sql, err := trustLinesOfAsset(sq.Select(
+		"count(*) FILTER (WHERE "+authorized+") AS num_authorized",
+		"count(*) FILTER (WHERE NOT ("+authorized+")) AS num_unauthorized",
+		"COALESCE(sum(balance), 0)::text AS amount",
+		"COALESCE(sum(balance) FILTER (WHERE "+authorized+"), 0)::text AS authorized_amount",
+		"COALESCE(sum(balance) FILTER (WHERE NOT ("+authorized+")), 0)::text AS unauthorized_amount",
+	).From("trust_lines"), asset)
+	if err != nil {
+		return distribution, err
+	}
+	if err = q.Get(&distribution, sql); err != nil {
+		return distribution, errors.Wrap(err, "could not count trust lines")
+	}
+
+	// balances are bucketed by their number of digits in stroops, empty
+	// balances in decade -1.
+	sql, err = trustLinesOfAsset(sq.Select(
+		"CASE WHEN balance = 0 THEN -1 ELSE floor(log(balance::numeric))::int END AS decade",
+		"count(*) AS count",
+		"sum(balance)::text AS amount",
+	).From("trust_lines"), asset)
+	if err != nil {
+		return distribution, err
+	}
+	sql = sql.GroupBy("decade").OrderBy("decade")
+	if err = q.Select(&distribution.Histogram, sql); err != nil {
+		return distribution, errors.Wrap(err, "could not bucket trust lines")
+	}
+
+	return distribution, nil
+}
+
+func trustLinesOfAsset(sql sq.SelectBuilder, asset xdr.Asset) (sq.SelectBuilder, error) {
+	var assetType xdr.AssetType
+	var code, issuer string
+	if err := asset.Extract(&assetType, &code, &issuer); err != nil {
+		return sql, errors.Wrap(err, "could not extract asset")
+	}
+	if assetType == xdr.AssetTypeAssetTypeNative {
+		return sql, errors.New("native asset has no trust lines")
+	}
+
+	return sql.Where(sq.Eq{
+		"asset_type":   assetType,
+		"asset_code":   code,
+		"asset_issuer": issuer,
+	}), nil
+}
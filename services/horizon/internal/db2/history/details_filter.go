@@ -0,0 +1,94 @@
+package history
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/xdr"
+)
+
+// detailsAssetPrefixes are the prefixes of the assets found in the details of
+// operations and effects.
+var detailsAssetPrefixes = []string{"", "source_", "buying_", "selling_", "bought_", "sold_"}
+
+// FilterAsset returns the representation of an asset in the `assets` column
+// of the `history_operations` and `history_effects` tables: "native" or
+// "CODE:ISSUER".
+func FilterAsset(asset xdr.Asset) (string, error) {
+	var typ, code, issuer string
+	if err := asset.Extract(&typ, &code, &issuer); err != nil {
+		return "", err
+	}
+	if asset.Type == xdr.AssetTypeAssetTypeNative {
+		return "native", nil
+	}
+	return code + ":" + issuer, nil
+}
+
+// DetailsAssets returns the assets referenced by the details of an operation
+// or an effect, as stored in their `assets` column.
+func DetailsAssets(details map[string]interface{}) []string {
+	assets := []string{}
+	for _, prefix := range detailsAssetPrefixes {
+		typ, ok := details[prefix+"asset_type"]
+		if !ok {
+			continue
+		}
+		if typ == "native" {
+			assets = append(assets, "native")
+			continue
+		}
+		assets = append(assets, fmt.Sprintf("%v:%v", details[prefix+"asset_code"], details[prefix+"asset_issuer"]))
+	}
+	return assets
+}
+
+// detailsAmountFields are the fields holding the amounts found in the details
+// of operations and effects.
+var detailsAmountFields = []string{"amount", "starting_balance", "source_amount", "bought_amount", "sold_amount"}
+
+// DetailsAmount returns the amount of an operation or an effect in stroops,
+// as stored in their `amount` column. Path payments and trades move two
+// amounts, the largest one is returned so that filtering by a minimum amount
+// matches the rows moving at least that amount of one of their assets.
+func DetailsAmount(details map[string]interface{}) (xdr.Int64, bool) {
+	var max xdr.Int64
+	found := false
+	for _, field := range detailsAmountFields {
+		value, ok := details[field].(string)
+		if !ok {
+			continue
+		}
+		parsed, err := amount.Parse(value)
+		if err != nil {
+			return 0, false
+		}
+		if !found || parsed > max {
+			max = parsed
+		}
+		found = true
+	}
+	return max, found
+}
+
+// closedAtFilter restricts sql to the rows, identified by the toid in col,
+// whose ledger closed in [start, end). A zero start or end leaves the range
+// open on that side.
+func closedAtFilter(sql sq.SelectBuilder, col string, start, end time.Time) sq.SelectBuilder {
+	// the first ledger closed at or after t, or one past the last ledger if
+	// there is none.
+	const firstLedgerID = `COALESCE((
+		SELECT id FROM history_ledgers WHERE closed_at >= ? ORDER BY closed_at ASC LIMIT 1
+	), ?)`
+
+	if !start.IsZero() {
+		sql = sql.Where(col+" >= "+firstLedgerID, start.UTC(), int64(math.MaxInt64))
+	}
+	if !end.IsZero() {
+		sql = sql.Where(col+" < "+firstLedgerID, end.UTC(), int64(math.MaxInt64))
+	}
+	return sql
+}
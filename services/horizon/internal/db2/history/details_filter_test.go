@@ -0,0 +1,78 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetailsAssets(t *testing.T) {
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+	assert.Equal(t, []string{}, DetailsAssets(map[string]interface{}{
+		"starting_balance": "100.0000000",
+	}))
+	assert.Equal(t, []string{"native"}, DetailsAssets(map[string]interface{}{
+		"asset_type": "native",
+		"amount":     "5.0000000",
+	}))
+	assert.Equal(t, []string{"USD:" + issuer, "native"}, DetailsAssets(map[string]interface{}{
+		"buying_asset_type":   "credit_alphanum4",
+		"buying_asset_code":   "USD",
+		"buying_asset_issuer": issuer,
+		"selling_asset_type":  "native",
+	}))
+	assert.Equal(t, []string{"native", "EUR:" + issuer}, DetailsAssets(map[string]interface{}{
+		"asset_type":          "native",
+		"source_asset_type":   "credit_alphanum4",
+		"source_asset_code":   "EUR",
+		"source_asset_issuer": issuer,
+	}))
+
+	asset, err := FilterAsset(xdr.MustNewCreditAsset("USD", issuer))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "USD:"+issuer, asset)
+	}
+	asset, err = FilterAsset(xdr.MustNewNativeAsset())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "native", asset)
+	}
+}
+
+func TestDetailsAmount(t *testing.T) {
+	amount, ok := DetailsAmount(map[string]interface{}{"amount": "5.0000000"})
+	assert.True(t, ok)
+	assert.Equal(t, xdr.Int64(50000000), amount)
+
+	amount, ok = DetailsAmount(map[string]interface{}{"starting_balance": "100.0000000"})
+	assert.True(t, ok)
+	assert.Equal(t, xdr.Int64(1000000000), amount)
+
+	// trade
+	amount, ok = DetailsAmount(map[string]interface{}{
+		"bought_amount":     "1.0000000",
+		"bought_asset_type": "native",
+		"sold_amount":       "20.0000000",
+		"sold_asset_type":   "credit_alphanum4",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, xdr.Int64(200000000), amount)
+
+	// path payment
+	amount, ok = DetailsAmount(map[string]interface{}{
+		"amount":            "3.0000000",
+		"asset_type":        "native",
+		"source_amount":     "7.5000000",
+		"source_asset_type": "credit_alphanum4",
+		"source_max":        "10.0000000",
+	})
+	assert.True(t, ok)
+	assert.Equal(t, xdr.Int64(75000000), amount)
+
+	_, ok = DetailsAmount(map[string]interface{}{"limit": "1.0000000"})
+	assert.False(t, ok)
+
+	_, ok = DetailsAmount(map[string]interface{}{"amount": "invalid"})
+	assert.False(t, ok)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/stellar/go/services/horizon/internal/db2"
@@ -137,6 +138,50 @@ func (q *EffectsQ) OfType(typ EffectType) *EffectsQ {
 	return q
 }
 
+// OfTypes filters the query to only effects of the given types.
+func (q *EffectsQ) OfTypes(types ...EffectType) *EffectsQ {
+	q.sql = q.sql.Where(sq.Eq{"heff.type": types})
+	return q
+}
+
+// ForAsset filters the query to only effects whose details reference the
+// given asset.
+func (q *EffectsQ) ForAsset(asset xdr.Asset) *EffectsQ {
+	filterAsset, err := FilterAsset(asset)
+	if err != nil {
+		q.Err = err
+		return q
+	}
+
+	q.sql = q.sql.Where("heff.assets @> ARRAY[?]::character varying[]", filterAsset)
+	return q
+}
+
+// WithMinAmount filters the query to only effects of at least the given
+// amount, in stroops.
+func (q *EffectsQ) WithMinAmount(min xdr.Int64) *EffectsQ {
+	q.sql = q.sql.Where("heff.amount >= ?", int64(min))
+	return q
+}
+
+// WithMemo filters the query to only effects of transactions with the given
+// memo.
+func (q *EffectsQ) WithMemo(memo string) *EffectsQ {
+	q.sql = q.sql.Where(`EXISTS (
+		SELECT 1 FROM history_operations hop
+		JOIN history_transactions ht ON ht.id = hop.transaction_id
+		WHERE hop.id = heff.history_operation_id AND ht.memo = ?
+	)`, memo)
+	return q
+}
+
+// ClosedBetween filters the query to only effects in ledgers closed in
+// [start, end), a zero time leaves the range open on its side.
+func (q *EffectsQ) ClosedBetween(start, end time.Time) *EffectsQ {
+	q.sql = closedAtFilter(q.sql, "heff.history_operation_id", start, end)
+	return q
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *EffectsQ) Page(page db2.PageQuery) *EffectsQ {
 	if q.Err != nil {
@@ -217,6 +262,12 @@ func (q *EffectsQ) orderBookFilter(a xdr.Asset, prefix string) {
 }
 
 var selectEffect = sq.
-	Select("heff.*, hacc.address").
+	Select(
+		"heff.history_account_id, " +
+			"heff.history_operation_id, " +
+			"heff.\"order\", " +
+			"heff.type, " +
+			"heff.details, " +
+			"hacc.address").
 	From("history_effects heff").
 	LeftJoin("history_accounts hacc ON hacc.id = heff.history_account_id")
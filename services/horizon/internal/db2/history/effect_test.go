@@ -0,0 +1,46 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestEffectQueryFilters(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	var effects []Effect
+	err := q.Effects().OfTypes(EffectAccountCreated, EffectSignerCreated).Select(&effects)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(effects, 6)
+	}
+
+	effects = nil
+	err = q.Effects().ForAsset(xdr.MustNewNativeAsset()).Select(&effects)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(effects, 5)
+	}
+
+	effects = nil
+	err = q.Effects().WithMinAmount(100 * 10000000).Select(&effects)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(effects, 6)
+	}
+
+	effects = nil
+	err = q.Effects().WithMemo("hello").Select(&effects)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(effects, 0)
+	}
+
+	effects = nil
+	ledger3ClosedAt := time.Date(2019, 10, 31, 13, 19, 46, 0, time.UTC)
+	err = q.Effects().ClosedBetween(ledger3ClosedAt, time.Time{}).Select(&effects)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(effects, 2)
+	}
+}
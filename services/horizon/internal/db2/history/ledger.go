@@ -60,6 +60,23 @@ func (q *Q) LedgerCapacityUsageStats(currentSeq int32, dest *LedgerCapacityUsage
 	`, currentSeq-ledgers, currentSeq)
 }
 
+// LedgerFeeStats loads, for each of the `ledgers` ledgers up to currentSeq, its
+// capacity, the number of operations it included and the lowest fee per
+// operation it accepted, most recent ledger first.
+func (q *Q) LedgerFeeStats(currentSeq, ledgers int32, dest *[]LedgerFeeStats) error {
+	return q.SelectRaw(dest, `
+		SELECT
+		  hl.sequence, hl.base_fee, hl.max_tx_set_size,
+		  COALESCE(SUM(ht.operation_count), 0) as operation_count,
+		  ceil(min(ht.max_fee/ht.operation_count))::bigint as min_accepted_fee
+		  FROM history_ledgers hl
+		  LEFT JOIN history_transactions ht ON ht.ledger_sequence = hl.sequence
+		  WHERE hl.sequence > $1 AND hl.sequence <= $2
+		  GROUP BY hl.sequence, hl.base_fee, hl.max_tx_set_size
+		  ORDER BY hl.sequence DESC
+	`, currentSeq-ledgers, currentSeq)
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *LedgersQ) Page(page db2.PageQuery) *LedgersQ {
 	if q.Err != nil {
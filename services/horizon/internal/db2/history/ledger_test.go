@@ -43,4 +43,13 @@ func TestLedgerQueries(t *testing.T) {
 		tt.Assert.Contains(foundSeqs, int32(2))
 		tt.Assert.Contains(foundSeqs, int32(3))
 	}
+
+	// LedgerFeeStats
+	var stats []LedgerFeeStats
+	err = q.LedgerFeeStats(3, 2, &stats)
+
+	if tt.Assert.NoError(err) && tt.Assert.Len(stats, 2) {
+		tt.Assert.Equal(int32(3), stats[0].Sequence)
+		tt.Assert.Equal(int32(2), stats[1].Sequence)
+	}
 }
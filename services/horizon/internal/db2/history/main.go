@@ -348,6 +348,23 @@ type LedgerCapacityUsageStats struct {
 	CapacityUsage null.String `db:"ledger_capacity_usage"`
 }
 
+// LedgerFeeStats contains the capacity usage and the lowest fee per operation
+// accepted by a ledger.
+type LedgerFeeStats struct {
+	Sequence       int32    `db:"sequence"`
+	BaseFee        int32    `db:"base_fee"`
+	MaxTxSetSize   int32    `db:"max_tx_set_size"`
+	OperationCount int64    `db:"operation_count"`
+	MinAcceptedFee null.Int `db:"min_accepted_fee"`
+}
+
+// TradesTimeRange is the range of close times of a set of trades, nil when the set is
+// empty.
+type TradesTimeRange struct {
+	From *time.Time `db:"from"`
+	To   *time.Time `db:"to"`
+}
+
 // LedgerCache is a helper struct to load ledger data related to a batch of
 // sequences.
 type LedgerCache struct {
@@ -542,6 +559,43 @@ type TrustLine struct {
 	LastModifiedLedger uint32        `db:"last_modified_ledger"`
 }
 
+// AssetDistribution is a summary of the trust lines of an asset, from the
+// `trust_lines` table.
+type AssetDistribution struct {
+	NumAuthorized      int32  `db:"num_authorized"`
+	NumUnauthorized    int32  `db:"num_unauthorized"`
+	Amount             string `db:"amount"`
+	AuthorizedAmount   string `db:"authorized_amount"`
+	UnauthorizedAmount string `db:"unauthorized_amount"`
+	Histogram          []AssetBalanceBucket
+}
+
+// AssetBalanceBucket counts the trust lines of an asset whose balance, in
+// stroops, is in [10^Decade, 10^(Decade+1)), or is zero for decade -1.
+type AssetBalanceBucket struct {
+	Decade int32  `db:"decade"`
+	Count  int32  `db:"count"`
+	Amount string `db:"amount"`
+}
+
+// OrderBookSample is the depth of the order book of an asset pair after a
+// ledger, from the `history_order_book_samples` table. Prices are in units of
+// the counter asset per unit of the base asset and are null when a side of
+// the book is empty. Ask depths are amounts of the base asset and bid depths
+// amounts of the counter asset, in stroops.
+type OrderBookSample struct {
+	LedgerSequence uint32      `db:"ledger_sequence"`
+	ClosedAt       time.Time   `db:"closed_at"`
+	BestBid        null.String `db:"best_bid"`
+	BestAsk        null.String `db:"best_ask"`
+	MidPrice       null.String `db:"mid_price"`
+	Spread         null.String `db:"spread"`
+	BidDepth1      string      `db:"bid_depth_1"`
+	AskDepth1      string      `db:"ask_depth_1"`
+	BidDepth5      string      `db:"bid_depth_5"`
+	AskDepth5      string      `db:"ask_depth_5"`
+}
+
 // QTrustLines defines trust lines related queries.
 type QTrustLines interface {
 	NewTrustLinesBatchInsertBuilder(maxBatchSize int) TrustLinesBatchInsertBuilder
@@ -2,6 +2,7 @@ package history
 
 import (
 	"encoding/json"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-errors/errors"
@@ -173,6 +174,46 @@ func (q *OperationsQ) OnlyPayments() *OperationsQ {
 	return q
 }
 
+// OfTypes filters the query to only operations of the given types.
+func (q *OperationsQ) OfTypes(types ...xdr.OperationType) *OperationsQ {
+	q.sql = q.sql.Where(sq.Eq{"hop.type": types})
+	return q
+}
+
+// ForAsset filters the query to only operations whose details reference the
+// given asset.
+func (q *OperationsQ) ForAsset(asset xdr.Asset) *OperationsQ {
+	filterAsset, err := FilterAsset(asset)
+	if err != nil {
+		q.Err = err
+		return q
+	}
+
+	q.sql = q.sql.Where("hop.assets @> ARRAY[?]::character varying[]", filterAsset)
+	return q
+}
+
+// WithMinAmount filters the query to only operations moving at least the
+// given amount, in stroops.
+func (q *OperationsQ) WithMinAmount(min xdr.Int64) *OperationsQ {
+	q.sql = q.sql.Where("hop.amount >= ?", int64(min))
+	return q
+}
+
+// WithMemo filters the query to only operations of transactions with the
+// given memo.
+func (q *OperationsQ) WithMemo(memo string) *OperationsQ {
+	q.sql = q.sql.Where("ht.memo = ?", memo)
+	return q
+}
+
+// ClosedBetween filters the query to only operations in ledgers closed in
+// [start, end), a zero time leaves the range open on its side.
+func (q *OperationsQ) ClosedBetween(start, end time.Time) *OperationsQ {
+	q.sql = closedAtFilter(q.sql, "hop.id", start, end)
+	return q
+}
+
 // IncludeFailed changes the query to include failed transactions.
 func (q *OperationsQ) IncludeFailed() *OperationsQ {
 	q.includeFailed = true
@@ -2,9 +2,11 @@ package history
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
 )
 
 func TestOperationQueries(t *testing.T) {
@@ -293,3 +295,61 @@ func TestOperationIncludeTransactions(t *testing.T) {
 	tt.Assert.Equal(*transaction, expectedTransactions[0])
 	assertOperationMatchesTransaction(tt, op, *transaction)
 }
+
+func TestOperationQueryFilters(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+	q := &Q{tt.HorizonSession()}
+
+	ops, _, err := q.Operations().OfTypes(xdr.OperationTypePayment).Fetch()
+	if tt.Assert.NoError(err) && tt.Assert.Len(ops, 1) {
+		tt.Assert.Equal(int64(12884905985), ops[0].ID)
+	}
+
+	ops, _, err = q.Operations().
+		OfTypes(xdr.OperationTypePayment, xdr.OperationTypeCreateAccount).
+		Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 4)
+	}
+
+	// create_account operations do not reference an asset
+	ops, _, err = q.Operations().ForAsset(xdr.MustNewNativeAsset()).Fetch()
+	if tt.Assert.NoError(err) && tt.Assert.Len(ops, 1) {
+		tt.Assert.Equal(int64(12884905985), ops[0].ID)
+	}
+
+	usd := xdr.MustNewCreditAsset("USD", "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H")
+	ops, _, err = q.Operations().ForAsset(usd).Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 0)
+	}
+
+	// starting balances count as amounts
+	ops, _, err = q.Operations().WithMinAmount(100 * 10000000).Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 3)
+	}
+
+	ops, _, err = q.Operations().WithMemo("hello").Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 0)
+	}
+
+	// ledger 2 closed at 13:19:45 and ledger 3 at 13:19:46
+	ledger3ClosedAt := time.Date(2019, 10, 31, 13, 19, 46, 0, time.UTC)
+	ops, _, err = q.Operations().ClosedBetween(ledger3ClosedAt, time.Time{}).Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 1)
+	}
+
+	ops, _, err = q.Operations().ClosedBetween(time.Time{}, ledger3ClosedAt).Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 3)
+	}
+
+	ops, _, err = q.Operations().ClosedBetween(ledger3ClosedAt.Add(time.Second), time.Time{}).Fetch()
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(ops, 0)
+	}
+}
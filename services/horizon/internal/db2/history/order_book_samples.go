@@ -0,0 +1,119 @@
+package history
+
+import (
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// PagingToken returns a cursor for this order book sample
+func (sample OrderBookSample) PagingToken() string {
+	return strconv.FormatUint(uint64(sample.LedgerSequence), 10)
+}
+
+// InsertOrderBookSample records the depth of the order book of base and
+// counter after a ledger. Samples are only written by the master ingestion
+// node so a sample already recorded for the ledger is left untouched.
+func (q *Q) InsertOrderBookSample(base, counter xdr.Asset, sample OrderBookSample) error {
+	baseID, err := q.GetCreateAssetID(base)
+	if err != nil {
+		return errors.Wrap(err, "could not get base asset id")
+	}
+	counterID, err := q.GetCreateAssetID(counter)
+	if err != nil {
+		return errors.Wrap(err, "could not get counter asset id")
+	}
+
+	sql := sq.Insert("history_order_book_samples").SetMap(map[string]interface{}{
+		"base_asset_id":    baseID,
+		"counter_asset_id": counterID,
+		"ledger_sequence":  sample.LedgerSequence,
+		"closed_at":        sample.ClosedAt.UTC(),
+		"best_bid":         sample.BestBid,
+		"best_ask":         sample.BestAsk,
+		"mid_price":        sample.MidPrice,
+		"spread":           sample.Spread,
+		"bid_depth_1":      sample.BidDepth1,
+		"ask_depth_1":      sample.AskDepth1,
+		"bid_depth_5":      sample.BidDepth5,
+		"ask_depth_5":      sample.AskDepth5,
+	}).Suffix("ON CONFLICT (base_asset_id, counter_asset_id, ledger_sequence) DO NOTHING")
+
+	_, err = q.Exec(sql)
+	return err
+}
+
+// GetOrderBookSamples returns a page of the samples of the order book of base
+// and counter, closed in [start, end). A zero start or end leaves that side of
+// the range open.
+func (q *Q) GetOrderBookSamples(
+	base, counter xdr.Asset,
+	start, end time.Time,
+	page db2.PageQuery,
+) ([]OrderBookSample, error) {
+	ids, err := q.getAssetPairIDs(base, counter)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		// an asset that was never seen has no samples
+		return nil, nil
+	}
+
+	sql := selectOrderBookSamples.Where(sq.Eq{
+		"base_asset_id":    ids[0],
+		"counter_asset_id": ids[1],
+	})
+	if !start.IsZero() {
+		sql = sql.Where("closed_at >= ?", start.UTC())
+	}
+	if !end.IsZero() {
+		sql = sql.Where("closed_at < ?", end.UTC())
+	}
+
+	sql, err = page.ApplyTo(sql, "ledger_sequence")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply query to page")
+	}
+
+	var results []OrderBookSample
+	if err := q.Select(&results, sql); err != nil {
+		return nil, errors.Wrap(err, "could not run select query")
+	}
+
+	return results, nil
+}
+
+// getAssetPairIDs returns the ids of base and counter, or nil if either of
+// them is not in history_assets.
+func (q *Q) getAssetPairIDs(base, counter xdr.Asset) ([]int64, error) {
+	ids := make([]int64, 2)
+	for i, asset := range []xdr.Asset{base, counter} {
+		id, err := q.GetAssetID(asset)
+		if q.NoRows(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get asset id")
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+var selectOrderBookSamples = sq.Select(
+	"ledger_sequence",
+	"closed_at",
+	"best_bid",
+	"best_ask",
+	"mid_price",
+	"spread",
+	"bid_depth_1",
+	"ask_depth_1",
+	"bid_depth_5",
+	"ask_depth_5",
+).From("history_order_book_samples")
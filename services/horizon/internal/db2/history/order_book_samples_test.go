@@ -0,0 +1,79 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestOrderBookSamples(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+	q := &Q{tt.HorizonSession()}
+
+	native := xdr.MustNewNativeAsset()
+	usd := xdr.MustNewCreditAsset("USD", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD")
+	eur := xdr.MustNewCreditAsset("EUR", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD")
+
+	// nothing is sampled for assets that were never seen
+	samples, err := q.GetOrderBookSamples(native, eur, time.Time{}, time.Time{}, db2.MustPageQuery("", false, "asc", 10))
+	tt.Assert.NoError(err)
+	tt.Assert.Empty(samples)
+
+	start := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := uint32(1); i <= 5; i++ {
+		sample := OrderBookSample{
+			LedgerSequence: i,
+			ClosedAt:       start.Add(time.Duration(i) * 5 * time.Second),
+			BestAsk:        null.StringFrom("1.0100000"),
+			BidDepth1:      "0",
+			AskDepth1:      "100",
+			BidDepth5:      "0",
+			AskDepth5:      "300",
+		}
+		tt.Assert.NoError(q.InsertOrderBookSample(native, usd, sample))
+	}
+	// a sample of the same ledger is only recorded once
+	tt.Assert.NoError(q.InsertOrderBookSample(native, usd, OrderBookSample{
+		LedgerSequence: 5,
+		ClosedAt:       start,
+		BidDepth1:      "1",
+		AskDepth1:      "1",
+		BidDepth5:      "1",
+		AskDepth5:      "1",
+	}))
+	tt.Assert.NoError(q.InsertOrderBookSample(usd, native, OrderBookSample{
+		LedgerSequence: 3,
+		ClosedAt:       start,
+		BidDepth1:      "0",
+		AskDepth1:      "0",
+		BidDepth5:      "0",
+		AskDepth5:      "0",
+	}))
+
+	samples, err = q.GetOrderBookSamples(native, usd, time.Time{}, time.Time{}, db2.MustPageQuery("", false, "desc", 2))
+	tt.Assert.NoError(err)
+	if tt.Assert.Len(samples, 2) {
+		tt.Assert.Equal(uint32(5), samples[0].LedgerSequence)
+		tt.Assert.Equal("300", samples[0].AskDepth5)
+		tt.Assert.Equal(null.StringFrom("1.0100000"), samples[0].BestAsk)
+		tt.Assert.False(samples[0].BestBid.Valid)
+		tt.Assert.Equal("4", samples[1].PagingToken())
+	}
+
+	samples, err = q.GetOrderBookSamples(
+		native, usd,
+		start.Add(10*time.Second), start.Add(20*time.Second),
+		db2.MustPageQuery("2", false, "asc", 10),
+	)
+	tt.Assert.NoError(err)
+	if tt.Assert.Len(samples, 1) {
+		tt.Assert.Equal(uint32(3), samples[0].LedgerSequence)
+		tt.Assert.True(samples[0].ClosedAt.Equal(start.Add(15 * time.Second)))
+	}
+}
@@ -210,6 +210,16 @@ var tradesInsert = sq.Insert("history_trades").Columns(
 	"price_d",
 )
 
+// TradesTimeRangeByOperationID loads the close times of the first and last trades
+// of the operations with ids between start and end, exclusive of end.
+func (q *Q) TradesTimeRangeByOperationID(start, end int64, dest *TradesTimeRange) error {
+	return q.GetRaw(dest, `
+		SELECT min(ledger_closed_at) as "from", max(ledger_closed_at) as "to"
+		FROM history_trades
+		WHERE history_operation_id >= $1 AND history_operation_id < $2
+	`, start, end)
+}
+
 // Trade records a trade into the history_trades table
 func (q *Q) InsertTrade(
 	opid int64,
@@ -22,6 +22,16 @@ var AllowedResolutions = map[time.Duration]struct{}{
 	time.Hour * 24 * 7: {}, //week
 }
 
+// MaterializedResolutions are the resolutions, in milliseconds, at which trade aggregations
+// are maintained in the history_trades_aggregations table as trades are ingested, from the
+// smallest to the largest. The buckets of the smallest resolution are computed from the
+// trades, and each other resolution is composed from the previous one.
+var MaterializedResolutions = []int64{
+	int64(time.Minute / time.Millisecond),
+	int64(time.Hour / time.Millisecond),
+	int64(time.Hour * 24 / time.Millisecond),
+}
+
 // StrictResolutionFiltering represents a simple feature flag to determine whether only
 // predetermined resolutions of trade aggregations are allowed.
 var StrictResolutionFiltering = true
@@ -117,11 +127,21 @@ func (q *TradeAggregationsQ) WithEndTime(endTime strtime.Millis) (*TradeAggregat
 	}
 }
 
-// GetSql generates a sql statement to aggregate Trades based on given parameters
+// GetSql generates a sql statement to aggregate Trades based on given parameters. The
+// buckets are composed from the materialized aggregations when the resolution, offset
+// and time range allow it, and computed from the trades otherwise.
 func (q *TradeAggregationsQ) GetSql() sq.SelectBuilder {
 	var orderPreserved bool
 	orderPreserved, q.baseAssetID, q.counterAssetID = getCanonicalAssetOrder(q.baseAssetID, q.counterAssetID)
 
+	if source, ok := q.materializedSource(); ok {
+		return q.composeSql(source, orderPreserved)
+	}
+	return q.computeSql(orderPreserved)
+}
+
+// computeSql generates a sql statement aggregating the trades into buckets.
+func (q *TradeAggregationsQ) computeSql(orderPreserved bool) sq.SelectBuilder {
 	var bucketSQL sq.SelectBuilder
 	if orderPreserved {
 		bucketSQL = bucketTrades(q.resolution, q.offset)
@@ -158,6 +178,86 @@ func (q *TradeAggregationsQ) GetSql() sq.SelectBuilder {
 		OrderBy("timestamp " + q.pagingParams.Order)
 }
 
+// materializedSource returns the largest materialized resolution the buckets can be
+// composed from: it must divide the resolution, the offset and the time boundaries.
+func (q *TradeAggregationsQ) materializedSource() (int64, bool) {
+	for i := len(MaterializedResolutions) - 1; i >= 0; i-- {
+		source := MaterializedResolutions[i]
+		if q.resolution%source == 0 &&
+			q.offset%source == 0 &&
+			int64(q.startTime)%source == 0 &&
+			(q.endTime.IsNil() || int64(q.endTime)%source == 0) {
+			return source, true
+		}
+	}
+	return 0, false
+}
+
+// composeSql generates a sql statement aggregating the materialized buckets of the
+// source resolution into buckets of the query resolution.
+func (q *TradeAggregationsQ) composeSql(source int64, orderPreserved bool) sq.SelectBuilder {
+	var bucketSQL sq.SelectBuilder
+	if orderPreserved {
+		bucketSQL = sq.Select(
+			formatComposedTimestampSelect(q.resolution, q.offset),
+			"timestamp as source_timestamp",
+			"count",
+			"base_volume",
+			"counter_volume",
+			"high",
+			"low",
+			"open",
+			"close",
+		)
+	} else {
+		bucketSQL = sq.Select(
+			formatComposedTimestampSelect(q.resolution, q.offset),
+			"timestamp as source_timestamp",
+			"count",
+			"counter_volume as base_volume",
+			"base_volume as counter_volume",
+			"reverse_high as high",
+			"reverse_low as low",
+			"ARRAY[open[2], open[1]] as open",
+			"ARRAY[close[2], close[1]] as close",
+		)
+	}
+
+	bucketSQL = bucketSQL.From("history_trades_aggregations").
+		Where(sq.Eq{
+			"resolution":       source,
+			"base_asset_id":    q.baseAssetID,
+			"counter_asset_id": q.counterAssetID,
+		}).
+		Where(sq.GtOrEq{"timestamp": int64(q.startTime)})
+	if !q.endTime.IsNil() {
+		bucketSQL = bucketSQL.Where(sq.Lt{"timestamp": int64(q.endTime)})
+	}
+
+	return sq.Select(
+		"timestamp",
+		"sum(count)::bigint as count",
+		"sum(base_volume) as base_volume",
+		"sum(counter_volume) as counter_volume",
+		"sum(counter_volume)/sum(base_volume) as avg",
+		"max_price(high ORDER BY source_timestamp) as high",
+		"min_price(low ORDER BY source_timestamp) as low",
+		"first(open ORDER BY source_timestamp) as open",
+		"last(close ORDER BY source_timestamp) as close",
+	).
+		FromSelect(bucketSQL, "htrd").
+		GroupBy("timestamp").
+		Limit(q.pagingParams.Limit).
+		OrderBy("timestamp " + q.pagingParams.Order)
+}
+
+// formatComposedTimestampSelect is like formatBucketTimestampSelect, for the
+// timestamp of a materialized bucket.
+func formatComposedTimestampSelect(resolution int64, offset int64) string {
+	return fmt.Sprintf("div((timestamp - %d), %d)*%d + %d as timestamp",
+		offset, resolution, resolution, offset)
+}
+
 // formatBucketTimestampSelect formats a sql select clause for a bucketed timestamp, based on given resolution
 // and the offset. Given a time t, it gives it a timestamp defined by
 // f(t) = ((t - offset)/resolution)*resolution + offset.
@@ -195,3 +295,131 @@ func reverseBucketTrades(resolution int64, offset int64) sq.SelectBuilder {
 		"ARRAY[price_d, price_n] as price",
 	)
 }
+
+// RebuildTradeAggregations recomputes the materialized trade aggregations of the buckets
+// containing the trades closed between from and to, inclusive. It must be called after
+// trades of that range are ingested or cleared.
+func (q *Q) RebuildTradeAggregations(from, to time.Time) error {
+	fromMillis := from.Unix() * 1000
+	toMillis := to.Unix() * 1000
+
+	for i, resolution := range MaterializedResolutions {
+		start := fromMillis - fromMillis%resolution
+		end := toMillis - toMillis%resolution + resolution
+
+		_, err := q.Exec(sq.Delete("history_trades_aggregations").
+			Where(sq.Eq{"resolution": resolution}).
+			Where(sq.GtOrEq{"timestamp": start}).
+			Where(sq.Lt{"timestamp": end}))
+		if err != nil {
+			return errors.Wrap(err, "could not delete trade aggregations")
+		}
+
+		var bucketSQL sq.SelectBuilder
+		if i == 0 {
+			bucketSQL = materializeTrades(resolution, start, end)
+		} else {
+			bucketSQL = materializeAggregations(MaterializedResolutions[i-1], resolution, start, end)
+		}
+		sql, args, err := bucketSQL.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "could not build trade aggregations query")
+		}
+
+		_, err = q.ExecRaw(
+			"INSERT INTO history_trades_aggregations ("+materializedColumns+") "+sql,
+			args...,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "could not insert trade aggregations with resolution %d", resolution)
+		}
+	}
+	return nil
+}
+
+const materializedColumns = "resolution, base_asset_id, counter_asset_id, timestamp, count, " +
+	"base_volume, counter_volume, high, low, reverse_high, reverse_low, open, close"
+
+// materializeTrades generates a select statement aggregating the trades closed between
+// start and end into buckets of the given resolution, in the canonical asset order. The
+// reverse order extremes are kept too, the price comparisons of both orders may round
+// differently.
+func materializeTrades(resolution int64, start int64, end int64) sq.SelectBuilder {
+	const order = "ORDER BY history_operation_id, \"order\""
+	return sq.Select(
+		fmt.Sprint(resolution),
+		"base_asset_id",
+		"counter_asset_id",
+		"timestamp",
+		"count(*)",
+		"sum(base_amount)",
+		"sum(counter_amount)",
+		"max_price(price "+order+")",
+		"min_price(price "+order+")",
+		"max_price(reverse_price "+order+")",
+		"min_price(reverse_price "+order+")",
+		"first(price "+order+")",
+		"last(price "+order+")",
+	).
+		FromSelect(
+			sq.Select(
+				formatBucketTimestampSelect(resolution, 0),
+				"history_operation_id",
+				"\"order\"",
+				"base_asset_id",
+				"counter_asset_id",
+				"base_amount",
+				"counter_amount",
+				"ARRAY[price_n, price_d] as price",
+				"ARRAY[price_d, price_n] as reverse_price",
+			).
+				From("history_trades").
+				Where(sq.GtOrEq{"ledger_closed_at": strtime.MillisFromInt64(start).ToTime()}).
+				Where(sq.Lt{"ledger_closed_at": strtime.MillisFromInt64(end).ToTime()}),
+			"htrd",
+		).
+		GroupBy("base_asset_id", "counter_asset_id", "timestamp")
+}
+
+// materializeAggregations generates a select statement composing the materialized buckets
+// of the source resolution between start and end into buckets of the given resolution.
+func materializeAggregations(source int64, resolution int64, start int64, end int64) sq.SelectBuilder {
+	return sq.Select(
+		fmt.Sprint(resolution),
+		"base_asset_id",
+		"counter_asset_id",
+		"timestamp",
+		"sum(count)",
+		"sum(base_volume)",
+		"sum(counter_volume)",
+		"max_price(high ORDER BY source_timestamp)",
+		"min_price(low ORDER BY source_timestamp)",
+		"max_price(reverse_high ORDER BY source_timestamp)",
+		"min_price(reverse_low ORDER BY source_timestamp)",
+		"first(open ORDER BY source_timestamp)",
+		"last(close ORDER BY source_timestamp)",
+	).
+		FromSelect(
+			sq.Select(
+				formatComposedTimestampSelect(resolution, 0),
+				"timestamp as source_timestamp",
+				"base_asset_id",
+				"counter_asset_id",
+				"count",
+				"base_volume",
+				"counter_volume",
+				"high",
+				"low",
+				"reverse_high",
+				"reverse_low",
+				"open",
+				"close",
+			).
+				From("history_trades_aggregations").
+				Where(sq.Eq{"resolution": source}).
+				Where(sq.GtOrEq{"timestamp": start}).
+				Where(sq.Lt{"timestamp": end}),
+			"hta",
+		).
+		GroupBy("base_asset_id", "counter_asset_id", "timestamp")
+}
@@ -0,0 +1,105 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/test"
+	strtime "github.com/stellar/go/support/time"
+	"github.com/stellar/go/xdr"
+)
+
+func TestMaterializedTradeAggregations(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	test.ResetHorizonDB(t, tt.HorizonDB)
+	q := &Q{tt.HorizonSession()}
+
+	seller := xdr.MustAddress("GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB")
+	buyer := xdr.MustAddress("GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD")
+	native := xdr.MustNewNativeAsset()
+	usd := xdr.MustNewCreditAsset("USD", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD")
+
+	// trades spread over three days, a few of them sharing a ledger
+	start := strtime.MillisFromSeconds(time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC).Unix())
+	var opid int64 = 1 << 12
+	for i := int64(0); i < 60; i++ {
+		closedAt := start + strtime.MillisFromSeconds(i*i*97)
+		for order := int32(0); order < int32(i%3)+1; order++ {
+			opid++
+			sold, bought := native, usd
+			if (i+int64(order))%2 == 0 {
+				sold, bought = usd, native
+			}
+			err := q.InsertTrade(opid, order, buyer, false, xdr.OfferEntry{}, xdr.ClaimOfferAtom{
+				SellerId:     seller,
+				OfferId:      xdr.Int64(i),
+				AssetSold:    sold,
+				AmountSold:   xdr.Int64(100 + i*7 + int64(order)),
+				AssetBought:  bought,
+				AmountBought: xdr.Int64(300 - i*3 + int64(order)),
+			}, xdr.Price{N: xdr.Int32(1 + i%5), D: xdr.Int32(2 + i%3)}, closedAt)
+			tt.Require.NoError(err)
+		}
+	}
+	end := start + strtime.MillisFromSeconds(59*59*97)
+	tt.Require.NoError(q.RebuildTradeAggregations(start.ToTime(), end.ToTime()))
+
+	nativeID, err := q.GetAssetID(native)
+	tt.Require.NoError(err)
+	usdID, err := q.GetAssetID(usd)
+	tt.Require.NoError(err)
+
+	assertComposed := func(base, counter, resolution, offset int64, order string) {
+		page := db2.PageQuery{Order: order, Limit: db2.MaxPageSize}
+
+		aggQ, err := q.GetTradeAggregationsQ(base, counter, resolution, offset, page)
+		tt.Require.NoError(err)
+		_, ok := aggQ.materializedSource()
+		tt.Require.True(ok)
+		var composed []TradeAggregation
+		tt.Require.NoError(q.Select(&composed, aggQ.GetSql()))
+
+		aggQ, err = q.GetTradeAggregationsQ(base, counter, resolution, offset, page)
+		tt.Require.NoError(err)
+		var orderPreserved bool
+		orderPreserved, aggQ.baseAssetID, aggQ.counterAssetID = getCanonicalAssetOrder(base, counter)
+		var computed []TradeAggregation
+		tt.Require.NoError(q.Select(&computed, aggQ.computeSql(orderPreserved)))
+
+		tt.Require.NotEmpty(computed)
+		if tt.Assert.Len(composed, len(computed)) {
+			for i := range computed {
+				tt.Assert.InDelta(computed[i].Average, composed[i].Average, 1e-9)
+				computed[i].Average, composed[i].Average = 0, 0
+				tt.Assert.Equal(computed[i], composed[i])
+			}
+		}
+	}
+
+	hour := int64(time.Hour / time.Millisecond)
+	for _, resolution := range []int64{
+		int64(time.Minute / time.Millisecond),
+		int64(5 * time.Minute / time.Millisecond),
+		hour,
+		24 * hour,
+		7 * 24 * hour,
+	} {
+		for _, order := range []string{"asc", "desc"} {
+			assertComposed(nativeID, usdID, resolution, 0, order)
+			assertComposed(usdID, nativeID, resolution, 0, order)
+		}
+	}
+	assertComposed(nativeID, usdID, 24*hour, 3*hour, "asc")
+	assertComposed(usdID, nativeID, 7*24*hour, 5*hour, "desc")
+
+	// buckets of cleared trades are removed on rebuild
+	_, err = q.Exec(sq.Delete("history_trades").
+		Where(sq.GtOrEq{"ledger_closed_at": (start + 3600000).ToTime()}))
+	tt.Require.NoError(err)
+	tt.Require.NoError(q.RebuildTradeAggregations((start + 3600000).ToTime(), end.ToTime()))
+	assertComposed(nativeID, usdID, hour, 0, "asc")
+	assertComposed(usdID, nativeID, 24*hour, 0, "desc")
+}
@@ -17,6 +17,10 @@
 // migrations/23_exp_asset_stats.sql (883B)
 // migrations/24_accounts.sql (1.402kB)
 // migrations/25_expingest_rename_columns.sql (641B)
+// migrations/26_trade_aggregations.sql (4.209kB)
+// migrations/27_history_filters.sql (1.541kB)
+// migrations/28_trust_lines_by_balance.sql (286B)
+// migrations/29_order_book_samples.sql (1.317kB)
 // migrations/2_index_participants_by_toid.sql (277B)
 // migrations/3_use_sequence_in_history_accounts.sql (447B)
 // migrations/4_add_protocol_version.sql (188B)
@@ -434,6 +438,86 @@ func migrations25_expingest_rename_columnsSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations26_trade_aggregationsSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x58\xdf\x6f\xda\x30\x10\x7e\xcf\x5f\x71\xea\x53\x42\x43\xd5\x6a\xd3\x34\xa9\xda\x03\x85\xb4\x43\x83\x50\x05\xd0\x86\xaa\x0a\x99\xc4\x80\x25\x92\x20\xc7\xb4\x65\x7f\xfd\xce\x97\x1f\x04\x28\x50\xca\xd3\xd6\xf9\xa5\x96\x7d\xb9\x3b\xdf\xf7\x7d\x67\xd3\x6a\x15\xce\x43\x31\x91\x4c\x71\xe8\xcf\x8d\x6a\x15\xa6\x22\x51\xb1\x5c\x0e\x95\x64\x01\x4f\x86\x6c\x32\x91\x7c\xc2\x94\x88\xa3\x04\xfc\x38\x52\x4c\xe0\x44\x4d\x39\x90\x01\xac\xed\xc7\x63\xe0\x4f\x5c\x2e\x81\x25\x09\x57\x30\x67\x42\x02\x53\xda\xab\xfe\x40\xf2\x24\x9e\x2d\x0a\xcb\x2c\xd0\x45\x1b\x63\x4b\xc1\x66\xe2\x37\x0f\xbc\x92\x89\x79\x05\xa1\x88\x16\x8a\xdb\x70\x05\xd3\x78\x81\xae\xa2\x00\xa7\x01\x5b\x5a\x36\x88\x28\x77\xeb\xb3\x28\x8e\x84\xcf\x66\x59\xd4\x58\x06\x5c\x96\x02\x64\x27\xb9\xc0\xf8\x98\x5b\xc2\x87\x53\x31\x99\x92\xaf\x7c\x61\x16\x3f\x03\x93\x5c\x7b\xd3\x4e\xf9\x8b\x92\x3c\xe4\x94\x64\x9a\x37\x99\x05\x30\x97\xc2\xe7\x89\x4d\x8b\x34\xc7\x82\x84\x73\x26\x45\x92\x1d\x69\x14\xab\x69\x1a\x3f\x81\x90\x2d\x41\xc6\x8b\x28\xd0\x2e\x03\x31\x1e\x73\xc9\x23\x35\x5b\x5e\x18\x46\xdd\x73\x6a\x3d\x07\x7a\xb5\x9b\x96\xb3\xb7\xde\xa6\x01\x38\x56\x75\x83\x74\xdc\x34\xef\x9a\x6e\x4f\xcf\xdc\x4e\x0f\xdc\x7e\xab\x65\x93\xe1\x88\xe1\x61\xa8\x08\x43\x11\xbc\x6e\x08\x9e\x73\xeb\x78\x8e\x5b\x77\xba\x45\x64\xfa\x22\x31\x45\x60\xa5\x6e\x7c\xcc\x1a\x21\x59\x79\x7a\x9f\x1b\x25\xb0\x86\x8a\x85\x73\x38\x90\x36\xc5\x83\xd2\xd8\x7b\xbe\x27\xac\x45\xc8\x53\x43\xb7\xdf\x76\xbc\x66\xfd\x75\x8f\x78\x82\xc2\x76\x97\x21\x51\xa1\x3c\x32\xc3\x87\xc7\x74\x5f\x33\x63\xdf\xfe\x1a\xa5\xf6\xec\x17\x7e\x36\xf6\xe3\x39\x8f\x60\xfb\xe8\xf9\xb6\x3f\x8b\x13\xbe\x7b\xfb\xde\x6b\xb6\x6b\xde\x00\x7e\x38\x03\x73\x0d\x7b\x7b\x0b\x43\xbb\xc4\x22\x7b\x05\x8d\x65\x58\xd7\x05\x1f\x9b\x6e\xc3\xf9\x05\x53\x25\x03\xcd\xc2\xe1\x08\x39\x89\x76\xd0\x71\xf7\x72\xb4\xdf\x6d\xba\x77\x30\x42\xc9\x70\x30\x5f\x0f\x82\x21\x9a\x6e\xd7\xf1\x7a\x18\xa2\xd7\xd9\xcf\xf8\xb2\x87\x83\x67\x2a\x42\x64\x9b\x5b\x34\xb1\x37\xa8\x60\x13\xe2\xb6\xc6\xd5\x5e\x03\xcf\x2e\x43\x65\x13\x2e\x76\x5a\x7e\xcb\xe8\x3a\x2d\xa7\xde\x23\xd7\x5f\x2e\x71\xbc\x23\x31\xb3\x92\x89\x22\x59\x84\x19\x54\xa1\x5e\xc7\x46\xa6\x57\x0a\x07\xd9\x22\x99\x86\xec\x65\x48\x5d\xc6\x4c\x7b\x4d\xc7\x6b\x38\x1e\xdc\x0c\x8a\xfa\x61\x92\x92\xca\x46\x21\xcf\xa8\xef\x9c\xe5\x1f\x8b\xe8\x84\x8f\x8b\xc8\x79\x51\x4e\xca\xe0\x04\x27\x63\x21\x13\xf5\x9e\x23\xcc\xd8\xb1\xdf\x19\xb7\x5e\xa7\x9d\x75\xdc\x12\xe2\x7a\x04\xe2\xc9\x34\x7d\xed\xd1\xd4\x77\x03\xf3\x95\xc9\xe7\xb1\x3f\x85\xb1\x8c\x43\x98\xf1\x60\x82\xd8\x11\x59\x50\x39\xca\x82\x0a\x5c\x21\x4d\xc0\xc2\x0b\x09\x46\x62\x22\x10\x52\xa8\xc2\x25\x82\x4d\xfc\xb1\x2a\xf4\x07\xce\xe1\x52\x5b\xac\xb8\x52\x04\xdc\x9b\xe9\x1b\xe8\x57\x62\x58\x69\x3b\x5c\x49\x44\x8f\x9a\xe7\xd5\x06\x0f\x54\xa3\x21\x92\x3d\x9d\x04\x8f\x3a\x25\x9a\xdb\x6b\x16\x41\x6e\x11\x91\xc5\x1a\xa6\xe4\x92\xca\xb7\x2e\x6d\xc3\xa2\x6e\x62\xdc\x79\x9d\xfe\xbd\x46\xe0\xed\xba\xf9\x77\x5a\xc6\x27\x02\xfb\xc8\xa6\x51\x34\x85\xcd\xb6\x91\xe6\xb4\xd1\x36\xf2\xc5\x0d\xf1\xd2\xa5\x54\xb0\x3f\xc1\x17\x14\xc2\xb7\xea\xca\x9b\x32\xd5\x77\xd4\x41\xeb\xad\xce\x70\x64\x90\xf2\x85\x78\xe8\xab\x54\xfc\x74\x45\x1e\x32\x25\xb9\xa7\xb7\xe5\x6e\xd3\x43\x0a\x5f\x3d\x58\x52\xb1\x66\xc8\x59\x95\x6c\xb2\x2d\xd8\xd2\x1b\x07\xd7\x37\x03\xae\xa4\x76\x10\xfa\x94\x8a\x7b\x69\x58\x6a\x0e\x47\xd2\x71\x97\x3c\xd7\x64\x44\x46\x3f\xbf\xe3\xa3\xae\xfc\xde\xfc\x96\x76\x2c\x12\x32\xfb\xd8\x3a\xfe\xfa\xe5\xf3\x7f\x21\xff\x9d\x42\xce\xa1\xb3\x2a\xf9\xec\x43\x4a\x39\x6b\x63\x27\x89\xb9\x5a\xfa\x7f\x41\x23\x7e\x8e\x8c\x86\xd7\xb9\x7f\xc3\x2f\x59\x7c\x3d\xf9\xb8\x7a\x6d\xfc\x01\xfc\xd5\xa2\x77\x71\x10\x00\x00")
+
+func migrations26_trade_aggregationsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations26_trade_aggregationsSql,
+		"migrations/26_trade_aggregations.sql",
+	)
+}
+
+func migrations26_trade_aggregationsSql() (*asset, error) {
+	bytes, err := migrations26_trade_aggregationsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/26_trade_aggregations.sql", size: 4209, mode: os.FileMode(0644), modTime: time.Unix(1792436330, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa0, 0x6f, 0x4c, 0xe1, 0x3e, 0x4c, 0x63, 0xc1, 0x53, 0x7b, 0x20, 0xa7, 0x46, 0xec, 0xfe, 0x95, 0xd5, 0x9e, 0x66, 0x31, 0xd9, 0x56, 0xa2, 0x5b, 0xc3, 0x67, 0xcb, 0xfa, 0xd1, 0x76, 0xcb, 0x38}}
+	return a, nil
+}
+
+var _migrations27_history_filtersSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x94\xc1\x8e\x9b\x30\x10\x86\xef\x3c\xc5\xdc\x36\x51\x93\x3c\x40\x39\xd1\x05\x55\x2b\x51\x52\xa5\x89\x54\xa9\xaa\x22\x07\x06\xb0\x0a\x76\xe4\x99\xed\x36\x6f\x5f\xd9\x98\x0d\x51\x12\x44\xf6\x88\xfd\xcf\x37\xff\x3f\x63\xb1\x5c\xc2\xa7\x56\x56\x46\x30\xc2\xee\x18\x2c\x97\x20\x88\x90\x09\x84\x2a\x40\xb4\xfa\x55\x31\x1c\x44\xfe\x07\xb8\x46\x28\x65\xc3\x68\x08\x74\xe9\x3e\xf5\x11\x8d\x60\xa9\x55\xa7\xc6\xb2\xc4\x9c\x09\x50\x15\x47\x2d\x15\xd3\x62\x80\x6b\x24\x31\xb9\x2a\x7f\x60\xb0\x44\x83\x2a\xc7\x02\x0e\x27\x77\x51\x20\x0b\xd9\x10\xcc\x08\xd1\x56\xd6\x92\x58\x9b\xd3\x2a\xee\xce\x23\x57\x37\x1f\x1a\x93\x8e\x28\xcd\xfb\xb7\x02\x62\xa3\xf5\xf1\x3e\xc4\x09\xe7\x2b\xd8\xd6\x78\x7a\x32\x2e\x52\xd3\x59\x90\xaa\x42\xb2\x69\x3e\x5b\x28\x18\xfd\x46\xfe\x0c\x0b\x4b\x3a\x60\xa9\x0d\x02\xd7\x92\xa0\x9b\x98\xd4\x0a\x84\x41\x68\xb0\x64\xc8\x76\x69\xba\x70\xe6\x48\xdb\x53\xf5\xc4\xd0\x0a\xce\xeb\x73\x40\x97\xdc\x4a\x2c\xce\x5b\xf6\x23\x5d\xc0\xab\x62\xd9\xf8\x38\x0d\x16\x95\x9d\xb3\x85\x1b\xec\x4d\xac\x82\x20\x4a\xb7\xc9\x06\xb6\xd1\x97\x34\xe9\x93\xed\x07\x5b\x88\xe2\xb8\x9f\x77\x5e\x0b\x23\x72\x46\x03\x7f\x85\x39\x49\x55\xfd\xfa\x1d\x4e\x2a\xf7\x2b\x97\x95\x54\x7c\xbb\xa2\xdf\xf3\x47\xba\x5d\xd4\x5e\xb6\x0a\x9e\x37\x49\xb4\x4d\xe0\x25\x8b\x93\x9f\x20\x55\x81\xff\xf6\xd7\x26\xf7\x5a\xed\x7d\xd3\x75\x06\xd7\xf7\xb0\xfb\xf1\x92\x7d\x85\x4a\x2a\x98\x75\xba\x79\xf8\x00\xb9\xb3\x34\x46\x3e\xb0\x41\x84\x59\xb7\xbe\x71\xb6\x0f\x7b\xdb\x72\x3f\x89\x07\xfd\x0e\x99\x57\x66\x2f\x99\x0f\x38\x65\x23\x14\x89\xfc\x7d\x0e\x2d\xb6\x7a\x08\x1e\xde\x5f\xd2\xad\x72\x1e\x06\xc1\xf0\x3f\x12\xeb\x37\x15\xc4\x9b\xf5\xf7\xc9\xbd\xc2\xfb\xf2\xab\xc0\x13\xb5\x6e\x96\x23\xda\x5b\x8b\x9f\x2e\xf7\xf4\xd1\x37\xee\x58\xcf\xeb\x74\xf7\x2d\xf3\x6f\x3d\x9c\xae\xf7\x0d\x6e\xe9\xcf\x56\x26\xb7\xb8\x57\x42\x84\x4c\x61\xf0\x7f\x00\xed\x34\x27\x36\x05\x06\x00\x00")
+
+func migrations27_history_filtersSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations27_history_filtersSql,
+		"migrations/27_history_filters.sql",
+	)
+}
+
+func migrations27_history_filtersSql() (*asset, error) {
+	bytes, err := migrations27_history_filtersSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/27_history_filters.sql", size: 1541, mode: os.FileMode(0644), modTime: time.Unix(1792438214, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x48, 0x55, 0xcb, 0xb7, 0x9d, 0x2, 0x3c, 0x5a, 0x5c, 0xad, 0x40, 0xc3, 0x2, 0xf7, 0x6b, 0x5b, 0x16, 0xaf, 0x85, 0xfb, 0x92, 0xc0, 0x8a, 0x7e, 0x6a, 0x97, 0xc7, 0xb6, 0x2c, 0x10, 0xc3, 0x23}}
+	return a, nil
+}
+
+var _migrations28_trust_lines_by_balanceSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x4f\xcb\x0e\x82\x30\x10\xbc\xf7\x2b\xf6\xa8\x11\xfc\x01\x4e\x2a\x8d\xe1\x02\x06\x21\xf1\xd6\x94\x76\x15\x12\x6c\x49\xb7\x68\xf8\x7b\x51\x94\x78\x32\xde\x36\xf3\xd8\x99\x09\x43\x58\x5d\x9b\x8b\x93\x1e\xa1\xec\x58\x18\x82\x77\x3d\x79\xd1\x36\x06\x49\x54\x83\x90\x44\xe8\x45\x25\x5b\x69\x14\x02\xa1\xbb\x21\x81\xaf\x11\x6a\xdb\x6a\x74\x04\xf6\x0c\xd2\xc0\x4b\x16\x00\x59\xe7\x51\x43\x35\xc0\xdb\xb1\x66\xbb\x9c\x6f\x0a\x0e\x49\x1a\xf3\xd3\xef\xe7\x59\xfa\xcd\x43\x79\x4c\xd2\x3d\x6c\x8b\x9c\xf3\xc5\x24\xf4\x43\x87\xc1\x14\x25\x94\xd5\xf3\xdd\x10\xf5\xe8\x82\x4f\xe6\x08\x2b\x65\x7b\x33\x12\x7a\x19\xb1\xe7\xa8\x79\x63\x6c\xef\x86\xc5\x79\x76\xf8\xa3\x50\xc4\x1e\x4a\x6b\xfb\x1c\x1e\x01\x00\x00")
+
+func migrations28_trust_lines_by_balanceSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations28_trust_lines_by_balanceSql,
+		"migrations/28_trust_lines_by_balance.sql",
+	)
+}
+
+func migrations28_trust_lines_by_balanceSql() (*asset, error) {
+	bytes, err := migrations28_trust_lines_by_balanceSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/28_trust_lines_by_balance.sql", size: 286, mode: os.FileMode(0644), modTime: time.Unix(1792437704, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3b, 0x15, 0x3, 0x8b, 0x46, 0x4f, 0x8, 0x14, 0x4c, 0x54, 0xa, 0xc2, 0xbe, 0x77, 0x1e, 0xfb, 0x59, 0x83, 0x92, 0xe1, 0xdf, 0xc9, 0x17, 0x70, 0x9b, 0x58, 0xc1, 0x27, 0x3, 0x80, 0x9b, 0x9d}}
+	return a, nil
+}
+
+var _migrations29_order_book_samplesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x94\x51\x6f\xda\x30\x10\xc7\xdf\xf3\x29\xee\xa5\x12\x68\xa4\x52\x1f\xfa\xd4\x27\x0a\x1e\x8b\x06\x09\x0a\x41\x5d\xf7\x62\x39\xc9\x01\x56\x49\x9c\xf9\xcc\xba\x7c\xfb\x39\x0e\x50\x42\x11\xdb\xaa\xf9\x25\x8a\xef\xfe\xbf\xff\xd9\x77\x89\xef\xc3\xa7\x42\xae\xb5\x30\x08\xcb\xca\xf3\x7d\xd8\x48\x32\x4a\xd7\x5c\xe9\x1c\x35\x4f\x95\x7a\xe1\x24\x8a\x6a\x8b\x04\x99\x2a\x8d\x90\x25\x81\xd9\x20\xe4\x58\x99\x0d\xa8\x95\x7b\x71\xc9\xd0\x24\xd3\x61\x4b\x10\xa1\x69\x80\x95\x90\x9a\xa0\x65\xe4\x90\xd6\x2e\x8a\xbf\x2a\xd4\xb2\x40\x0b\xdc\x82\x2c\xd7\x48\x46\xaa\x12\xa8\x26\x83\x05\x88\x95\xb1\x38\xfc\x89\xba\x06\x2b\x5a\xa3\xbe\x85\xb9\x96\x19\x52\x03\x14\x1a\xad\x04\x76\xa5\x34\x47\xb7\x4c\xed\xca\x46\xe3\x5c\xc1\xb2\x5d\xf8\x10\x4d\x05\xed\x0b\x1a\xd8\xc7\x4b\x5b\x3b\x35\x20\xc7\x2b\x1a\x31\xbd\x4f\x06\x51\xda\x82\x65\x7e\xcc\xef\x26\x76\x3d\xd5\x6a\x85\x1a\xf3\x06\xf8\x2a\xcd\xc6\x16\x78\x77\xe3\x00\xf7\x37\x07\x41\x61\x51\x55\x73\x8c\x5b\xcf\x1b\xc5\x6c\x98\x30\x48\x86\x8f\x53\x76\xed\xce\x7b\x1e\xd8\xd5\xd4\xc4\x9d\x0f\xb7\x0c\xbb\x1e\x83\x49\x10\x26\x70\x71\x85\x51\x02\xe1\x72\x3a\x85\x98\x7d\x66\x31\x0b\x47\x6c\x71\x74\x70\x0c\xea\xc9\xbc\x3f\x70\xe0\xfd\x19\xde\xd8\xff\x0b\xdc\xb6\x8d\x13\xfe\xd8\x61\x99\x21\x80\xa5\xb2\x09\x8b\xaf\x82\xf7\x35\x6d\x15\x61\xce\x85\x39\x84\x93\x60\xc6\x16\xc9\x70\x36\x87\xa7\x20\xf9\x12\x2d\x13\xb7\x03\xdf\xa3\x90\x9d\x49\x53\x3b\x47\x3c\x6d\xaf\xa8\x25\x2f\x67\x2c\x0e\x46\x27\xd1\xa6\xff\x17\xa3\xb6\x3b\xdc\x75\xe7\x62\x94\x2a\x8d\x22\x3f\xad\xb9\x43\xb6\x5a\x37\x24\xfc\xae\x13\xfd\x8b\xd3\xda\x7a\x3e\x2a\x7d\x73\xbd\xff\xb8\xeb\x3f\x4b\xe7\x71\x30\x1b\xc6\xcf\xf0\x95\x3d\xf7\x3a\x73\x39\x78\x37\x4d\x83\xf3\x31\xe8\x7b\xfd\x87\xe3\xec\x07\xe1\x98\x7d\xbb\x32\xfb\x3c\xad\x79\xab\x87\x28\xbc\xf6\x8d\x2c\x17\x41\x38\x81\xd4\x68\x44\xe8\x9d\x3b\x5a\x3f\xff\xe4\x27\x37\x56\xaf\xa5\x37\x8e\xa3\xf9\x9f\x3f\xbd\x4c\x50\x26\x72\x7c\xf0\x7e\x03\x27\x66\xa2\xbb\x25\x05\x00\x00")
+
+func migrations29_order_book_samplesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations29_order_book_samplesSql,
+		"migrations/29_order_book_samples.sql",
+	)
+}
+
+func migrations29_order_book_samplesSql() (*asset, error) {
+	bytes, err := migrations29_order_book_samplesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/29_order_book_samples.sql", size: 1317, mode: os.FileMode(0644), modTime: time.Unix(1792438214, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0xa3, 0x8, 0x5c, 0xfc, 0x51, 0xd4, 0x91, 0xce, 0xad, 0xf8, 0x33, 0xa8, 0xc9, 0x2a, 0xe4, 0x52, 0xe0, 0x9d, 0x15, 0xf2, 0x13, 0xd2, 0x3f, 0x6b, 0xf1, 0x9c, 0xdf, 0xbb, 0x91, 0xc1, 0x3c}}
+	return a, nil
+}
+
 var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\xca\xc2\x50\x0c\x46\xf7\x3c\x45\xc6\xff\x47\xfa\x04\x9d\xc4\x16\xe9\xd2\x4a\xb5\xe0\x76\x49\xdb\x8b\xcd\xe0\xcd\x25\x37\x20\x7d\x7b\x41\x07\x5b\xbb\xb8\x86\x8f\x73\x72\xb2\x0c\x77\x77\xbe\x29\x99\xc7\x2e\x02\x1c\xda\x72\x7f\x29\xb1\xaa\x8b\xf2\x8a\x93\x44\xd7\xcf\x6e\x12\x1e\xb1\xa9\x71\xe2\x64\xa2\xb3\x93\xe8\x95\x8c\x25\xb8\x48\x6a\x3c\x70\xa4\x60\x09\xbb\x73\x55\x1f\xb1\x37\xf5\x1e\xff\xb6\x5b\x1e\xff\xf3\x2f\xbc\xbd\xf1\xb6\xc6\x9b\x52\x48\x34\xfc\x28\x58\xae\x5f\x0a\x58\x26\x15\xf2\x08\x00\x45\xdb\x9c\xb6\x49\xf9\xea\xfe\xf9\x25\x87\x67\x00\x00\x00\xff\xff\x33\xec\x54\x7a\x15\x01\x00\x00")
 
 func migrations2_index_participants_by_toidSqlBytes() ([]byte, error) {
@@ -739,6 +823,14 @@ var _bindata = map[string]func() (*asset, error){
 
 	"migrations/25_expingest_rename_columns.sql": migrations25_expingest_rename_columnsSql,
 
+	"migrations/26_trade_aggregations.sql": migrations26_trade_aggregationsSql,
+
+	"migrations/27_history_filters.sql": migrations27_history_filtersSql,
+
+	"migrations/28_trust_lines_by_balance.sql": migrations28_trust_lines_by_balanceSql,
+
+	"migrations/29_order_book_samples.sql": migrations29_order_book_samplesSql,
+
 	"migrations/2_index_participants_by_toid.sql": migrations2_index_participants_by_toidSql,
 
 	"migrations/3_use_sequence_in_history_accounts.sql": migrations3_use_sequence_in_history_accountsSql,
@@ -817,6 +909,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"23_exp_asset_stats.sql":                       &bintree{migrations23_exp_asset_statsSql, map[string]*bintree{}},
 		"24_accounts.sql":                              &bintree{migrations24_accountsSql, map[string]*bintree{}},
 		"25_expingest_rename_columns.sql":              &bintree{migrations25_expingest_rename_columnsSql, map[string]*bintree{}},
+		"26_trade_aggregations.sql":                    &bintree{migrations26_trade_aggregationsSql, map[string]*bintree{}},
+		"27_history_filters.sql":                       &bintree{migrations27_history_filtersSql, map[string]*bintree{}},
+		"28_trust_lines_by_balance.sql":                &bintree{migrations28_trust_lines_by_balanceSql, map[string]*bintree{}},
+		"29_order_book_samples.sql":                    &bintree{migrations29_order_book_samplesSql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql":             &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql":       &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
 		"4_add_protocol_version.sql":                   &bintree{migrations4_add_protocol_versionSql, map[string]*bintree{}},
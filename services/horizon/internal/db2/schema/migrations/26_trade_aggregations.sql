@@ -0,0 +1,90 @@
+-- +migrate Up
+-- history_trades_aggregations contains the trade aggregations of every asset pair at
+-- the resolutions of history.MaterializedResolutions (1 minute, 1 hour and 1 day), in
+-- the canonical asset order of history_trades. reverse_high and reverse_low are the
+-- extremes of the reversed prices, the price comparisons of both orders may round
+-- differently.
+
+CREATE TABLE history_trades_aggregations (
+    resolution       BIGINT    NOT NULL,
+    base_asset_id    BIGINT    NOT NULL REFERENCES history_assets(id),
+    counter_asset_id BIGINT    NOT NULL REFERENCES history_assets(id),
+    timestamp        BIGINT    NOT NULL,
+    count            BIGINT    NOT NULL,
+    base_volume      NUMERIC   NOT NULL,
+    counter_volume   NUMERIC   NOT NULL,
+    high             NUMERIC[],
+    low              NUMERIC[],
+    reverse_high     NUMERIC[],
+    reverse_low      NUMERIC[],
+    open             BIGINT[],
+    close            BIGINT[],
+    PRIMARY KEY(base_asset_id, counter_asset_id, resolution, timestamp)
+);
+
+CREATE INDEX htrd_agg_by_time ON history_trades_aggregations USING btree (resolution, timestamp);
+
+INSERT INTO history_trades_aggregations (resolution, base_asset_id, counter_asset_id, timestamp, count,
+    base_volume, counter_volume, high, low, reverse_high, reverse_low, open, close)
+SELECT
+    60000, base_asset_id, counter_asset_id, timestamp, count(*),
+    sum(base_amount), sum(counter_amount),
+    max_price(price ORDER BY history_operation_id, "order"),
+    min_price(price ORDER BY history_operation_id, "order"),
+    max_price(reverse_price ORDER BY history_operation_id, "order"),
+    min_price(reverse_price ORDER BY history_operation_id, "order"),
+    first(price ORDER BY history_operation_id, "order"),
+    last(price ORDER BY history_operation_id, "order")
+FROM (
+    SELECT
+        div((cast((extract(epoch from ledger_closed_at) * 1000 ) as bigint) - 0), 60000)*60000 + 0 as timestamp,
+        history_operation_id, "order", base_asset_id, counter_asset_id, base_amount, counter_amount,
+        ARRAY[price_n, price_d] as price, ARRAY[price_d, price_n] as reverse_price
+    FROM history_trades
+) htrd
+GROUP BY base_asset_id, counter_asset_id, timestamp;
+
+INSERT INTO history_trades_aggregations (resolution, base_asset_id, counter_asset_id, timestamp, count,
+    base_volume, counter_volume, high, low, reverse_high, reverse_low, open, close)
+SELECT
+    3600000, base_asset_id, counter_asset_id, timestamp, sum(count),
+    sum(base_volume), sum(counter_volume),
+    max_price(high ORDER BY source_timestamp),
+    min_price(low ORDER BY source_timestamp),
+    max_price(reverse_high ORDER BY source_timestamp),
+    min_price(reverse_low ORDER BY source_timestamp),
+    first(open ORDER BY source_timestamp),
+    last(close ORDER BY source_timestamp)
+FROM (
+    SELECT
+        div((timestamp - 0), 3600000)*3600000 + 0 as timestamp, timestamp as source_timestamp,
+        base_asset_id, counter_asset_id, count, base_volume, counter_volume,
+        high, low, reverse_high, reverse_low, open, close
+    FROM history_trades_aggregations
+    WHERE resolution = 60000
+) hta
+GROUP BY base_asset_id, counter_asset_id, timestamp;
+
+INSERT INTO history_trades_aggregations (resolution, base_asset_id, counter_asset_id, timestamp, count,
+    base_volume, counter_volume, high, low, reverse_high, reverse_low, open, close)
+SELECT
+    86400000, base_asset_id, counter_asset_id, timestamp, sum(count),
+    sum(base_volume), sum(counter_volume),
+    max_price(high ORDER BY source_timestamp),
+    min_price(low ORDER BY source_timestamp),
+    max_price(reverse_high ORDER BY source_timestamp),
+    min_price(reverse_low ORDER BY source_timestamp),
+    first(open ORDER BY source_timestamp),
+    last(close ORDER BY source_timestamp)
+FROM (
+    SELECT
+        div((timestamp - 0), 86400000)*86400000 + 0 as timestamp, timestamp as source_timestamp,
+        base_asset_id, counter_asset_id, count, base_volume, counter_volume,
+        high, low, reverse_high, reverse_low, open, close
+    FROM history_trades_aggregations
+    WHERE resolution = 3600000
+) hta
+GROUP BY base_asset_id, counter_asset_id, timestamp;
+
+-- +migrate Down
+DROP TABLE history_trades_aggregations cascade;
@@ -0,0 +1,30 @@
+-- +migrate Up
+-- assets and amount back the filters of the operations and effects endpoints,
+-- assets lists the assets referenced by the details (see
+-- history.DetailsAssets) and amount is their amount in stroops (see
+-- history.DetailsAmount). They're filled by ingestion: the rows ingested
+-- before this migration are left NULL, and so aren't matched by the asset and
+-- amount filters, until their ledgers are reingested.
+
+ALTER TABLE history_operations ADD assets character varying[];
+ALTER TABLE history_operations ADD amount bigint;
+ALTER TABLE history_effects ADD assets character varying[];
+ALTER TABLE history_effects ADD amount bigint;
+
+CREATE INDEX index_history_operations_on_assets ON history_operations USING gin (assets);
+CREATE INDEX index_history_operations_on_amount ON history_operations USING btree (amount);
+CREATE INDEX index_history_effects_on_assets ON history_effects USING gin (assets);
+CREATE INDEX index_history_effects_on_amount ON history_effects USING btree (amount);
+CREATE INDEX index_history_transactions_on_memo ON history_transactions USING btree (memo);
+
+-- +migrate Down
+DROP INDEX index_history_transactions_on_memo;
+DROP INDEX index_history_effects_on_amount;
+DROP INDEX index_history_effects_on_assets;
+DROP INDEX index_history_operations_on_amount;
+DROP INDEX index_history_operations_on_assets;
+
+ALTER TABLE history_effects DROP COLUMN amount;
+ALTER TABLE history_effects DROP COLUMN assets;
+ALTER TABLE history_operations DROP COLUMN amount;
+ALTER TABLE history_operations DROP COLUMN assets;
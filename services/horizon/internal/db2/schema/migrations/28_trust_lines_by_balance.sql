@@ -0,0 +1,6 @@
+-- +migrate Up
+-- trust_lines_by_asset_balance serves the holders of an asset, sorted by balance.
+CREATE INDEX trust_lines_by_asset_balance ON trust_lines USING BTREE(asset_type, asset_code, asset_issuer, balance, account_id);
+
+-- +migrate Down
+DROP INDEX trust_lines_by_asset_balance;
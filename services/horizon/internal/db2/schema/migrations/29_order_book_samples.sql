@@ -0,0 +1,27 @@
+-- +migrate Up
+-- history_order_book_samples contains the depth of the order books of the asset
+-- pairs sampled by the experimental ingestion system after every ledger. Prices
+-- are in units of the counter asset per unit of the base asset, ask depths are
+-- amounts of the base asset and bid depths amounts of the counter asset offered
+-- within 1% and 5% of the mid price.
+
+CREATE TABLE history_order_book_samples (
+    base_asset_id    BIGINT                      NOT NULL REFERENCES history_assets(id),
+    counter_asset_id BIGINT                      NOT NULL REFERENCES history_assets(id),
+    ledger_sequence  INTEGER                     NOT NULL,
+    closed_at        TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    best_bid         NUMERIC,
+    best_ask         NUMERIC,
+    mid_price        NUMERIC,
+    spread           NUMERIC,
+    bid_depth_1      NUMERIC                     NOT NULL,
+    ask_depth_1      NUMERIC                     NOT NULL,
+    bid_depth_5      NUMERIC                     NOT NULL,
+    ask_depth_5      NUMERIC                     NOT NULL,
+    PRIMARY KEY(base_asset_id, counter_asset_id, ledger_sequence)
+);
+
+CREATE INDEX history_order_book_samples_by_ledger ON history_order_book_samples USING btree (ledger_sequence);
+
+-- +migrate Down
+DROP TABLE history_order_book_samples cascade;
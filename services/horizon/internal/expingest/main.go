@@ -4,6 +4,7 @@
 package expingest
 
 import (
+	"context"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -54,6 +55,9 @@ type Config struct {
 	DisableStateVerification bool
 
 	OrderBookGraph *orderbook.OrderBookGraph
+	// OrderBookPairs are the asset pairs whose order book is sampled in
+	// `history_order_book_samples` after every ledger.
+	OrderBookPairs []AssetPair
 }
 
 type dbQ interface {
@@ -98,6 +102,27 @@ type System struct {
 	stateVerificationMutex   sync.Mutex
 	stateVerificationRunning bool
 	disableStateVerification bool
+	// stateVerificationRequested is true when state verification has been
+	// requested in the admin server, it's run at the next checkpoint ledger
+	// even if disableStateVerification is true.
+	stateVerificationRequested bool
+
+	// resume is not nil when the system is paused and is closed when it's
+	// resumed.
+	pauseLock sync.Mutex
+	resume    chan struct{}
+
+	orderBookPairs []AssetPair
+}
+
+// Status describes the state of the ingestion system, as reported by the
+// admin server.
+type Status struct {
+	Paused                     bool
+	StateReady                 bool
+	StateVerificationRunning   bool
+	StateVerificationDisabled  bool
+	StateVerificationRequested bool
 }
 
 type alwaysRetry struct {
@@ -151,6 +176,7 @@ func NewSystem(config Config) (*System, error) {
 		graph:                    config.OrderBookGraph,
 		retry:                    alwaysRetry{time.Second},
 		disableStateVerification: config.DisableStateVerification,
+		orderBookPairs:           config.OrderBookPairs,
 	}
 
 	addPipelineHooks(
@@ -217,6 +243,9 @@ func (s *System) Run() {
 	// If the state is successfully ingested `resumeFromLedger` method continues
 	// processing ledgers.
 	s.retry.onError(func() error {
+		// Every attempt is a trace of its own, see ilog.StartTrace.
+		ctx := ilog.StartTrace(context.Background())
+
 		// Transaction will be commited or rolled back in pipelines post hooks.
 		err := s.historyQ.Begin()
 		if err != nil {
@@ -244,7 +273,7 @@ func (s *System) Run() {
 			// `LastLedgerExpIngest` value is blocked for update and will always
 			// be updated when leading instance finishes processing state.
 			// In case of errors it will start `Run` from the beginning.
-			log.Info("Starting ingestion system from empty state...")
+			log.Ctx(ctx).Info("Starting ingestion system from empty state...")
 
 			// Clear last_ingested_ledger in key value store
 			if err = s.historyQ.UpdateLastLedgerExpIngest(0); err != nil {
@@ -274,7 +303,7 @@ func (s *System) Run() {
 					return err
 				}
 
-				log.WithFields(ilog.F{
+				log.Ctx(ctx).WithFields(ilog.F{
 					"err":                  err,
 					"last_ingested_ledger": lastIngestedLedger,
 				}).Error("Error running session, resuming from the last ingested ledger")
@@ -283,7 +312,7 @@ func (s *System) Run() {
 			// The other node already ingested a state (just now or in the past)
 			// so we need to get offers from a DB, then resume session normally.
 			// State pipeline is NOT processed.
-			log.WithField("last_ledger", lastIngestedLedger).
+			log.Ctx(ctx).WithField("last_ledger", lastIngestedLedger).
 				Info("Resuming ingestion system from last processed ledger...")
 
 			err = loadOrderBookGraphFromDB(s.historyQ, s.graph, lastIngestedLedger)
@@ -292,7 +321,7 @@ func (s *System) Run() {
 			}
 		}
 
-		s.resumeFromLedger(lastIngestedLedger)
+		s.resumeFromLedger(ctx, lastIngestedLedger)
 		return nil
 	})
 }
@@ -339,7 +368,7 @@ func loadOrderBookGraphFromDB(
 	return err
 }
 
-func (s *System) resumeFromLedger(lastIngestedLedger uint32) {
+func (s *System) resumeFromLedger(ctx context.Context, lastIngestedLedger uint32) {
 	s.retry.onError(func() error {
 		err := s.session.Resume(lastIngestedLedger + 1)
 		if err != nil {
@@ -354,7 +383,7 @@ func (s *System) resumeFromLedger(lastIngestedLedger uint32) {
 			return errors.Wrap(err, "Error returned from ingest.LiveSession")
 		}
 
-		log.Info("Session shut down")
+		log.Ctx(ctx).Info("Session shut down")
 		return nil
 	})
 }
@@ -372,9 +401,78 @@ func (s *System) setStateReady() {
 	s.stateReady = true
 }
 
+// Pause stops the ingestion system before it processes the next ledger,
+// until Resume is called. A ledger being processed is processed to completion.
+func (s *System) Pause() {
+	s.pauseLock.Lock()
+	defer s.pauseLock.Unlock()
+	if s.resume == nil {
+		s.resume = make(chan struct{})
+	}
+}
+
+// Resume allows the ingestion system to process ledgers again.
+func (s *System) Resume() {
+	s.pauseLock.Lock()
+	defer s.pauseLock.Unlock()
+	if s.resume != nil {
+		close(s.resume)
+		s.resume = nil
+	}
+}
+
+// waitWhilePaused blocks until the ingestion system is resumed.
+func (s *System) waitWhilePaused() {
+	s.pauseLock.Lock()
+	resume := s.resume
+	s.pauseLock.Unlock()
+	if resume == nil {
+		return
+	}
+
+	log.Info("Ingestion paused")
+	<-resume
+	log.Info("Ingestion resumed")
+}
+
+// RequestStateVerification makes the ingestion system verify the state at the
+// next checkpoint ledger, even if state verification is disabled.
+func (s *System) RequestStateVerification() {
+	s.stateVerificationMutex.Lock()
+	defer s.stateVerificationMutex.Unlock()
+	s.stateVerificationRequested = true
+}
+
+// shouldVerifyState returns true if the state should be verified at the next
+// checkpoint ledger.
+func (s *System) shouldVerifyState() bool {
+	s.stateVerificationMutex.Lock()
+	defer s.stateVerificationMutex.Unlock()
+	return !s.disableStateVerification || s.stateVerificationRequested
+}
+
+// Status returns the current state of the ingestion system.
+func (s *System) Status() Status {
+	status := Status{StateReady: s.StateReady()}
+
+	s.pauseLock.Lock()
+	status.Paused = s.resume != nil
+	s.pauseLock.Unlock()
+
+	s.stateVerificationMutex.Lock()
+	status.StateVerificationRunning = s.stateVerificationRunning
+	status.StateVerificationDisabled = s.disableStateVerification
+	status.StateVerificationRequested = s.stateVerificationRequested
+	s.stateVerificationMutex.Unlock()
+
+	return status
+}
+
 func (s *System) Shutdown() {
 	log.Info("Shutting down ingestion system...")
 	s.session.Shutdown()
+	// Release a paused pipeline so the session can observe the shutdown.
+	s.Resume()
 }
 
 func createArchive(archiveURL string) (*historyarchive.Archive, error) {
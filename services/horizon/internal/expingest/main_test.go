@@ -2,6 +2,7 @@ package expingest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -14,3 +15,39 @@ func TestCheckVerifyStateVersion(t *testing.T) {
 		"State verifier is outdated, update it, then update stateVerifierExpectedIngestionVersion value",
 	)
 }
+
+func TestPauseResume(t *testing.T) {
+	system := &System{}
+	system.Pause()
+	assert.True(t, system.Status().Paused)
+
+	done := make(chan struct{})
+	go func() {
+		system.waitWhilePaused()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected a paused system to wait")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	system.Resume()
+	<-done
+	assert.False(t, system.Status().Paused)
+
+	// not paused, returns immediately
+	system.waitWhilePaused()
+}
+
+func TestRequestStateVerification(t *testing.T) {
+	system := &System{disableStateVerification: true}
+	assert.False(t, system.shouldVerifyState())
+
+	system.RequestStateVerification()
+	assert.True(t, system.shouldVerifyState())
+	status := system.Status()
+	assert.True(t, status.StateVerificationDisabled)
+	assert.True(t, status.StateVerificationRequested)
+}
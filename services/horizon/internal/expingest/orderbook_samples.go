@@ -0,0 +1,151 @@
+package expingest
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	ilog "github.com/stellar/go/support/log"
+	"github.com/stellar/go/xdr"
+)
+
+// AssetPair is a pair of assets whose order book is sampled after every
+// ledger. Prices are in units of Counter per unit of Base.
+type AssetPair struct {
+	Base    xdr.Asset
+	Counter xdr.Asset
+}
+
+// ParseAssetPairs parses a comma separated list of asset pairs in the format
+// base/counter, where each asset is `native` or `Code:Issuer`.
+func ParseAssetPairs(s string) ([]AssetPair, error) {
+	var pairs []AssetPair
+	if s == "" {
+		return pairs, nil
+	}
+
+	for _, pairString := range strings.Split(s, ",") {
+		parts := strings.Split(pairString, "/")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("%s is not a valid asset pair, expected base/counter", pairString)
+		}
+
+		var pair AssetPair
+		for i, dest := range []*xdr.Asset{&pair.Base, &pair.Counter} {
+			assets, err := xdr.BuildAssets(parts[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid asset pair", pairString)
+			}
+			if len(assets) != 1 {
+				return nil, errors.Errorf("%s is not a valid asset pair, expected base/counter", pairString)
+			}
+			*dest = assets[0]
+		}
+		if pair.Base.Equals(pair.Counter) {
+			return nil, errors.Errorf("%s is not a valid asset pair, base and counter are equal", pairString)
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// sampleOrderBooks records the order books of the sampled pairs after ledger
+// `ledgerSeq` has been applied to the order book graph. Samples are a best
+// effort: errors are logged and do not stop ingestion.
+func (s *System) sampleOrderBooks(historyQ *history.Q, ledgerSeq uint32, closedAt time.Time) {
+	for _, pair := range s.orderBookPairs {
+		// depth within 5% of the mid price can be anywhere in the book so the
+		// price levels are not limited
+		asks, bids, _ := s.graph.FindAsksAndBids(pair.Base, pair.Counter, math.MaxInt32)
+
+		sample := sampleOrderBook(asks, bids)
+		sample.LedgerSequence = ledgerSeq
+		sample.ClosedAt = closedAt
+
+		if err := historyQ.InsertOrderBookSample(pair.Base, pair.Counter, sample); err != nil {
+			log.WithFields(ilog.F{
+				"ledger":  ledgerSeq,
+				"base":    pair.Base.String(),
+				"counter": pair.Counter.String(),
+				"err":     err,
+			}).Error("Error inserting order book sample")
+		}
+	}
+}
+
+// sampleOrderBook summarizes the order book of a pair. `asks` are the offers
+// selling the base asset and `bids` the offers selling the counter asset, both
+// sorted from the best price as returned by OrderBookGraph.FindAsksAndBids.
+// Depths are only measured when both sides of the book have offers.
+func sampleOrderBook(asks, bids []xdr.OfferEntry) history.OrderBookSample {
+	sample := history.OrderBookSample{
+		BidDepth1: "0",
+		AskDepth1: "0",
+		BidDepth5: "0",
+		AskDepth5: "0",
+	}
+
+	var bestAsk, bestBid *big.Rat
+	if len(asks) > 0 {
+		bestAsk = askPrice(asks[0])
+		sample.BestAsk = null.StringFrom(bestAsk.FloatString(7))
+	}
+	if len(bids) > 0 {
+		bestBid = bidPrice(bids[0])
+		sample.BestBid = null.StringFrom(bestBid.FloatString(7))
+	}
+	if bestAsk == nil || bestBid == nil {
+		return sample
+	}
+
+	mid := new(big.Rat).Add(bestAsk, bestBid)
+	mid.Quo(mid, big.NewRat(2, 1))
+	sample.MidPrice = null.StringFrom(mid.FloatString(7))
+	sample.Spread = null.StringFrom(new(big.Rat).Sub(bestAsk, bestBid).FloatString(7))
+
+	sample.BidDepth1, sample.AskDepth1 = depthWithin(asks, bids, mid, 1)
+	sample.BidDepth5, sample.AskDepth5 = depthWithin(asks, bids, mid, 5)
+
+	return sample
+}
+
+// depthWithin returns the amounts offered by bids and asks within `percent`
+// percent of the mid price.
+func depthWithin(asks, bids []xdr.OfferEntry, mid *big.Rat, percent int64) (string, string) {
+	maxAsk := new(big.Rat).Mul(mid, big.NewRat(100+percent, 100))
+	minBid := new(big.Rat).Mul(mid, big.NewRat(100-percent, 100))
+
+	askDepth := new(big.Int)
+	for _, offer := range asks {
+		if askPrice(offer).Cmp(maxAsk) <= 0 {
+			askDepth.Add(askDepth, big.NewInt(int64(offer.Amount)))
+		}
+	}
+
+	bidDepth := new(big.Int)
+	for _, offer := range bids {
+		if bidPrice(offer).Cmp(minBid) >= 0 {
+			bidDepth.Add(bidDepth, big.NewInt(int64(offer.Amount)))
+		}
+	}
+
+	return bidDepth.String(), askDepth.String()
+}
+
+// askPrice returns the price of an offer selling the base asset, in units of
+// the counter asset.
+func askPrice(offer xdr.OfferEntry) *big.Rat {
+	return big.NewRat(int64(offer.Price.N), int64(offer.Price.D))
+}
+
+// bidPrice returns the price of an offer selling the counter asset inverted,
+// in units of the counter asset per unit of the base asset.
+func bidPrice(offer xdr.OfferEntry) *big.Rat {
+	return big.NewRat(int64(offer.Price.D), int64(offer.Price.N))
+}
@@ -0,0 +1,81 @@
+package expingest
+
+import (
+	"testing"
+
+	"github.com/guregu/null"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAssetPairs(t *testing.T) {
+	issuer := "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+	usd := xdr.MustNewCreditAsset("USD", issuer)
+	eur := xdr.MustNewCreditAsset("EUR", issuer)
+
+	pairs, err := ParseAssetPairs("")
+	assert.NoError(t, err)
+	assert.Empty(t, pairs)
+
+	pairs, err = ParseAssetPairs("native/USD:" + issuer + ",EUR:" + issuer + "/USD:" + issuer)
+	assert.NoError(t, err)
+	assert.Equal(t, []AssetPair{
+		{Base: xdr.MustNewNativeAsset(), Counter: usd},
+		{Base: eur, Counter: usd},
+	}, pairs)
+
+	for _, invalid := range []string{
+		"native",
+		"native/USD:" + issuer + "/EUR:" + issuer,
+		"native/USD",
+		"native/native",
+		"native/USD:" + issuer + ",",
+	} {
+		_, err = ParseAssetPairs(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSampleOrderBook(t *testing.T) {
+	offer := func(amount xdr.Int64, n, d xdr.Int32) xdr.OfferEntry {
+		return xdr.OfferEntry{Amount: amount, Price: xdr.Price{N: n, D: d}}
+	}
+
+	empty := sampleOrderBook(nil, nil)
+	assert.Equal(t, history.OrderBookSample{
+		BidDepth1: "0",
+		AskDepth1: "0",
+		BidDepth5: "0",
+		AskDepth5: "0",
+	}, empty)
+
+	asks := []xdr.OfferEntry{
+		offer(100, 101, 100), // 1.01
+		offer(200, 105, 100), // 1.05
+		offer(400, 2, 1),     // 2
+	}
+	oneSided := sampleOrderBook(asks, nil)
+	assert.Equal(t, null.StringFrom("1.0100000"), oneSided.BestAsk)
+	assert.False(t, oneSided.BestBid.Valid)
+	assert.False(t, oneSided.MidPrice.Valid)
+	assert.Equal(t, "0", oneSided.AskDepth5)
+
+	// bids sell the counter asset, their inverted price is in counter per base
+	bids := []xdr.OfferEntry{
+		offer(1000, 100, 99), // 0.99
+		offer(2000, 100, 95), // 0.95
+		offer(4000, 100, 90), // 0.90
+	}
+	sample := sampleOrderBook(asks, bids)
+	assert.Equal(t, history.OrderBookSample{
+		BestBid:   null.StringFrom("0.9900000"),
+		BestAsk:   null.StringFrom("1.0100000"),
+		MidPrice:  null.StringFrom("1.0000000"),
+		Spread:    null.StringFrom("0.0200000"),
+		BidDepth1: "1000",
+		AskDepth1: "100",
+		BidDepth5: "3000",
+		AskDepth5: "300",
+	}, sample)
+}
@@ -3,6 +3,7 @@ package expingest
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/stellar/go/exp/ingest"
 	"github.com/stellar/go/exp/ingest/pipeline"
@@ -135,8 +136,18 @@ func preProcessingHook(
 	system *System,
 	historySession *db.Session,
 ) (context.Context, error) {
+	// Every ledger is processed in a trace of its own.
+	ctx = ilog.StartTrace(ctx)
+
 	historyQ := &history.Q{historySession}
 
+	// Wait while the system is paused in the admin server, but only when
+	// not holding a transaction (and so a lock on the last ingested ledger)
+	// already, to not block other instances.
+	if tx := historySession.GetTx(); tx == nil {
+		system.waitWhilePaused()
+	}
+
 	// Start a transaction only if not in a transaction already.
 	// The only case this can happen is during the first run when
 	// a transaction is started to get the latest ledger `FOR UPDATE`
@@ -182,7 +193,7 @@ func preProcessingHook(
 		historySession.Rollback()
 	}
 
-	log.WithFields(ilog.F{
+	log.Ctx(ctx).WithFields(ilog.F{
 		"ledger":            ledgerSeq,
 		"type":              pipelineType,
 		"updating_database": updateDatabase,
@@ -211,7 +222,7 @@ func postProcessingHook(
 		case verify.StateError:
 			markStateInvalid(historySession, err)
 		default:
-			log.
+			log.Ctx(ctx).
 				WithFields(ilog.F{
 					"ledger": ledgerSeq,
 					"type":   pipelineType,
@@ -259,15 +270,23 @@ func postProcessingHook(
 		return errors.Wrap(err, "Error applying order book changes")
 	}
 
+	// Sample order books on a master ingestion node only, once the ledger
+	// changes are visible in the graph.
+	if system != nil && pipelineType == ledgerPipeline && isMaster && len(system.orderBookPairs) > 0 {
+		header := pipeline.GetLedgerHeaderFromContext(ctx)
+		closedAt := time.Unix(int64(header.Header.ScpValue.CloseTime), 0).UTC()
+		system.sampleOrderBooks(historyQ, ledgerSeq, closedAt)
+	}
+
 	stateInvalid, err := historyQ.GetExpStateInvalid()
 	if err != nil {
-		log.WithField("err", err).Error("Error getting state invalid value")
+		log.Ctx(ctx).WithField("err", err).Error("Error getting state invalid value")
 	}
 
 	// Run verification routine only when...
 	if system != nil && // system is defined (not in tests)...
 		!stateInvalid && // state has not been proved to be invalid...
-		!system.disableStateVerification && // state verification is not disabled...
+		system.shouldVerifyState() && // state verification is not disabled or was requested...
 		pipelineType == ledgerPipeline && // it's a ledger pipeline...
 		isMaster && // it's a master ingestion node (to verify on a single node only)...
 		historyarchive.IsCheckpoint(ledgerSeq) { // it's a checkpoint ledger.
@@ -278,13 +297,13 @@ func postProcessingHook(
 				case verify.StateError:
 					markStateInvalid(historySession, err)
 				default:
-					log.WithField("err", err).Error("State verification errored")
+					log.Ctx(ctx).WithField("err", err).Error("State verification errored")
 				}
 			}
 		}()
 	}
 
-	log.WithFields(ilog.F{"ledger": ledgerSeq, "type": pipelineType}).Info("Processed ledger")
+	log.Ctx(ctx).WithFields(ilog.F{"ledger": ledgerSeq, "type": pipelineType}).Info("Processed ledger")
 	return nil
 }
 
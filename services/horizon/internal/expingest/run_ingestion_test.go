@@ -1,6 +1,7 @@
 package expingest
 
 import (
+	"context"
 	"sort"
 	"testing"
 
@@ -337,7 +338,7 @@ func (s *ResumeIngestionTestSuite) SetupTest() {
 }
 
 func (s *ResumeIngestionTestSuite) TearDownTest() {
-	s.system.resumeFromLedger(1)
+	s.system.resumeFromLedger(context.Background(), 1)
 
 	t := s.T()
 	s.session.AssertExpectations(t)
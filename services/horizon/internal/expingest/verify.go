@@ -39,6 +39,7 @@ func (s *System) verifyState() error {
 		return nil
 	}
 	s.stateVerificationRunning = true
+	s.stateVerificationRequested = false
 	s.stateVerificationMutex.Unlock()
 
 	if stateVerifierExpectedIngestionVersion != CurrentVersion {
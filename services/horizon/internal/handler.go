@@ -83,7 +83,7 @@ func (we *web) streamHandler(jfn interface{}, sfn streamFunc, params interface{}
 			// https://github.com/stellar/go/issues/715 for more details.
 			rateLimiter := we.rateLimiter
 			if rateLimiter != nil {
-				limited, _, err := rateLimiter.RateLimiter.RateLimit(rateLimiter.VaryBy.Key(r), 1)
+				limited, err := rateLimiter.RateLimitStream(r)
 				if err != nil {
 					stream.Err(errors.Wrap(err, "RateLimiter error"))
 					return
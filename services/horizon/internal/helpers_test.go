@@ -11,9 +11,9 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/stellar/go/network"
 	"github.com/stellar/go/services/horizon/internal/actions"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	"github.com/stellar/go/services/horizon/internal/test"
 	supportLog "github.com/stellar/go/support/log"
-	"github.com/stellar/throttled"
 )
 
 func NewTestApp() *App {
@@ -24,10 +24,11 @@ func NewTestConfig() Config {
 	return Config{
 		DatabaseURL:            test.DatabaseURL(),
 		StellarCoreDatabaseURL: test.StellarCoreDatabaseURL(),
-		RateQuota: &throttled.RateQuota{
-			MaxRate:  throttled.PerHour(1000),
+		RateQuota: &ratelimit.Quota{
+			PerHour:  1000,
 			MaxBurst: 100,
 		},
+		RateLimitStreamCost:      1,
 		ConnectionTimeout:        55 * time.Second, // Default
 		LogLevel:                 supportLog.InfoLevel,
 		NetworkPassphrase:        network.TestNetworkPassphrase,
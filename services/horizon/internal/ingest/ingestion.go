@@ -26,6 +26,7 @@ func (ingest *Ingestion) ClearAll() error {
 		string(OperationParticipantsTableName),
 		string(OperationsTableName),
 		string(TradesTableName),
+		string(TradeAggregationsTableName),
 		string(TransactionParticipantsTableName),
 		string(TransactionsTableName),
 	}
@@ -61,6 +62,17 @@ func (ingest *Ingestion) Clear(start int64, end int64) error {
 	if err != nil {
 		return errors.Wrap(err, "Error clearing history_ledgers")
 	}
+	// The aggregations of the cleared trades are rebuilt with the next flush
+	q := history.Q{Session: ingest.DB}
+	var tradesRange history.TradesTimeRange
+	err = q.TradesTimeRangeByOperationID(start, end, &tradesRange)
+	if err != nil {
+		return errors.Wrap(err, "Error loading history_trades time range")
+	}
+	if tradesRange.From != nil {
+		ingest.tradesChanged(tradesRange.From.UTC(), tradesRange.To.UTC())
+	}
+
 	err = clear(start, end, "history_trades", "history_operation_id")
 	if err != nil {
 		return errors.Wrap(err, "Error clearing history_trades")
@@ -76,12 +88,18 @@ func (ingest *Ingestion) Close() error {
 
 // Effect adds a new row into the `history_effects` table.
 func (ingest *Ingestion) Effect(address Address, opid int64, order int, typ history.EffectType, details interface{}) error {
+	detailsMap, ok := details.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("Effect details must be a map[string]interface{}, not a %T", details)
+	}
+
 	djson, err := json.Marshal(details)
 	if err != nil {
 		return errors.Wrap(err, "Error marshaling details")
 	}
 
-	ingest.builders[EffectsTableName].Values(address, opid, order, typ, djson)
+	assets, amount := filterColumns(detailsMap)
+	ingest.builders[EffectsTableName].Values(address, opid, order, typ, djson, assets, amount)
 	return nil
 }
 
@@ -110,6 +128,15 @@ func (ingest *Ingestion) Flush() error {
 		}
 	}
 
+	if !ingest.tradesTo.IsZero() {
+		q := history.Q{Session: ingest.DB}
+		err = q.RebuildTradeAggregations(ingest.tradesFrom, ingest.tradesTo)
+		if err != nil {
+			return errors.Wrap(err, "Error rebuilding trade aggregations")
+		}
+		ingest.tradesFrom, ingest.tradesTo = time.Time{}, time.Time{}
+	}
+
 	err = ingest.commit()
 	if err != nil {
 		return errors.Wrap(err, "ingest.commit error")
@@ -227,10 +254,19 @@ func (ingest *Ingestion) Operation(
 		return errors.Wrap(err, "Error marshaling details")
 	}
 
-	ingest.builders[OperationsTableName].Values(id, txid, order, source.Address(), typ, djson)
+	assets, amount := filterColumns(details)
+	ingest.builders[OperationsTableName].Values(id, txid, order, source.Address(), typ, djson, assets, amount)
 	return nil
 }
 
+// filterColumns returns the values of the `assets` and `amount` columns,
+// which back the filters of the operations and effects endpoints, for the
+// provided details.
+func filterColumns(details map[string]interface{}) (interface{}, null.Int) {
+	amount, ok := history.DetailsAmount(details)
+	return sqx.StringArray(history.DetailsAssets(details)), null.NewInt(int64(amount), ok)
+}
+
 // OperationParticipants ingests the provided accounts `aids` as participants of
 // operation with id `op`, creating a new row in the
 // `history_operation_participants` table.
@@ -242,6 +278,7 @@ func (ingest *Ingestion) OperationParticipants(op int64, aids []xdr.AccountId) {
 
 // Rollback aborts this ingestions transaction
 func (ingest *Ingestion) Rollback() (err error) {
+	ingest.tradesFrom, ingest.tradesTo = time.Time{}, time.Time{}
 	err = ingest.DB.Rollback()
 	return
 }
@@ -300,10 +337,13 @@ func (ingest *Ingestion) Trade(
 			buyerAccountId, boughtAssetId, trade.AmountBought, sellerAccountId, soldAssetId, trade.AmountSold
 	}
 
+	closedAt := time.Unix(ledgerClosedAt, 0).UTC()
+	ingest.tradesChanged(closedAt, closedAt)
+
 	ingest.builders[TradesTableName].Values(
 		opid,
 		order,
-		time.Unix(ledgerClosedAt, 0).UTC(),
+		closedAt,
 		trade.OfferId,
 		baseAccountId,
 		baseAssetId,
@@ -316,6 +356,17 @@ func (ingest *Ingestion) Trade(
 	return nil
 }
 
+// tradesChanged extends the range of trades whose aggregations must be rebuilt
+// when flushing.
+func (ingest *Ingestion) tradesChanged(from, to time.Time) {
+	if ingest.tradesTo.IsZero() || from.Before(ingest.tradesFrom) {
+		ingest.tradesFrom = from
+	}
+	if to.After(ingest.tradesTo) {
+		ingest.tradesTo = to
+	}
+}
+
 // Transaction ingests the provided transaction data into a new row in the
 // `history_transactions` table
 func (ingest *Ingestion) Transaction(
@@ -431,6 +482,8 @@ func (ingest *Ingestion) createInsertBuilders() {
 			"source_account",
 			"type",
 			"details",
+			"assets",
+			"amount",
 		},
 	}
 
@@ -450,6 +503,8 @@ func (ingest *Ingestion) createInsertBuilders() {
 			"\"order\"",
 			"type",
 			"details",
+			"assets",
+			"amount",
 		},
 	}
 
@@ -334,3 +334,9 @@ func TestTradeIngestTimestamp(t *testing.T) {
 
 	tt.Require.Equal(trades[len(trades)-1].LedgerCloseTime, ledgers[len(ledgers)-1].ClosedAt)
 }
+
+func TestEffectDetailsNotAMap(t *testing.T) {
+	ingestion := Ingestion{}
+	err := ingestion.Effect("GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU", 1, 1, history.EffectAccountFlagsUpdated, map[string]bool{"auth_required_flag": true})
+	assert.EqualError(t, err, "Effect details must be a map[string]interface{}, not a map[string]bool")
+}
@@ -5,6 +5,7 @@ package ingest
 
 import (
 	"sync"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	metrics "github.com/rcrowley/go-metrics"
@@ -25,7 +26,7 @@ const (
 	// Scripts, that have yet to be ported to this codebase can then be leveraged
 	// to re-ingest old data with the new algorithm, providing a seamless
 	// transition when the ingested data's structure changes.
-	CurrentVersion = 16
+	CurrentVersion = 17
 )
 
 // Address is a type of a param provided to BatchInsertBuilder that gets exchanged
@@ -43,6 +44,7 @@ const (
 	OperationParticipantsTableName   TableName = "history_operation_participants"
 	OperationsTableName              TableName = "history_operations"
 	TradesTableName                  TableName = "history_trades"
+	TradeAggregationsTableName       TableName = "history_trades_aggregations"
 	TransactionParticipantsTableName TableName = "history_transaction_participants"
 	TransactionsTableName            TableName = "history_transactions"
 )
@@ -137,6 +139,16 @@ type System struct {
 
 	lock    sync.Mutex
 	current *Session
+	paused  bool
+	lastErr error
+}
+
+// Status describes the state of the ingestion system, as reported by the
+// admin server.
+type Status struct {
+	Paused     bool
+	InProgress bool
+	LastError  error
 }
 
 // IngesterMetrics tracks all the metrics for the ingestion subsystem
@@ -173,6 +185,11 @@ type Ingestion struct {
 	// database.
 	DB       *db.Session
 	builders map[TableName]*BatchInsertBuilder
+	// tradesFrom and tradesTo are the close times of the first and last trades
+	// ingested or cleared since the last flush, whose aggregations are rebuilt
+	// when flushing.
+	tradesFrom time.Time
+	tradesTo   time.Time
 }
 
 // Session represents a single attempt at ingesting data into the history
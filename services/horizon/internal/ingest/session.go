@@ -109,7 +109,7 @@ func (is *Session) clearLedger() {
 	}
 }
 
-func (is *Session) effectFlagDetails(flagDetails map[string]bool, flagPtr *xdr.Uint32, setValue bool) {
+func (is *Session) effectFlagDetails(flagDetails map[string]interface{}, flagPtr *xdr.Uint32, setValue bool) {
 	if flagPtr != nil {
 		flags := xdr.AccountFlags(*flagPtr)
 
@@ -256,7 +256,7 @@ func (is *Session) ingestEffects() {
 			effects.Add(source, history.EffectAccountThresholdsUpdated, thresholdDetails)
 		}
 
-		flagDetails := map[string]bool{}
+		flagDetails := map[string]interface{}{}
 		is.effectFlagDetails(flagDetails, op.SetFlags, true)
 		is.effectFlagDetails(flagDetails, op.ClearFlags, false)
 
@@ -618,6 +618,9 @@ func (is *Session) ingestTrades() {
 			is.Err = errors.Wrap(is.Err, "q.InsertTrade error")
 			return
 		}
+
+		closedAt := time.Unix(is.Cursor.Ledger().CloseTime, 0).UTC()
+		is.Ingestion.tradesChanged(closedAt, closedAt)
 	}
 }
 
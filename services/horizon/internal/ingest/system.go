@@ -203,9 +203,15 @@ func (i *System) ReingestSingle(sequence int32) error {
 }
 
 // Tick triggers the ingestion system to ingest any new ledger data, provided
-// that there currently is not an import session in progress.
+// that it is not paused and there currently is not an import session in
+// progress.
 func (i *System) Tick() *Session {
 	i.lock.Lock()
+	if i.paused {
+		log.Debug("ingest: paused")
+		i.lock.Unlock()
+		return nil
+	}
 	if i.current != nil {
 		log.Info("ingest: already in progress")
 		i.lock.Unlock()
@@ -220,6 +226,32 @@ func (i *System) Tick() *Session {
 	return is
 }
 
+// Pause stops the ingestion system from starting new import sessions until
+// Resume is called. An import session in progress runs to completion.
+func (i *System) Pause() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.paused = true
+}
+
+// Resume allows the ingestion system to start import sessions again.
+func (i *System) Resume() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.paused = false
+}
+
+// Status returns the current state of the ingestion system.
+func (i *System) Status() Status {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return Status{
+		Paused:     i.paused,
+		InProgress: i.current != nil,
+		LastError:  i.lastErr,
+	}
+}
+
 // run causes the importer to check stellar-core to see if we can import new
 // data.
 func (i *System) runOnce() {
@@ -300,6 +332,10 @@ func (i *System) runOnce() {
 
 	is.Run()
 
+	i.lock.Lock()
+	i.lastErr = is.Err
+	i.lock.Unlock()
+
 	if is.Err != nil {
 		// We need to use `Error` method because `is.Err` is `withMessage` struct from
 		// `github.com/pkg/errors` and encodes to `{}` in the logs.
@@ -66,3 +66,19 @@ func ensureEmpty(tt *test.T, tableName TableName) {
 	tt.Require.NoError(err)
 	tt.Assert.Equal(0, found)
 }
+
+func TestPause(t *testing.T) {
+	is := &System{}
+	is.Pause()
+	if is.Tick() != nil {
+		t.Fatal("expected a paused system not to start an import session")
+	}
+	if status := is.Status(); !status.Paused || status.InProgress {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	is.Resume()
+	if is.Status().Paused {
+		t.Fatal("expected the system to be resumed")
+	}
+}
@@ -91,6 +91,7 @@ func initExpIngester(app *App, orderBookGraph *orderbook.OrderBookGraph) {
 		OrderBookGraph:           orderBookGraph,
 		TempSet:                  tempSet,
 		DisableStateVerification: app.config.IngestDisableStateVerification,
+		OrderBookPairs:           app.config.OrderBookHistoryPairs,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"context"
+	"crypto/subtle"
 	"database/sql"
 	"net/http"
 	"strings"
@@ -78,7 +79,7 @@ func loggerMiddleware(h http.Handler) http.Handler {
 		ctx := r.Context()
 		mw := newWrapResponseWriter(w, r)
 
-		logger := log.WithField("req", middleware.GetReqID(ctx))
+		logger := log.Ctx(ctx).WithField(log.RequestKey, middleware.GetReqID(ctx))
 		ctx = log.Set(ctx, logger)
 
 		// Checking `Accept` header from user request because if the streaming connection
@@ -253,6 +254,24 @@ func acceptOnlyJSON(h http.Handler) http.Handler {
 	})
 }
 
+// adminAuthMiddleware responds with an error unless the request carries the
+// admin auth token in its `Authorization: Bearer` header.
+func adminAuthMiddleware(token string) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			given := strings.TrimPrefix(header, "Bearer ")
+			if token == "" || given == header ||
+				subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				problem.Render(r.Context(), w, hProblem.Unauthorized)
+				return
+			}
+
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
 // ExperimentalIngestionMiddleware is a middleware which enables a handler
 // if the experimental ingestion system is enabled and initialized.
 // It also ensures that state (ledger entries) has been verified and are
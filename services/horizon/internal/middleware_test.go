@@ -9,10 +9,10 @@ import (
 	"github.com/stellar/go/services/horizon/internal/actions"
 	horizonContext "github.com/stellar/go/services/horizon/internal/context"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	hProblem "github.com/stellar/go/services/horizon/internal/render/problem"
 	"github.com/stellar/go/services/horizon/internal/test"
 	"github.com/stellar/go/support/db"
-	"github.com/stellar/throttled"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -31,8 +31,8 @@ func (suite *RateLimitMiddlewareTestSuite) SetupSuite() {
 
 func (suite *RateLimitMiddlewareTestSuite) SetupTest() {
 	suite.c = NewTestConfig()
-	suite.c.RateQuota = &throttled.RateQuota{
-		MaxRate:  throttled.PerHour(10),
+	suite.c.RateQuota = &ratelimit.Quota{
+		PerHour:  10,
 		MaxBurst: 9,
 	}
 	suite.app = NewApp(suite.c)
@@ -116,6 +116,46 @@ func (suite *RateLimitMiddlewareTestSuite) TestRateLimit_XForwardedFor() {
 	assert.Equal(suite.T(), 429, w.Code)
 }
 
+// Limits requests with a known API key by the quota of the key.
+func (suite *RateLimitMiddlewareTestSuite) TestRateLimit_APIKey() {
+	suite.c.RateLimitVaryBy = []ratelimit.Scope{ratelimit.ScopeAPIKey}
+	suite.c.RateLimitAPIKeyHeader = "X-Api-Key"
+	suite.app.Close()
+	suite.app = NewApp(suite.c)
+	suite.app.web.rateLimiter.APIKeys = ratelimit.APIKeys{
+		"secret": {Name: "partner", Key: "secret", PerHour: 20, Burst: 19},
+	}
+	suite.rh = NewRequestHelper(suite.app)
+
+	withKey := func(r *http.Request) {
+		r.Header.Set("X-Api-Key", "secret")
+	}
+
+	// requests from different IPs share the bucket of the key
+	for i := 0; i < 20; i++ {
+		w := suite.rh.Get("/", withKey, test.RequestHelperRemoteAddr("4.4.4."+strconv.Itoa(i)))
+		assert.Equal(suite.T(), 200, w.Code)
+		assert.Equal(suite.T(), "20", w.Header().Get("X-RateLimit-Limit"))
+	}
+	w := suite.rh.Get("/", withKey, test.RequestHelperRemoteAddr("4.4.4.100"))
+	assert.Equal(suite.T(), 429, w.Code)
+
+	// unknown keys are limited by IP
+	w = suite.rh.Get("/", func(r *http.Request) {
+		r.Header.Set("X-Api-Key", "unknown")
+	})
+	assert.Equal(suite.T(), 200, w.Code)
+	assert.Equal(suite.T(), "10", w.Header().Get("X-RateLimit-Limit"))
+
+	buckets, err := suite.app.web.rateLimiter.Buckets()
+	assert.NoError(suite.T(), err)
+	keys := []string{}
+	for _, bucket := range buckets {
+		keys = append(keys, bucket.Key)
+	}
+	assert.ElementsMatch(suite.T(), []string{"api_key=partner", "ip=127.0.0.1"}, keys)
+}
+
 func TestRateLimitMiddlewareTestSuite(t *testing.T) {
 	suite.Run(t, new(RateLimitMiddlewareTestSuite))
 }
@@ -125,11 +165,12 @@ func TestRateLimit_Redis(t *testing.T) {
 	ht := StartHTTPTest(t, "base")
 	defer ht.Finish()
 	c := NewTestConfig()
-	c.RateQuota = &throttled.RateQuota{
-		MaxRate:  throttled.PerHour(10),
+	c.RateQuota = &ratelimit.Quota{
+		PerHour:  10,
 		MaxBurst: 9,
 	}
 	c.RedisURL = "redis://127.0.0.1:6379/"
+	c.RateLimitRedisKey = "horizon-test-rate-limit"
 	app := NewApp(c)
 	defer app.Close()
 	rh := NewRequestHelper(app)
@@ -212,3 +253,34 @@ func TestRequiresExperimentalIngestion(t *testing.T) {
 	tt.Assert.Equal(http.StatusOK, w.Code)
 	tt.Assert.Equal(w.Header().Get(actions.LastLedgerHeaderName), "")
 }
+
+func TestAdminAuthMiddleware(t *testing.T) {
+	endpoint := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	for _, testCase := range []struct {
+		name          string
+		token         string
+		authorization string
+		expectedCode  int
+	}{
+		{"no header", "secret", "", http.StatusUnauthorized},
+		{"wrong token", "secret", "Bearer public", http.StatusUnauthorized},
+		{"not a bearer token", "secret", "secret", http.StatusUnauthorized},
+		{"no token configured", "", "Bearer ", http.StatusUnauthorized},
+		{"valid token", "secret", "Bearer secret", http.StatusOK},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := adminAuthMiddleware(testCase.token)(http.HandlerFunc(endpoint))
+			request := httptest.NewRequest("GET", "http://localhost/ingestion", nil)
+			if testCase.authorization != "" {
+				request.Header.Set("Authorization", testCase.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, request)
+			assert.Equal(t, testCase.expectedCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/stellar/go/support/render/problem"
+)
+
+// HTTPRateLimiter rate limits http requests.
+type HTTPRateLimiter struct {
+	Limiter *Limiter
+	// Quota limits the requests without a known API key, they are not
+	// limited when it is nil.
+	Quota *Quota
+	// StreamCost is the number of requests each update of a stream counts
+	// for, on top of the request opening it.
+	StreamCost int
+	// VaryBy are the scopes the bucket keys are made of.
+	VaryBy []Scope
+	// APIKeyHeader is the header clients send their API key in.
+	APIKeyHeader string
+	APIKeys      APIKeys
+	// DeniedHandler renders the response of the limited requests.
+	DeniedHandler http.Handler
+}
+
+// RateLimit wraps a handler with a middleware counting each request,
+// reporting the state of its bucket in the X-RateLimit-* headers and denying
+// the request once it is limited.
+func (h *HTTPRateLimiter) RateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, quota, _ := h.bucket(r)
+		if quota == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		limited, result, err := h.Limiter.RateLimit(key, *quota, 1)
+		if err != nil {
+			problem.Render(r.Context(), w, err)
+			return
+		}
+
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil(result.ResetAfter.Seconds()))))
+		if limited {
+			if retryAfter := int(math.Ceil(result.RetryAfter.Seconds())); retryAfter >= 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			}
+			h.DeniedHandler.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RateLimitStream counts an update of the stream served for the request and
+// returns whether it is limited.
+func (h *HTTPRateLimiter) RateLimitStream(r *http.Request) (bool, error) {
+	key, quota, cost := h.bucket(r)
+	if quota == nil || cost == 0 {
+		return false, nil
+	}
+
+	limited, _, err := h.Limiter.RateLimit(key, *quota, cost)
+	return limited, err
+}
+
+// Buckets returns the buckets currently counting requests.
+func (h *HTTPRateLimiter) Buckets() ([]Bucket, error) {
+	return h.Limiter.Store.Buckets()
+}
+
+// bucket returns the key and quota of the bucket of the request, along with
+// the cost of its stream updates.
+func (h *HTTPRateLimiter) bucket(r *http.Request) (string, *Quota, int) {
+	quota, streamCost := h.Quota, h.StreamCost
+
+	var apiKey *APIKey
+	if h.APIKeyHeader != "" {
+		if key, ok := h.APIKeys[r.Header.Get(h.APIKeyHeader)]; ok {
+			apiKey = key
+			quota = key.Quota()
+			if key.StreamCost != 0 {
+				streamCost = key.StreamCost
+			}
+		}
+	}
+
+	return bucketKey(h.VaryBy, r, apiKey), quota, streamCost
+}
@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPRateLimiter(t *testing.T) {
+	store, _ := newTestStore(10)
+	h := &HTTPRateLimiter{
+		Limiter:      &Limiter{Store: store},
+		Quota:        &Quota{PerHour: 10, MaxBurst: 2},
+		StreamCost:   2,
+		VaryBy:       []Scope{ScopeAPIKey},
+		APIKeyHeader: "X-Api-Key",
+		APIKeys: APIKeys{
+			"secret":    {Name: "partner", Key: "secret", PerHour: 60, Burst: 3, StreamCost: 1},
+			"unlimited": {Name: "internal", Key: "unlimited"},
+		},
+		DeniedHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}),
+	}
+	handler := h.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	request := func(apiKey string) *http.Request {
+		r := httptest.NewRequest("GET", "/ledgers", nil)
+		r.RemoteAddr = "4.4.4.4:4312"
+		r.Header.Set("X-Api-Key", apiKey)
+		return r
+	}
+	serve := func(apiKey string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request(apiKey))
+		return w
+	}
+
+	w := serve("")
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "3", w.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "2", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "360", w.Header().Get("X-RateLimit-Reset"))
+
+	// a stream update counts for the stream cost
+	limited, err := h.RateLimitStream(request(""))
+	require.NoError(t, err)
+	assert.False(t, limited)
+	w = serve("")
+	assert.Equal(t, 429, w.Code)
+	assert.Equal(t, "360", w.Header().Get("Retry-After"))
+
+	// known API keys have their own bucket and quota
+	for i := 0; i < 4; i++ {
+		w = serve("secret")
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "4", w.Header().Get("X-RateLimit-Limit"))
+	}
+	limited, err = h.RateLimitStream(request("secret"))
+	require.NoError(t, err)
+	assert.True(t, limited)
+
+	// keys without quota are not limited
+	for i := 0; i < 10; i++ {
+		w = serve("unlimited")
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "", w.Header().Get("X-RateLimit-Limit"))
+	}
+
+	buckets, err := h.Buckets()
+	require.NoError(t, err)
+	keys := []string{}
+	for _, bucket := range buckets {
+		keys = append(keys, bucket.Key)
+	}
+	assert.ElementsMatch(t, []string{"ip=4.4.4.4", "api_key=partner"}, keys)
+}
+
+func TestHTTPRateLimiterAPIKeysBehindOneIP(t *testing.T) {
+	store, _ := newTestStore(10)
+	h := &HTTPRateLimiter{
+		Limiter:      &Limiter{Store: store},
+		Quota:        &Quota{PerHour: 10, MaxBurst: 1},
+		VaryBy:       []Scope{ScopeIP},
+		APIKeyHeader: "X-Api-Key",
+		APIKeys: APIKeys{
+			"secret":       {Name: "partner", Key: "secret", PerHour: 10, Burst: 1},
+			"other secret": {Name: "other", Key: "other secret", PerHour: 10, Burst: 1},
+		},
+		DeniedHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}),
+	}
+	handler := h.RateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(apiKey string) int {
+		r := httptest.NewRequest("GET", "/ledgers", nil)
+		r.RemoteAddr = "4.4.4.4:4312"
+		r.Header.Set("X-Api-Key", apiKey)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// the anonymous requests use up the quota of the IP...
+	assert.Equal(t, 200, serve(""))
+	assert.Equal(t, 200, serve(""))
+	assert.Equal(t, 429, serve(""))
+
+	// ...but not the quotas of the keys sent from it, which don't share a
+	// bucket either.
+	assert.Equal(t, 200, serve("secret"))
+	assert.Equal(t, 200, serve("secret"))
+	assert.Equal(t, 429, serve("secret"))
+	assert.Equal(t, 200, serve("other secret"))
+	assert.Equal(t, 200, serve("other secret"))
+	assert.Equal(t, 429, serve("other secret"))
+
+	buckets, err := h.Buckets()
+	require.NoError(t, err)
+	keys := []string{}
+	for _, bucket := range buckets {
+		keys = append(keys, bucket.Key)
+	}
+	assert.ElementsMatch(t, []string{"ip=4.4.4.4", "api_key=partner", "api_key=other"}, keys)
+}
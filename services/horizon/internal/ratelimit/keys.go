@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/stellar/go/support/config"
+	"github.com/stellar/go/support/errors"
+)
+
+// APIKey is a key sent by clients in a header to be rate limited by its own
+// quota rather than the default one.
+type APIKey struct {
+	// Name identifies the key in the buckets, so the key itself is not
+	// disclosed by them.
+	Name string `toml:"-" valid:"-"`
+	Key  string `toml:"key" valid:"required"`
+	// PerHour is the number of requests allowed in a one hour period, 0 for
+	// no limit.
+	PerHour int `toml:"per_hour" valid:"optional"`
+	// Burst defaults to DefaultMaxBurst.
+	Burst int `toml:"burst" valid:"optional"`
+	// StreamCost is the cost of each update of a stream, it defaults to the
+	// stream cost of the HTTPRateLimiter.
+	StreamCost int `toml:"stream_cost" valid:"optional"`
+}
+
+// Quota returns the quota of the key, nil if it is not limited.
+func (k *APIKey) Quota() *Quota {
+	if k.PerHour <= 0 {
+		return nil
+	}
+	burst := k.Burst
+	if burst == 0 {
+		burst = DefaultMaxBurst
+	}
+	return &Quota{PerHour: k.PerHour, MaxBurst: burst}
+}
+
+// APIKeys maps the keys sent by clients to their APIKey.
+type APIKeys map[string]*APIKey
+
+// LoadAPIKeys reads the API keys from a TOML file with a table per key:
+//
+//	[keys.partner]
+//	key = "..."
+//	per_hour = 36000
+//	burst = 500
+//	stream_cost = 2
+func LoadAPIKeys(path string) (APIKeys, error) {
+	var file struct {
+		Keys map[string]*APIKey `toml:"keys" valid:"required"`
+	}
+	if err := config.Read(path, &file); err != nil {
+		return nil, errors.Wrap(err, "could not read API keys")
+	}
+
+	keys := APIKeys{}
+	for name, key := range file.Keys {
+		if key.Key == "" {
+			return nil, errors.Errorf("API key %s has no key", name)
+		}
+		if _, ok := keys[key.Key]; ok {
+			return nil, errors.Errorf("API key %s is a duplicate", name)
+		}
+		if key.PerHour < 0 || key.Burst < 0 || key.StreamCost < 0 {
+			return nil, errors.Errorf("API key %s has a negative quota", name)
+		}
+		key.Name = name
+		keys[key.Key] = key
+	}
+	return keys, nil
+}
+
+// ParseScopes parses a comma separated list of scopes.
+func ParseScopes(s string) ([]Scope, error) {
+	var scopes []Scope
+	for _, part := range strings.Split(s, ",") {
+		scope := Scope(strings.TrimSpace(part))
+		switch scope {
+		case ScopeIP, ScopeAPIKey, ScopeAccount, ScopeAsset:
+			scopes = append(scopes, scope)
+		case "":
+		default:
+			return nil, errors.Errorf("unknown rate limit scope %q", scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, errors.New("no rate limit scope")
+	}
+	return scopes, nil
+}
+
+// assetParamPrefixes are the prefixes of the asset query parameters of the
+// horizon endpoints.
+var assetParamPrefixes = []string{"", "selling_", "buying_", "base_", "counter_", "source_", "destination_"}
+
+// scopeValue returns the value of the scope for the request, or an empty
+// string if the scope does not apply to it.
+func scopeValue(scope Scope, r *http.Request, apiKey *APIKey) string {
+	switch scope {
+	case ScopeIP:
+		return remoteIP(r)
+	case ScopeAPIKey:
+		if apiKey != nil {
+			return apiKey.Name
+		}
+	case ScopeAccount:
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		for i := 0; i+1 < len(segments); i++ {
+			if segments[i] == "accounts" {
+				return segments[i+1]
+			}
+		}
+	case ScopeAsset:
+		query := r.URL.Query()
+		var assets []string
+		for _, prefix := range assetParamPrefixes {
+			if code := query.Get(prefix + "asset_code"); code != "" {
+				assets = append(assets, code+":"+query.Get(prefix+"asset_issuer"))
+			} else if query.Get(prefix+"asset_type") == "native" {
+				assets = append(assets, "native")
+			}
+		}
+		sort.Strings(assets)
+		return strings.Join(assets, ",")
+	}
+	return ""
+}
+
+// bucketKey joins the scopes which apply to the request. Requests to which
+// none applies are counted by IP. Requests with a known API key are always
+// counted by key: when the API key is not one of the scopes, it replaces the
+// IP so the key doesn't share the bucket of the other requests from its IP.
+func bucketKey(scopes []Scope, r *http.Request, apiKey *APIKey) string {
+	if apiKey != nil && !hasScope(scopes, ScopeAPIKey) {
+		keyScopes := []Scope{ScopeAPIKey}
+		for _, scope := range scopes {
+			if scope != ScopeIP {
+				keyScopes = append(keyScopes, scope)
+			}
+		}
+		scopes = keyScopes
+	}
+
+	var parts []string
+	for _, scope := range scopes {
+		if value := scopeValue(scope, r, apiKey); value != "" {
+			parts = append(parts, string(scope)+"="+value)
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, string(ScopeIP)+"="+remoteIP(r))
+	}
+	return strings.Join(parts, "|")
+}
+
+func hasScope(scopes []Scope, scope Scope) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteIP(r *http.Request) string {
+	// To support IPv6
+	lastSemicolon := strings.LastIndex(r.RemoteAddr, ":")
+	if lastSemicolon == -1 {
+		return r.RemoteAddr
+	}
+	return r.RemoteAddr[0:lastSemicolon]
+}
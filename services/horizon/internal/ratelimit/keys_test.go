@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes("ip")
+	require.NoError(t, err)
+	assert.Equal(t, []Scope{ScopeIP}, scopes)
+
+	scopes, err = ParseScopes("api_key, account,asset")
+	require.NoError(t, err)
+	assert.Equal(t, []Scope{ScopeAPIKey, ScopeAccount, ScopeAsset}, scopes)
+
+	_, err = ParseScopes("ip,cookie")
+	assert.EqualError(t, err, `unknown rate limit scope "cookie"`)
+
+	_, err = ParseScopes("")
+	assert.EqualError(t, err, "no rate limit scope")
+}
+
+func TestBucketKey(t *testing.T) {
+	apiKey := &APIKey{Name: "partner", Key: "secret"}
+	issuer := "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD"
+	account := "GAOQJGUAB7NI7K7I62ORBXMN3J4SSWQUQ7FOEPSDJ322W2HMCNWPHXFB"
+
+	r := httptest.NewRequest("GET", "/accounts/"+account+"/payments", nil)
+	r.RemoteAddr = "4.4.4.4:4312"
+	assert.Equal(t, "ip=4.4.4.4", bucketKey([]Scope{ScopeIP}, r, nil))
+	assert.Equal(t, "api_key=partner", bucketKey([]Scope{ScopeAPIKey}, r, apiKey))
+	assert.Equal(t, "ip=4.4.4.4|api_key=partner", bucketKey([]Scope{ScopeIP, ScopeAPIKey}, r, apiKey))
+	assert.Equal(t, "account="+account, bucketKey([]Scope{ScopeAccount}, r, nil))
+	assert.Equal(t, "api_key=partner|account="+account, bucketKey([]Scope{ScopeAPIKey, ScopeAccount}, r, apiKey))
+
+	// known API keys replace the IP when they are not one of the scopes
+	assert.Equal(t, "api_key=partner", bucketKey([]Scope{ScopeIP}, r, apiKey))
+	assert.Equal(t, "api_key=partner|account="+account, bucketKey([]Scope{ScopeIP, ScopeAccount}, r, apiKey))
+	assert.Equal(t, "api_key=partner", bucketKey([]Scope{ScopeAsset}, r, apiKey))
+
+	// requests to which no scope applies are limited by IP
+	assert.Equal(t, "ip=4.4.4.4", bucketKey([]Scope{ScopeAPIKey, ScopeAsset}, r, nil))
+
+	r = httptest.NewRequest(
+		"GET",
+		"/order_book?selling_asset_type=native&buying_asset_type=credit_alphanum4&buying_asset_code=USD&buying_asset_issuer="+issuer,
+		nil,
+	)
+	assert.Equal(t, "asset=USD:"+issuer+",native", bucketKey([]Scope{ScopeAsset, ScopeAccount}, r, nil))
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	write := func(content string) string {
+		file, err := ioutil.TempFile("", "api-keys")
+		require.NoError(t, err)
+		defer file.Close()
+		_, err = file.WriteString(content)
+		require.NoError(t, err)
+		return file.Name()
+	}
+
+	path := write(`
+[keys.partner]
+key = "secret"
+per_hour = 36000
+burst = 500
+stream_cost = 2
+
+[keys.internal]
+key = "other secret"
+`)
+	defer os.Remove(path)
+
+	keys, err := LoadAPIKeys(path)
+	require.NoError(t, err)
+	assert.Equal(t, APIKeys{
+		"secret":       {Name: "partner", Key: "secret", PerHour: 36000, Burst: 500, StreamCost: 2},
+		"other secret": {Name: "internal", Key: "other secret"},
+	}, keys)
+	assert.Equal(t, &Quota{PerHour: 36000, MaxBurst: 500}, keys["secret"].Quota())
+	assert.Nil(t, keys["other secret"].Quota())
+
+	path = write(`
+[keys.partner]
+key = "secret"
+
+[keys.copy]
+key = "secret"
+`)
+	defer os.Remove(path)
+	_, err = LoadAPIKeys(path)
+	assert.Error(t, err)
+
+	path = write(`
+[keys.partner]
+key = "secret"
+per_hour = -1
+`)
+	defer os.Remove(path)
+	_, err = LoadAPIKeys(path)
+	assert.EqualError(t, err, "API key partner has a negative quota")
+}
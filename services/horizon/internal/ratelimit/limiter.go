@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// maxCASAttempts is the number of times a bucket update is attempted when
+// it races with concurrent requests.
+const maxCASAttempts = 10
+
+// Limiter takes rate limiting decisions with the generic cell rate
+// algorithm, keeping the buckets in a Store.
+type Limiter struct {
+	Store Store
+}
+
+// RateLimit counts quantity requests in the bucket identified by key and
+// returns whether they are limited by the quota.
+func (l *Limiter) RateLimit(key string, quota Quota, quantity int) (bool, Result, error) {
+	emissionInterval := quota.emissionInterval()
+	delayVariationTolerance := emissionInterval * time.Duration(quota.MaxBurst+1)
+	increment := emissionInterval * time.Duration(quantity)
+
+	result := Result{Limit: quota.MaxBurst + 1, RetryAfter: -1}
+	var ttl time.Duration
+	var limited, updated bool
+	for i := 0; i < maxCASAttempts; i++ {
+		value, now, err := l.Store.GetWithTime(key)
+		if err != nil {
+			return false, result, errors.Wrap(err, "could not load bucket")
+		}
+
+		tat := now
+		if value != -1 {
+			tat = time.Unix(0, value)
+		}
+
+		newTat := tat.Add(increment)
+		if now.After(tat) {
+			newTat = now.Add(increment)
+		}
+
+		allowAt := newTat.Add(-delayVariationTolerance)
+		if diff := now.Sub(allowAt); diff < 0 {
+			if increment <= delayVariationTolerance {
+				result.RetryAfter = -diff
+			}
+			ttl = tat.Sub(now)
+			limited = true
+			break
+		}
+
+		ttl = newTat.Sub(now)
+		if value == -1 {
+			updated, err = l.Store.SetIfNotExistsWithTTL(key, newTat.UnixNano(), ttl)
+		} else {
+			updated, err = l.Store.CompareAndSwapWithTTL(key, value, newTat.UnixNano(), ttl)
+		}
+		if err != nil {
+			return false, result, errors.Wrap(err, "could not update bucket")
+		}
+		if updated {
+			break
+		}
+	}
+
+	if !limited && !updated {
+		return false, result, errors.New("could not update bucket, too many concurrent requests")
+	}
+
+	if next := delayVariationTolerance - ttl; next > -emissionInterval {
+		result.Remaining = int(next / emissionInterval)
+	}
+	result.ResetAfter = ttl
+	return limited, result, nil
+}
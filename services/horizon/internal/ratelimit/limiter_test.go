@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(maxKeys int) (*MemoryStore, *time.Time) {
+	now := time.Unix(1500000000, 0)
+	store := NewMemoryStore(maxKeys)
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func TestLimiter(t *testing.T) {
+	store, now := newTestStore(10)
+	limiter := &Limiter{Store: store}
+	quota := Quota{PerHour: 10, MaxBurst: 9}
+
+	for i := 0; i < 10; i++ {
+		limited, result, err := limiter.RateLimit("a", quota, 1)
+		require.NoError(t, err)
+		assert.False(t, limited)
+		assert.Equal(t, 10, result.Limit)
+		assert.Equal(t, 9-i, result.Remaining)
+		assert.Equal(t, time.Duration(i+1)*6*time.Minute, result.ResetAfter)
+		assert.Equal(t, time.Duration(-1), result.RetryAfter)
+	}
+
+	limited, result, err := limiter.RateLimit("a", quota, 1)
+	require.NoError(t, err)
+	assert.True(t, limited)
+	assert.Equal(t, 0, result.Remaining)
+	assert.Equal(t, 6*time.Minute, result.RetryAfter)
+
+	// other buckets are not affected
+	limited, _, err = limiter.RateLimit("b", quota, 1)
+	require.NoError(t, err)
+	assert.False(t, limited)
+
+	// a request is allowed again once its emission interval passed
+	*now = now.Add(6 * time.Minute)
+	limited, result, err = limiter.RateLimit("a", quota, 1)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	assert.Equal(t, 0, result.Remaining)
+
+	// quantities count as many requests
+	limited, result, err = limiter.RateLimit("c", quota, 4)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	assert.Equal(t, 6, result.Remaining)
+	limited, _, err = limiter.RateLimit("c", quota, 7)
+	require.NoError(t, err)
+	assert.True(t, limited)
+}
+
+func TestLimiterConcurrentRequests(t *testing.T) {
+	store, _ := newTestStore(10)
+	limiter := &Limiter{Store: store}
+	quota := Quota{PerHour: 100, MaxBurst: 49}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	allowed := 0
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limited, _, err := limiter.RateLimit("a", quota, 1)
+			assert.NoError(t, err)
+			if !limited {
+				mutex.Lock()
+				allowed++
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 50, allowed)
+}
+
+func TestMemoryStore(t *testing.T) {
+	store, now := newTestStore(2)
+
+	value, storeNow, err := store.GetWithTime("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), value)
+	assert.Equal(t, *now, storeNow)
+
+	ok, err := store.SetIfNotExistsWithTTL("a", 1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = store.SetIfNotExistsWithTTL("a", 2, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = store.CompareAndSwapWithTTL("a", 2, 3, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = store.CompareAndSwapWithTTL("a", 1, 3, 2*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	value, _, err = store.GetWithTime("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), value)
+
+	// the bucket closest to its expiry is evicted when the store is full
+	_, err = store.SetIfNotExistsWithTTL("b", 1, time.Minute)
+	require.NoError(t, err)
+	_, err = store.SetIfNotExistsWithTTL("c", 1, 3*time.Minute)
+	require.NoError(t, err)
+	buckets, err := store.Buckets()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Bucket{
+		{Key: "a", ResetAfter: 2 * time.Minute},
+		{Key: "c", ResetAfter: 3 * time.Minute},
+	}, buckets)
+
+	// expired buckets do not exist
+	*now = now.Add(2 * time.Minute)
+	value, _, err = store.GetWithTime("a")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), value)
+	ok, err = store.CompareAndSwapWithTTL("a", 3, 4, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	buckets, err = store.Buckets()
+	require.NoError(t, err)
+	assert.Equal(t, []Bucket{{Key: "c", ResetAfter: time.Minute}}, buckets)
+}
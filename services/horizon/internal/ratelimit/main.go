@@ -0,0 +1,64 @@
+// Package ratelimit implements the rate limiting of horizon requests. Requests
+// are counted in buckets, following the generic cell rate algorithm, whose keys
+// are derived from the client IP, API key, account or asset the request is
+// about. The buckets are kept in a Store, which is shared by all the horizon
+// instances of a cluster when backed by redis.
+package ratelimit
+
+import (
+	"time"
+)
+
+// DefaultMaxBurst is the number of requests allowed above the rate of a quota
+// which does not configure its burst.
+const DefaultMaxBurst = 100
+
+// Quota is the rate at which requests are allowed in a bucket.
+type Quota struct {
+	// PerHour is the number of requests allowed in a one hour period.
+	PerHour int
+	// MaxBurst is the number of requests allowed to be made at once above
+	// the rate.
+	MaxBurst int
+}
+
+// emissionInterval returns the interval at which a request is allowed.
+func (q Quota) emissionInterval() time.Duration {
+	return time.Hour / time.Duration(q.PerHour)
+}
+
+// Result describes the state of a bucket after a rate limiting decision.
+type Result struct {
+	// Limit is the maximum number of requests which can be made at once.
+	Limit int
+	// Remaining is the number of requests which can be made right away.
+	Remaining int
+	// ResetAfter is the time after which the bucket is back to its
+	// initial state.
+	ResetAfter time.Duration
+	// RetryAfter is the time after which a limited request would be allowed,
+	// -1 when the request was allowed.
+	RetryAfter time.Duration
+}
+
+// Bucket describes a bucket held by a Store.
+type Bucket struct {
+	Key string
+	// ResetAfter is the time after which the bucket expires.
+	ResetAfter time.Duration
+}
+
+// Scope is a component of the key of the bucket a request is counted in.
+type Scope string
+
+const (
+	// ScopeIP keys buckets by the client IP.
+	ScopeIP Scope = "ip"
+	// ScopeAPIKey keys buckets by the API key sent by the client, requests with
+	// a known API key are limited by the quota of that key.
+	ScopeAPIKey Scope = "api_key"
+	// ScopeAccount keys buckets by the account in the path of the request.
+	ScopeAccount Scope = "account"
+	// ScopeAsset keys buckets by the assets in the query of the request.
+	ScopeAsset Scope = "asset"
+)
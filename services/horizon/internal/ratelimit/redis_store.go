@@ -0,0 +1,142 @@
+package ratelimit
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stellar/go/support/errors"
+)
+
+// casScript swaps the value of KEYS[1] to ARGV[2] with a ttl of ARGV[3]
+// milliseconds if it is ARGV[1].
+var casScript = redis.NewScript(1, `
+local v = redis.call('get', KEYS[1])
+if v == false or v ~= ARGV[1] then
+	return 0
+end
+redis.call('set', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// RedisStore is a Store kept in redis, shared by all the horizon instances
+// connected to it.
+type RedisStore struct {
+	pool   *redis.Pool
+	prefix string
+}
+
+// NewRedisStore returns a RedisStore keeping the buckets under the given
+// prefix.
+func NewRedisStore(pool *redis.Pool, prefix string) *RedisStore {
+	return &RedisStore{pool: pool, prefix: prefix + ":"}
+}
+
+// GetWithTime implements Store.
+func (s *RedisStore) GetWithTime(key string) (int64, time.Time, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	if err := conn.Send("TIME"); err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "could not send TIME")
+	}
+	if err := conn.Send("GET", s.prefix+key); err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "could not send GET")
+	}
+	if err := conn.Flush(); err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "could not flush redis connection")
+	}
+
+	timeReply, err := redis.Int64s(conn.Receive())
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "could not read redis time")
+	}
+	if len(timeReply) != 2 {
+		return 0, time.Time{}, errors.Errorf("unexpected redis time reply %v", timeReply)
+	}
+	now := time.Unix(timeReply[0], timeReply[1]*int64(time.Microsecond))
+
+	value, err := redis.Int64(conn.Receive())
+	if err == redis.ErrNil {
+		return -1, now, nil
+	}
+	if err != nil {
+		return 0, time.Time{}, errors.Wrap(err, "could not read bucket")
+	}
+	return value, now, nil
+}
+
+// SetIfNotExistsWithTTL implements Store.
+func (s *RedisStore) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	_, err := redis.String(conn.Do("SET", s.prefix+key, value, "PX", ttlMillis(ttl), "NX"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "could not set bucket")
+	}
+	return true, nil
+}
+
+// CompareAndSwapWithTTL implements Store.
+func (s *RedisStore) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	swapped, err := redis.Bool(casScript.Do(conn, s.prefix+key, old, new, ttlMillis(ttl)))
+	if err != nil {
+		return false, errors.Wrap(err, "could not swap bucket")
+	}
+	return swapped, nil
+}
+
+// Buckets implements Store.
+func (s *RedisStore) Buckets() ([]Bucket, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	var buckets []Bucket
+	cursor := "0"
+	for {
+		reply, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", s.prefix+"*", "COUNT", 1000))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not scan buckets")
+		}
+		var keys []string
+		if _, err = redis.Scan(reply, &cursor, &keys); err != nil {
+			return nil, errors.Wrap(err, "could not read scanned buckets")
+		}
+
+		for _, key := range keys {
+			pttl, err := redis.Int64(conn.Do("PTTL", key))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read bucket ttl")
+			}
+			// the bucket expired since it was scanned
+			if pttl < 0 {
+				continue
+			}
+			buckets = append(buckets, Bucket{
+				Key:        strings.TrimPrefix(key, s.prefix),
+				ResetAfter: time.Duration(pttl) * time.Millisecond,
+			})
+		}
+
+		if cursor == "0" {
+			return buckets, nil
+		}
+	}
+}
+
+// ttlMillis rounds ttl up to the millisecond, redis does not accept a ttl
+// of zero.
+func ttlMillis(ttl time.Duration) int64 {
+	millis := int64((ttl + time.Millisecond - 1) / time.Millisecond)
+	if millis < 1 {
+		return 1
+	}
+	return millis
+}
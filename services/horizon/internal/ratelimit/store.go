@@ -0,0 +1,129 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Store holds the theoretical arrival time of the next request of each
+// bucket, in nanoseconds since epoch. The time of the store is used for the
+// rate limiting decisions so the horizon instances sharing a store agree on
+// them.
+type Store interface {
+	// GetWithTime returns the value of the bucket, or -1 if it does not
+	// exist, along with the current time of the store.
+	GetWithTime(key string) (int64, time.Time, error)
+	// SetIfNotExistsWithTTL sets the value of a bucket which does not exist
+	// and returns whether it did.
+	SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error)
+	// CompareAndSwapWithTTL sets the value of a bucket whose value is old and
+	// returns whether it was.
+	CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error)
+	// Buckets returns the buckets which did not expire yet.
+	Buckets() ([]Bucket, error)
+}
+
+// MemoryStore is a Store kept in memory, for a single horizon instance.
+type MemoryStore struct {
+	maxKeys int
+	now     func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]memoryEntry
+}
+
+type memoryEntry struct {
+	value   int64
+	expires time.Time
+}
+
+// NewMemoryStore returns a MemoryStore holding up to maxKeys buckets. The
+// buckets closest to their expiry are evicted when it is full.
+func NewMemoryStore(maxKeys int) *MemoryStore {
+	return &MemoryStore{
+		maxKeys: maxKeys,
+		now:     time.Now,
+		entries: map[string]memoryEntry{},
+	}
+}
+
+// GetWithTime implements Store.
+func (s *MemoryStore) GetWithTime(key string) (int64, time.Time, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	entry, ok := s.entries[key]
+	if !ok || !entry.expires.After(now) {
+		return -1, now, nil
+	}
+	return entry.value, now, nil
+}
+
+// SetIfNotExistsWithTTL implements Store.
+func (s *MemoryStore) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	if entry, ok := s.entries[key]; ok && entry.expires.After(now) {
+		return false, nil
+	}
+	s.set(key, value, now, ttl)
+	return true, nil
+}
+
+// CompareAndSwapWithTTL implements Store.
+func (s *MemoryStore) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	entry, ok := s.entries[key]
+	if !ok || !entry.expires.After(now) || entry.value != old {
+		return false, nil
+	}
+	s.set(key, new, now, ttl)
+	return true, nil
+}
+
+// Buckets implements Store.
+func (s *MemoryStore) Buckets() ([]Bucket, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	buckets := make([]Bucket, 0, len(s.entries))
+	for key, entry := range s.entries {
+		if entry.expires.After(now) {
+			buckets = append(buckets, Bucket{Key: key, ResetAfter: entry.expires.Sub(now)})
+		}
+	}
+	return buckets, nil
+}
+
+func (s *MemoryStore) set(key string, value int64, now time.Time, ttl time.Duration) {
+	if _, ok := s.entries[key]; !ok && s.maxKeys > 0 && len(s.entries) >= s.maxKeys {
+		s.evict(now)
+	}
+	s.entries[key] = memoryEntry{value: value, expires: now.Add(ttl)}
+}
+
+// evict removes the expired entries, or the entry closest to its expiry if
+// none expired.
+func (s *MemoryStore) evict(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range s.entries {
+		if !entry.expires.After(now) {
+			delete(s.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expires.Before(oldest) {
+			oldestKey, oldest = key, entry.expires
+		}
+	}
+	if len(s.entries) >= s.maxKeys {
+		delete(s.entries, oldestKey)
+	}
+}
@@ -5,17 +5,53 @@
 package reap
 
 import (
+	"sync"
 	"time"
 
 	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/errors"
 )
 
+var (
+	// ErrPaused is returned by DeleteRange when the reaper is paused.
+	ErrPaused = errors.New("reaper is paused")
+	// ErrRunning is returned by DeleteRange when the reaper is already
+	// removing history.
+	ErrRunning = errors.New("reaper is running")
+)
+
+// InvalidRangeError is returned by DeleteRange for the ranges it doesn't
+// remove.
+type InvalidRangeError struct {
+	// Field is the invalid bound of the range, "start" or "end".
+	Field  string
+	Reason string
+}
+
+func (e *InvalidRangeError) Error() string {
+	return "invalid ledger range: " + e.Field + " " + e.Reason
+}
+
 // System represents the history reaping subsystem of horizon.
 type System struct {
 	HorizonDB      *db.Session
 	RetentionCount uint
 
+	lock    sync.Mutex
 	nextRun time.Time
+	paused  bool
+	running bool
+	lastRun time.Time
+	lastErr error
+}
+
+// Status describes the state of the reaper, as reported by the admin server.
+type Status struct {
+	RetentionCount uint
+	Paused         bool
+	NextRun        time.Time
+	LastRun        time.Time
+	LastError      error
 }
 
 // New initializes the reaper, causing it to begin polling the stellar-core
@@ -1,9 +1,10 @@
 package reap
 
 import (
+	"fmt"
 	"time"
 
-	"github.com/stellar/go/services/horizon/internal/errors"
+	herr "github.com/stellar/go/services/horizon/internal/errors"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/toid"
 	"github.com/stellar/go/support/log"
@@ -37,59 +38,170 @@ func (r *System) DeleteUnretainedHistory() error {
 	return nil
 }
 
+// DeleteRange removes all data associated with the ledgers from `start` to
+// `end`, inclusive. The range must start at the elder ledger, so the history
+// stays contiguous, and end before the ledgers retained by the reaper and the
+// latest ingested ledger. It fails with ErrPaused if the reaper is paused and
+// ErrRunning if it is already removing history.
+func (r *System) DeleteRange(start, end int32) error {
+	if err := r.start(); err != nil {
+		return err
+	}
+	defer r.stop()
+
+	latest := ledger.CurrentState()
+	if start != latest.HistoryElder {
+		return &InvalidRangeError{
+			Field:  "start",
+			Reason: fmt.Sprintf("must be the elder ledger %d", latest.HistoryElder),
+		}
+	}
+	last := latest.HistoryLatest - 1
+	if r.RetentionCount > 0 {
+		if unretained := latest.HistoryLatest - int32(r.RetentionCount); unretained < last {
+			last = unretained
+		}
+	}
+	if end < start || end > last {
+		return &InvalidRangeError{
+			Field:  "end",
+			Reason: fmt.Sprintf("must be between %d and %d", start, last),
+		}
+	}
+
+	log.
+		WithField("start", start).
+		WithField("end", end).
+		Info("reaper: clearing range")
+
+	return r.clearRange(start, end+1)
+}
+
+// Pause stops the reaper from deleting unretained history until Resume is
+// called.
+func (r *System) Pause() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.paused = true
+}
+
+// Resume allows the reaper to delete unretained history again.
+func (r *System) Resume() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.paused = false
+}
+
+// Status returns the current state of the reaper.
+func (r *System) Status() Status {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return Status{
+		RetentionCount: r.RetentionCount,
+		Paused:         r.paused,
+		NextRun:        r.nextRun,
+		LastRun:        r.lastRun,
+		LastError:      r.lastErr,
+	}
+}
+
 // Tick triggers the reaper system to update itself, deleted unretained history
-// if it is the appropriate time.
+// if it is the appropriate time and the reaper is not paused.
 func (r *System) Tick() {
-	if time.Now().Before(r.nextRun) {
+	r.lock.Lock()
+	skip := r.paused || r.running || time.Now().Before(r.nextRun)
+	if !skip {
+		r.running = true
+	}
+	r.lock.Unlock()
+	if skip {
 		return
 	}
 
-	r.runOnce()
+	err := r.runOnce()
+
+	r.lock.Lock()
+	r.running = false
+	r.lastRun = time.Now()
+	r.lastErr = err
 	r.nextRun = time.Now().Add(1 * time.Hour)
+	r.lock.Unlock()
 }
 
-func (r *System) runOnce() {
+// start marks the reaper as removing history, unless it is paused or already
+// doing so.
+func (r *System) start() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.paused {
+		return ErrPaused
+	}
+	if r.running {
+		return ErrRunning
+	}
+	r.running = true
+	return nil
+}
+
+func (r *System) stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.running = false
+}
+
+func (r *System) runOnce() (err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
-			err := errors.FromPanic(rec)
+			err = herr.FromPanic(rec)
 			log.Errorf("reaper panicked: %s", err)
-			errors.ReportToSentry(err, nil)
+			herr.ReportToSentry(err, nil)
 		}
 	}()
 
-	err := r.DeleteUnretainedHistory()
+	err = r.DeleteUnretainedHistory()
 	if err != nil {
 		log.Errorf("reaper failed: %s", err)
 	}
+	return err
 }
 
 func (r *System) clearBefore(seq int32) error {
 	log.WithField("new_elder", seq).Info("reaper: clearing")
+	return r.clearRange(0, seq)
+}
 
+// clearRange removes the data of the ledgers from `startSeq`, inclusive, to
+// `endSeq`, exclusive.
+func (r *System) clearRange(startSeq, endSeq int32) error {
 	clear := r.HorizonDB.DeleteRange
-	end := toid.New(seq, 0, 0).ToInt64()
+	start := toid.New(startSeq, 0, 0).ToInt64()
+	end := toid.New(endSeq, 0, 0).ToInt64()
 
-	err := clear(0, end, "history_effects", "history_operation_id")
+	err := clear(start, end, "history_effects", "history_operation_id")
+	if err != nil {
+		return err
+	}
+	err = clear(start, end, "history_operation_participants", "history_operation_id")
 	if err != nil {
 		return err
 	}
-	err = clear(0, end, "history_operation_participants", "history_operation_id")
+	err = clear(start, end, "history_operations", "id")
 	if err != nil {
 		return err
 	}
-	err = clear(0, end, "history_operations", "id")
+	err = clear(start, end, "history_transaction_participants", "history_transaction_id")
 	if err != nil {
 		return err
 	}
-	err = clear(0, end, "history_transaction_participants", "history_transaction_id")
+	err = clear(start, end, "history_transactions", "id")
 	if err != nil {
 		return err
 	}
-	err = clear(0, end, "history_transactions", "id")
+	err = clear(start, end, "history_ledgers", "id")
 	if err != nil {
 		return err
 	}
-	err = clear(0, end, "history_ledgers", "id")
+	err = clear(int64(startSeq), int64(endSeq), "history_order_book_samples", "ledger_sequence")
 	if err != nil {
 		return err
 	}
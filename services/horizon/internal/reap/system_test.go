@@ -2,13 +2,22 @@ package reap
 
 import (
 	"testing"
+	"time"
 
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/db2/schema"
+	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestDeleteUnretainedHistory(t *testing.T) {
 	tt := test.Start(t).Scenario("kahuna")
 	defer tt.Finish()
+	// the scenario predates history_order_book_samples
+	_, err := schema.Migrate(tt.HorizonDB.DB, schema.MigrateUp, 0)
+	tt.Require.NoError(err)
 
 	db := tt.HorizonSession()
 
@@ -18,7 +27,7 @@ func TestDeleteUnretainedHistory(t *testing.T) {
 		prev int
 		cur  int
 	)
-	err := db.GetRaw(&prev, `SELECT COUNT(*) FROM history_ledgers`)
+	err = db.GetRaw(&prev, `SELECT COUNT(*) FROM history_ledgers`)
 	tt.Require.NoError(err)
 
 	err = sys.DeleteUnretainedHistory()
@@ -29,12 +38,35 @@ func TestDeleteUnretainedHistory(t *testing.T) {
 	}
 
 	tt.UpdateLedgerState()
+
+	// order book samples are reaped along with the ledgers they were taken at
+	q := &history.Q{db}
+	for _, seq := range []uint32{1, uint32(ledger.CurrentState().HistoryLatest)} {
+		err = q.InsertOrderBookSample(
+			xdr.MustNewNativeAsset(),
+			xdr.MustNewCreditAsset("USD", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD"),
+			history.OrderBookSample{
+				LedgerSequence: seq,
+				ClosedAt:       time.Now(),
+				BidDepth1:      "0",
+				AskDepth1:      "0",
+				BidDepth5:      "0",
+				AskDepth5:      "0",
+			},
+		)
+		tt.Require.NoError(err)
+	}
+
 	sys.RetentionCount = 10
 	err = sys.DeleteUnretainedHistory()
 	if tt.Assert.NoError(err) {
 		err = db.GetRaw(&cur, `SELECT COUNT(*) FROM history_ledgers`)
 		tt.Require.NoError(err)
 		tt.Assert.Equal(10, cur)
+
+		err = db.GetRaw(&cur, `SELECT COUNT(*) FROM history_order_book_samples`)
+		tt.Require.NoError(err)
+		tt.Assert.Equal(1, cur)
 	}
 
 	tt.UpdateLedgerState()
@@ -46,3 +78,68 @@ func TestDeleteUnretainedHistory(t *testing.T) {
 		tt.Assert.Equal(1, cur)
 	}
 }
+
+func TestDeleteRange(t *testing.T) {
+	tt := test.Start(t).Scenario("kahuna")
+	defer tt.Finish()
+	db := tt.HorizonSession()
+	sys := New(0, db)
+	tt.UpdateLedgerState()
+	state := ledger.CurrentState()
+	tt.Require.Equal(int32(1), state.HistoryElder)
+
+	// the range must start at the elder ledger and end before the latest one
+	tt.Assert.IsType(&InvalidRangeError{}, sys.DeleteRange(2, 4))
+	tt.Assert.IsType(&InvalidRangeError{}, sys.DeleteRange(1, state.HistoryLatest))
+	// nor reach the retained ledgers
+	sys.RetentionCount = uint(state.HistoryLatest) - 2
+	tt.Assert.IsType(&InvalidRangeError{}, sys.DeleteRange(1, 3))
+	sys.RetentionCount = 0
+
+	var prev, cur int
+	err := db.GetRaw(&prev, `SELECT COUNT(*) FROM history_ledgers`)
+	tt.Require.NoError(err)
+
+	err = sys.DeleteRange(1, 3)
+	if tt.Assert.NoError(err) {
+		err = db.GetRaw(&cur, `SELECT COUNT(*) FROM history_ledgers`)
+		tt.Require.NoError(err)
+		tt.Assert.Equal(prev-3, cur)
+
+		err = db.GetRaw(&cur, `SELECT COUNT(*) FROM history_ledgers WHERE sequence BETWEEN 1 AND 3`)
+		tt.Require.NoError(err)
+		tt.Assert.Equal(0, cur)
+	}
+}
+
+func TestDeleteRangeBusy(t *testing.T) {
+	sys := New(0, nil)
+
+	sys.Pause()
+	assert.Equal(t, ErrPaused, sys.DeleteRange(1, 2))
+	sys.Resume()
+
+	sys.running = true
+	assert.Equal(t, ErrRunning, sys.DeleteRange(1, 2))
+	sys.running = false
+
+	// a range the reaper doesn't remove leaves it idle
+	assert.IsType(t, &InvalidRangeError{}, sys.DeleteRange(1, 2))
+	assert.False(t, sys.running)
+}
+
+func TestPause(t *testing.T) {
+	sys := New(1, nil)
+	sys.nextRun = time.Now().Add(-time.Minute)
+	sys.Pause()
+	// a paused reaper never touches the database
+	sys.Tick()
+
+	status := sys.Status()
+	assert.True(t, status.Paused)
+	assert.True(t, status.LastRun.IsZero())
+	assert.Equal(t, uint(1), status.RetentionCount)
+
+	sys.Resume()
+	assert.False(t, sys.Status().Paused)
+}
@@ -104,4 +104,15 @@ var (
 		Detail: "Data cannot be presented because it's still being ingested. Please " +
 			"wait for several minutes before trying your request again.",
 	}
+
+	// Unauthorized is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	Unauthorized = problem.P{
+		Type:   "unauthorized",
+		Title:  "Unauthorized",
+		Status: http.StatusUnauthorized,
+		Detail: "The request is missing valid credentials.  Requests to the admin " +
+			"server must send the admin auth token in an 'Authorization: Bearer' " +
+			"header.",
+	}
 )
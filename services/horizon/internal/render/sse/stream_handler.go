@@ -4,13 +4,13 @@ import (
 	"net/http"
 
 	"github.com/stellar/go/services/horizon/internal/ledger"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	"github.com/stellar/go/support/errors"
-	"github.com/stellar/throttled"
 )
 
 // StreamHandler represents a stream handling action
 type StreamHandler struct {
-	RateLimiter  *throttled.HTTPRateLimiter
+	RateLimiter  *ratelimit.HTTPRateLimiter
 	LedgerSource ledger.Source
 }
 
@@ -36,7 +36,7 @@ func (handler StreamHandler) ServeStream(
 		// https://github.com/stellar/go/issues/715 for more details.
 		rateLimiter := handler.RateLimiter
 		if rateLimiter != nil {
-			limited, _, err := rateLimiter.RateLimiter.RateLimit(rateLimiter.VaryBy.Key(r), 1)
+			limited, err := rateLimiter.RateLimitStream(r)
 			if err != nil {
 				stream.Err(errors.Wrap(err, "RateLimiter error"))
 				return
@@ -0,0 +1,90 @@
+package resourceadapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stellar/go/amount"
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/httpx"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
+)
+
+// PopulateAssetHolder fills out the resource's fields
+func PopulateAssetHolder(
+	ctx context.Context,
+	dest *protocol.AssetHolder,
+	row history.TrustLine,
+) {
+	dest.PT = row.HolderPagingToken()
+	dest.AccountID = row.AccountID
+	dest.Balance = amount.StringFromInt64(row.Balance)
+	dest.Limit = amount.StringFromInt64(row.Limit)
+	dest.BuyingLiabilities = amount.StringFromInt64(row.BuyingLiabilities)
+	dest.SellingLiabilities = amount.StringFromInt64(row.SellingLiabilities)
+	dest.IsAuthorized = row.IsAuthorized()
+	dest.LastModifiedLedger = row.LastModifiedLedger
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dest.Links.Account = lb.Link(fmt.Sprintf("/accounts/%s", row.AccountID))
+}
+
+// PopulateAssetDistribution fills out the resource's fields
+func PopulateAssetDistribution(
+	ctx context.Context,
+	dest *protocol.AssetDistribution,
+	asset xdr.Asset,
+	row history.AssetDistribution,
+) (err error) {
+	if err = asset.Extract(&dest.Asset.Type, &dest.Asset.Code, &dest.Asset.Issuer); err != nil {
+		return errors.Wrap(err, "Invalid asset in PopulateAssetDistribution")
+	}
+
+	dest.NumHolders = row.NumAuthorized + row.NumUnauthorized
+	dest.NumAuthorized = row.NumAuthorized
+	dest.NumUnauthorized = row.NumUnauthorized
+	if dest.Amount, err = amount.IntStringToAmount(row.Amount); err != nil {
+		return errors.Wrap(err, "Invalid amount in PopulateAssetDistribution")
+	}
+	if dest.AuthorizedAmount, err = amount.IntStringToAmount(row.AuthorizedAmount); err != nil {
+		return errors.Wrap(err, "Invalid amount in PopulateAssetDistribution")
+	}
+	if dest.UnauthorizedAmount, err = amount.IntStringToAmount(row.UnauthorizedAmount); err != nil {
+		return errors.Wrap(err, "Invalid amount in PopulateAssetDistribution")
+	}
+
+	dest.Histogram = make([]protocol.AssetBalanceBucket, 0, len(row.Histogram))
+	for _, bucket := range row.Histogram {
+		res := protocol.AssetBalanceBucket{NumHolders: bucket.Count}
+		res.MinBalance, res.MaxBalance = "0.0000000", "0.0000001"
+		if bucket.Decade >= 0 {
+			res.MinBalance, err = amount.IntStringToAmount(powerOfTen(bucket.Decade))
+			if err != nil {
+				return errors.Wrap(err, "Invalid bucket in PopulateAssetDistribution")
+			}
+			res.MaxBalance, err = amount.IntStringToAmount(powerOfTen(bucket.Decade + 1))
+			if err != nil {
+				return errors.Wrap(err, "Invalid bucket in PopulateAssetDistribution")
+			}
+		}
+		if res.Amount, err = amount.IntStringToAmount(bucket.Amount); err != nil {
+			return errors.Wrap(err, "Invalid amount in PopulateAssetDistribution")
+		}
+		dest.Histogram = append(dest.Histogram, res)
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	path := fmt.Sprintf("/assets/%s:%s", dest.Asset.Code, dest.Asset.Issuer)
+	dest.Links.Self = lb.Link(path, "distribution")
+	dest.Links.Holders = lb.PagedLink(path, "holders")
+	return nil
+}
+
+// powerOfTen returns 10^exp as an integer string, which may exceed int64.
+func powerOfTen(exp int32) string {
+	return "1" + strings.Repeat("0", int(exp))
+}
@@ -0,0 +1,44 @@
+package resourceadapter
+
+import (
+	"context"
+	"testing"
+
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPopulateAssetDistribution(t *testing.T) {
+	issuer := "GBZ35ZJRIKJGYH5PBKLKOZ5L6EXCNTO7BKIL7DAVVDFQ2ODJEEHHJXIM"
+	row := history.AssetDistribution{
+		NumAuthorized:      3,
+		NumUnauthorized:    1,
+		Amount:             "9223372036854775817",
+		AuthorizedAmount:   "9223372036854775817",
+		UnauthorizedAmount: "0",
+		Histogram: []history.AssetBalanceBucket{
+			{Decade: -1, Count: 1, Amount: "0"},
+			{Decade: 0, Count: 2, Amount: "10"},
+			{Decade: 18, Count: 1, Amount: "9223372036854775807"},
+		},
+	}
+
+	var res protocol.AssetDistribution
+	err := PopulateAssetDistribution(context.Background(), &res, xdr.MustNewCreditAsset("XIM", issuer), row)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "credit_alphanum4", res.Type)
+	assert.Equal(t, "XIM", res.Code)
+	assert.Equal(t, issuer, res.Issuer)
+	assert.Equal(t, int32(4), res.NumHolders)
+	assert.Equal(t, "922337203685.4775817", res.Amount)
+	assert.Equal(t, "0.0000000", res.UnauthorizedAmount)
+	assert.Equal(t, []protocol.AssetBalanceBucket{
+		{MinBalance: "0.0000000", MaxBalance: "0.0000001", NumHolders: 1, Amount: "0.0000000"},
+		{MinBalance: "0.0000001", MaxBalance: "0.0000010", NumHolders: 2, Amount: "0.0000010"},
+		{MinBalance: "100000000000.0000000", MaxBalance: "1000000000000.0000000", NumHolders: 1, Amount: "922337203685.4775807"},
+	}, res.Histogram)
+	assert.Equal(t, "/assets/XIM:"+issuer+"/holders{?cursor,limit,order}", res.Links.Holders.Href)
+}
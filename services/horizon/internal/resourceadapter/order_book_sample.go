@@ -0,0 +1,49 @@
+package resourceadapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stellar/go/amount"
+	protocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/httpx"
+	"github.com/stellar/go/support/render/hal"
+)
+
+// PopulateOrderBookSample fills out the resource's fields
+func PopulateOrderBookSample(
+	ctx context.Context,
+	dest *protocol.OrderBookSample,
+	row history.OrderBookSample,
+) error {
+	dest.PT = row.PagingToken()
+	dest.LedgerSequence = row.LedgerSequence
+	dest.ClosedAt = row.ClosedAt
+	dest.BestBid = row.BestBid.Ptr()
+	dest.BestAsk = row.BestAsk.Ptr()
+	dest.MidPrice = row.MidPrice.Ptr()
+	dest.Spread = row.Spread.Ptr()
+
+	var err error
+	dest.BidDepth1, err = amount.IntStringToAmount(row.BidDepth1)
+	if err != nil {
+		return err
+	}
+	dest.AskDepth1, err = amount.IntStringToAmount(row.AskDepth1)
+	if err != nil {
+		return err
+	}
+	dest.BidDepth5, err = amount.IntStringToAmount(row.BidDepth5)
+	if err != nil {
+		return err
+	}
+	dest.AskDepth5, err = amount.IntStringToAmount(row.AskDepth5)
+	if err != nil {
+		return err
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dest.Links.Ledger = lb.Link(fmt.Sprintf("/ledgers/%d", row.LedgerSequence))
+	return nil
+}
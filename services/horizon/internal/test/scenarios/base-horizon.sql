@@ -14,12 +14,17 @@ SET client_min_messages = warning;
 
 SET search_path = public, pg_catalog;
 
+ALTER TABLE IF EXISTS ONLY public.history_order_book_samples DROP CONSTRAINT IF EXISTS history_order_book_samples_counter_asset_id_fkey;
+ALTER TABLE IF EXISTS ONLY public.history_order_book_samples DROP CONSTRAINT IF EXISTS history_order_book_samples_base_asset_id_fkey;
+ALTER TABLE IF EXISTS ONLY public.history_trades_aggregations DROP CONSTRAINT IF EXISTS history_trades_aggregations_counter_asset_id_fkey;
+ALTER TABLE IF EXISTS ONLY public.history_trades_aggregations DROP CONSTRAINT IF EXISTS history_trades_aggregations_base_asset_id_fkey;
 ALTER TABLE IF EXISTS ONLY public.history_trades DROP CONSTRAINT IF EXISTS history_trades_counter_asset_id_fkey;
 ALTER TABLE IF EXISTS ONLY public.history_trades DROP CONSTRAINT IF EXISTS history_trades_counter_account_id_fkey;
 ALTER TABLE IF EXISTS ONLY public.history_trades DROP CONSTRAINT IF EXISTS history_trades_base_asset_id_fkey;
 ALTER TABLE IF EXISTS ONLY public.history_trades DROP CONSTRAINT IF EXISTS history_trades_base_account_id_fkey;
 ALTER TABLE IF EXISTS ONLY public.asset_stats DROP CONSTRAINT IF EXISTS asset_stats_id_fkey;
 DROP INDEX IF EXISTS public.trust_lines_by_type_code_issuer;
+DROP INDEX IF EXISTS public.trust_lines_by_asset_balance;
 DROP INDEX IF EXISTS public.trust_lines_by_issuer;
 DROP INDEX IF EXISTS public.trust_lines_by_account_id;
 DROP INDEX IF EXISTS public.trade_effects_by_order_book;
@@ -28,10 +33,13 @@ DROP INDEX IF EXISTS public.offers_by_selling_asset;
 DROP INDEX IF EXISTS public.offers_by_seller;
 DROP INDEX IF EXISTS public.offers_by_last_modified_ledger;
 DROP INDEX IF EXISTS public.offers_by_buying_asset;
+DROP INDEX IF EXISTS public.index_history_transactions_on_memo;
 DROP INDEX IF EXISTS public.index_history_transactions_on_id;
 DROP INDEX IF EXISTS public.index_history_operations_on_type;
 DROP INDEX IF EXISTS public.index_history_operations_on_transaction_id;
 DROP INDEX IF EXISTS public.index_history_operations_on_id;
+DROP INDEX IF EXISTS public.index_history_operations_on_assets;
+DROP INDEX IF EXISTS public.index_history_operations_on_amount;
 DROP INDEX IF EXISTS public.index_history_ledgers_on_sequence;
 DROP INDEX IF EXISTS public.index_history_ledgers_on_previous_ledger_hash;
 DROP INDEX IF EXISTS public.index_history_ledgers_on_ledger_hash;
@@ -39,6 +47,8 @@ DROP INDEX IF EXISTS public.index_history_ledgers_on_importer_version;
 DROP INDEX IF EXISTS public.index_history_ledgers_on_id;
 DROP INDEX IF EXISTS public.index_history_ledgers_on_closed_at;
 DROP INDEX IF EXISTS public.index_history_effects_on_type;
+DROP INDEX IF EXISTS public.index_history_effects_on_assets;
+DROP INDEX IF EXISTS public.index_history_effects_on_amount;
 DROP INDEX IF EXISTS public.index_history_accounts_on_id;
 DROP INDEX IF EXISTS public.index_history_accounts_on_address;
 DROP INDEX IF EXISTS public.htrd_time_lookup;
@@ -50,10 +60,12 @@ DROP INDEX IF EXISTS public.htrd_by_counter_offer;
 DROP INDEX IF EXISTS public.htrd_by_counter_account;
 DROP INDEX IF EXISTS public.htrd_by_base_offer;
 DROP INDEX IF EXISTS public.htrd_by_base_account;
+DROP INDEX IF EXISTS public.htrd_agg_by_time;
 DROP INDEX IF EXISTS public.htp_by_htid;
 DROP INDEX IF EXISTS public.hs_transaction_by_id;
 DROP INDEX IF EXISTS public.hs_ledger_by_id;
 DROP INDEX IF EXISTS public.hop_by_hoid;
+DROP INDEX IF EXISTS public.history_order_book_samples_by_ledger;
 DROP INDEX IF EXISTS public.hist_tx_p_id;
 DROP INDEX IF EXISTS public.hist_op_p_id;
 DROP INDEX IF EXISTS public.hist_e_id;
@@ -72,6 +84,8 @@ ALTER TABLE IF EXISTS ONLY public.trust_lines DROP CONSTRAINT IF EXISTS trust_li
 ALTER TABLE IF EXISTS ONLY public.offers DROP CONSTRAINT IF EXISTS offers_pkey;
 ALTER TABLE IF EXISTS ONLY public.key_value_store DROP CONSTRAINT IF EXISTS key_value_store_pkey;
 ALTER TABLE IF EXISTS ONLY public.history_transaction_participants DROP CONSTRAINT IF EXISTS history_transaction_participants_pkey;
+ALTER TABLE IF EXISTS ONLY public.history_trades_aggregations DROP CONSTRAINT IF EXISTS history_trades_aggregations_pkey;
+ALTER TABLE IF EXISTS ONLY public.history_order_book_samples DROP CONSTRAINT IF EXISTS history_order_book_samples_pkey;
 ALTER TABLE IF EXISTS ONLY public.history_operation_participants DROP CONSTRAINT IF EXISTS history_operation_participants_pkey;
 ALTER TABLE IF EXISTS ONLY public.history_assets DROP CONSTRAINT IF EXISTS history_assets_pkey;
 ALTER TABLE IF EXISTS ONLY public.history_assets DROP CONSTRAINT IF EXISTS history_assets_asset_code_asset_type_asset_issuer_key;
@@ -90,7 +104,9 @@ DROP TABLE IF EXISTS public.key_value_store;
 DROP TABLE IF EXISTS public.history_transactions;
 DROP SEQUENCE IF EXISTS public.history_transaction_participants_id_seq;
 DROP TABLE IF EXISTS public.history_transaction_participants;
+DROP TABLE IF EXISTS public.history_trades_aggregations;
 DROP TABLE IF EXISTS public.history_trades;
+DROP TABLE IF EXISTS public.history_order_book_samples;
 DROP TABLE IF EXISTS public.history_operations;
 DROP SEQUENCE IF EXISTS public.history_operation_participants_id_seq;
 DROP TABLE IF EXISTS public.history_operation_participants;
@@ -372,7 +388,9 @@ CREATE TABLE history_effects (
     history_operation_id bigint NOT NULL,
     "order" integer NOT NULL,
     type integer NOT NULL,
-    details jsonb
+    details jsonb,
+    assets character varying[],
+    amount bigint
 );
 
 
@@ -443,7 +461,29 @@ CREATE TABLE history_operations (
     application_order integer NOT NULL,
     type integer NOT NULL,
     details jsonb,
-    source_account character varying(64) DEFAULT ''::character varying NOT NULL
+    source_account character varying(64) DEFAULT ''::character varying NOT NULL,
+    assets character varying[],
+    amount bigint
+);
+
+
+--
+-- Name: history_order_book_samples; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_order_book_samples (
+    base_asset_id bigint NOT NULL,
+    counter_asset_id bigint NOT NULL,
+    ledger_sequence integer NOT NULL,
+    closed_at timestamp without time zone NOT NULL,
+    best_bid numeric,
+    best_ask numeric,
+    mid_price numeric,
+    spread numeric,
+    bid_depth_1 numeric NOT NULL,
+    ask_depth_1 numeric NOT NULL,
+    bid_depth_5 numeric NOT NULL,
+    ask_depth_5 numeric NOT NULL
 );
 
 
@@ -473,6 +513,27 @@ CREATE TABLE history_trades (
 );
 
 
+--
+-- Name: history_trades_aggregations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE history_trades_aggregations (
+    resolution bigint NOT NULL,
+    base_asset_id bigint NOT NULL,
+    counter_asset_id bigint NOT NULL,
+    "timestamp" bigint NOT NULL,
+    count bigint NOT NULL,
+    base_volume numeric NOT NULL,
+    counter_volume numeric NOT NULL,
+    high numeric[],
+    low numeric[],
+    reverse_high numeric[],
+    reverse_low numeric[],
+    open bigint[],
+    close bigint[]
+);
+
+
 --
 -- Name: history_transaction_participants; Type: TABLE; Schema: public; Owner: -
 --
@@ -659,6 +720,10 @@ INSERT INTO gorp_migrations VALUES ('22_trust_lines.sql', '2019-10-31 14:19:49.1
 INSERT INTO gorp_migrations VALUES ('23_exp_asset_stats.sql', '2019-10-31 14:19:49.15222+01');
 INSERT INTO gorp_migrations VALUES ('24_accounts.sql', '2019-10-31 14:19:49.160844+01');
 INSERT INTO gorp_migrations VALUES ('25_expingest_rename_columns.sql', '2019-10-31 14:19:49.163717+01');
+INSERT INTO gorp_migrations VALUES ('26_trade_aggregations.sql', '2019-11-18 10:12:31.204513+01');
+INSERT INTO gorp_migrations VALUES ('27_history_filters.sql', '2019-11-25 11:02:47.518302+01');
+INSERT INTO gorp_migrations VALUES ('28_trust_lines_by_balance.sql', '2019-11-26 09:41:12.207914+01');
+INSERT INTO gorp_migrations VALUES ('29_order_book_samples.sql', '2019-11-28 10:12:31.482301+01');
 
 
 --
@@ -695,17 +760,17 @@ SELECT pg_catalog.setval('history_assets_id_seq', 1, false);
 -- Data for Name: history_effects; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_effects VALUES (2, 12884905985, 1, 2, '{"amount": "5.0000000", "asset_type": "native"}');
-INSERT INTO history_effects VALUES (1, 12884905985, 2, 3, '{"amount": "5.0000000", "asset_type": "native"}');
-INSERT INTO history_effects VALUES (1, 8589938689, 1, 0, '{"starting_balance": "100.0000000"}');
-INSERT INTO history_effects VALUES (3, 8589938689, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}');
-INSERT INTO history_effects VALUES (1, 8589938689, 3, 10, '{"weight": 1, "public_key": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"}');
-INSERT INTO history_effects VALUES (4, 8589942785, 1, 0, '{"starting_balance": "100.0000000"}');
-INSERT INTO history_effects VALUES (3, 8589942785, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}');
-INSERT INTO history_effects VALUES (4, 8589942785, 3, 10, '{"weight": 1, "public_key": "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"}');
-INSERT INTO history_effects VALUES (2, 8589946881, 1, 0, '{"starting_balance": "100.0000000"}');
-INSERT INTO history_effects VALUES (3, 8589946881, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}');
-INSERT INTO history_effects VALUES (2, 8589946881, 3, 10, '{"weight": 1, "public_key": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"}');
+INSERT INTO history_effects VALUES (2, 12884905985, 1, 2, '{"amount": "5.0000000", "asset_type": "native"}', '{native}', 50000000);
+INSERT INTO history_effects VALUES (1, 12884905985, 2, 3, '{"amount": "5.0000000", "asset_type": "native"}', '{native}', 50000000);
+INSERT INTO history_effects VALUES (1, 8589938689, 1, 0, '{"starting_balance": "100.0000000"}', '{}', 1000000000);
+INSERT INTO history_effects VALUES (3, 8589938689, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}', '{native}', 1000000000);
+INSERT INTO history_effects VALUES (1, 8589938689, 3, 10, '{"weight": 1, "public_key": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"}', '{}', NULL);
+INSERT INTO history_effects VALUES (4, 8589942785, 1, 0, '{"starting_balance": "100.0000000"}', '{}', 1000000000);
+INSERT INTO history_effects VALUES (3, 8589942785, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}', '{native}', 1000000000);
+INSERT INTO history_effects VALUES (4, 8589942785, 3, 10, '{"weight": 1, "public_key": "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2"}', '{}', NULL);
+INSERT INTO history_effects VALUES (2, 8589946881, 1, 0, '{"starting_balance": "100.0000000"}', '{}', 1000000000);
+INSERT INTO history_effects VALUES (3, 8589946881, 2, 3, '{"amount": "100.0000000", "asset_type": "native"}', '{native}', 1000000000);
+INSERT INTO history_effects VALUES (2, 8589946881, 3, 10, '{"weight": 1, "public_key": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"}', '{}', NULL);
 
 
 --
@@ -742,10 +807,16 @@ SELECT pg_catalog.setval('history_operation_participants_id_seq', 8, true);
 -- Data for Name: history_operations; Type: TABLE DATA; Schema: public; Owner: -
 --
 
-INSERT INTO history_operations VALUES (12884905985, 12884905984, 1, 1, '{"to": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", "from": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", "amount": "5.0000000", "asset_type": "native"}', 'GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU');
-INSERT INTO history_operations VALUES (8589938689, 8589938688, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H');
-INSERT INTO history_operations VALUES (8589942785, 8589942784, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H');
-INSERT INTO history_operations VALUES (8589946881, 8589946880, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H');
+INSERT INTO history_operations VALUES (12884905985, 12884905984, 1, 1, '{"to": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", "from": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", "amount": "5.0000000", "asset_type": "native"}', 'GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU', '{native}', 50000000);
+INSERT INTO history_operations VALUES (8589938689, 8589938688, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H', '{}', 1000000000);
+INSERT INTO history_operations VALUES (8589942785, 8589942784, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H', '{}', 1000000000);
+INSERT INTO history_operations VALUES (8589946881, 8589946880, 1, 0, '{"funder": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", "account": "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", "starting_balance": "100.0000000"}', 'GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H', '{}', 1000000000);
+
+
+--
+-- Data for Name: history_order_book_samples; Type: TABLE DATA; Schema: public; Owner: -
+--
+
 
 
 --
@@ -754,6 +825,12 @@ INSERT INTO history_operations VALUES (8589946881, 8589946880, 1, 0, '{"funder":
 
 
 
+--
+-- Data for Name: history_trades_aggregations; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
 --
 -- Data for Name: history_transaction_participants; Type: TABLE DATA; Schema: public; Owner: -
 --
@@ -876,6 +953,22 @@ ALTER TABLE ONLY history_operation_participants
     ADD CONSTRAINT history_operation_participants_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: history_order_book_samples history_order_book_samples_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_order_book_samples
+    ADD CONSTRAINT history_order_book_samples_pkey PRIMARY KEY (base_asset_id, counter_asset_id, ledger_sequence);
+
+
+--
+-- Name: history_trades_aggregations history_trades_aggregations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_trades_aggregations
+    ADD CONSTRAINT history_trades_aggregations_pkey PRIMARY KEY (base_asset_id, counter_asset_id, resolution, "timestamp");
+
+
 --
 -- Name: history_transaction_participants history_transaction_participants_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1006,6 +1099,13 @@ CREATE UNIQUE INDEX hist_op_p_id ON history_operation_participants USING btree (
 CREATE UNIQUE INDEX hist_tx_p_id ON history_transaction_participants USING btree (history_account_id, history_transaction_id);
 
 
+--
+-- Name: history_order_book_samples_by_ledger; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX history_order_book_samples_by_ledger ON history_order_book_samples USING btree (ledger_sequence);
+
+
 --
 -- Name: hop_by_hoid; Type: INDEX; Schema: public; Owner: -
 --
@@ -1034,6 +1134,13 @@ CREATE UNIQUE INDEX hs_transaction_by_id ON history_transactions USING btree (id
 CREATE INDEX htp_by_htid ON history_transaction_participants USING btree (history_transaction_id);
 
 
+--
+-- Name: htrd_agg_by_time; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX htrd_agg_by_time ON history_trades_aggregations USING btree (resolution, "timestamp");
+
+
 --
 -- Name: htrd_by_base_account; Type: INDEX; Schema: public; Owner: -
 --
@@ -1111,6 +1218,20 @@ CREATE UNIQUE INDEX index_history_accounts_on_address ON history_accounts USING
 CREATE UNIQUE INDEX index_history_accounts_on_id ON history_accounts USING btree (id);
 
 
+--
+-- Name: index_history_effects_on_amount; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_effects_on_amount ON history_effects USING btree (amount);
+
+
+--
+-- Name: index_history_effects_on_assets; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_effects_on_assets ON history_effects USING gin (assets);
+
+
 --
 -- Name: index_history_effects_on_type; Type: INDEX; Schema: public; Owner: -
 --
@@ -1160,6 +1281,20 @@ CREATE UNIQUE INDEX index_history_ledgers_on_previous_ledger_hash ON history_led
 CREATE UNIQUE INDEX index_history_ledgers_on_sequence ON history_ledgers USING btree (sequence);
 
 
+--
+-- Name: index_history_operations_on_amount; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_operations_on_amount ON history_operations USING btree (amount);
+
+
+--
+-- Name: index_history_operations_on_assets; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_operations_on_assets ON history_operations USING gin (assets);
+
+
 --
 -- Name: index_history_operations_on_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1181,6 +1316,13 @@ CREATE INDEX index_history_operations_on_transaction_id ON history_operations US
 CREATE INDEX index_history_operations_on_type ON history_operations USING btree (type);
 
 
+--
+-- Name: index_history_transactions_on_memo; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_history_transactions_on_memo ON history_transactions USING btree (memo);
+
+
 --
 -- Name: index_history_transactions_on_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1230,6 +1372,13 @@ CREATE INDEX signers_by_account ON accounts_signers USING btree (account_id);
 CREATE INDEX trade_effects_by_order_book ON history_effects USING btree (((details ->> 'sold_asset_type'::text)), ((details ->> 'sold_asset_code'::text)), ((details ->> 'sold_asset_issuer'::text)), ((details ->> 'bought_asset_type'::text)), ((details ->> 'bought_asset_code'::text)), ((details ->> 'bought_asset_issuer'::text))) WHERE (type = 33);
 
 
+--
+-- Name: trust_lines_by_asset_balance; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX trust_lines_by_asset_balance ON trust_lines USING btree (asset_type, asset_code, asset_issuer, balance, account_id);
+
+
 --
 -- Name: trust_lines_by_account_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1259,6 +1408,22 @@ ALTER TABLE ONLY asset_stats
     ADD CONSTRAINT asset_stats_id_fkey FOREIGN KEY (id) REFERENCES history_assets(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
 
+--
+-- Name: history_order_book_samples history_order_book_samples_base_asset_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_order_book_samples
+    ADD CONSTRAINT history_order_book_samples_base_asset_id_fkey FOREIGN KEY (base_asset_id) REFERENCES history_assets(id);
+
+
+--
+-- Name: history_order_book_samples history_order_book_samples_counter_asset_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_order_book_samples
+    ADD CONSTRAINT history_order_book_samples_counter_asset_id_fkey FOREIGN KEY (counter_asset_id) REFERENCES history_assets(id);
+
+
 --
 -- Name: history_trades history_trades_base_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1291,6 +1456,22 @@ ALTER TABLE ONLY history_trades
     ADD CONSTRAINT history_trades_counter_asset_id_fkey FOREIGN KEY (counter_asset_id) REFERENCES history_assets(id);
 
 
+--
+-- Name: history_trades_aggregations history_trades_aggregations_base_asset_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_trades_aggregations
+    ADD CONSTRAINT history_trades_aggregations_base_asset_id_fkey FOREIGN KEY (base_asset_id) REFERENCES history_assets(id);
+
+
+--
+-- Name: history_trades_aggregations history_trades_aggregations_counter_asset_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY history_trades_aggregations
+    ADD CONSTRAINT history_trades_aggregations_counter_asset_id_fkey FOREIGN KEY (counter_asset_id) REFERENCES history_assets(id);
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -25,7 +25,7 @@
 // bad_cost-core.sql (29.849kB)
 // bad_cost-horizon.sql (34.334kB)
 // base-core.sql (29.713kB)
-// base-horizon.sql (47.813kB)
+// base-horizon.sql (54.504kB)
 // change_trust-core.sql (33.104kB)
 // change_trust-horizon.sql (43.637kB)
 // core_database_schema_version_8-core.sql (8.369kB)
@@ -634,7 +634,7 @@ func baseCoreSql() (*asset, error) {
 	return a, nil
 }
 
-var _baseHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7d\x79\x6f\xa3\x48\xf3\xff\xff\xfb\x2a\xd0\x68\xa5\xcc\x68\x32\x1b\xee\x63\xf6\x3b\x8f\x84\x6d\x7c\xc4\xf7\x15\x27\x59\xad\x50\x03\x8d\x4d\x82\xc1\x01\x1c\xdb\xb3\x7a\xde\xfb\x4f\x1c\xb6\x01\x73\xda\xce\x3c\xfb\x8b\x56\xb3\xb6\xa9\xfe\xd4\x41\x55\x75\xf5\x01\xfd\xed\xdb\x6f\xdf\xbe\x21\x03\xd3\x76\xe6\x16\x1c\x0f\x3b\x88\x02\x1c\x20\x01\x1b\x22\xca\x7a\xb9\xfa\xed\xdb\xb7\xdf\xdc\xeb\xb5\xf5\x72\x05\x15\x44\xb5\xcc\xe5\x91\xe0\x1d\x5a\xb6\x66\x1a\x08\xf7\x07\xfd\x07\x16\xa2\x92\x76\xc8\x6a\x2e\xba\xcd\x63\x24\xbf\x8d\x85\x09\x62\x3b\xc0\x81\x4b\x68\x38\xa2\xa3\x2d\xa1\xb9\x76\x90\x1f\x08\xfa\xa7\x77\x49\x37\xe5\xd7\xd3\x5f\x65\x5d\x73\xa9\xa1\x21\x9b\x8a\x66\xcc\x91\x1f\xc8\xcd\x74\x52\x67\x6f\xfe\xdc\xc3\x19\x0a\xb0\x14\x51\x36\x0d\xd5\xb4\x96\x9a\x31\x17\x6d\xc7\xd2\x8c\xb9\x8d\xfc\x40\x4c\x23\xc0\x58\x40\xf9\x55\x54\xd7\x86\xec\x68\xa6\x21\x4a\xa6\xa2\x41\xf7\xba\x0a\x74\x1b\x46\xd8\x2c\x35\x43\x5c\x42\xdb\x06\x73\x8f\x60\x03\x2c\x43\x33\xe6\x7f\x06\xb2\x43\x60\xc9\x0b\x71\x05\x9c\x05\xf2\x03\x59\xad\x25\x5d\x93\x6f\x5d\x65\x65\xe0\x00\xdd\x74\xc9\xf8\xce\x44\x18\x21\x13\xbe\xd2\x11\x90\x56\x1d\x11\x1e\x5b\xe3\xc9\x18\xe9\xf7\x3a\x4f\x01\xfd\x1f\x0b\xcd\x76\x4c\x6b\x27\x3a\x16\x50\xa0\x8d\xd4\x46\xfd\x01\x52\xed\xf7\xc6\x93\x11\xdf\xea\x4d\x42\x8d\xa2\x84\xa2\x6c\xae\x0d\x07\x5a\x22\xb0\x6d\xe8\x88\x9a\x22\xaa\xaf\x70\xf7\xe7\xaf\x60\x28\x7b\x9f\x7e\x05\x4b\xd7\xaf\x7e\x9d\x82\x3e\xb7\xf2\xda\xf9\x02\xba\x8e\x9c\xc5\x2c\x44\x75\x04\xf7\xc8\x5b\xbd\x9a\xf0\x18\xa2\x0c\x60\x1d\x6b\x6d\x3b\xa2\xae\x19\xae\x68\x3b\xd1\xd9\xad\xa0\x28\x9b\x0a\x14\x35\xdb\x5e\x43\xab\x54\xe3\x33\x9a\x1c\x0d\x91\xd7\x0c\x28\x50\x84\xaa\x0a\x65\xc7\x6b\x68\x5a\x0a\xb4\x44\xc9\x34\x5f\xb3\x1b\xda\xda\xdc\x80\x56\x98\x57\x36\xbd\xa9\xaa\x01\xb9\x0d\x75\xdd\x0d\x6c\xcf\xa4\x65\x1a\xe5\x99\xe0\x48\xad\x03\xdb\x11\x97\xa6\xa2\xa9\x1a\x54\x44\x1d\x2a\xf3\xe2\x6d\xa5\xf5\xae\xa0\x74\x9a\xa1\xc0\xad\x18\x72\x43\xc3\x06\x5e\x4a\xb2\x45\xd3\xc8\xb5\x7c\xb4\xb5\xb9\x82\x16\x38\xb4\x75\xbd\xe5\x82\xd6\x47\x49\x2e\x92\xa2\x5c\x5b\xdf\xca\x5e\x43\x1b\xbe\xad\xa1\x21\x97\x52\x21\xd4\x7c\x65\xc1\x77\xcd\x5c\xdb\xc1\x6f\xe2\x02\xd8\x8b\x33\xa1\x2e\x47\xd0\x96\x2b\xd3\x72\x13\x67\xd0\xfb\x9d\x0b\x73\xae\x2d\x65\xdd\xb4\xa1\x22\x82\x52\xbe\xb8\x8f\xe7\x33\x5c\x29\x08\xe6\x33\x84\x0e\xb7\x04\x8a\x62\x41\xdb\xce\x6e\xbe\x70\x2c\xc5\xab\x10\x44\xdd\x34\x5f\xd7\xab\x02\xd4\xab\x3c\x91\x7c\x2a\xa0\x59\x25\x81\xf7\xdd\x63\xe1\x06\x6e\xaa\x74\x73\x46\x31\xd2\x3d\xfc\x19\x4d\x0a\x65\xd7\x7d\x23\xaf\x13\x2c\xc1\x24\xdc\x69\xe6\xb5\x58\xb9\x0d\x16\x4e\xee\x1d\xb0\x23\x09\xc8\xed\xbe\xf2\x5b\x04\x71\x5a\x84\xd8\xf4\xe5\x30\x73\x09\x35\xdb\x11\x9d\xad\xb8\xca\x87\x74\x29\xcd\x55\x51\x4a\x58\x94\x6c\xdf\x9b\x66\x13\xc3\xed\x4a\x0c\x57\x17\x05\xfb\xfb\x84\x66\x6e\x79\x91\xdd\x48\xda\x15\xea\x0c\x5d\xfb\xe6\x66\xcc\xa2\x1d\xbf\x2f\x64\x41\xad\x0e\xc4\xf9\xba\x1c\xd2\x8d\x66\xa8\xba\xd7\x69\x89\x0a\xb4\x1d\xcd\xf0\x3e\x17\x6c\xbb\x30\x97\x50\x54\xcc\x25\xd0\x8a\xb6\x70\x07\x4c\xe1\x32\xd3\x00\x4b\x58\xa4\xcc\x0c\xd5\x67\x19\x65\x66\xb8\x8a\x5b\x15\x2c\x60\xfd\xd2\x25\x03\x34\xa8\x6d\x8a\xe2\xbd\xc2\x9d\xf8\x0e\xf4\x35\x14\xdd\xbc\x0e\x33\x80\x63\x94\x85\x39\x24\x94\x4c\xe2\x0a\x58\x8e\x26\x6b\x2b\x60\x64\xd6\xe1\x79\x4d\x4b\xcb\x70\x28\x79\xca\x4a\x90\xdc\xb0\x34\x7f\xcf\xe3\x8b\xf0\xf3\x09\x3f\x1c\xdf\x8f\x40\x6f\xa4\xe2\x7f\xf4\x46\x2e\xc1\x28\xce\x8b\x60\xb1\xa0\x04\x73\xd3\x5a\x89\x4b\x6d\x1e\x54\x94\x19\x22\xc4\x28\x0b\xeb\x18\xcb\x81\x19\x1c\xe2\xd9\xb2\x28\x87\xf2\x83\xc3\xc2\xc8\xfb\x84\x12\x0c\xa4\xb2\xe0\x63\xa4\xa5\x79\x14\xc1\x2e\x2d\xb7\x9b\x08\x8b\x00\x7b\x09\x33\x0b\xbd\x68\x52\xf0\x5b\x57\xfb\x9d\x69\xb7\x87\x68\x8a\xcf\xbb\x26\xd4\xf9\x69\x67\x52\x10\x3b\x25\xd8\xaf\x80\x1c\x84\x59\x36\x92\xf7\x2d\x05\x28\x94\xf9\xb3\x09\xfd\x6c\x9e\x4d\x13\x4b\xcc\xd9\xc4\x49\x03\xd8\xa0\xc5\x58\x18\x4e\x85\x5e\xf5\x8c\xbb\xe5\x76\x8d\x36\x7c\x2b\xcd\x39\x02\x52\xb8\xb5\x92\x67\xb4\xd3\x01\x6e\x61\x0d\x53\xf2\x7c\x19\xfd\x92\x21\x8a\xb5\x0d\x86\x82\xc5\x88\x83\x71\x5f\x61\xdd\x82\x9c\x5f\x46\x17\xbf\x49\x41\xda\x20\x07\x14\x97\xe7\x50\xd3\x15\x90\x28\xd6\x6b\x64\x13\xc7\x3a\x80\x6c\xe2\xe2\x84\xb1\xcc\x5c\x90\xda\x4d\x89\xc5\x48\x03\x2a\xbe\xd1\x18\x09\x0d\x7e\x92\x40\xb9\xd4\x0c\x71\x65\x69\x32\xfc\x6c\xac\x97\xd0\xd2\xe4\xbf\xfe\xfe\x52\xa0\x15\xd8\x9e\xd1\x4a\x07\xb6\xf3\x19\x18\x3b\xa8\x7b\x73\xfe\x05\x5a\xa8\x9a\x95\xd8\xa4\x3e\xed\x55\x27\xad\x7e\x2f\x43\x1f\x11\xcc\xe7\x47\xe9\x6e\x91\x13\x41\x33\x30\xf6\xda\x5d\x80\xe1\x4d\x21\xba\xcd\x8f\xc2\xdf\x22\x65\x14\xf1\x54\x2f\x80\x20\x3c\x4e\x84\xde\x38\x06\xa1\xaf\xe6\xf6\x9b\xbe\x8f\x9b\x6a\x53\xe8\xf2\x27\x1c\xfe\xfc\xcd\x5f\xee\xe9\x81\x25\xfc\xbe\xff\x0d\x99\xec\x56\xf0\x7b\xd0\xe4\x4f\x64\x2c\x2f\xe0\x12\x7c\x47\xbe\xfd\x89\xf4\x37\x06\xb4\xbe\x23\xdf\xbc\x55\xa0\xea\x48\x70\xef\x57\x80\xbc\xc7\xfb\x2d\x82\x18\xbd\x18\x00\x57\xfb\xdd\xae\xd0\x9b\x64\x20\xfb\x04\x48\xbf\x17\x05\x40\x5a\x63\xe4\x66\xbf\xbe\xb3\xff\xcd\xf6\x40\x6e\xe2\x9c\xf7\xea\x07\x3c\x0f\x16\xca\xd5\x27\x62\xcb\x5e\x7f\x12\xb3\x27\x32\x6b\x4d\x9a\x07\xb1\xc2\x0b\x3d\x11\xf6\x47\x94\x98\x20\x65\x94\x3f\x01\xf1\x0c\x30\xe8\xdc\xad\xe6\xe3\x61\x07\x59\x59\xa6\x0c\x95\xb5\x05\x74\x44\x07\xc6\x7c\x0d\xe6\xd0\x33\x43\xc1\x85\xa9\xb0\xb8\xf9\x8e\x16\x88\xbf\xf7\xd5\xa3\xfc\xfb\x7b\x9b\x64\xcb\x83\x67\xe7\xe2\x23\x23\x61\x32\x1d\xf5\xc6\xa1\xdf\x7e\x43\x10\x04\xe9\xf0\xbd\xc6\x94\x6f\x08\x88\xa7\x7d\xb7\x3b\xf5\x93\xdd\x78\x32\x6a\x55\x27\x1e\x05\x3f\x46\x7e\x17\x7f\x47\xc6\x42\x47\xa8\x4e\x90\xdf\x31\xf7\x5b\xfc\x6e\xe4\x06\xe2\x65\xda\xe5\xc1\x5f\x4d\x39\x3c\x49\xb9\x22\x99\xea\x32\xfd\x0a\x70\x38\xa8\x78\xf8\xe9\x2c\x0d\x3f\xff\x86\x20\x55\x7e\x2c\x20\xb3\xa6\xd0\x43\x7e\xc7\xfe\xc2\xfe\xbe\xfb\x1d\xfb\x0b\xff\xfb\x3f\xbf\xe3\xde\x67\xfc\x2f\xfc\x6f\x64\xe2\x5f\x44\x84\xce\x58\x70\x8d\x22\xf4\x6a\x5f\x12\x2d\x53\xa0\x1f\xb8\xd0\x32\xf9\x1c\x3e\xda\x32\xff\x77\x8e\x65\x4e\xfb\xd4\xc0\x0e\x87\x7e\xb8\x98\x21\x8e\xdd\xf6\x09\xa2\x27\x31\x82\x8c\x5d\x5b\x21\x3f\x8e\x19\xe0\xd6\xff\x79\xf2\x34\x10\x90\x1f\xe1\x88\xf8\x92\x14\xb5\x57\x95\x31\x0e\x18\x13\x71\x1f\xc6\xc5\x25\x4c\x2c\x81\x2e\x95\x32\x09\x34\x26\x69\x24\x20\xa3\xe2\x1e\xbd\xec\x54\xda\xa4\x32\xef\x62\x69\x13\x40\xe3\xd2\x86\x83\x24\x53\x5a\xb7\xe7\x52\xa0\x0a\xd6\xba\x23\x3a\x40\xd2\xa1\xbd\x02\x32\x44\x7e\x20\x37\x37\x7f\x46\xaf\x6e\x34\x67\x21\x9a\x9a\x12\xda\xb3\x11\xd1\xf5\x50\xfc\x06\xfa\x79\xd1\x55\x4c\x37\x3f\x10\x0f\xf3\x1e\xbe\x2e\xc7\xd9\x5a\x44\x5e\x00\x0b\xc8\x0e\xb4\x90\x77\x60\xed\x34\x63\xfe\x99\xa2\xbf\x78\x95\x42\x6f\xda\xe9\xf8\xfa\x49\x40\x07\x86\x0c\x11\x49\x9b\x6b\x86\x13\xbf\xe8\xaf\x0e\xeb\x1a\x90\x34\x5d\x73\x34\x68\x27\xd3\xed\x17\xb9\x0b\x10\xfa\x6b\xa5\xa2\xb1\x5e\x4a\xd0\x4a\x26\x32\xd6\x4b\xd1\x5e\x4b\xd0\x70\x2c\x17\x48\x33\x1c\x38\x87\x56\x8c\x28\x71\x1e\xbc\x90\xc6\xaa\x0e\xe6\x69\xa8\xa1\x19\xf2\x04\x2c\x02\x8f\x63\x2d\x81\xed\x40\x4b\xdc\x40\x6d\xbe\x70\x10\x7b\x09\x5c\x3b\xc4\xf5\x71\x16\x16\xb4\x17\xa6\xae\x88\xba\xb9\xc9\x27\x5a\x42\x45\x5b\x2f\xf3\xe9\x16\xda\x7c\x91\x46\x95\xb4\x25\xe0\x44\xe5\xd3\xb8\x8b\x8e\xd9\x2e\x75\x48\x7f\xd2\xcc\xf7\xca\x60\xc9\xeb\x15\xee\x12\xec\x8a\x51\x68\xdc\xb0\x25\xbd\xd8\x00\x4b\x98\x40\x48\x93\x71\x42\x6f\x9e\x28\x81\x92\x3b\x91\xe0\x52\x13\xee\x07\xc9\x17\x5b\x71\x3f\x65\x5a\x20\xbc\x4f\xf5\xf5\x1b\x17\x22\x0d\x9c\xb8\x80\x8a\xa1\x09\x83\xb3\xb5\x0b\x4d\x35\xfb\x8a\x69\x4a\x72\x36\x00\x4b\x57\xdf\x53\x0d\x12\x92\xc6\x21\x13\x26\x07\xb7\x1f\xf8\x69\x71\x65\x2e\xf5\x04\x33\xe1\x14\xf5\x25\xc3\x14\xf1\x89\x96\x73\xcd\x11\x9f\xdb\x0f\xee\xf5\x61\x49\x22\x45\xa3\xe3\xf2\x45\x52\x54\x9d\x64\xab\xf0\xba\x46\xa1\xb0\x0a\x6c\xef\xc0\x6d\x52\x8e\x4e\x35\xf7\xa9\x9d\xe2\xb3\x57\xe7\xda\x29\xbe\xca\x72\x70\x9d\x04\x11\xc1\x6a\xa5\x6b\xde\x66\x12\xc4\xd1\x96\xd0\x76\xc0\x72\x85\xb8\xbd\xb1\xf7\x15\xf9\x69\x1a\xf0\x54\xd0\xb4\xb9\xb9\xfd\x4c\x43\x30\xa9\x57\x4c\xe6\xc3\x14\x60\x0a\x6a\x50\x60\xf0\xa3\x89\x3f\x56\xc7\xbc\x1f\x5a\xbd\xea\x48\xf0\x06\xd6\x95\xa7\xe0\xa7\x5e\x1f\xe9\xb6\x7a\x0f\x7c\x67\x2a\x1c\xbe\xf3\x8f\xc7\xef\x55\xbe\xda\x14\x10\x2c\x4f\x99\xb3\xcd\x1e\x07\x3a\x09\xd9\x60\xd2\x1f\x31\xe0\xd6\x79\x07\xfa\xe7\x9b\x14\x8d\x6f\xbe\x7f\xb7\xe0\x5c\xd6\x81\x6d\x9f\xf8\x9a\xbf\x89\x26\x39\x55\x65\xdc\x28\x7f\x86\xf6\x62\xcd\xfc\x15\x8d\x83\x5e\x59\xf1\xe6\x05\x64\x91\x8c\xfa\x71\xe1\x99\x67\x8f\x2b\xbb\x6d\x18\xf3\x97\x39\x6d\x96\x22\x48\x7f\xd6\x13\x6a\x48\xe5\x29\x47\x23\x7f\x85\x2a\x5b\xa1\x03\x56\xec\xf2\x1f\x9a\x92\x26\xdb\x7e\xe5\xe1\x52\xaf\x0b\x70\x02\xb7\x8b\xc5\x8c\x98\xd6\x23\x9e\x2e\xb4\xa4\x51\x7e\xf2\x76\xe4\x7c\x4a\xf1\xe6\x8c\x8e\x45\x81\x0e\xd0\x74\x1b\x79\xb1\x4d\x43\x4a\x77\xb6\xfd\x72\xcd\xa5\x76\x08\x70\x02\x3b\xec\x07\x09\x29\xb2\x85\x76\x39\x16\x8a\xc2\xa4\x0d\x96\xc9\x0d\x03\xb3\x84\xd6\xe7\xbc\x1b\x71\x90\x63\x9f\xe5\xd0\x18\x87\xe3\x8d\x28\x46\x7f\xd8\xe5\x18\xeb\x98\xcc\xb5\x73\xec\x9b\xe2\x6d\x2c\x08\x9c\xdc\x46\x3e\xed\x7a\xa5\x14\xa6\x3d\xb8\x4e\xf0\x35\xb6\x01\xf4\x44\x17\xec\xa4\x6e\x72\x80\x2e\xca\xa6\x66\xa4\x0c\xf9\x54\x08\xc5\x95\x69\xea\x29\x23\x4c\x60\x43\x51\x85\x69\xf7\xda\xbb\x6c\x41\x1b\x5a\xef\x69\x24\x4b\xb0\x15\x9d\xad\xe8\xd5\x4e\xda\xcf\x34\xaa\x95\x65\x3a\xa6\x6c\xea\xa9\x7a\xc5\xef\xd1\xde\x59\x20\x50\xa0\xe5\x95\x17\x41\x41\xbd\x96\x65\x68\xdb\xea\x5a\x17\x53\x1d\x25\x50\x1c\x68\x3a\x54\xd2\xa9\xd2\xc3\x2a\x65\x05\xf5\xd2\x28\x4b\xd9\x0f\x90\xd3\xe7\x15\xcf\x36\xf9\xf9\xab\xac\xca\xd7\xed\xc6\x32\x79\xfc\xaa\x6e\xad\x94\xa2\x17\x76\x73\x99\xbc\x4e\xbb\xbd\x64\xf2\x8c\x6e\x30\xb4\xbf\xe0\x6a\xbe\x99\x37\x1c\x8c\x6e\xf7\x4f\x19\x32\xba\x95\xbf\xec\xab\xe2\xf5\x80\x17\x76\x80\x41\xe4\x9b\x6b\x4b\x3e\xec\x1f\x4e\xe9\x7a\xf6\xe9\xe4\xe6\xe6\xfb\xf7\xf4\x21\x6b\x7a\x1c\x04\xdb\x3b\x2e\x35\x67\xf0\x38\xd1\xe7\xab\xd6\x0b\x41\x4a\x3c\xa7\xf7\xf2\xb6\xf1\xa4\xb2\x8d\x3d\xcc\x94\x45\x14\x3c\x5f\x95\x45\xe2\x8f\x59\x13\x09\x4e\x1f\x0b\xcb\xa1\xcb\x64\x77\xa0\xca\xe0\xe8\x89\xa4\xd9\xc1\x13\x3d\x88\x64\x9a\x3a\x04\xc6\xbe\x4f\xd2\x64\x28\x1a\x91\xfe\xd7\xff\x2d\xda\x27\x1f\xb7\xb9\x8b\xb1\xde\x3a\xb2\xd1\x3e\x7e\x31\xb4\x51\x2d\xf1\xe1\x31\x4f\x6a\xd1\x7b\xbc\x10\xa9\x36\x85\x6a\x1b\xf9\xfc\x39\x6c\xc1\xff\xfc\x40\xd0\x2f\x5f\xf2\xb0\x92\xda\xef\xad\xf6\x7f\x27\x86\x2c\x80\x17\x31\x6a\x0c\x3e\x66\x71\x5f\xc2\xcc\x60\x4a\xde\x69\x75\x85\xf0\x4a\xde\xb5\x57\xb0\x2f\x2d\x92\xc4\x2e\xe9\x4d\xf3\xf6\xa9\x5d\xa7\x3f\xcd\xe1\xf2\xab\x7a\xd4\x92\xca\x5e\xd8\xa7\xe6\x70\x3b\xed\x55\xd3\x1a\x64\xf4\xab\x91\xbd\x89\x57\xf4\xd5\xbd\x7f\x86\x45\x2a\x3c\x8c\x0a\xb2\x7f\xce\xe0\xac\x68\xd7\x9b\xdd\x8b\x26\xaf\x05\x1c\x58\x27\xc6\x8b\x3b\x0e\x48\x1f\x48\xa4\x0d\xd1\xfe\x27\x83\x2c\x67\x2b\x42\xe3\x1d\xea\xe6\x0a\x26\x4d\x5c\x3a\x5b\x77\xc8\xb3\xd6\x13\x27\x5e\x9d\xad\xb8\x84\x0e\x48\xb9\xe4\x0e\xb6\xd2\x2e\xdb\xda\xdc\x00\xce\xda\x82\x49\x73\x6c\x1c\xfd\xe5\xaf\xbf\x8f\xd5\xcb\x3f\xff\x4d\xaa\x5f\xfe\xfa\x3b\x6e\x73\xb8\x34\x53\xa6\xc3\x8e\x58\x86\x69\xc0\xcc\x6a\xe8\x88\x75\x0a\x13\x68\xa6\x2d\xa1\x28\x99\x6b\x43\xf1\x26\x9b\x59\x0b\x18\x73\x18\x1f\x8f\x45\x3b\x57\xd7\x12\x2e\xda\x1c\x2a\xe9\x03\xae\xf8\xce\xe1\x73\x63\x2d\xfe\x10\x89\x1f\x66\xc9\x8b\x5d\x91\x15\x85\xec\x45\xa9\x9c\xc5\x87\x60\x6f\xf4\xb9\x42\x07\x4f\xd2\xec\xe7\x5c\xdc\xfa\xa4\xe8\x72\x5b\x76\x39\x17\x79\xe4\x39\xc9\x13\xc3\x0f\x1d\x27\xce\xdc\x67\x14\x54\x5e\x81\x64\xa4\x8e\xf2\x35\x19\xa6\x75\xbe\xde\x45\x44\x31\xd7\x92\x0e\x91\x95\x05\x65\xcd\x9b\x08\x28\xbe\x38\x7c\xe6\x8a\x60\x78\xaf\xfb\xb9\xf7\x2a\xfc\x3c\xd5\x2f\x59\x50\x2d\xb8\xf4\x54\x66\x2d\xa9\xdc\x64\x78\xe6\xbe\x84\xa3\x39\x44\x5d\x5b\x6a\x69\xb5\xf7\xb5\x77\x2f\x7c\x80\x73\xc4\xd6\x1f\x34\x65\xef\x22\xfb\xc7\x28\x8a\x14\x29\xbe\x8f\x78\xcf\xad\xe4\x3c\xa1\x31\x16\x26\x19\x6b\x35\xe1\x59\xf1\xf0\x4a\x4d\xb9\xb9\x8c\xeb\x29\x51\xf0\x01\x96\x4c\xa5\x32\xe7\x40\x8a\x28\x99\x5a\xeb\x5f\x4d\xcd\xc2\xcf\x00\x65\x2a\x9a\x53\x98\x26\xab\x5a\x03\x0e\x40\x54\xd3\xca\xda\x8b\x84\xd4\xf8\x09\x9f\xa3\x5b\x0e\xde\xe9\x7e\x92\x6b\x80\x26\xed\xb0\xb8\x04\x37\x65\x1d\xff\x02\xc8\xac\xed\x01\x17\xc0\x66\xad\xa6\x17\x81\x6d\xf5\xc6\xc2\x68\x82\xb4\x7a\x93\xfe\xc9\x8a\xba\x37\xec\x1a\x23\x9f\x6f\x30\x51\x33\x34\x47\x03\xba\xe8\xef\x5b\xff\xc3\x7e\xd3\x6f\x6e\x91\x1b\x1c\xc5\xb8\x6f\x18\xfa\x8d\xc0\x10\x8c\xfc\x8e\x71\xdf\x49\xee\x0f\x94\x60\x09\xe2\x2b\x8a\xdd\x7c\xf9\xb3\x18\x38\x2e\xfa\x6f\x53\x88\x38\xaa\xb4\x13\x1d\x53\x53\x32\x19\x91\x38\xcd\x94\x61\x44\x88\x6b\x1b\x1e\x46\x0e\xa2\x66\x9c\xbc\xc0\x21\x9b\x1d\xc5\xe1\x74\x19\x7e\xa4\x08\x14\x45\x8c\xaf\x36\x64\xf2\xa0\x48\x8c\x2c\xa5\x13\x25\xfa\xe3\x94\xfd\x94\x89\xb7\xff\x30\x93\x05\x8d\xb1\x28\x59\x86\x05\xbd\x67\x11\xf4\x09\x05\x58\x30\x28\x57\xca\x05\x18\xbf\xb7\xdc\x15\xd7\x82\xc5\xd0\x72\x86\x62\xbd\x9b\x01\xe6\x73\x0b\xce\x81\x63\x5a\xd9\xf7\x9a\xa5\x30\x9c\x2d\x07\x1f\x36\x52\xf0\x44\x6c\x01\x35\x38\x8a\x29\x75\x33\x38\x4f\x0d\x7f\x25\x4a\xdc\x2a\x56\x26\x3a\x87\x13\x74\x29\x8f\xc5\x50\x0f\x3e\xb8\x0b\x5e\x91\x9c\xcd\x80\xa2\x19\xac\x14\x03\x2c\xcc\xe0\x50\x87\xba\xf1\x9f\xcd\x88\xc3\x59\xae\x14\x23\x3c\x72\x27\x82\x19\x44\xff\x8d\x6a\x59\x9c\x30\x94\xe2\xe8\x72\x2a\x11\xbe\x3a\x87\x89\xd7\x4c\xcf\xc2\x30\x8c\xa1\x4a\x39\x2e\x46\x8a\xaa\xb6\xdd\x3f\x92\x6e\x2e\x75\x51\xd5\xa0\x9e\x99\x19\x31\x8c\x60\x88\x72\x37\x9e\xda\xaf\x88\xef\x57\x2a\xb7\x39\x6a\x50\x14\x53\x2a\x40\x30\x5a\xd4\x8c\x39\xb4\x1d\xf1\x74\x2d\x34\x87\x15\xcd\x95\x8b\x45\x8c\x89\x14\x40\xde\xa2\x33\xc8\xee\x4b\x30\x8c\xa5\x68\xbc\x14\x13\xf6\xe0\xbe\xaa\x69\xed\xeb\x8f\x4c\x1e\x38\xc1\x12\x54\x29\x1e\x9c\xef\x54\xd9\xb0\x04\x81\xa1\xa5\x3c\x0a\x47\x13\x44\xcf\x0f\x42\x8c\xa0\x48\xae\x54\x10\xe2\xd8\x3e\xd2\x2d\xb8\x34\xdf\xa1\xf8\x13\x5a\xe6\x61\x36\xdf\x34\x6c\xc7\x02\x5a\x4e\xb7\x8b\x11\x2c\x4a\x94\x0a\x48\x1c\x17\x43\x43\xe4\x4c\x6c\x92\x64\xd0\x52\xae\x85\x13\x62\xac\x8e\xcb\xc4\xa7\x70\xbc\x94\x53\xe1\x64\xa1\x52\x04\xa3\x51\x96\x2c\xd5\x6d\xe0\x94\x2b\x77\x10\x80\x16\x34\xc0\x12\x8a\xb2\xa9\xaf\x97\x39\xb1\x47\x13\x0c\xb6\xf7\xad\x94\x02\x34\x73\x63\x61\xd9\x0a\xf4\x64\x73\xe1\x5e\x03\xec\x16\xb9\x69\x54\x1f\xdb\x0d\x7a\xd4\x23\xfb\xbd\x96\x30\xa8\x76\x7b\xf5\x0a\x43\xe0\x3c\x49\xd0\xcf\xd4\xa0\x57\x1b\x8f\x3a\x8d\x59\x9b\x69\x54\x3a\xd5\xee\xb0\xd3\xaa\xf7\xc9\x31\x23\x3c\xcd\x1e\xa6\x71\x2b\xa5\x32\xc1\x5d\x26\x95\xc7\xc6\xf0\x7e\xf6\xd0\x99\xf5\x9f\x9a\xf5\xce\xc3\xa4\x3d\x7b\xa0\xea\x8d\x26\x4f\x74\x7a\x4f\x4f\xf8\xfd\xb0\xdd\x65\xfa\xfc\x3d\x3f\x15\x86\xf5\x29\xdd\x19\x54\xc7\x42\xfd\xe1\xb1\xdf\x2b\xcc\x84\xf0\x98\x8c\x06\x4f\xcd\x56\x07\xaf\xb6\x88\x7a\x6f\x48\x56\x1e\x3b\xf5\x6e\xaf\xd6\xa9\xdf\x4f\x7b\x83\x29\xde\x7c\x22\x9e\xbb\xf5\x71\xb3\xdf\x9b\x56\x85\x3e\x3f\x9e\x31\xc3\x2a\xd3\x7f\xc4\x9b\x85\x99\x90\x2e\x13\x9e\x9a\x55\x06\x4f\x3c\xf5\x44\xce\x78\xa1\xf9\x38\x1b\xe1\xd3\x76\x1f\x9f\xf6\xc9\xca\xb4\xd1\x9c\x0e\x19\x52\x98\x0e\xda\xfd\x1e\x3e\x6c\x3e\x90\xb3\x51\xb3\xdf\x1a\xf5\xda\xed\x26\x7e\x73\xee\x46\x58\x77\x4c\x9a\x73\xaf\x83\xc7\xc2\x8e\x4f\x74\xfe\x61\xc3\xec\x4d\xa2\xb7\x08\x79\x8b\x38\xd6\x1a\x16\xf0\xc0\xd3\xed\x9f\x65\x06\x56\x65\xb6\x1c\x5e\x45\xd3\xc8\x14\xcb\x2d\x82\xdd\xfa\xcf\x04\xe5\x2b\x9a\xb4\xe5\xf0\xdc\x48\xdb\x6f\x3b\x0c\xc5\x00\x86\xb3\x2c\xc9\xa1\x14\xc7\x52\x9e\x54\x6e\x58\xfc\xf3\xc9\x4f\xdb\x9f\xbe\x23\x9f\xa8\x3f\x50\xff\xef\xd3\x2d\xf2\xe9\x38\x39\xe8\x5e\x32\x80\xa3\xbd\xc3\x4f\xff\x4d\x73\xd4\x38\x37\x2c\xc6\x0d\xbf\x45\x88\x0f\xe5\xc6\x52\x2c\xc7\x11\x2c\xcd\x72\x9e\x6a\xa8\xc7\xcc\x76\xdc\xa1\xa7\x31\x17\x83\x89\x46\x17\x1b\x43\xd1\x03\xe3\xc2\x0c\x88\x28\x83\x04\x6d\xc2\xb0\xd7\xd6\x87\xb8\x45\x30\x5f\x21\xff\xf1\x8d\x4f\xdf\x5d\x15\x3f\xf9\xae\x20\xbe\xc2\x9d\xcb\xe3\xdc\x24\x5a\x5c\x2a\x32\x90\x8a\xc4\x99\xc0\x81\x3e\xc8\xca\x01\x83\x8f\xb6\x72\x4c\x9f\x62\x56\x3e\x33\xf7\x16\x97\x0a\xdf\x4b\x45\xb3\x2c\xf6\xa1\x56\xf6\x19\x7c\xb4\x95\x63\xfa\x14\xb3\xf2\x99\x7d\xb5\x2f\x55\x4e\x92\x4d\xda\xcf\x7c\x6e\x92\xdd\xef\x69\x0e\xd7\x00\x14\x05\x38\x4c\xa2\x68\x9a\x95\x49\x08\x38\x4a\x92\x39\x15\x55\x51\x92\x04\x92\x8a\xcb\x04\x2a\x13\x2c\x0d\x14\x85\x65\x18\x02\x85\x12\xa4\x68\x52\x52\x28\x4a\x41\x39\x40\x2b\x2a\x83\xa9\x6e\xcd\xc6\x49\x8c\xcc\x4a\x2a\xc0\x00\x27\x53\x04\x86\x49\x2c\x4e\xa3\x28\xa3\x72\xa8\x2a\x31\x14\x0d\x64\x94\x24\xa0\x82\x91\x38\x0e\x08\x19\xe7\x70\x94\x65\x65\x9c\xc0\x00\x8d\xa3\x34\xa4\x69\xd4\xef\x75\xb0\x58\xf5\x47\x78\xd5\x1f\x1d\x2f\x0a\x89\xa0\x28\x24\x38\x92\xa5\xc9\xdc\xab\x41\x5e\xc7\x58\x96\xbd\x45\x30\xda\xbd\x9f\x27\x7f\xb7\x08\xe9\xfe\x83\x05\xff\xec\x7f\xc4\x0e\x1f\xdc\xae\x87\xe7\x79\xbe\x76\xef\xb0\xda\x9d\x09\x8c\x7a\x77\xb4\xae\x3e\xf1\x2a\x55\x63\x94\x99\xc5\x0f\xbf\xa2\xd3\xd6\xdb\xa0\xfa\x3a\xd7\xba\xad\xed\x4a\xab\xac\x9f\xe7\xe3\x01\x06\xba\xe6\xe0\x69\x45\xbc\x55\xc7\x55\xf5\x19\xab\xbc\xcc\x66\x5b\x63\x67\x3b\xaa\xb5\xb3\x86\x46\x8f\x52\x21\xfb\xf4\xfc\x8c\x6d\x65\x17\x9a\x7f\x94\x2c\x55\x9e\xbb\x9f\x5a\x87\x7f\xf8\xa1\xfb\xcf\xe6\xf8\x7d\xc3\x0f\x86\xaf\xde\x27\xbe\xde\x6d\xdf\xbf\x03\x7a\xb8\xec\xeb\xb5\x8e\x03\x5f\x9e\xa4\xc5\xea\xa9\xc5\x8c\xa7\xed\xbe\x0a\xef\xa5\x96\xf2\xfa\xf6\xc2\x6d\xfa\x18\xef\x58\x77\x2a\xdb\x15\x24\xb3\xa5\xc9\x1b\xb2\x5a\xe1\x77\x18\xed\x2c\x9d\x59\xa3\x2e\x35\x9b\x6b\xb0\x11\x98\xc5\x23\xdb\x12\x88\xfa\xcf\x47\xcd\xe3\xdf\xed\x91\x1d\xf0\x73\x85\x0f\xf9\xe3\x5f\x23\xfc\xe5\xf0\xf7\xcc\x3f\x62\xe4\x90\xe7\x6b\xe8\x7d\xd2\xe5\x7f\xf5\x9f\xef\x74\x68\x4a\x5e\x88\x87\x0a\x7e\x1d\x37\xbf\xa1\x09\x85\x63\x55\x8a\xa0\x21\xa4\x59\x05\x93\x70\x46\xa2\x24\x96\x53\x71\x02\xa8\x1e\x26\x43\xd1\x1c\xc0\x49\x15\xa8\x18\x89\x12\x40\x41\x25\x0a\x97\x68\x82\x90\x50\x46\x82\x1c\x77\xe3\xe5\x24\x22\xd1\xeb\xa9\xb4\x60\x20\x51\x8e\x46\x89\xdc\xab\x7e\x27\x4e\x52\x1c\x9e\x11\x29\x44\x4a\xa4\xf8\x89\xdf\xb3\x6c\x63\xf0\xfc\x82\xf5\xd6\x94\x89\x4a\xf7\xcc\x8c\x34\x76\xfd\xf7\xe9\xb6\x41\x3c\xac\xcc\xd7\xaf\xef\x75\xbe\xef\x54\xb1\x36\xde\x65\x2a\x0c\xfd\xac\x2f\x05\xa5\xbf\x7a\xa8\x76\xa9\x66\xc7\xe2\xea\xbd\x17\x8a\x7a\x03\xf4\x06\x6f\xb6\xbb\xce\xdb\x64\x50\xef\xbc\x37\xd8\xdd\x60\x7a\x07\x78\xd3\x83\xf6\x82\x24\xe4\x8a\xa3\x29\xff\xb0\xbd\x5f\x62\x7a\xad\xbb\xd9\xbc\xad\x5f\xda\xf2\x6e\xf8\xd3\xe6\x98\xfa\x1d\x2f\x4c\xb4\xea\x7c\x38\xb0\x36\x34\xb1\x79\x03\x83\x46\xdf\x79\x41\x1f\xde\xe0\x4b\x75\xd4\x30\x58\x9e\x6c\x6f\xee\x0d\x8d\x31\xde\x20\x58\xdf\xa1\xc2\x62\x71\xd7\x78\x65\x77\x42\x6d\xc9\x18\x4d\x3f\x08\x13\x82\x40\xb0\x93\x1c\x69\x1f\x04\x3c\x5f\x79\xfd\x50\x8f\xfd\x80\x3f\xdf\x9d\x8a\x06\x01\x76\x1d\x07\xf6\x56\xa8\x91\xc0\x63\x30\x8e\x41\xbf\xa1\xd8\x37\x14\x43\x50\xf4\xbb\xf7\x5f\xaa\xa3\xe2\x18\x8d\xe3\xb9\x57\x49\x9c\x23\x39\x9a\xc1\x39\x3a\xc3\x8d\x73\x9d\xf8\x5f\xf9\x57\x79\x6c\x6b\xe4\xee\x6e\x37\x6e\x57\x98\x9a\x51\xe3\x9a\x38\xba\x7d\xa9\x7c\xb5\xd1\xb9\x63\x6f\x5a\x9b\x9f\xd8\xa3\x32\x9e\x3d\x81\xca\x3d\xa8\x7b\x4e\x2c\x24\x38\x71\xf2\xdf\xff\xe7\x4e\x8c\xfa\x4e\x9c\x53\x4b\x15\x78\x88\xe5\xdc\xd2\x2a\x65\x5f\x40\xda\x00\x13\x4b\x89\xb8\x1c\x98\xf8\xa8\x18\x3f\x0f\x26\x36\x3e\x24\xce\x43\x21\x63\xc3\xd8\xf3\x50\xa8\xd8\xb0\xe6\x3c\x14\x3a\x8a\x42\x9e\x87\xc2\xc4\x8a\xff\xf3\x50\xd8\xd8\x88\xe5\x3a\x0f\x18\x5d\x65\xae\x27\x7b\xe7\xc9\x2d\xc2\x16\x9d\xe3\x4a\x79\xcc\xe6\xe2\xe8\x09\x45\x4c\x24\x5c\x0e\x5f\xc8\xc3\x50\xe1\x9f\x4f\x8e\x79\xd1\xe8\xeb\x16\xf9\xa4\x5a\xe6\xf2\xa2\xd9\x08\x77\xbc\x59\x6a\x8a\xe8\x03\xe6\x8f\x13\x8c\x17\x8e\xcb\xc3\x67\x36\x34\x3c\x57\xd7\x86\x02\x2d\xdf\x7c\xe7\xcd\x01\x7b\x3a\xfa\x93\xa4\x97\x5a\x30\x7f\xae\xe0\x03\xe6\xaa\xd3\xac\x16\x64\x90\xc3\x67\xf2\x43\xad\x76\xee\xfc\xcc\xbf\xce\x6a\x7e\xae\x3b\x7c\x46\x3f\xd4\x6a\x17\x44\xfc\x87\x5b\x2d\x27\x71\x26\x3c\x4c\x77\xc1\xae\xab\x52\x4f\x15\x9d\x9b\x9c\x53\xf7\x02\x26\x16\x37\x64\x7a\x25\x90\x0b\x84\xc7\x80\xd2\xca\x9b\x5c\x20\x22\x9a\xf6\xd2\x3a\xf2\x5c\x1c\x32\x96\x3e\xcf\xc5\x89\x25\x94\xb3\xe5\xa1\xa3\x38\x69\x65\x4e\x2e\x0e\x13\x0d\xd5\xb3\xe5\x61\xa3\x38\xe9\xa5\x4e\xd9\x07\xa2\xae\x51\xec\xe4\xed\x3e\x2d\x51\xee\xa4\x3e\xfd\x74\x85\x98\x0a\x2d\x8f\xcb\x50\x92\x58\x86\x02\x28\xaa\xaa\x34\xc4\x08\x96\x00\x50\x45\x55\x05\xa7\x30\xc0\xd0\x2a\x8e\xcb\x98\xca\x01\x09\x07\xb8\xa2\xaa\xb2\x84\x32\x0c\x4b\x51\x0c\x41\x03\x05\xe2\x34\xc5\x01\x7f\x34\x7f\xd1\x1a\x75\x68\x16\x88\xd8\x0f\x91\x53\xa7\x58\x29\x14\xcb\x98\x9e\x0d\xae\x46\x22\xda\x1f\x5b\xb7\xe9\x17\xa8\x11\x2f\x4b\xb3\xc5\x4e\x1a\x7a\xed\x0e\xce\x65\x82\x19\x3c\x3a\xcd\x76\xfb\xe7\xec\x81\xdd\x3c\x68\xcf\x15\x50\x5d\x53\x1d\xaa\xcb\x7b\x63\x53\x7e\x3f\x01\x5a\x89\x0d\xfd\x42\xdf\x05\xef\x5f\x69\x39\x5f\x62\x0f\xb8\x32\xa7\x1e\xb0\xe5\x1b\x06\xf5\xae\xdc\xc0\x9c\xed\xcb\xf8\xa9\xfd\xcc\x6d\x84\xb9\x39\xae\x00\x38\x63\xa7\x5a\xdd\x0c\xc1\x74\x68\xb6\x15\xfa\x0a\x98\xd7\xf7\xd7\x8d\x07\xcf\x0d\xd6\xdc\xea\x65\xf7\x2a\x8f\xc6\x34\xaa\xbf\xf5\x3b\x6f\x3d\xb6\xde\xfc\x89\x93\xe4\x70\xc0\x4a\xe0\xa9\x07\x27\x93\xfb\xe7\x96\x6e\x11\x63\x69\x54\xc5\x88\x37\xc1\xe2\xd6\x03\xb2\x3f\xaa\xcd\x77\xd5\xca\xdd\x5c\x5e\xcf\xf1\x46\xdb\xaa\x75\xd7\x6d\x74\x3c\x21\x86\x7d\xd0\x9e\x56\x36\x3f\x7e\xdc\x84\xe7\x19\xc2\x93\xab\xc3\x24\xdd\xf8\x23\x7d\xec\xba\x4f\xe4\x99\xa9\x1a\x32\xcb\x1a\x54\xa5\x87\xc7\x67\xbc\xa6\x3f\xce\x80\xf5\x40\x4f\xb7\x1b\x69\x46\x34\x7a\xf7\xf3\x95\x41\xf0\xe3\xea\xa2\x55\x5f\x51\xd2\x76\xdc\x9a\x79\xf3\x04\x3c\xb3\xb4\x03\x7b\xcc\x33\x06\xda\xa9\xd3\x08\x9e\xed\x6b\x17\xf0\xff\xaa\x4b\x6f\x17\xf0\xef\xc6\xf8\x57\xd7\x26\x61\x3a\x24\xf5\x56\x1d\x08\xdb\xd5\xf0\x8e\x30\x9b\xbd\xaf\x3f\x31\x66\xb4\xd3\x6c\x4c\x57\xbb\xf5\xa7\xe5\x70\x36\xb7\xd6\xe3\xaf\x13\x7e\xaf\xff\x52\x3e\xf2\x17\x2e\xd4\xbf\x34\x7f\xd2\xe0\x5e\xcf\xe4\x1f\xf2\xa5\x39\x9f\xe0\x0b\xe7\xd8\xe2\x9a\xbe\xf0\x2b\xef\x85\x6f\x8b\x7f\x3e\x2a\x68\xbd\xe2\xd0\x7b\x76\x70\x3f\x89\xe9\xff\xeb\x76\x22\x5e\xb2\xcc\xef\x47\x23\x9b\xb8\x18\x12\xba\xa9\x96\x93\x38\xa8\x32\x8a\x04\x38\x40\x29\x12\x41\x10\x9c\xc4\xb0\xaa\x02\x58\x95\x20\x19\x86\x91\x30\xa0\x12\x84\x04\x48\x9a\x05\x0a\x25\xa3\x8a\xca\x91\xb4\x42\x2a\x37\xde\x6a\x28\x76\x49\xbd\xea\x0f\xa6\xb3\x92\x3c\x89\x72\x0c\x96\xba\xca\x76\xb8\x1a\xae\x92\x82\x45\x80\x0e\xdb\x1c\xbe\x0f\x5f\xa5\x36\xde\xe4\x89\xd9\xc3\xcb\xc8\x6a\x2f\x5f\x1e\x51\x54\x6d\xb0\x76\xa7\xc5\x2c\x51\x61\xb4\xb9\x9f\xdd\xf1\x8f\x04\x7f\xc8\xf1\xde\x5f\x46\x8e\xf7\xff\xac\xb7\x1e\xdd\x81\x7d\x30\x7f\xd9\x76\xc1\x74\xc0\xd1\x95\x9f\xaa\xcd\x41\x54\x36\xad\xde\xf3\xe3\xcf\xca\xec\xfe\xb5\x6e\xb6\xf7\x39\x9c\xe7\xfb\x94\xd5\x0e\xe3\x3d\xbc\x6f\xea\x9c\x7b\x49\xa8\xd6\x7e\xbe\xbd\xbf\x0e\x2b\x43\xb3\xc7\xdf\x6b\xea\x60\xf4\x58\x33\x3b\x8b\x77\x67\x27\x4f\x08\xbd\x3e\xa8\x0e\x29\x6c\xfe\xaa\xd8\xf5\x26\xa8\xf4\x66\x1b\x94\x1a\xdf\x3d\x2c\x66\xe8\xe3\xfc\xd5\x42\xab\x95\x81\x40\xf6\x40\xfd\x01\x6f\x2f\x65\x9b\x78\xde\x74\x96\x9a\x44\x4e\x46\x56\xb7\x53\x20\xb7\x47\x9c\x36\x2d\xb7\xfb\x0b\x7f\x27\xb9\x5d\xbb\xab\xa0\x1d\xf4\xbe\xb1\x73\x16\x9b\x1e\xa6\x3f\xa1\x60\xb7\x32\x31\xae\xd7\xdc\xbe\x77\xaa\xbb\x3e\xe5\x54\x04\xb9\xea\xeb\x48\xcc\x1d\xab\x6f\x3c\xdd\x31\xd3\x63\xfb\x6e\xa2\x10\x39\xf1\x7c\x01\xff\x9e\xb5\x9b\x4c\x2e\xe0\xcf\xf3\xff\xbb\x7c\x96\x98\x5b\x2b\xe7\xdb\xa2\x6f\x3c\x67\xaa\x99\x67\x8b\x4b\xef\x85\xeb\x0b\x5f\xe5\x18\x5e\x29\x5b\xfc\x33\x67\x69\x8b\x12\xf8\x69\xbb\x36\xac\x3e\x19\x3f\xd1\x87\x0d\x5d\x25\x25\x46\x36\x04\x8e\x1a\x4d\x36\xaf\x7d\xe5\xe9\xbe\x29\x55\x46\xf8\x7c\xf2\x60\xf7\xfa\xd3\x77\xec\xe9\xc1\xa9\x93\xf7\x6d\x8e\x9f\x4f\xb6\xfd\xda\x6c\xf1\xa0\x68\x2b\xa3\xd3\xc3\xe5\x2a\x65\x2e\xbf\x0a\x28\xf8\x59\xbd\x7a\x6e\xc5\x68\x12\x50\x28\x4d\x42\x09\xd0\xa4\x8a\xcb\x8a\x04\x14\x89\xa5\x68\x49\x25\x48\x92\x25\x59\x4a\x95\x69\x9c\xc6\x49\x06\x28\x80\x80\x0a\xc1\xc9\x8a\xa2\xa2\x2a\xcd\xa1\x38\x46\x10\x12\xed\xe7\x56\xfc\xb2\xdc\x8a\xe7\xe7\x56\x96\xe0\x32\x72\xab\x7f\x35\x3c\xe2\xbb\x34\xb7\x56\x63\x37\xf5\x24\xb7\xf6\xf1\xea\x1d\xdf\x27\xa9\xa7\x4a\x8d\x70\x9a\x0f\xf5\x3e\x36\x22\x78\xb4\x0b\x5f\x07\xec\xfd\x88\x36\x7a\x18\xcf\xc1\x99\xa6\xec\x5a\x8e\x1f\xd2\xe9\xb9\x95\x1f\x0b\xcf\xda\xb3\x04\xeb\x9b\xaa\x6d\xb5\x2b\x46\xbb\xb5\xb6\xef\x50\xea\xc1\xb9\xaf\x55\xac\xb9\x69\xaf\x17\x9d\xe1\xdd\x94\x7e\x9c\xbe\x90\xce\x66\xb6\x5b\xd8\xcc\xd4\x19\x93\xd5\x2e\xdc\xf6\xbb\xf4\xfd\x9b\xac\xbe\xdd\xb7\x31\x74\xa6\x57\x5e\x5f\x37\x06\x39\x67\x07\x2d\xf5\xa5\xd5\xf8\x77\xe5\xd6\x4b\x73\xdb\xa5\xf1\xdc\xdd\x74\x96\xd6\x15\x73\x2b\xcf\x3c\x75\x58\x9e\x79\xd1\xe7\xc2\x00\xa2\xca\x74\xca\x3c\x34\xe5\xda\x70\x4b\x0f\xef\x36\x7a\xf3\x4d\x26\xa6\x35\x8c\x02\xf7\x44\x4b\xc3\x7c\xcc\x6b\xe7\xd6\xff\x51\x6e\xe3\xaf\x94\x5b\x59\xf2\xd8\xbe\x55\xda\x16\xff\x08\x8b\xc6\xd3\x72\x46\x2c\x64\xde\x6a\xef\xe6\xcf\x3b\xad\x63\x0d\xb8\xfe\x83\x34\x1e\x6e\x00\xd9\xee\x74\xcc\x31\x3a\xc0\xfa\x3a\xd6\xfa\xda\x91\xeb\xb6\x29\xf5\xb1\xce\x74\xcd\xbf\x34\xed\xc9\x4b\x5f\x03\x46\x93\xd6\xc6\x8e\x52\x5f\x0d\x9f\xef\xbb\xf7\x5f\x5b\x83\xda\xae\x49\xee\x2a\xf3\xab\xd7\xad\x12\x0e\x59\x5c\x91\x80\x24\xa1\x38\x29\xe1\x0c\x40\x65\x02\x23\x51\x19\x30\x98\xc2\x02\x99\x93\x64\x06\x63\x09\x4c\xe5\x54\x0a\x10\x92\x42\x73\x50\x06\x84\xc2\xb2\xaa\x84\x42\x99\x92\x6f\x0e\xbb\xf8\x2e\xc8\xad\x79\x93\x13\x24\xca\x71\x54\xd6\x96\x17\xff\x6a\x78\xf6\xea\xd2\xdc\x5a\x8b\xdd\xd4\x93\xdc\x5a\x76\x6e\x22\x3d\xb7\xd6\xee\xd7\x3a\xe6\x74\x1a\x9d\x3a\xf9\xb0\xdd\x38\xa8\x52\xab\x3e\x08\x2a\xed\x48\x94\x4e\x4a\xbb\xae\xd5\x98\x57\x57\x5f\xf5\x87\xe7\xee\x72\x2b\x3b\x14\xa9\xf5\x54\x7c\xb9\x75\x5e\xb6\x74\x57\xa1\x9e\xef\x49\x81\xac\xe9\xb2\xad\x92\xb4\xc0\x2f\x2a\x8d\xf1\x74\x60\x1b\xac\xfa\x54\xfb\x77\xe5\xd6\x4b\x73\xdb\xa5\xf1\xdc\x41\x5f\xe9\xda\x15\x73\xeb\xaf\x9c\x93\xf9\x88\xdc\x7a\x6e\x6e\xe3\xaf\x94\x5b\xcf\x1d\xc3\x04\xb9\x75\x27\xad\x14\x69\xbc\xd5\xb6\xb0\x2e\xcb\x1d\xa5\x39\xdc\xe8\xa3\xe6\x57\x6b\xf6\xf5\x19\x36\xd8\x97\xf6\xd6\xe4\xdf\xd4\xd5\xc3\x6c\x72\x6f\x3f\x76\x20\x6c\xbd\x3c\x72\x2b\x5b\x7a\x62\xe1\x4b\x13\xce\xc6\xb0\xd2\xe7\xa9\xc7\x4e\xf3\x6b\x7f\xc1\xb7\x86\xa3\x57\xbd\xc6\xdc\xdf\x35\x71\xbe\x60\xdd\x9a\x32\xbb\x9c\xf5\xaa\x9f\xb2\x13\xcb\xf1\xd7\xfd\x1c\xb2\x35\xdc\xae\xf6\x4f\x3c\x7a\xef\x03\xf1\xf7\x74\xb9\x42\xa3\x19\xcb\x55\x09\xef\xf1\xb9\x60\x99\x2a\xed\x75\x33\xe5\x1f\x8b\x89\x9e\xe3\x91\x70\xc4\xed\xe1\xa0\xb8\xfd\xcb\xfc\xca\xbe\x01\x23\x82\xe9\xbd\x46\x85\xaf\xd5\xc2\x2f\x07\x3c\x65\x8a\x0c\x46\xad\x2e\x3f\x7a\x42\xda\xc2\x13\xf2\xf9\xf8\x16\x9c\xd4\x83\x38\x62\x87\xfe\x5e\x4d\xe6\x4c\x71\x4f\x25\x3d\xbe\x7f\x27\xf7\xc8\x90\x94\x23\x90\xaf\x67\xed\x00\x36\x53\x83\x30\xeb\xa8\x26\xfe\x95\x5b\x24\x4b\xa3\xd0\x51\x16\x27\xc7\x45\x5f\xae\xc7\x11\x31\x51\x85\x18\xc3\xa8\xf4\x09\xd2\xc6\x0f\xdf\x48\x3c\x3e\xfb\x62\xa9\x63\xa8\x49\x92\x27\x31\x8e\x79\xd1\xe1\x25\x4a\xb7\x91\x37\x30\xdd\x86\x5e\xd8\x94\x77\xf8\x46\xf2\x01\xe4\x17\xeb\x17\x43\x4d\xd2\x2f\x89\x71\xee\xdd\x89\xbd\xd1\x28\xf6\x24\x5d\xc1\x03\xdc\x2f\xd6\x2e\xca\x36\x49\xb9\xb3\x04\x43\xa6\xbd\xd6\x70\x2a\x24\xdd\x58\x97\x3e\x7a\x93\x4b\x9a\xe6\x3a\xb7\xb5\xb4\xe2\xa5\x6e\x6a\xca\xee\xbe\x9c\x2d\x74\xd7\xd5\x2c\x99\x49\x96\xa6\x19\x62\x15\xd6\x3c\x75\xb9\x3f\x77\x45\xfd\xba\xda\xa7\xb1\xc9\xd2\x3f\x53\xb4\x5c\x0b\xc4\xab\xa7\xd8\xf7\x2b\xe9\x17\x43\x4d\x52\x27\x89\x71\x54\xfa\xa4\xba\x22\x78\x6d\xa2\xff\xbf\x2b\x09\xeb\x83\x25\xc9\x18\x62\x13\x15\x6d\xff\xe6\x91\xac\xd7\x0d\x86\x3f\x5f\x49\xd2\x10\x62\x92\xb8\x71\x86\xa5\xab\x35\xbf\xd0\x3b\x96\x16\xa2\x01\x96\x87\x62\xbd\xd5\xab\x09\x8f\xc5\x5e\x9b\x18\xe4\x55\xaf\x45\x36\x38\xd2\xef\xc5\x2a\xdd\xe9\xb8\xd5\x6b\x20\x92\x63\x41\x18\xae\xdb\x6e\xbd\x53\xe6\xd2\x25\x0f\x9d\x1d\x78\x86\xc0\x31\x49\xc3\x07\x11\x86\x04\x8c\xca\x16\x22\x4a\x17\x2b\xf1\xa0\xc4\xcb\x05\x4c\x3e\x7f\x31\x55\xd4\x44\xf2\x94\xba\x51\xda\x79\x1d\xe1\xf9\x32\x86\x51\x5c\x91\x62\xfd\x64\xf4\xfe\x1e\x3a\xde\x74\x69\xfc\xee\xf7\x72\x79\x82\xb7\x65\x16\x92\x28\xa5\xcb\x97\x0e\x6f\x4b\x38\x5b\x9c\x23\x44\x58\x92\xc8\xf4\x63\x52\x04\xdc\x9e\xbc\x87\x39\x49\xb8\x05\xb0\x17\x97\x48\xe6\xbd\x8e\xba\x90\x58\xf1\x97\x58\x27\x49\xe3\x27\x9c\x4b\xe4\x09\x5e\xe4\x59\x48\xa2\xd8\x1b\xb2\x6f\x4f\x5f\x86\x9d\x37\xf4\xb8\xd8\xf5\x53\xf0\x5c\xf9\xe3\xa3\x9c\xa2\x51\x90\x00\x79\x61\x3c\xa4\x22\x16\x14\x33\xa3\x1a\x16\xa1\x8b\xe6\xd9\xfa\xd2\x4e\x23\x06\x17\x76\x81\xfd\x73\xec\xd1\x64\x9c\x70\xca\xc6\xed\xfe\x44\x8d\x34\x61\x8f\xef\x10\xbd\x50\x4c\x4d\x29\x2c\x60\xb8\x47\x3b\x43\x68\x73\x25\xae\xae\x25\x77\x80\x15\x16\x3d\x65\x44\x70\x96\x26\xc9\x0a\x38\xdb\xeb\x29\x10\x60\xa5\xe4\x87\x33\x55\x88\x9e\x0e\x71\xaa\x84\xb9\x72\xbd\x72\x61\x9e\xa5\x43\x20\xfc\x11\xe3\x5c\xe3\x67\x1b\xfa\x70\xda\x9a\x1b\xdc\x97\xdb\x3a\x0a\x17\x16\x79\xff\xd8\x6c\xb4\xda\x48\x94\x28\x6c\xd7\x6b\x89\x75\x82\x59\xac\xab\x48\x12\xd0\xf1\x6f\x89\x73\xc9\x6d\x3d\x62\x9c\xef\x92\x79\xee\xe7\x58\x8a\xcb\x24\x7c\x68\xcf\x05\x02\x9f\x82\xc5\x24\x57\x60\x4c\xce\xd8\x69\x41\xd9\x02\x7a\xc3\xa4\xeb\x88\xe7\x41\x15\x12\x2e\x75\x6c\xb6\xc7\x8b\x9d\x43\x74\xb1\x7c\x31\xbc\x3c\x21\x4f\x8f\x41\xca\x95\xf4\x3a\x76\x8c\xa0\x15\x95\x32\xd7\x9a\xd7\x91\xad\x90\x4c\xd9\xb2\xec\x25\xd6\x4d\xf3\x75\xbd\xba\x4c\xa2\x28\x56\xe1\x3b\xba\x3f\x67\x29\x51\xbe\x15\xd0\x2c\xd1\x3b\x4b\xe3\x1a\x12\xc6\xd1\x8a\xc5\x6d\x20\xe0\xed\xc9\xd1\x50\xb7\x27\xe7\x8b\xa5\x28\x71\x85\xbc\x1d\xe0\xe4\x49\x5c\xb2\x3a\x72\x51\xaf\x66\xdd\x12\x86\xcd\xb5\x9b\xff\xae\xed\x93\xb7\xed\x99\x86\x18\x1c\xba\x7c\xa9\x41\x73\x19\x44\xc6\xbc\x89\x13\x04\x01\x61\x09\xd9\x2f\xf7\x83\x2c\xec\x7c\x89\x13\xa2\x2c\x0a\x18\x54\xe1\x2e\x9e\xb3\x5b\x9d\x3f\xa8\xcb\x44\xcd\x2d\xfb\x13\x97\x84\xa2\x90\x41\x0d\xe5\x42\x1e\x9c\xe8\x4a\xd2\x26\x41\xe7\x96\x6f\x45\x3d\x39\x04\x7e\x6d\x67\x88\x40\x9f\x53\x6f\xa6\xc3\xc5\x4e\xd8\xbd\xbe\xa1\x4f\xce\xf0\xcd\x15\x3f\xd6\xa0\xb8\x32\xa1\x23\x95\x3f\xcc\xfe\xe1\x63\x9b\xf3\x34\x09\xd1\x16\x57\x22\xe9\x80\xe8\x0f\xd3\x26\xf1\x34\xea\x3c\xb5\x92\x1a\x15\xd7\x6f\x3f\x21\xf5\x61\x3a\x1d\x0e\x66\xcb\xd3\x23\x75\xe6\x30\x0a\x7d\x7c\xf0\xfd\x23\x42\x3b\x8e\x9e\x38\x00\x2e\x1b\xe0\x51\xd0\xe8\x10\xea\x4a\x11\x9e\xc5\xa2\x88\x0e\x39\xe3\xba\x4c\x66\xd7\xeb\xbe\x4e\x81\x0b\xc9\x9e\xdf\x89\x85\x07\xdb\x1f\xe1\x36\xa7\xf8\x67\x0f\xf5\x83\x45\x3d\x77\x60\x19\x3a\x09\xed\x6c\x03\x27\xc3\xb9\xd2\x05\x6b\x95\xd1\x32\x3c\x44\x93\x21\x59\xd2\x79\x56\x57\x90\x30\xf1\x98\xac\x14\x49\x93\x68\x33\x24\xf6\x8f\xb0\xbb\x82\x8c\xc1\x59\xbd\x29\x52\x1d\x4e\xca\xcb\x11\xe5\x9a\xf7\x35\x7a\xa2\x5e\x86\x60\xe9\x77\x76\xbf\x9f\xeb\x0a\x0b\x48\xa7\x50\x91\x35\xd4\xfd\x2e\xb6\x94\x65\xd4\x84\x05\x6b\xa0\xc0\x43\x59\xbb\x9f\x6f\x17\x25\xd3\x7c\x3d\x5b\xc4\x0c\xcc\xdc\x82\xf9\xf3\xe7\xfd\xe1\xdf\xdf\xfe\xf3\x1f\xe4\xc6\x36\x75\x25\xb4\x85\xe7\xe6\xfb\x77\x07\x6e\x9d\x2f\x5f\x6e\x91\x74\x42\xd9\x54\x8a\x11\xfa\x4b\x19\xe9\xa4\x92\xb9\x9e\x2f\x9c\x42\xec\x23\xa4\xd9\x02\x44\x48\x63\x22\x7c\x41\x66\x4d\x61\x24\xf8\x29\x17\xf9\x81\x10\x44\xd6\x0e\x83\x90\x0f\x5c\xd2\xd1\xa5\x22\xba\x37\x2b\xbc\xa1\xa1\xb8\x4f\x45\x00\x2f\x5c\xb0\x4a\x44\xcb\x16\x2d\x6b\xa1\x2a\x06\xe7\x6d\x0c\xf3\x36\x8a\x5d\x57\xcc\x38\x6e\x01\x81\xc3\x7b\xce\x4e\x37\x19\x16\xde\xe6\xa9\x29\xa2\x1a\xda\x6d\x52\x6f\xff\x9a\xcd\x9e\x01\x5b\xa4\xde\x1f\x09\xad\x46\xef\xb0\x01\x09\x19\x09\x75\x61\x24\xf4\xaa\xc2\x38\xb6\xf0\xee\x5d\xed\xf7\x90\xe9\xa0\xe6\x9a\x71\x24\x8c\x27\xa3\x56\x75\xe2\xfe\x54\x13\x3a\xc2\x44\x40\xaa\xfc\xb8\xca\xd7\x84\xec\xe3\xfa\x63\x5f\xc5\xd8\x0c\xf5\xf5\x8c\x11\xe5\x93\xb3\x45\x2b\x4d\x92\xa8\x7d\xe2\xb3\xe9\x89\xc6\x0a\x52\x7b\xce\x7e\xb6\x54\x4b\x04\x33\x7c\xff\x73\x3b\x84\xe5\x48\xb2\xc2\x7e\xf2\x34\xdb\x61\xca\x59\xe0\x74\xae\xfd\x7f\x68\x86\x14\x61\xa2\xb6\x48\x58\x1d\xb8\xae\x53\xc4\x67\x7e\xff\x0d\x06\x49\x77\x8d\x93\xa9\xf5\xa2\xde\x31\x30\x6d\x67\x6e\xc1\xf1\xb0\x83\x28\xc0\x01\xae\x8b\x21\xca\x7a\xb9\x42\x64\x73\xb9\xd2\xa1\x03\x3d\x1d\xfe\x5f\x00\x00\x00\xff\xff\x25\xee\x12\x24\xc5\xba\x00\x00")
+var _baseHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd5\x3d\x69\x6f\xe2\x48\xd3\xdf\xf7\x57\x58\xa3\x95\x32\xa3\xc9\x4c\x7c\x1f\xb3\xef\x3e\x92\x01\x13\x08\xf7\x15\x92\xac\x56\xc8\x17\xc4\x09\x60\x62\x4c\x08\xb3\x7a\xfe\xfb\xdb\xbe\xc0\x36\x3e\xda\x47\x32\xfb\x44\xab\x59\xc0\xd5\x75\x75\x75\x75\x75\x75\x75\xfb\xdb\xb7\xdf\xbe\x7d\x43\xfa\xfa\xd6\x5c\x18\xea\x68\xd0\x46\x14\xd1\x14\x25\x71\xab\x22\xca\x6e\xb5\x01\xcf\x7e\xb3\x9e\xd7\xc0\x67\x55\x41\xe6\x86\xbe\x3a\x01\xbc\xaa\xc6\x56\xd3\xd7\x08\xf7\x9d\xfe\x8e\xf9\xa0\xa4\x03\xb2\x59\xcc\xac\xe6\x21\x90\xdf\x46\xc2\x18\xd9\x9a\xa2\xa9\xae\xd4\xb5\x39\x33\xb5\x95\xaa\xef\x4c\xe4\x4f\x04\xfd\xc3\x7e\xb4\xd4\xe5\xe7\xf3\x5f\xe5\xa5\x66\x41\xab\x6b\x59\x57\xb4\xf5\x02\x3c\xb8\x98\x8c\xeb\xec\xc5\x1f\x1e\xba\xb5\x22\x1a\xca\x4c\xd6\xd7\x73\xdd\x58\x01\x88\xd9\xd6\x34\xc0\xff\xb6\x00\x52\x5f\xbb\x38\x1e\x55\x80\x7a\xbe\x5b\xcb\x26\x60\x67\x26\x01\x4c\xaa\xf5\x7c\x2e\x2e\xb7\x6a\x80\x0c\x40\x30\x5b\xa9\xdb\xad\xb8\xb0\x01\xf6\xa2\xb1\x06\xb8\xfe\x70\x79\x57\x45\x43\x7e\x9c\x6d\x44\xf3\x11\x3c\xdb\xec\xa4\xa5\x26\x5f\x5a\xc2\xca\x40\x27\x4b\xdd\x02\xe3\xdb\x63\x61\x88\x8c\xf9\x4a\x5b\x40\x9a\x75\x44\xb8\x6b\x8e\xc6\x23\xa4\xd7\x6d\xdf\xbb\xf0\xdf\x1f\xb5\xad\xa9\x1b\x87\x99\x6e\x28\xaa\x01\x58\xd1\x9f\x67\x5b\x71\xb5\x59\x02\x7a\xb5\x61\xaf\x8f\x54\x7b\xdd\xd1\x78\xc8\x37\xbb\x63\x1f\x82\xf8\x46\x40\xf0\xdd\xda\x04\x3f\x8a\xdb\xad\x6a\xce\x34\x65\x36\x7f\x56\x0f\x7f\xfc\x02\x46\x2c\xa3\xc8\xcf\x85\x69\x88\x0a\x40\x22\x2e\x80\x1d\x2e\x44\xab\x9b\x60\xd8\x88\x68\x55\x5c\x21\xe5\xb1\x52\x86\x4a\xe0\x49\x97\x24\x78\x0e\x82\xb2\xfd\xe9\x23\x48\x7e\xac\x46\x1d\x6a\xd9\xa5\x73\x18\xb4\x5c\x5d\x12\x31\x1f\xd4\x09\xb9\x0d\xde\xec\xd6\x84\x3b\x1f\xa4\x8b\xd6\x34\x76\x5b\x73\xb6\xd4\xd6\x16\x6b\x80\xc9\xc3\x46\x05\x7d\xa0\xa8\x33\x6d\xbb\xdd\xa9\x46\xa6\xc6\x0e\x71\x49\x5c\x8a\x6b\x59\xcd\xd4\x32\x0f\xb1\xa3\x0a\xd3\x9a\x01\xb5\xcf\xd4\xf9\x5c\x95\x4d\xbb\xe1\xc9\xcd\x24\x37\xdc\x6a\x8b\x35\x98\x6b\x7c\xb4\x92\xe1\x75\x40\xc2\x01\xdf\xaa\xcb\xa5\x35\x69\xd8\xfa\xc8\xd2\x28\x4d\x05\x27\xe8\xa5\x08\x74\xb1\x02\x73\xce\x5c\x53\x95\xd9\x52\x55\x16\xf0\x6d\xa5\xdd\x01\x92\x3b\x6d\xad\xa8\x6f\x33\x9f\x01\xaf\xb7\xa2\xec\xb8\x21\xdd\x9a\xd3\x56\x7a\x91\xf6\x69\x3d\x17\x6c\xad\x6f\x54\x43\x3c\xb6\xb5\xec\xb4\x40\xeb\x13\x27\x85\xb8\x28\xd2\xd6\x56\xff\xb6\x40\xfb\x55\xba\x49\x06\xdb\x3b\x56\x62\x37\xde\xaa\x2f\x3b\x35\x75\x90\xc6\x36\xdf\x18\xea\xab\xa6\xef\xb6\xee\x6f\xb3\x47\x71\xfb\x98\x13\x55\x71\x0c\xda\x6a\xa3\x1b\xd6\x94\xe1\x46\x86\x79\xd1\x28\x39\x1b\xca\x4b\x7d\x0b\xc6\xa0\x98\xa9\x2f\x3c\x7f\x94\xc3\x94\x7d\x4d\xb3\xdb\x90\xbf\x71\x66\x03\x72\xdd\x60\x0e\x75\xf9\x5b\x8a\x8a\x62\x80\x68\x38\xb9\xf9\xa3\x09\xe2\x6f\x2b\x6e\x9f\x2d\x81\xa3\xde\x6d\x20\xa0\x37\x69\x2c\x39\x50\xa2\x66\x64\x44\xec\x85\x24\xd0\x0d\xac\x49\xc6\xf2\xb6\x70\xa0\x1e\xfa\x1c\x4d\xa0\xe6\x25\xaf\x91\x1d\x78\x64\x20\xe2\x0f\x54\x20\x5a\x80\x20\xd5\x0e\x20\x80\x6a\xd3\xa0\x37\x16\xe0\xa3\x99\xda\x5f\xdb\x80\xa3\xb6\xc2\x84\xf4\x16\xae\x3f\x81\x01\xd6\x1d\x3e\xf4\x54\xc0\x84\xf5\xc9\x01\x6a\xee\xb5\x30\xcc\xcc\xb7\xd9\x66\x06\x43\x0b\x38\x7a\x58\x48\x15\x16\xcc\x8b\x7b\x92\x81\xd5\xb7\xcd\xcc\x1f\x41\x42\x46\x66\x11\xcd\xac\x10\x32\xb9\x11\xa4\xea\xac\x1e\x4a\x9d\x1b\x60\x43\x34\x37\x40\x85\x93\xea\x08\x9c\x2e\xcb\xd1\xbd\x69\xeb\xf9\xd2\x9e\xa2\x67\x20\xd4\x37\xb5\xb5\xfd\x19\xb2\xed\xa3\x0e\x9c\x92\xa2\xaf\x44\x0d\xb6\x85\x95\x36\xf1\x2f\x25\xd6\xa2\x35\xf6\xd2\x97\x12\xbe\x48\x3a\x61\x29\xe1\x8f\xb7\x37\x90\x8b\x14\x27\xc8\x4c\x40\xea\x46\xa1\xb0\xf8\x00\xd8\xec\x55\x5c\xee\xd4\x99\x35\x04\xd5\x04\xc4\x21\x48\x68\x0a\x11\xc1\x29\x98\x23\x0c\x53\x93\xb5\x8d\xb8\x36\x21\x17\x76\x91\x4d\xf3\xf0\x50\x52\x92\x60\xf3\x8b\xf2\x35\x99\xe9\x7a\x21\x6d\x56\x9d\x47\x37\xcc\x4c\xdf\x09\xa0\x20\xe8\x39\x80\xef\x8e\xdf\xf1\x39\xf6\xfa\xdb\xf9\x68\xaf\xc7\xdd\xdc\x84\xed\xb3\x66\x90\x1c\x2c\x74\x63\x33\x5b\x69\x0b\x23\xd5\x8e\x42\x90\xd0\x32\x86\xbc\x7e\x02\x85\xf0\xfc\xb0\x79\xb7\x94\x07\x34\x66\xcf\x85\xba\x8b\xfc\x24\xf4\x21\xd0\xcc\x34\x60\x70\x67\xe6\xdb\x72\xfd\x30\x88\xed\x29\x22\x09\x3b\xac\x1b\x74\x5a\x57\x7b\xed\x49\xa7\x8b\x68\x8a\x43\xbb\x26\xd4\xf9\x49\x7b\x0c\x89\x3b\x66\xb0\x97\x80\xd9\x1d\x66\xc9\x98\xec\x6f\x31\x88\x7c\x73\x5d\x32\xa0\x33\x7f\x25\xc3\x84\xa6\xa2\x64\xe0\xa8\xe4\x88\xdb\x62\x24\x0c\x26\x42\xb7\x9a\xa3\xb7\xac\x60\x00\x2c\xf1\x33\x53\x0e\x20\x81\x6e\x1d\x9e\x7d\xb2\x34\x84\x83\x3d\x9f\x69\x20\xdb\x1d\x33\x26\xd0\x2a\x8d\x99\x58\xb2\x28\x34\x1a\x05\x5c\x5b\x37\xaf\x00\x07\xec\x2e\xe6\xa1\x65\x73\x27\x99\x2c\xb2\x04\x32\x0c\x69\xb0\xae\xd3\x81\xe7\xe7\x18\x36\x43\x70\x14\x9a\xa6\x92\x81\x43\x33\x4e\x32\x30\x3c\x60\x68\x2a\x80\x84\xb6\x7c\x30\x1c\xa8\x0b\xc5\x5f\x5f\x0f\x85\x6b\x7e\x1c\x01\x69\xed\x25\x6e\x0c\x4d\x56\x3f\xaf\x77\x2b\x15\x7c\xf8\xeb\xef\x2f\x10\xad\xc4\xb7\x1c\xad\xac\x1c\xf3\x67\x71\x7d\x50\x97\xf6\xe6\x2a\x44\x8b\xb9\x66\x44\x36\xa9\x4f\xba\xd5\x71\xb3\xd7\x4d\x90\xc7\xf2\x20\x27\xee\x2e\x91\x33\x46\x13\x70\x78\xd2\x15\xc0\x61\xe7\xd3\xad\xe6\x27\xe6\x2f\x91\x2c\x82\xd8\xa2\x43\x60\x10\xee\xc6\x42\x77\x14\x42\xb1\xdc\x2c\xb6\x2f\x4b\x6f\xdc\x54\x1b\x42\x87\x3f\xa3\xf0\x87\xb5\x71\xfe\xed\x1b\xd2\x05\x8b\xbc\x1f\xde\x6f\xc8\x18\xc4\x87\x3f\xdc\x26\x7f\x20\x23\xf9\x51\x5d\x89\x3f\x90\x6f\x7f\x20\xbd\x3d\xb0\x50\xf0\xc9\xde\x6e\xaf\x0e\x05\xab\xbf\x5c\xcc\x1e\xbe\xdf\x02\x18\x83\x0f\x5d\xc4\xd5\x5e\xa7\x23\x74\xc7\x09\x98\x1d\x00\x10\xa4\x04\x11\x20\xcd\x11\x72\xe1\x6d\xa4\x7b\xbf\x6d\x6d\x24\x17\x61\xca\x9e\xf8\x2e\xcd\xa3\x86\x52\xe5\x09\xe8\xb2\xdb\x1b\x87\xf4\x89\x4c\x9b\xe3\xc6\x91\x2d\xff\x8e\x7a\x80\xfc\x09\x4b\x88\x91\x2c\xc2\x9f\x21\xb1\x15\xd0\x6f\x5f\x6d\x16\x56\x05\xc4\xc6\xd0\x65\x55\xd9\x19\xe2\x12\x59\x8a\xeb\xc5\x4e\x5c\xa8\xb6\x1a\x20\x2b\x00\xfc\xec\xa6\x1b\x9a\xcb\xbe\x67\xab\x27\xfe\xbd\xbe\x8d\xd2\xe5\xd1\xb2\x53\xf1\x23\x43\x61\x3c\x19\x76\x47\xbe\xdf\x7e\x43\xc0\x5f\x9b\xef\x5e\x4f\xf8\x6b\x01\xb1\xa5\xef\x74\x26\x8e\xb3\x03\xc1\x69\xb3\x3a\xb6\x21\xf8\x11\xf2\xfb\xec\x77\x30\x31\xb4\x85\xea\x18\xf9\x1d\xb3\xbe\x85\x7b\x23\x75\x20\x16\x93\x2e\x0d\x7d\x69\xc2\xe1\x51\xc2\xc1\x78\xaa\x62\xf2\x41\x50\x38\x8a\x78\xfc\x29\x97\x84\x9f\xc1\x6f\x55\x7e\x24\x20\xd3\x86\xd0\x05\x9d\xf9\x17\xf6\xf7\x15\xf8\x17\xff\xfb\x3f\xbf\xe3\xf6\x67\x1c\x7c\x46\xc6\xce\x43\x44\x68\x03\x48\xa0\x14\xa1\x5b\xfb\x12\xa9\x19\x88\x79\xa0\xa0\x66\xd2\x29\xbc\xb7\x66\xfe\x2f\x8f\x66\xce\xe7\x54\x57\x0f\xc7\x79\x18\x4e\x11\xa7\x69\xfb\x0c\xa3\xcd\x31\x82\x8c\x2c\x5d\x59\x15\x4c\x9e\x07\xb8\x74\x7e\x1e\xdf\xf7\x05\xf0\xb3\x6f\x44\x7c\x89\x1a\xb5\xa5\xf2\x18\x46\x18\x62\xd1\x1b\xc6\xf0\x1c\x46\x86\x40\x45\xb9\x8c\x42\x1a\xe2\x34\x30\x20\x83\xec\x9e\xac\xec\x4b\xec\x70\x28\x95\xdb\x08\xa4\x61\x6e\xfd\x83\x24\x91\x5b\x6b\xe6\x52\xd4\xb9\xb8\x5b\x9a\x33\x53\x94\xc0\x92\x6c\x23\xca\xaa\x55\x49\x77\xf1\x47\xf0\xe9\x5e\x33\x1f\x67\xba\xa6\xf8\x8a\xe3\x02\xb2\x1e\x83\x5f\x57\x3e\x7b\x74\xc1\xc9\xe6\x0c\xc4\x63\xa2\xc5\x91\xe5\x94\x10\x47\xe4\x47\xd1\x00\x2b\x5b\xd5\x40\x5e\x45\xc3\x2a\x7a\xf8\x4c\xd1\x5f\xec\x48\xa1\x3b\x69\xb7\x1d\xf9\xdc\x72\x15\x44\xd2\x16\xda\xda\x0c\x3f\x74\x4a\x25\x96\x9a\x28\x69\x4b\xcd\xb4\x2a\xfc\x22\xe1\xbc\x8a\x0f\x08\x40\x67\xe3\x7d\x06\xd4\x29\x01\xbe\x22\x81\xc0\xb3\xd9\x76\x27\x01\x3b\x36\x2c\x44\x00\x40\x05\x8b\xc2\x10\x50\xe4\x56\x03\x94\xc4\xa0\xdd\x22\x0e\xab\x6f\x13\x22\x02\x17\x81\x87\x71\xad\xc0\x40\x04\x4b\xf3\xbd\xaa\x2d\x1e\x4d\x64\xbb\x12\x2d\x3d\x84\xe5\x31\x1f\x0d\x75\xfb\xa8\x2f\x95\xd9\x52\xdf\xa7\x03\xad\x54\x45\xdb\xad\xd2\xe1\x1e\x01\xcd\x38\xa8\xa8\xfa\x98\x33\x91\xcf\xc7\x5d\x70\xcd\x56\xd4\x20\x9d\x2c\x9d\x63\x95\xee\xbe\xe4\xb3\x7a\x88\xd0\x2b\x46\xa1\x61\xc5\x66\xb4\x62\x6b\xeb\x27\x02\x90\x26\xc3\x80\x76\x62\x2a\x02\x92\x3b\xe3\xa0\xa8\x0a\xbd\x45\x72\x61\x2d\x7a\x39\x5a\x88\xe1\x7d\x2e\xaf\xd3\x18\x0a\xd4\x35\x62\x08\x11\x7d\x09\x83\xdc\xd2\xf9\x72\xdb\x8e\x60\x40\xa0\x48\x6f\xe0\xd4\x69\x9c\x4b\x10\xe1\x34\x8e\x9e\x30\x7a\x70\x3b\x03\x3f\x6e\x5c\xe9\xab\x65\x84\x9a\x70\x8a\xfa\x92\xa0\x8a\x70\xa2\x25\xaf\x3a\xc2\x9b\x09\x6e\x5f\x1f\xf7\x40\x62\x24\x3a\xed\x97\x44\x8d\xaa\x33\x6f\xe5\xdf\x48\x81\x1a\x56\xae\xee\x4d\xf5\xcd\xcc\xa2\xee\x73\x3d\x85\xb3\x57\x79\xf5\x14\xde\xd6\x39\x9a\x4e\x04\x8b\xe2\x66\xb3\xd4\xec\xca\x24\xc4\xaa\xca\x00\x8a\x5d\x6d\x10\x6b\x36\xb6\xbf\x22\x3f\xf5\xb5\x7a\xce\x68\x5c\x6e\xce\xcb\x34\xb8\x49\x3d\x38\x9e\x8f\x29\xc0\x18\xac\x6e\x80\xc1\x0f\xc7\xce\x5a\x1d\xb3\x7f\x68\x76\x41\x73\x7b\x61\x5d\xb9\x77\x7f\xea\xf6\x90\x4e\xb3\x7b\xcb\xb7\x27\xc2\xf1\x3b\x7f\x77\xfa\x5e\xe5\xc1\x2a\x1f\xc1\xd2\x84\xc9\xad\xf6\x30\xa2\xb3\x21\xeb\xee\x32\x20\x6b\xd0\x0d\xc0\xc5\x7e\xbe\x88\x91\xf8\xe2\xc7\x0f\x43\x5d\xc8\xc0\xb7\x6e\xcf\x6c\xcd\xa9\x8b\x8a\x76\x55\x09\x1d\xe5\x64\x68\x0b\x4b\xe6\x6c\xa1\x1c\xe5\x4a\x1a\x6f\xf6\x80\x84\xf1\xa8\xef\x37\x3c\xd3\xf4\x51\xb2\xd9\xfa\x71\x7e\x98\xd1\x26\x09\x82\xf4\xa6\x5d\xa1\x06\x68\xa5\x48\xe4\x6c\x89\x25\x0b\x74\xc4\x15\x7a\xfc\xdd\xaa\x2d\x8a\xe6\xcd\xdb\x79\x28\x6a\x75\x2e\x1e\xd7\xec\x42\x63\x66\x16\x37\x23\x9e\x6f\xb4\xc4\x41\x7e\xb2\x77\x8e\x3e\xc5\x58\x73\xc2\xc4\xa2\xa8\xa6\xa8\x2d\xb7\xc8\xd3\x56\x5f\x4b\x3e\xeb\x8c\x18\x9e\x7f\xfd\x1d\x98\x2b\x1c\x3e\xe2\xed\xd3\xdb\xe1\x29\xaa\x3a\x17\x8f\xab\x3a\x6f\x5d\x11\x23\x8e\xaf\xca\x16\x6a\xe0\x46\x15\xf8\x46\x37\x74\x35\xe9\xdb\x43\xb4\xfb\xee\xc8\x87\xe7\x18\xd1\x10\x85\x53\xdf\xc1\xc1\x1f\xab\x6c\x43\x73\x99\x75\xae\xeb\x38\x9d\x85\xdb\x18\xaa\x68\xa6\x36\x72\x60\x77\x1b\x05\x1a\xf6\x68\x6d\xee\xd7\x50\x01\xf2\x99\x2c\xd8\x59\xa8\x65\x8a\x4b\x20\xb7\xb6\x8e\x59\x25\xce\x55\x75\xb6\xd1\xf5\x65\xcc\xa2\xd4\x2a\xcc\x04\x20\x31\x7d\x6d\x3f\x06\x33\x89\x6a\xbc\xc6\x81\x58\x49\x09\xf3\x6d\x66\x87\x5b\xda\xcf\x38\xa8\x8d\xa1\x9b\xba\xac\x2f\x63\xe5\x42\x63\xac\x4c\x15\xc1\xa0\xb3\x23\x12\x37\x06\xdf\xc9\x32\x98\xd9\xe6\xbb\xe5\x2c\xd6\x50\x5c\xc1\xc1\xa0\x03\x9d\x10\x0b\x15\x3f\xac\x62\x36\x5d\x8b\x8e\xb2\x98\x9a\x85\x94\x69\x12\xde\x41\xa5\xbb\xbc\xac\x22\x97\x3b\xf3\x25\xd2\xf8\xa8\x99\x30\x93\xa0\x05\x67\xc6\x44\x5a\xe7\x33\x65\x34\x78\xc2\xcc\xe9\x2b\x49\x28\xcd\x36\xd3\x56\x90\xc1\xe3\x2e\x31\xab\x4c\x6b\xb1\x20\x3b\xa2\xd8\x93\x66\x29\x73\xe6\x56\xdf\x19\xf2\xb1\x8a\x3c\x66\xea\xf1\xdc\xc9\x05\x08\x8e\xd3\x56\xb9\x25\x4d\xc2\x11\x05\x25\x85\x7b\xe3\xbc\x84\xf2\xf3\xc9\x1f\x7b\xc7\x0b\xa3\x95\x1f\x3e\x65\x19\x0d\xe5\xba\xd7\x94\xa9\x3e\xcf\x44\x29\x01\xb8\x99\x04\xe8\xba\x49\x5e\xdf\xaf\xe2\xf6\x39\xf8\xeb\x0a\x0c\x05\x3b\x45\x1c\xfc\x79\x0b\x42\x06\x31\x8c\x00\x80\x2a\xea\xc6\x7c\x9c\x61\xde\x83\xb3\xee\x7c\x4e\x81\x38\xe1\xa0\x52\x71\x9c\x43\xc4\x5b\x80\x5b\x7e\x54\xb4\xd7\xdd\xd3\x9f\x9f\x4b\x8d\x4b\xdd\x8e\xce\xd3\x93\x76\x7d\x5a\x2c\xd9\xd0\xd9\xd3\x24\xa0\x44\x4b\x74\x40\xfc\x43\x2d\xce\xa0\x53\x68\xc1\x19\xfe\x11\x2a\x81\xa2\xcd\x92\xb6\x75\x8f\x51\x22\x60\x1c\x2e\x55\x71\xed\x05\x32\xd6\x8e\xc6\x3a\x10\xb4\x39\xbf\x05\x03\xb9\xd3\x09\x99\x59\x28\xc4\x0b\x9c\xd1\x09\x3f\xf4\x55\x60\x46\x9e\xf5\xb5\xb9\x9e\xd9\xf7\x05\x20\x60\x9e\xab\xb6\x90\xcf\x9f\xfd\x1a\xfc\xcf\x9f\x08\xfa\xe5\x4b\x1a\xae\xa8\xf6\x9e\xd6\xfe\xef\x4c\x91\x10\xf8\x02\x4a\x0d\xa1\x0f\x69\xdc\xe1\x30\x6d\x30\x05\x8b\x00\xcb\x19\x59\xc1\x62\x78\x67\x98\x81\xd8\x56\x5f\xee\xec\xed\x8e\xbc\x06\x0c\x67\x77\x9f\x8e\xe3\xee\x53\x02\x9a\x04\x26\x5e\x01\x9f\x2b\x35\xc6\x6f\x79\x3c\x24\x02\xd9\x7b\x1c\xa7\xcd\x6a\xc7\x3d\xe8\xfb\xf0\x4f\x60\xb9\x06\x62\x74\x75\x16\x05\xee\x3d\x8b\x68\x06\x1c\x95\xa7\x43\xef\x27\xdb\xe9\x1c\x7f\x4b\xec\xf2\xe8\xaa\xd1\x12\xfa\x3d\xba\x02\x19\x32\xe6\x86\x09\x76\x8a\x44\xdd\x69\x35\xb7\xe5\xc4\xdd\x29\x54\x3e\x2a\xf2\xce\x28\x6c\xc1\xd8\x3b\x85\xda\x79\xf4\x1d\xd7\x20\x21\xfe\x0e\xd4\x59\x97\x68\xab\x9e\x7d\xfa\x59\x82\x4e\xb7\xc0\x45\x76\xb0\x21\x7a\x72\xb4\x1d\xbd\xcd\x78\x24\x1d\x39\x5e\xac\x7c\x41\x7c\xc2\x21\x2e\x95\xf3\x4b\x92\x31\xe6\xdb\x4c\x5d\xbf\xaa\x4b\xc0\x54\xd4\x9e\x08\x78\x0c\xa6\x8f\xdd\xd2\x8c\x79\xb8\x02\x6b\x98\x98\x47\x56\x52\x26\xee\xb1\xb5\xcb\x28\x9a\x3b\x80\x3a\x6a\x6b\x95\xfe\xf2\xd7\xdf\xa7\x55\xce\x3f\xff\x8d\x5a\xe7\x00\x88\x90\xce\xd5\x95\x1e\x93\x69\x3f\xe1\x5a\x03\x35\x40\xac\x9a\x2c\x5c\xe7\x68\x5c\xc9\xac\xb3\xd0\x12\xe8\x38\xc5\xde\xc7\x62\x81\xfd\x2e\xd4\x70\xde\x26\x18\x4f\x59\x9a\xb0\xb0\x2d\x54\x25\x3e\x31\x13\x3e\x05\x91\x77\xac\x85\x8f\x00\x3a\xc3\x2c\x7a\x1f\x3d\xb0\x59\x99\xbc\xdf\x9d\xb2\xaf\xe9\x9e\xf3\xc8\xcb\xb4\x7b\x0e\xf2\xf3\xb1\x38\xc4\x89\x1a\x61\xb6\x1c\x93\x23\xf8\xc0\xd5\x22\x51\x96\xe8\xbf\xdc\x23\x72\x53\x30\x21\x86\xb6\x63\xe2\x75\x6c\x36\x10\x3c\x54\x92\x1e\x22\x8a\x0e\x14\xa3\x5a\xc9\x63\x59\xb3\x13\x86\xf0\x75\x27\x39\x8b\x0d\xfc\xe7\x76\xf2\xf6\x95\xff\x34\xec\x87\xd4\x6a\x40\xee\x6a\x67\xd9\xa6\xce\xb6\xcf\x96\x58\xf2\x74\x52\x07\xf8\x67\xa5\x99\x1f\x54\x18\xf5\x0e\xc6\x11\xda\xda\x04\x31\x81\x6b\x22\xde\x91\x30\x98\x20\xc5\xb1\x11\xfb\x0c\x5e\xca\x69\x33\xab\xe6\x2d\x7e\x1b\xd8\xbf\xe1\xe6\xdf\x04\xce\x96\xf3\x2c\x4f\x08\xc8\xc3\x78\x89\x42\x25\xe6\x4a\x61\x84\x8c\x8d\xf5\x4b\x13\x13\xfa\x3c\x63\xa2\xa0\x29\x81\x69\xb4\xa8\x35\xab\xf8\x6b\xae\x1b\x49\x65\x8e\x48\x8d\x1f\xf3\x29\xb2\xa5\xe0\x3b\x2f\x55\x2b\x03\x69\x54\xf1\x56\x11\xbc\x31\x25\x42\x05\x50\x26\x55\x1e\x15\x40\x9b\x54\xa8\x03\x83\xb6\xd9\x1d\x09\x60\x55\xd6\xec\x8e\x7b\x67\xc5\x3a\xf6\xb2\x6b\x84\x7c\xbe\xc0\x66\xda\x1a\xf8\x42\x71\x39\x73\x8e\xc4\x7c\xdf\xbe\x2c\x2f\x2e\x91\x0b\x1c\xc5\xb8\x6f\x18\xfa\x8d\xc0\x10\x8c\xfc\x81\x71\x3f\x48\xee\x3b\x4a\xb0\x04\xf1\x15\xc5\x2e\x80\x69\x41\x21\xc7\x67\xce\xdd\x3b\x01\x43\xb5\x6e\x66\xd1\x35\x25\x91\x10\x89\xd3\x4c\x16\x42\xc4\x6c\xb7\x55\x8f\x2b\x07\x40\xf5\xec\xba\x9f\x64\x72\x14\x87\xd3\x59\xe8\x91\xd6\xd5\x41\xb3\xf0\xae\x64\x22\x0d\x8a\xc4\xc8\x4c\x32\x51\x33\x67\x9d\xe2\xa5\x9f\xec\xd2\xe6\x44\x12\x34\xc6\xa2\x64\x16\x12\xb4\x47\xc2\x9d\x13\x20\x48\x30\x28\x97\xc9\x04\x18\x67\xb6\x3c\xc0\x4b\xc1\x62\x68\x36\x45\xb1\x76\x67\x78\xd9\x39\xdd\x48\xee\x6b\x96\xc2\x70\x36\x1b\x7a\xbf\x92\xdc\xd3\xfd\x10\x62\x70\x14\x93\xa9\x33\x38\x5b\x0c\x67\xc7\x7a\xf6\xa6\x18\x89\xd8\x39\x9c\xa0\x33\x59\x2c\x86\xda\xe8\xdd\x5e\xb0\x83\xe4\x64\x02\x14\xcd\x60\x99\x08\x60\x7e\x02\xc7\x38\xd4\x1a\xff\xc9\x84\x38\x9c\xe5\x32\x11\xc2\x03\x3d\xe1\x26\x8d\x9d\x5b\x71\x93\x28\x61\x28\xc5\xd1\xd9\x44\x22\x1c\x71\x8e\xb9\xf6\x44\xcb\xc2\x30\x8c\xa1\x32\x19\x2e\x46\xce\xe6\xda\x9b\x77\xbd\x86\xbe\x5a\x82\xaf\xea\x52\x49\x26\x42\x30\x44\xb6\x8e\xa7\xbc\xca\x19\xaf\xa2\xe1\x2d\x45\x0c\x8a\x62\x32\x0d\x10\x8c\x06\xdd\xbc\xb0\x76\xe8\xce\x6b\x26\x52\x48\xd1\x5c\xb6\xb1\x88\x31\x81\x00\xc8\x2e\x4e\x11\xb5\x14\x8d\xb1\x14\x8d\x67\x22\xc2\x1e\xcd\x17\x4c\xc6\x5e\xfc\x91\x48\x03\x07\x33\x23\x95\x89\x06\xe7\x18\x55\x32\x5a\x82\xc0\xd0\x4c\x16\x85\xa3\x11\xac\xa7\x0f\x42\x8c\xa0\x48\x2e\xd3\x20\xc4\x31\x6f\xa4\x1b\xea\x4a\x7f\x55\x67\x3f\x55\x43\x3f\x6e\xe0\x00\x50\xf0\x54\x4b\x99\x76\x31\x82\x45\x89\x4c\x03\x12\xc7\x67\xbe\x25\x72\x22\x6e\x92\x64\xd0\x4c\xa6\x85\x13\xb3\x50\x1c\x97\x88\x9f\xc2\xf1\x4c\x46\x85\x93\x50\xa1\x08\x46\xa3\x2c\x99\x69\xda\xc0\x29\x8b\x6f\x77\x00\x1a\xaa\x75\xfa\x02\x74\xc0\x72\xb7\x4a\x19\x7b\x34\xc1\x60\xd9\x6c\x8b\x76\xbd\xa1\x7f\x1f\x2c\x48\x02\xfb\x86\xb1\x08\x86\xfe\xc0\xf0\x1f\x04\xf6\x1d\x07\x71\x15\x96\x2d\x5e\x64\x8e\x71\xdb\x5c\x5b\x9a\x67\xc3\x03\xfb\x86\x53\x08\x86\xfd\x40\xf1\x1f\x24\xf3\x9d\xc2\x58\x02\xcd\xd6\x09\xec\x2c\x74\x75\xaf\x9b\x7f\x38\x23\x43\x23\x28\x50\x13\x06\x24\x01\x62\x30\x1c\x96\xad\x4b\xb8\x88\x12\x8c\x33\x12\x3e\x4d\x91\x2c\x4e\xa0\xde\x48\x88\x59\x0d\x24\x16\x90\x67\x5d\x0e\x9c\x15\x91\x7b\xbc\x63\x80\xc3\xeb\xea\x5d\xeb\x9a\x1e\x76\xc9\x5e\xb7\x29\xf4\xab\x9d\x6e\xbd\xc2\x10\x38\x4f\x12\xf4\x03\xd5\xef\xd6\x46\xc3\xf6\xf5\xb4\xc5\x5c\x57\xda\xd5\xce\xa0\xdd\xac\xf7\xc8\x11\x23\xdc\x4f\x6f\x27\x61\xfd\xc4\x12\xc1\x2d\x22\x95\xbb\xeb\xc1\xcd\xf4\xb6\x3d\xed\xdd\x37\xea\xed\xdb\x71\x6b\x7a\x4b\xd5\xaf\x1b\x3c\xd1\xee\xde\xdf\xe3\x37\x83\x56\x87\xe9\xf1\x37\xfc\x44\x18\xd4\x27\x74\xbb\x5f\x1d\x09\xf5\xdb\xbb\x5e\x17\x9a\x08\x61\x13\x19\xf6\xef\x1b\xcd\x36\x5e\x6d\x12\xf5\xee\x80\xac\xdc\xb5\xeb\x9d\x6e\xad\x5d\xbf\x99\x74\xfb\x13\xbc\x71\x4f\x3c\x74\xea\xa3\x46\xaf\x3b\xa9\x0a\x3d\x7e\x34\x65\x06\x55\xa6\x77\x87\x37\xa0\x89\x90\x16\x11\x9e\x9a\x56\xfa\xf7\x3c\x75\x4f\x4e\x79\xa1\x71\x37\x1d\xe2\x93\x56\x0f\x9f\xf4\xc8\xca\xe4\xba\x31\x19\x30\xa4\x30\xe9\xb7\x7a\x5d\x7c\xd0\xb8\x25\xa7\xc3\x46\xaf\x39\xec\xb6\x5a\x0d\xfc\x22\xef\x81\x07\x2b\x41\x90\xd2\xd7\xee\xf1\xdf\xd3\xc9\xfd\xef\xc0\xab\x25\x1e\x06\xb8\x44\x80\x2c\x60\x70\xa8\x10\x16\x78\x5e\xe6\x9f\x65\x95\x9b\xa5\xb4\xbc\x14\x49\x03\xf9\xae\x4b\x04\x98\xb8\x7d\xf6\x33\x5d\xd0\xa8\xd2\xf2\xbc\x23\xcd\x2b\x2f\xf7\x8d\x01\xb0\x0e\x61\x49\x0e\x44\xa5\x2c\x65\x73\x65\x0d\x8b\x7f\x3e\x39\x73\xe8\xa7\x1f\xc8\x27\xea\x3b\xea\xfc\x7d\xba\x44\x3e\x9d\x32\xb5\xd6\x23\xeb\x88\xe5\xab\xfa\xe9\xbf\x96\x43\xf9\xc7\xf9\x62\x7d\xa6\xdc\x06\x31\xe6\x1b\xe6\x01\x0b\xf1\x00\x18\x20\x7e\x01\x0f\x2c\xc5\x72\x1c\xc1\xd2\x2c\x67\xab\x01\xb5\x59\x00\x33\xb0\x61\x5a\xe9\x5a\xd7\x43\x5b\x14\x31\x14\x3d\xb2\xe3\x90\xb5\xfe\xc5\x50\x34\x1b\x49\x22\x48\x32\x42\x6a\x3f\x21\x58\xb9\x33\xb3\x11\x92\x9c\xb0\x50\xd8\x7c\x38\x87\xff\x00\x29\x00\xf1\xc9\x31\x30\x6b\x03\xc0\xa2\x9d\xd7\x35\xfb\xd4\x65\x25\xa8\x21\x39\x24\x5d\x0e\x49\x9c\x71\x4d\xf4\xc3\xfa\xc6\x25\xf9\xab\xfa\x26\x24\x39\x5c\xdf\xe4\x9c\x07\xf2\xf5\x0d\xee\x71\x48\xb3\x2c\xf6\xc1\x7d\xe3\x90\xfc\x55\x7d\x13\x92\x1c\xae\x6f\x72\x46\x1b\xe7\x7d\x93\x32\x65\x44\x1d\xa9\xc9\x3b\x65\x78\xc7\x6a\xfc\x11\x0d\x45\x89\x1c\x26\x51\x34\xcd\xca\xa4\x2a\x72\x94\x24\x73\x73\x74\x8e\x92\xa4\x28\xcd\x71\x99\x40\x65\xe0\x4d\x44\x45\x61\x19\x86\x40\x55\x49\xa5\x68\x52\x52\x28\x4a\x41\x39\x91\x56\xe6\x0c\x36\xb7\xc4\xe1\x24\x46\x66\xa5\xb9\x88\x89\x9c\x4c\x11\x18\x26\xb1\x38\x8d\xa2\xcc\x9c\x43\xe7\x12\x43\xd1\xa2\x8c\x92\x84\xaa\x60\x24\x8e\x8b\x84\x8c\x73\x38\xca\xb2\x32\x4e\x60\x22\x8d\xa3\xb4\x4a\xd3\xa8\x33\x87\x62\xa1\x85\x05\x61\x2f\x2c\xe8\x8b\xc8\x9f\xb9\xef\x04\x47\xb2\x34\x99\xfa\xd4\x9d\x8f\x30\x96\x65\xc1\x17\xda\x6f\x1e\xc7\x3f\x10\xaa\x58\xff\x60\xee\x3f\xde\x8f\xd8\xf1\x83\x35\x91\xf2\xe0\xaf\x76\x63\xb2\xda\x95\x2e\xae\xeb\x9d\xe1\xae\x7a\xcf\xcf\xa9\x1a\xa3\x4c\x0d\x7e\xf0\x15\x9d\x34\x5f\xfa\xd5\xe7\x85\xd6\x69\x82\xb5\x53\x65\xf7\xb0\x18\xf5\x31\xb1\xa3\xf7\xef\x37\xc4\x4b\x75\x54\x9d\x3f\x60\x95\xa7\xe9\xf4\x6d\x7d\xd8\x9a\x73\xe3\x60\x0c\xd6\x5d\x6a\xae\xb2\xf7\x0f\x0f\xd8\x9b\x6c\xa1\xe6\xef\x24\x63\x2e\x2f\xac\x4f\xcd\xe3\x3f\xfc\xc0\xfa\x67\x7f\xfa\xbe\xe7\xfb\x83\x67\xfb\x13\x5f\xef\xb4\x6e\x5e\x45\x7a\xb0\xea\x2d\x6b\x6d\x53\x7d\xba\x97\x1e\x37\xf7\x4d\x66\x04\x5c\xc4\x5c\xbd\x91\x9a\xca\xf3\xcb\x13\xb7\xef\x61\xbc\x69\x5c\xcd\xd9\x8e\x20\xe9\x4d\x4d\xde\x93\xd5\x0a\x7f\xc0\x68\x73\x65\x4e\xaf\xeb\x52\xa3\xb1\x13\xf7\x02\xf3\x78\xc7\x36\x05\xa2\xfe\xf3\x4e\xb3\xe9\x77\xba\x64\x5b\xfc\xb9\xc1\x07\xfc\xe9\xef\xda\xff\xe5\xf8\xf7\xc0\xdf\x61\x24\x78\x52\x43\x6f\xf8\xff\xb5\x3f\xc7\xe8\xe2\x7c\x44\x78\xa8\xe0\xe5\x98\xf9\x05\x4d\x28\x1c\x3b\xa7\x08\x5a\x55\x69\x56\xc1\x24\x9c\x91\x28\x89\xe5\xe6\x38\x21\xce\x6d\x9c\x00\x11\x27\xe2\xe4\x5c\x9c\x63\x24\x4a\x88\x0a\x2a\x51\xb8\x44\x13\x84\x84\x32\x92\xca\x71\x17\xb6\x7f\x22\x22\xad\x9e\x8a\x1b\x0c\x24\xca\xd1\x28\x91\xfa\xd4\x09\x1e\xac\xad\x8b\x84\x91\x42\xc4\x8c\x14\x67\xba\x70\x6c\xa5\xff\xf0\x84\x75\x77\x94\x8e\x4a\x37\xcc\x94\x5c\x1f\x7a\xaf\x93\xb7\x6b\xe2\x76\xa3\x3f\x7f\x7d\xad\xf3\x3d\xb3\x8a\xb5\xf0\x0e\x53\x61\xe8\x87\xe5\x4a\x50\x7a\x9b\xdb\x6a\x87\x6a\xb4\x0d\xae\xde\x7d\xa2\xa8\x17\x91\xde\xe3\x8d\x56\xc7\x7c\x19\xf7\xeb\xed\xd7\x6b\xf6\xd0\x9f\x5c\x89\xbc\x7e\x1a\x24\x3e\x53\x1c\x4e\xf8\xdb\xb7\x9b\x15\xb6\xac\x75\xf6\xfb\x97\xdd\x53\x4b\x3e\x0c\x7e\x6e\x39\xa6\x7e\xc5\x0b\x63\xad\xba\x18\xf4\x8d\x3d\x4d\xec\x5f\xc4\xfe\x75\xcf\x7c\x42\x6f\x5f\xd4\xa7\xea\xf0\x7a\xcd\xf2\x64\x6b\x7f\xb3\xd6\x98\xf5\x8b\x2a\xee\xae\x50\xe1\xf1\xf1\xea\xfa\x99\x3d\x08\xb5\x15\xb3\x6e\x38\x83\x30\x62\x10\x08\xdb\xa4\x41\xc0\xf3\x95\xe7\xff\xc1\x41\x40\xc0\x0f\x02\xac\x1c\x03\xb6\x8b\x1f\x10\xd7\x62\x30\x8e\x41\xbf\xa1\x18\xf8\x0f\x41\xd1\x1f\xf6\x7f\xb1\x86\x8a\x63\x34\x8e\xa7\x3e\x25\x71\x8e\xe4\x68\x06\xe7\xe8\x04\x33\x4e\x35\xe2\x7f\xe5\x5f\xe5\xae\xa5\x91\x87\xab\xc3\xa8\x55\x61\x6a\xeb\x1a\xd7\xc0\xd1\xb7\xa7\xca\xd7\x2d\xba\x30\xb7\xfb\xe6\xfe\x27\x76\xa7\x8c\xa6\xf7\x62\xe5\x46\xac\xdb\x46\x2c\x44\x18\x71\xf4\xdf\xff\xb8\x11\xa3\x8e\x11\xa7\xc4\x52\x10\xe7\x28\xf3\x86\x56\x31\x25\x27\x71\x0b\x63\x2c\x66\xc4\xa5\xa0\x09\xaf\xf1\xf1\x7c\x68\x42\xeb\x55\x22\x1f\x16\x32\xb4\xd0\xce\x87\x85\x0a\x2d\x8c\xf2\x61\xa1\x83\x58\xc8\x7c\x58\x98\xd0\x42\x20\x1f\x16\x36\xb4\xaa\x29\xe7\x8c\x6b\x29\x99\xab\xe4\xa2\x26\xc0\x37\x6c\xc6\x2e\xe6\xa4\x67\xe1\xd1\xe3\x1b\x31\x81\xe1\x72\xfc\x42\x1e\x97\x0a\xff\x7c\x32\xf5\x42\x2b\x31\xb0\xa6\xb3\x5e\xd0\x5a\x28\x0b\x62\xad\x49\xb3\xa6\xb6\x72\x67\xc3\x33\xa5\xc5\x22\x54\xea\x1f\xad\xc7\xcf\xac\x6f\xa9\x3f\xdf\xad\xad\x93\x7b\xb6\x52\xf3\xe5\xb9\x6d\xc9\x9d\x44\x70\x51\xbd\x42\xe5\x1d\x72\xe7\xe3\xa1\x73\x16\x71\x7a\x74\x3d\xcd\xf1\x33\xf9\xae\x7a\xcc\x9b\x09\xfa\x1f\xd0\xa3\xe3\x25\x8f\x9f\xd1\x77\xd5\x63\x01\x5f\xf1\x0b\xf4\x98\xe6\x84\x93\x0f\x7a\x17\x28\x14\x4c\x38\x48\x5c\x1a\xd6\xf8\x13\x95\xe5\x90\x48\x3f\xc1\x97\x77\xb6\x8a\xad\xbb\x8d\x8c\xf6\xc8\xf8\xd0\x28\x15\x11\x1e\x42\x84\xe7\x45\x44\x04\x3d\x3e\x91\x17\x0f\x19\x9a\x39\xf2\xe2\x09\x79\xce\xdc\xfc\xd0\x41\x3c\x64\x5e\x3c\x4c\xd0\x03\xe5\xe6\x87\x0d\xe2\xc1\xcb\x3a\x69\x59\x4a\xf4\x97\x56\xe9\x9d\x21\xfe\x8b\x3d\x69\x58\xc2\x98\xf2\xd5\x3d\xc8\xaa\x24\xb1\x0c\x25\xa2\xe8\x7c\x4e\xab\x18\xc1\x12\xa2\x3a\x47\xe7\x0a\x4e\x61\x22\x43\xcf\x71\x5c\xc6\xe6\x9c\x28\xe1\x22\xae\xcc\xe7\xb2\x84\x32\x60\x4e\xa6\x18\x82\x06\xfe\x05\xa7\x29\x4e\x74\xd2\x1b\x58\xb1\xa0\xeb\x98\x16\x23\xbc\x9c\x41\x6c\xce\x99\x42\xb1\x84\x7c\xb5\xfb\x34\x30\xa2\x9d\x64\x43\x8b\x7e\x52\x35\xe2\x69\xa5\x37\xd9\xf1\xf5\xb2\x76\xa5\x2e\x64\x82\xe9\xdf\x99\x8d\x56\xeb\xe7\xf4\x96\xdd\xdf\x6a\x0f\x15\xb1\xba\xa3\xda\x54\xc7\x59\xac\x1f\x33\xc2\x95\x70\x86\xc0\x97\xad\xb2\xff\x95\x56\x8b\x15\x76\x8b\x2b\x0b\xea\x16\x5b\xbd\x60\xea\xb2\x23\x5f\x63\xe6\xdb\xd3\xe8\xbe\xf5\xc0\xed\x85\x85\x3e\xaa\x88\xea\x94\x9d\x68\x75\xdd\x87\xa6\x4d\xb3\x4d\xdf\x57\x91\x79\x7e\x7d\xde\xdb\xe8\xb9\xfe\x8e\xdb\x3c\x1d\x9e\xe5\xe1\x88\x46\x97\x2f\xbd\xf6\x4b\x97\xad\x37\x7e\xe2\x24\x39\xe8\xb3\x92\x78\xdf\x55\xc7\xe3\x9b\x87\xe6\xd2\x20\x46\xd2\xb0\x8a\x11\x2f\x82\xc1\xed\xfa\x64\x6f\x58\x5b\x1c\xaa\x95\xab\x85\xbc\x5b\xe0\xd7\x2d\xa3\xd6\xd9\xb5\xd0\xd1\x98\x18\xf4\xc4\xd6\xa4\xb2\xff\xf3\xcf\x0b\x7f\xe2\xc5\x9f\x6d\x1e\x44\xc9\xc6\x9f\xe0\x43\xcf\x1d\x20\x5b\x4d\x55\x9f\x5a\x76\x62\x55\xba\xbd\x7b\xc0\x6b\xcb\xbb\xa9\x68\xdc\xd2\x93\xb7\xbd\x34\x25\xae\xbb\x37\x8b\xcd\x9a\xe0\x47\xd5\xc7\x66\x7d\x43\x49\x6f\xa3\xe6\xd4\x4e\x9c\xf0\xcc\x6a\xeb\xea\x63\x91\x90\x79\x88\xcd\xab\xd8\xba\xaf\x15\xa0\xff\x75\x29\xbd\x14\xa0\xdf\x09\xd1\xaf\xee\x74\x42\x37\x49\xea\xa5\xda\x17\xde\x36\x83\x2b\x42\x6f\x74\xbf\xfe\xc4\x98\xe1\x41\xdb\x62\xcb\x79\xa7\x7e\xbf\x1a\x4c\x17\xc6\x6e\xf4\x75\xcc\x7b\xf2\xaf\xe4\x13\x7d\xa1\xa0\xfc\x99\xe9\x93\x6b\xee\x39\x27\x7d\x9f\x2d\x2d\xa2\x6c\x21\x8f\x2e\xca\xb4\x85\x8f\xec\x0b\x47\x17\xff\xbc\xd7\xa0\xb5\x63\x5e\xfb\x9c\xae\x97\xd5\x75\xfe\xb5\x26\x11\xdb\x59\xa6\xcf\xa3\x81\x82\x49\x86\x54\x2d\x57\xcb\x49\x9c\x3a\x67\x14\x49\xe4\x44\x4a\x91\x08\x82\xe0\x24\x86\x9d\x2b\x22\x3b\x27\x48\x86\x61\x24\x4c\x9c\x13\x84\x24\x82\x49\x56\x54\x28\x19\x55\xe6\x60\xbe\x55\x48\xe5\xc2\xde\x42\xc6\x8a\x85\xe1\x58\x8a\x93\x27\x51\x8e\xc1\xc8\x8b\xb4\xa7\xfe\x28\xc9\xdd\x15\x69\xb3\x8d\xc1\xeb\xe0\x59\x6a\xe1\x60\xbd\x31\xbd\x7d\x1a\x1a\xad\xd5\xd3\x1d\x98\xda\xae\xd9\x6d\xbb\xc9\xac\x50\x61\xb8\xbf\x99\x5e\xf1\x77\xc4\xc9\xc7\xf3\x29\x3e\xde\xf9\x33\x5e\xba\x74\x5b\xed\x89\x8b\xa7\xb7\x8e\x38\xe9\x73\x74\xe5\xe7\x7c\xcb\xa9\xa8\xac\x1b\xdd\x87\xbb\x9f\x95\xe9\xcd\x73\x5d\x6f\x79\x3e\x9c\xe7\x7b\x94\xd1\xf2\xe3\xbb\x7d\xdd\xd7\x39\xeb\x91\x50\xad\xfd\x7c\x79\x7d\x1e\x54\x06\x7a\x97\xbf\xd1\xe6\xfd\xe1\x5d\x4d\x6f\x3f\xbe\x9a\x07\x79\x4c\x2c\xeb\xfd\xea\x80\xc2\x16\xcf\xca\xb6\xde\x10\x2b\xdd\xe9\x1e\xa5\x46\x57\xb7\x8f\x53\xf4\x6e\xf1\x6c\xa0\xd5\x4a\x5f\x20\xbb\x62\xfd\x16\x6f\xad\xe4\x2d\xf1\xb0\x6f\xaf\x34\x89\x1c\x0f\x8d\x4e\x1b\xc2\xb7\xf3\x30\xbe\xdd\xd9\x09\x3d\xf3\xed\xda\x55\x05\x6d\xa3\x37\xd7\x07\xf3\x71\xdf\xc5\x96\xf7\xa8\x78\xd8\xe8\x18\xd7\x6d\xbc\xbd\xb6\xab\x87\x1e\x65\x56\x04\xb9\xea\xc8\x48\x2c\x4c\xa3\xb7\xbe\xbf\x62\x26\x21\x5f\x99\x75\x3c\x17\xa0\xdf\x35\x0e\xe3\x71\x01\xfa\xfc\x2f\xf4\x67\x91\xbe\xb5\x52\xa4\x2f\x1e\x60\x32\xfc\xef\xd6\x17\x96\x2d\x7c\x95\xc3\x31\x53\x26\xdf\xba\x60\x69\x83\x12\xf8\x49\xab\x36\xa8\xde\xaf\x7f\xa2\xb7\x7b\xba\x4a\x4a\x8c\xbc\x16\x38\x6a\x38\xde\x3f\xf7\x94\xfb\x9b\x86\x54\x19\xe2\x8b\xf1\xed\xb6\xdb\x9b\xbc\x62\xf7\xb7\x66\x9d\xbc\x69\x71\xfc\x62\xfc\xd6\xab\x4d\x1f\x6f\x15\x6d\xb3\x6e\x77\x71\xb9\x4a\xe9\xab\xaf\x02\x2a\xfe\xac\x96\xee\x5b\x31\x9a\x14\x29\x94\x26\x55\x49\xa4\xc9\x39\x2e\x03\xe7\xaa\x48\x2c\x45\x4b\xc0\xa5\x92\x2c\xc9\x52\x73\x99\xc6\x69\x9c\x64\x44\x45\x24\x54\x85\xe0\x64\x45\x01\x91\x36\xcd\xa1\x38\x06\x7c\x2d\xed\xf8\x56\xbc\x98\x6f\xc5\xd3\x7d\x2b\x4b\x70\x17\x69\x4f\xfd\x2b\xbe\xa2\xbe\xb5\x9a\xe6\x5b\x7b\x78\xf5\x8a\xef\x91\xd4\x7d\xa5\x46\x98\x8d\xdb\x7a\x0f\x1b\x12\x3c\xda\x51\x9f\xfb\xec\xcd\x90\x5e\x77\x31\x9e\x53\xa7\x9a\x72\x68\x9a\x93\x14\xdf\xca\x8f\x84\x07\xed\x41\x52\xeb\xfb\xea\xd6\x68\x55\xd6\xad\xe6\x6e\x7b\x85\x52\xb7\xe6\x4d\xad\x62\x2c\xf4\xed\xee\xb1\x3d\xb8\x9a\xd0\x77\x93\x27\xd2\xdc\x4f\x0f\x8f\x5b\x66\x62\x8e\xc8\x6a\x47\x7d\xeb\x75\xe8\x9b\x17\x79\xfe\x72\xd3\xc2\xd0\xe9\xb2\xf2\xfc\xbc\x5f\x93\x0b\xb6\xdf\x9c\x3f\x35\xaf\xff\x5d\xbe\xb5\xa8\x6f\x2b\x3a\x9e\x3b\x60\xd6\x31\x4a\xf4\xad\x3c\x73\xdf\x66\x79\xe6\x69\xb9\x10\xfa\x2a\xaa\x4c\x26\xcc\x6d\x43\xae\x0d\xde\xe8\xc1\xd5\x7e\xd9\x78\x91\x89\x49\x0d\xa3\xc4\x1b\xa2\xa9\x61\x83\x77\xf1\xad\xbf\xc8\xb7\x95\xe5\x5b\x59\xf2\xd4\xbe\x99\xdd\xb7\x0a\x8f\xd7\xf7\xab\x29\xf1\x28\xf3\x46\xeb\xb0\x78\x38\x68\x6d\xa3\xcf\xf5\x6e\xa5\xd1\x60\x2f\x92\xad\x76\x5b\x1f\xa1\x7d\xac\xb7\xc4\x9a\x5f\xdb\x72\x7d\xab\x4b\x3d\xac\x3d\xd9\xf1\x4f\x8d\xed\xf8\xa9\xa7\x89\xeb\x06\xad\x8d\x4c\xa5\xbe\x19\x3c\xdc\x74\x6e\xbe\x36\xfb\xb5\x43\x83\x3c\x54\x16\xa5\xc7\xad\x12\xae\xb2\x38\xf0\xa8\x92\x84\xe2\xa4\x84\x33\x22\x2a\x13\x18\x89\xca\x22\x83\x29\xac\x28\x73\x92\xcc\x60\x2c\x81\xcd\xb9\x39\x25\x12\x92\x42\x73\xaa\x2c\x12\x0a\xcb\xce\x25\x54\x95\x29\xf9\xe2\x58\xfa\x58\xc0\xb7\x12\xe9\xbe\x95\xa3\x92\x6a\x80\x9c\xa7\xfe\xec\x55\x51\xdf\x5a\x4b\xf3\xad\x59\x73\x13\xf1\xbe\xb5\x76\xb3\x5b\x62\x66\xfb\xba\x5d\x27\x6f\xdf\xf6\x26\xaa\xd4\xaa\xb7\xc2\x9c\x36\x25\x6a\x49\x4a\x87\x8e\x71\xbd\xa8\x6e\xbe\x2e\x6f\x1f\x3a\xab\x37\xd9\xa4\x48\xad\x3b\xc7\x57\x6f\xe6\xd3\x1b\xdd\x51\xa8\x87\x1b\x52\x20\x6b\x4b\x79\x3b\x27\x69\x81\x7f\xac\x5c\x8f\x26\xfd\xed\x9a\x9d\xdf\xd7\xfe\x5d\xbe\xb5\xa8\x6f\x2b\x3a\x9e\xdb\xe8\x33\x5d\x2b\xd1\xb7\x7e\x64\x4e\xe6\x3d\x7c\x6b\x5e\xdf\x56\x96\x6f\xcd\xbb\x86\x71\x7d\xeb\x41\xda\x28\xd2\xe8\x4d\x7b\x53\xeb\xb2\xdc\x56\x1a\x83\xfd\x72\xd8\xf8\x6a\x4c\xbf\x3e\xa8\xd7\xec\x53\xeb\x4d\xe7\x5f\xe6\x9b\xdb\xe9\xf8\x66\x7b\xd7\x56\xd5\xe6\xd3\x1d\xb7\xd9\x4a\xf7\xac\xfa\xd4\x50\xa7\x23\xb5\xd2\xe3\xa9\xbb\x76\xe3\x6b\xef\x91\x6f\x0e\x86\xcf\xcb\x1a\x73\x73\xd5\xc0\x79\xc8\xb8\x35\x26\xbb\x9c\x74\xad\x56\xd6\xc4\x72\xf8\x6a\xad\xa3\xb7\xb6\xce\x64\xba\x87\x1b\xed\xbb\x77\x9c\x22\x37\x8b\x69\x34\xe1\xb0\x5c\xc4\x9d\x59\x05\xb6\xa9\xe2\xae\x76\xca\x7e\xea\x29\xf8\x3a\xae\xc0\xb7\xd9\x06\xe8\xe0\xf4\xbe\x57\xef\xae\xd4\xac\xb7\xcd\x04\x70\x3a\x6f\x84\xac\xd5\xfc\x77\xaf\x9e\x13\x45\xfa\xc3\x66\x87\x1f\xde\x23\x2d\xe1\x1e\xf9\x7c\xba\x71\x2a\xf6\x7d\x5a\x27\x1c\xe5\xf2\x9c\xc8\xee\x39\xa7\xa7\xbb\xae\x52\xdf\xfc\x75\xf6\x43\xd9\xda\x76\xd1\x26\x4a\xe0\x27\x1d\x94\xc4\x79\x72\x89\x24\x49\xe4\x7b\x23\x95\xff\xfa\x83\x92\xe4\x38\x61\x8c\x14\x21\x44\x30\xc8\x7d\x04\xb7\xe1\x77\x68\x85\xbe\x97\xc4\x75\x08\x6b\x14\xe7\x51\x84\x43\x56\x74\xbc\xb0\xec\x32\x70\xdb\xd9\xa5\xef\x72\xb4\xb4\x77\x68\x85\xbf\x97\x24\x5f\x08\x6b\x94\x7c\x51\x84\x53\x7b\x27\x74\x7b\x58\xe8\xa0\xe4\x49\x21\xb3\x93\x06\x66\x7e\xd5\xcc\x4a\x91\x2e\x48\x36\x4a\xb8\x5c\x8c\x21\x93\x6e\x73\x30\x11\xa2\x3a\xd6\x82\x0f\x76\x72\x46\xd5\x6c\x7e\x8d\xe0\x99\x3a\x35\xa6\xdc\x31\xa5\xa6\xb0\x5c\xc9\xa2\x89\x24\x49\x9a\xc0\x16\xbc\xe4\xe7\xaf\x3d\x88\x7f\x54\xb6\xc4\x67\x04\x12\xa5\x8d\x66\x27\x28\x69\xe0\xee\xf0\xcb\xb3\x7b\xc2\x2f\xc3\x57\xf5\x66\xb9\x12\x3d\xe9\x59\xc9\x9a\x89\xa0\x90\xa4\x9a\x38\x86\x32\xea\xe6\x74\x31\xfb\xa5\xff\xee\xf4\xec\xd7\xff\xa5\x56\x63\x94\xae\xad\x48\x32\x29\x2a\x8b\x67\x2d\x75\xf4\x84\x23\xef\xd0\xf7\x92\xe4\x0b\x61\x8d\x12\x27\x8a\x70\x90\xfb\xa8\x98\xd4\xbd\xde\xd6\xf9\x5f\x49\xcc\x3a\xc8\xa2\x78\xf4\x91\x09\xb2\xe6\xdd\x10\x95\x74\x2d\xac\xff\x73\x49\x9c\xfa\x30\x46\xb1\x1b\x26\x98\x39\xd2\x77\x16\x09\xa7\xb0\x74\x66\x5d\x2f\xe3\xb1\xdd\xec\xd6\x84\x3b\xb8\xeb\x6d\xdd\x39\xd9\x6e\x91\x8c\x1c\xc8\x15\x5a\x25\x4d\x46\xcd\xee\x35\x22\x99\x86\xaa\xfa\x63\xfe\x4b\xfb\x45\xc3\xf1\x9c\xfb\x5e\x1f\x9d\x83\xe1\x10\xa7\xfe\x77\x51\xfb\x18\x0c\xf2\xe6\x03\x8a\x67\x2b\xf2\x5d\xd9\xc5\x19\x8c\x7e\x05\x77\x2c\xab\x91\xe0\x31\x6b\x0e\xe9\x60\x07\x51\xf9\x79\xf4\x63\xb1\x58\x0a\xc5\x58\xc1\xfe\x3d\x06\x6d\xf1\xdc\x38\xa1\x5b\x71\x7e\xdc\x5b\x8d\xa1\x38\x8a\x09\x17\xa5\xe3\x45\x2a\xb9\xd9\x39\xa1\xf0\x73\x12\x48\x5d\x47\x8d\x80\xcb\xb3\xfb\xf2\xa3\x98\xb3\xae\xfd\x2f\xc2\x99\xfd\xda\x00\x28\xb6\xc2\x2f\x1b\x88\xe2\xc6\x71\x38\x45\xf8\x71\x2f\x5c\x86\xe2\x28\x14\x1e\x5d\x9e\xbf\xb4\x20\x6d\xd9\x5a\xd8\xf4\x63\xf0\x59\xfc\x87\x57\xc8\xb0\xa3\x20\x02\x65\xc1\xf1\x10\x8b\x11\x92\xcd\x84\x95\xd4\x4c\xb5\xb0\xd9\xba\x2e\x3a\x69\x84\xd0\xf9\x4d\xc0\xbb\x20\x22\xe8\x8c\x23\x5e\x80\x75\xe9\xbd\xec\x2a\x8e\xd9\xd3\x5d\xcf\x05\xd9\xd4\x14\x68\x06\xfd\x33\x5a\x0e\xa6\xf5\xcd\x6c\x53\x16\xdf\x2e\x2e\x3f\xeb\x31\xab\xc9\x5c\x92\x44\x0b\x60\xbe\x95\x27\x80\x8b\x2b\xc6\x3f\xe4\x14\x21\xf8\x16\x9f\x0c\xab\xcf\x59\x71\x97\x07\x83\x3c\xd0\x5d\xe7\x4b\xe0\x24\x9f\x78\x2e\x0c\x30\x01\xcb\xed\xeb\xb9\x3a\xc4\xe5\xf9\x84\x23\xaf\x25\x25\x5b\xcd\xf1\x55\xc0\x96\xa7\x2a\x6e\x38\x41\x74\x7e\x96\xbd\x03\xf5\xc1\xd0\x29\x92\x23\xbf\x91\x94\xc5\xd6\x19\x4e\xb8\x79\x2f\x8a\x41\xd3\xe9\x12\xb3\x48\xb7\x9e\x70\xe4\x1f\x5f\x69\x63\xc9\x34\xec\x8b\x9c\xed\x3b\xc2\xb5\x95\x5a\x80\xd9\x20\xa2\x10\xc7\x67\xe9\x90\x00\xb3\xd0\xb9\x03\x8b\x88\x7d\x87\xe4\xe9\x55\x86\xc5\x38\x0e\x21\x3b\xe7\x3a\xc8\x68\xe8\x1d\x8a\xc9\x0c\xda\x2b\xd4\x72\xd8\xb3\x51\x41\x31\x17\xbb\x2c\xf6\xf0\x85\xde\xce\x58\x98\xbf\x10\xbe\x34\x26\xcf\x5f\x0e\x99\xca\x69\x39\x7a\x0c\x60\x83\xe5\x32\x55\x9b\xe5\xf0\x06\xc5\x53\x32\x2f\x1e\xc7\x4b\x30\x13\xed\x36\xc5\x38\x0a\xe2\x82\xee\x51\xef\xed\x93\x91\xfc\x6d\x44\xcd\xb0\x1d\x43\x29\x1c\x86\xb1\xc1\x8d\xdb\xf4\xcc\xee\xf1\xad\xab\x31\x42\x94\x30\xcb\xb8\x78\xd2\x38\xce\x18\x98\x5a\x58\x4b\xd3\x6e\x06\xc5\xa6\xea\xcd\x79\x1d\xc5\xd9\x1d\xa8\x40\x1e\x51\x51\x80\xdf\xdf\x16\x55\x68\x2a\x81\x40\xba\x21\x32\x37\xe3\x02\x66\xe0\xbd\xb8\x1d\x24\xe1\x4e\xe7\x38\x62\x94\x05\x11\xba\x0b\x20\x5b\x0d\xab\x42\x7e\x3e\x05\x6f\xea\xaa\xcb\x01\xcb\xc0\x6e\xe0\xae\xdb\x12\xd9\x75\x32\x4d\xb1\xec\x2e\xb4\xb5\xbb\xbe\xde\xc2\x33\x6b\xed\x6f\x96\xcf\xaa\xfd\x76\xb0\x34\xbd\x46\xee\x92\x07\x51\xba\xd1\xb4\x85\xf2\x38\x40\x4b\xe2\x36\x0a\x75\x6a\x20\x0f\xeb\x25\x7c\xc8\xcb\x1e\x68\x01\xd4\x79\x56\x1e\xf1\xe8\x56\x1b\xdd\xb0\x5f\xe9\xeb\xbc\x9a\xa6\x7c\x45\x87\x29\xa4\xb3\x1f\x6a\x00\x2f\x8c\xeb\xd6\x73\x26\x33\xe1\xf4\xef\xa3\x91\x2a\x89\x0f\x16\x5e\x88\x8d\xa1\xbe\x6a\xfa\x6e\xfb\x21\xd2\x44\x11\x4b\x15\x2b\xaa\x11\xbc\x7c\x5e\x4e\xe1\xdd\x64\x3a\xbe\x17\x36\x4d\x8e\xd8\xec\x46\x10\xf5\xe9\x8a\x93\xd2\xe7\xa5\x28\xd4\x91\xb9\x90\x3c\xb3\x53\x08\x79\x99\x13\x54\x14\xea\x44\xbe\xe1\xa7\xa9\x20\xea\xb2\x1d\x69\x18\x3b\x8c\xb2\x53\xdd\x69\x10\x69\x30\x75\xf1\x2e\x0a\x0f\xbd\x2f\x1c\x42\x86\x94\x7c\x4a\x22\xb1\xf2\x82\x85\x73\xc4\x50\xbc\xa7\x87\x0c\xfe\x24\x97\x85\xda\x7a\x79\x70\x49\x3c\x47\xa1\x86\xcb\xaf\x59\x90\x19\xf9\x2e\xdb\xdc\xcf\xf1\xe7\x4e\x0d\xba\x15\x0d\x56\x6a\xc7\xf7\xba\xde\xdc\x4a\x8e\x46\x67\x71\xe7\x16\x6a\x04\x17\xc2\x3e\x98\x04\xce\xa2\x5e\xba\x5a\x02\x87\x91\xef\x72\x8d\xe1\x34\x0a\x36\x81\x63\xe7\x3d\xcb\x25\xf0\xe8\x20\x8a\xe3\xea\xf8\x3a\xe7\x14\x56\xca\xec\xd7\xe0\x6b\x9f\x13\x18\x8b\xef\x59\xaf\x10\xba\x84\xdd\xf3\x73\x54\x81\x02\x12\xaf\xfc\x3b\xa6\x86\x24\xa2\x5a\xc7\x7a\xc1\x91\xb7\xf8\x91\xfc\x1b\x2a\xb9\x59\x4c\xc0\x99\xba\xac\xfa\xfc\x59\x51\x4d\x51\x5b\x6e\x91\x6f\xff\xf9\x0f\x72\xb1\xd5\x97\x8a\xaf\xf6\xf5\xe2\xc7\x0f\xeb\xb5\xda\x5f\xbe\x5c\x22\xf1\x80\xd6\x2e\x32\x14\xa0\xb3\x8f\x1b\x0f\x2a\xe9\xbb\xc5\xa3\x09\x45\x3e\x00\x9a\xcc\x40\x00\x34\xc4\xc2\x17\x64\xda\x10\x86\x82\x33\x55\x20\x7f\x22\x04\x91\x54\x5e\x65\xdb\x80\x53\xdb\xe1\x5c\xf9\x56\xa0\xc7\xe2\x91\x5a\x5d\xe6\xaf\xe9\x8a\xd8\x10\xf7\x97\x19\x47\xd5\x95\xbb\x98\x12\xcb\xfd\xc3\x2c\x1c\x21\x4b\x13\xea\xf4\xa2\xf0\x44\x89\xa0\x59\x2c\x58\x85\x10\x89\x0d\x42\xd9\x31\xd5\x07\x21\x74\x76\xa5\xb8\x5d\x39\x5e\x2e\x9b\x61\xbc\x85\xad\x03\xfa\xdc\x87\xa6\xcc\xe6\xbe\x12\xc2\x7a\xeb\x63\x4e\x7f\xb8\x64\x91\x7a\x6f\x28\x34\xaf\xbb\xc7\xaa\x52\x64\x28\xd4\xc1\x50\xed\x56\x85\x51\xa8\x9a\xca\x7e\x0a\xd4\x32\xe9\xd7\x2c\x35\x0e\x05\x80\xb6\x59\x1d\x5b\x3f\xd5\x84\xb6\x00\x7e\xaa\xf2\xa3\x2a\x5f\x13\xca\x29\xeb\x0e\xe4\xd6\xcb\x53\x51\x69\x75\xde\xe7\xfc\x05\x75\x19\x78\x9e\xa2\xd6\x72\x34\x16\xde\x7e\xf8\x17\x2a\x2d\x92\xc5\xa0\xde\xce\xf6\x7d\xf2\xa9\xce\xdd\x55\x08\xed\x15\x87\x36\x5a\xcb\xd7\x90\x43\x07\xa2\x2e\x3e\x8a\x93\x28\x03\x3a\xb9\xed\x48\x3d\xb8\xf1\x51\x5e\x4d\xbc\x9b\xa5\x64\xd4\xc3\xfb\x0e\xa3\x68\x0d\x9c\x6f\x19\xff\x42\x35\xc4\x30\x13\x33\x34\xde\xcb\x28\xde\xdf\x83\x64\x57\xc8\x07\x79\x0a\xf8\x53\x35\x1f\x32\x72\x0a\x1d\xb3\xf9\xb8\x31\x05\xaf\xb5\x8f\x32\xad\x42\x8a\x7b\x4f\xa3\xeb\xeb\x5b\x13\x90\x1a\x0d\xda\x88\x75\x1a\xc2\xea\x03\x44\xd9\xad\x36\x88\xac\x5b\x73\xa3\xa9\xda\xd2\xfd\x3f\xf9\x4b\x1d\xf5\xe8\xd4\x00\x00")
 
 func baseHorizonSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -649,7 +649,7 @@ func baseHorizonSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "base-horizon.sql", size: 47813, mode: os.FileMode(0644), modTime: time.Unix(1572527989, 0)}
+	info := bindataFileInfo{name: "base-horizon.sql", size: 54504, mode: os.FileMode(0644), modTime: time.Unix(1572527989, 0)}
 	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0x5c, 0x14, 0xde, 0x13, 0xc4, 0x44, 0xaf, 0xdd, 0x2b, 0xcd, 0xeb, 0x61, 0x71, 0xa4, 0x80, 0xee, 0x80, 0x83, 0x49, 0x27, 0xd1, 0xb4, 0x51, 0xb6, 0x25, 0xf8, 0xde, 0xc2, 0xf4, 0x34, 0xa7}}
 	return a, nil
 }
@@ -49,7 +49,11 @@ func IngestTestTrade(
 		D: xdr.Int32(amountSold),
 	}
 
-	return q.InsertTrade(opCounter, 0, buyer, false, xdr.OfferEntry{}, trade, price, timestamp)
+	err := q.InsertTrade(opCounter, 0, buyer, false, xdr.OfferEntry{}, trade, price, timestamp)
+	if err != nil {
+		return err
+	}
+	return q.RebuildTradeAggregations(timestamp.ToTime(), timestamp.ToTime())
 }
 
 //PopulateTestTrades generates and ingests trades between two assets according to given parameters
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-chi/chi"
 	chimiddleware "github.com/go-chi/chi/middleware"
+	"github.com/gomodule/redigo/redis"
 	metrics "github.com/rcrowley/go-metrics"
 	"github.com/rs/cors"
 	"github.com/sebest/xff"
@@ -21,13 +22,14 @@ import (
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/services/horizon/internal/ratelimit"
 	hProblem "github.com/stellar/go/services/horizon/internal/render/problem"
 	"github.com/stellar/go/services/horizon/internal/render/sse"
 	"github.com/stellar/go/services/horizon/internal/txsub/sequence"
 	"github.com/stellar/go/support/db"
+	supportHttp "github.com/stellar/go/support/http"
 	"github.com/stellar/go/support/log"
 	"github.com/stellar/go/support/render/problem"
-	"github.com/stellar/throttled"
 )
 
 const (
@@ -40,7 +42,8 @@ const (
 type web struct {
 	appCtx             context.Context
 	router             *chi.Mux
-	rateLimiter        *throttled.HTTPRateLimiter
+	adminRouter        *chi.Mux
+	rateLimiter        *ratelimit.HTTPRateLimiter
 	sseUpdateFrequency time.Duration
 	staleThreshold     uint
 	ingestFailedTx     bool
@@ -75,6 +78,7 @@ func mustInitWeb(ctx context.Context, hq *history.Q, cq *core.Q, updateFreq time
 	return &web{
 		appCtx:             ctx,
 		router:             chi.NewRouter(),
+		adminRouter:        chi.NewRouter(),
 		historyQ:           hq,
 		coreQ:              cq,
 		sseUpdateFrequency: updateFreq,
@@ -103,6 +107,7 @@ func (w *web) mustInstallMiddlewares(app *App, connTimeout time.Duration) {
 	r.Use(requestCacheHeadersMiddleware)
 	r.Use(chimiddleware.RequestID)
 	r.Use(contextMiddleware)
+	r.Use(supportHttp.TraceMiddleware)
 	r.Use(xff.Handler)
 	r.Use(loggerMiddleware)
 	r.Use(timeoutMiddleware(connTimeout))
@@ -271,6 +276,11 @@ func (w *web) mustInstallActions(
 				},
 			},
 		)
+		r.With(requiresExperimentalIngestion.Wrap).Method(
+			http.MethodGet,
+			"/order_book/history",
+			restPageHandler(actions.OrderBookHistoryHandler{}),
+		)
 	} else {
 		r.Get("/order_book", OrderBookShowAction{}.Handle)
 	}
@@ -308,12 +318,23 @@ func (w *web) mustInstallActions(
 			"/assets",
 			restPageHandler(actions.AssetStatsHandler{}),
 		)
+		r.With(requiresExperimentalIngestion.Wrap).Method(
+			http.MethodGet,
+			"/assets/{asset}/holders",
+			restPageHandler(actions.AssetHoldersHandler{}),
+		)
+		r.With(acceptOnlyJSON, requiresExperimentalIngestion.Wrap).Method(
+			http.MethodGet,
+			"/assets/{asset}/distribution",
+			objectActionHandler{actions.AssetDistributionHandler{}},
+		)
 	} else if config.EnableAssetStats {
 		r.Get("/assets", AssetsAction{}.Handle)
 	}
 
 	// Network state related endpoints
 	r.Get("/fee_stats", OperationFeeStatsAction{}.Handle)
+	r.Get("/fee_stats/inclusion", FeeInclusionAction{}.Handle)
 
 	// friendbot
 	if config.FriendbotURL != nil {
@@ -328,28 +349,69 @@ func (w *web) mustInstallActions(
 	r.NotFound(NotFoundAction{}.Handle)
 }
 
-func maybeInitWebRateLimiter(rateQuota *throttled.RateQuota) *throttled.HTTPRateLimiter {
+// mustInstallAdminActions installs the routing configuration of the admin
+// server onto the provided app.
+func (w *web) mustInstallAdminActions(app *App) {
+	if w == nil {
+		log.Fatal("missing web instance for installing admin actions")
+	}
+
+	r := w.adminRouter
+	r.Use(chimiddleware.StripSlashes)
+	r.Use(chimiddleware.RequestID)
+	r.Use(contextMiddleware)
+	r.Use(loggerMiddleware)
+	r.Use(recoverMiddleware)
+	r.Use(adminAuthMiddleware(app.config.AdminAuthToken))
+
+	r.Get("/rate_limits", w.rateLimitBucketsHandler)
+
+	r.Route("/ingestion", func(r chi.Router) {
+		r.Get("/", app.ingestionStatusHandler)
+		r.Post("/expingest/verify_state", app.verifyStateHandler)
+		r.Post("/{subsystem}/pause", app.pauseIngestionHandler)
+		r.Post("/{subsystem}/resume", app.resumeIngestionHandler)
+	})
+	r.Post("/reap", app.reapHandler)
+	r.Get("/log_level", app.logLevelHandler)
+	r.Put("/log_level", app.setLogLevelHandler)
+	r.NotFound(NotFoundAction{}.Handle)
+}
+
+func maybeInitWebRateLimiter(config Config, redisPool *redis.Pool) *ratelimit.HTTPRateLimiter {
+	var apiKeys ratelimit.APIKeys
+	if config.RateLimitAPIKeysFile != "" {
+		var err error
+		apiKeys, err = ratelimit.LoadAPIKeys(config.RateLimitAPIKeysFile)
+		if err != nil {
+			log.Fatalf("unable to load rate limit API keys: %v", err)
+		}
+	}
+
 	// Disabled
-	if rateQuota == nil {
+	if config.RateQuota == nil && len(apiKeys) == 0 {
 		return nil
 	}
 
-	rateLimiter, err := throttled.NewGCRARateLimiter(LRUCacheSize, *rateQuota)
-	if err != nil {
-		log.Fatalf("unable to create RateLimiter: %v", err)
+	var store ratelimit.Store = ratelimit.NewMemoryStore(LRUCacheSize)
+	if redisPool != nil {
+		store = ratelimit.NewRedisStore(redisPool, config.RateLimitRedisKey)
 	}
 
-	return &throttled.HTTPRateLimiter{
-		RateLimiter:   rateLimiter,
-		DeniedHandler: &RateLimitExceededAction{Action{}},
-		VaryBy:        VaryByRemoteIP{},
+	varyBy := config.RateLimitVaryBy
+	if len(varyBy) == 0 {
+		varyBy = []ratelimit.Scope{ratelimit.ScopeIP}
 	}
-}
 
-type VaryByRemoteIP struct{}
-
-func (v VaryByRemoteIP) Key(r *http.Request) string {
-	return remoteAddrIP(r)
+	return &ratelimit.HTTPRateLimiter{
+		Limiter:       &ratelimit.Limiter{Store: store},
+		Quota:         config.RateQuota,
+		StreamCost:    config.RateLimitStreamCost,
+		VaryBy:        varyBy,
+		APIKeyHeader:  config.RateLimitAPIKeyHeader,
+		APIKeys:       apiKeys,
+		DeniedHandler: &RateLimitExceededAction{Action{}},
+	}
 }
 
 func remoteAddrIP(r *http.Request) string {
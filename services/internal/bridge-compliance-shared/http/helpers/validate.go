@@ -108,8 +108,8 @@ func isStellarAmount(i interface{}, context interface{}) bool {
 		return false
 	}
 
-	_, err := amount.Parse(am)
-	return err == nil
+	a, err := amount.ParseAmount(am)
+	return err == nil && a > 0
 }
 
 // isStellarDestination checks if `i` is either account public key or Stellar address.
@@ -41,8 +41,9 @@ func (op ChangeTrustOperationBody) Validate() error {
 	}
 
 	if op.Limit != nil {
-		_, err := amount.Parse(*op.Limit)
-		if err != nil {
+		// a zero limit removes the trust line
+		a, err := amount.ParseAmount(*op.Limit)
+		if err != nil || a < 0 {
 			return helpers.NewInvalidParameterError("limit", "Limit is not a valid amount.")
 		}
 	}
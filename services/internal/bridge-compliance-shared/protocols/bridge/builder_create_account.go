@@ -34,8 +34,8 @@ func (op CreateAccountOperationBody) Validate() error {
 		return helpers.NewInvalidParameterError("destination", "Destination must be a public key (starting with `G`)")
 	}
 
-	_, err := amount.Parse(op.StartingBalance)
-	if err != nil {
+	a, err := amount.ParseAmount(op.StartingBalance)
+	if err != nil || a <= 0 {
 		return helpers.NewInvalidParameterError("starting_balance", "Not a valid amount.")
 	}
 
@@ -3,6 +3,7 @@ package bridge
 import (
 	"strconv"
 
+	"github.com/stellar/go/amount"
 	"github.com/stellar/go/txnbuild"
 
 	shared "github.com/stellar/go/services/internal/bridge-compliance-shared"
@@ -60,8 +61,14 @@ func (op ManageOfferOperationBody) Build() txnbuild.Operation {
 
 // Validate validates if operation body is valid.
 func (op ManageOfferOperationBody) Validate() error {
+	// a zero amount deletes the offer
+	a, err := amount.ParseAmount(op.Amount)
+	if err != nil || a < 0 {
+		return helpers.NewInvalidParameterError("amount", "Not a valid amount.")
+	}
+
 	if op.OfferID != nil {
-		_, err := strconv.ParseInt(*op.OfferID, 10, 64)
+		_, err = strconv.ParseInt(*op.OfferID, 10, 64)
 		if err != nil {
 			return helpers.NewInvalidParameterError("offer_id", "Not a number.")
 		}
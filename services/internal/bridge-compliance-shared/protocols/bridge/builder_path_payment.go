@@ -92,13 +92,13 @@ func (op PathPaymentOperationBody) Validate() error {
 		return helpers.NewInvalidParameterError("destination", "Destination must be a public key (starting with `G`).")
 	}
 
-	_, err := amount.Parse(op.SendMax)
-	if err != nil {
+	a, err := amount.ParseAmount(op.SendMax)
+	if err != nil || a <= 0 {
 		return helpers.NewInvalidParameterError("send_max", "Not a valid amount.")
 	}
 
-	_, err = amount.Parse(op.DestinationAmount)
-	if err != nil {
+	a, err = amount.ParseAmount(op.DestinationAmount)
+	if err != nil || a <= 0 {
 		return helpers.NewInvalidParameterError("destination_amount", "Not a valid amount.")
 	}
 
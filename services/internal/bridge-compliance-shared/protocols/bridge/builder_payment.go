@@ -37,8 +37,8 @@ func (op PaymentOperationBody) Validate() error {
 		return helpers.NewInvalidParameterError("destination", "Destination must be a public key (starting with `G`).")
 	}
 
-	_, err := amount.Parse(op.Amount)
-	if err != nil {
+	a, err := amount.ParseAmount(op.Amount)
+	if err != nil || a <= 0 {
 		return helpers.NewInvalidParameterError("amount", "Invalid amount.")
 	}
 
@@ -0,0 +1,100 @@
+package channels
+
+import (
+	"context"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+// createChannel creates the channel account with StartingBalance XLM.
+func (p *Pool) createChannel(ctx context.Context, channel *Channel) error {
+	return p.submitFunderOperation(ctx, &txnbuild.CreateAccount{
+		Destination: channel.GetAccountID(),
+		Amount:      p.startingBalance(),
+	})
+}
+
+// topUp sends XLM from the funder to the channel so its balance is back at
+// StartingBalance when it dropped below MinBalance.
+func (p *Pool) topUp(ctx context.Context, channel *Channel, balance string) error {
+	current, err := amount.ParseInt64(balance)
+	if err != nil {
+		return errors.Wrap(err, "parsing balance")
+	}
+
+	min, err := amount.ParseInt64(p.minBalance())
+	if err != nil {
+		return errors.Wrap(err, "parsing min balance")
+	}
+
+	if current >= min {
+		return nil
+	}
+
+	target, err := amount.ParseInt64(p.startingBalance())
+	if err != nil {
+		return errors.Wrap(err, "parsing starting balance")
+	}
+
+	p.log.Ctx(ctx).WithField("channel", channel.GetAccountID()).WithField("balance", balance).Info("Topping up channel")
+
+	return p.submitFunderOperation(ctx, &txnbuild.Payment{
+		Destination: channel.GetAccountID(),
+		Amount:      amount.StringFromInt64(target - current),
+		Asset:       txnbuild.NativeAsset{},
+	})
+}
+
+// submitFunderOperation submits a transaction with a single operation from the
+// funder account. Funder transactions are serialized, as the funder's sequence
+// number is loaded from horizon every time.
+func (p *Pool) submitFunderOperation(ctx context.Context, op txnbuild.Operation) error {
+	if p.Funder == nil {
+		return errors.New("pool has no funder")
+	}
+
+	p.funderMutex.Lock()
+	defer p.funderMutex.Unlock()
+
+	funder, err := p.Horizon.AccountDetailContext(ctx, horizonclient.AccountRequest{AccountID: p.Funder.Address()})
+	if err != nil {
+		return errors.Wrap(err, "loading funder account")
+	}
+
+	tx := txnbuild.Transaction{
+		SourceAccount: &funder,
+		Operations:    []txnbuild.Operation{op},
+		Timebounds:    txnbuild.NewTimeout(300),
+		Network:       p.NetworkPassphrase,
+		BaseFee:       p.BaseFee,
+	}
+
+	txe, err := tx.BuildSignEncode(p.Funder)
+	if err != nil {
+		return errors.Wrap(err, "building funder transaction")
+	}
+
+	_, err = p.Horizon.SubmitTransactionXDRContext(ctx, txe)
+	if err != nil {
+		return errors.Wrap(err, "submitting funder transaction")
+	}
+
+	return nil
+}
+
+func (p *Pool) startingBalance() string {
+	if p.StartingBalance == "" {
+		return DefaultStartingBalance
+	}
+	return p.StartingBalance
+}
+
+func (p *Pool) minBalance() string {
+	if p.MinBalance == "" {
+		return DefaultMinBalance
+	}
+	return p.MinBalance
+}
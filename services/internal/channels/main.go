@@ -0,0 +1,193 @@
+// Package channels implements a pool of channel accounts. A channel account is
+// used as the source of a transaction (it pays the fee and provides the
+// sequence number) while the operations keep the source of the account that
+// actually sends the funds. Using many channels allows submitting many
+// transactions on behalf of a single account in the same ledger.
+package channels
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	// DefaultStartingBalance is the amount of XLM channels are created with and
+	// topped up to when Pool.StartingBalance is not set.
+	DefaultStartingBalance = "5"
+	// DefaultMinBalance is the XLM balance below which channels are topped up when
+	// Pool.MinBalance is not set.
+	DefaultMinBalance = "2"
+
+	// balanceCheckInterval is the number of transactions after which a channel is
+	// reloaded from horizon to check its balance.
+	balanceCheckInterval = 100
+)
+
+// Channel is a channel account from the pool. It implements the
+// txnbuild.Account interface so it can be used as a transaction source.
+type Channel struct {
+	Keypair  *keypair.Full
+	Sequence xdr.SequenceNumber
+
+	loaded bool
+	uses   int
+}
+
+// GetAccountID returns the address of the channel account.
+func (ch *Channel) GetAccountID() string {
+	return ch.Keypair.Address()
+}
+
+// IncrementSequenceNumber increments the channel's sequence number and returns
+// the new value.
+func (ch *Channel) IncrementSequenceNumber() (xdr.SequenceNumber, error) {
+	ch.Sequence++
+	return ch.Sequence, nil
+}
+
+// Pool is a pool of channel accounts. Channels are handed out by Acquire and
+// must be returned with Release once the transaction using them has been
+// submitted, so that every channel is used by a single transaction at a time.
+//
+// Channels that don't exist yet are created by Funder on first use. Funder also
+// tops up channels whose balance drops below MinBalance.
+type Pool struct {
+	Horizon           horizonclient.ClientInterface
+	NetworkPassphrase string
+	Funder            *keypair.Full
+	BaseFee           uint32
+	StartingBalance   string
+	MinBalance        string
+
+	channels    []*Channel
+	free        chan *Channel
+	funderMutex sync.Mutex
+	log         *log.Entry
+}
+
+// NewPool creates a pool using the given channel account seeds.
+func NewPool(
+	horizon horizonclient.ClientInterface,
+	networkPassphrase string,
+	funder *keypair.Full,
+	seeds []string,
+) (*Pool, error) {
+	if len(seeds) == 0 {
+		return nil, errors.New("at least one channel seed is required")
+	}
+
+	pool := &Pool{
+		Horizon:           horizon,
+		NetworkPassphrase: networkPassphrase,
+		Funder:            funder,
+		StartingBalance:   DefaultStartingBalance,
+		MinBalance:        DefaultMinBalance,
+		free:              make(chan *Channel, len(seeds)),
+		log:               log.WithField("service", "channels"),
+	}
+
+	for i, seed := range seeds {
+		kp, err := keypair.Parse(seed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid channel seed at index %d", i)
+		}
+
+		full, ok := kp.(*keypair.Full)
+		if !ok {
+			return nil, errors.Errorf("channel seed at index %d is not a secret seed", i)
+		}
+
+		channel := &Channel{Keypair: full}
+		pool.channels = append(pool.channels, channel)
+		pool.free <- channel
+	}
+
+	return pool, nil
+}
+
+// Size returns the number of channels in the pool.
+func (p *Pool) Size() int {
+	return len(p.channels)
+}
+
+// Acquire waits for a free channel and returns it. The channel is created and
+// its sequence number loaded from horizon if that was not done before. It
+// returns ctx.Err() if ctx is done before a channel is free.
+func (p *Pool) Acquire(ctx context.Context) (*Channel, error) {
+	var channel *Channel
+	select {
+	case channel = <-p.free:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if !channel.loaded || channel.uses >= balanceCheckInterval {
+		err := p.load(ctx, channel)
+		if err != nil {
+			p.free <- channel
+			return nil, err
+		}
+	}
+
+	channel.uses++
+	return channel, nil
+}
+
+// Release returns a channel to the pool. err is the result of submitting the
+// transaction that used the channel: when it's not nil the sequence number of
+// the channel is reloaded before its next use, as it's not known whether the
+// transaction consumed it.
+func (p *Pool) Release(channel *Channel, err error) {
+	if err != nil {
+		channel.loaded = false
+	}
+	p.free <- channel
+}
+
+// load loads the channel's sequence number, creating the channel account or
+// topping up its balance if needed.
+func (p *Pool) load(ctx context.Context, channel *Channel) error {
+	account, err := p.Horizon.AccountDetailContext(ctx, horizonclient.AccountRequest{AccountID: channel.GetAccountID()})
+	if isNotFound(err) {
+		p.log.Ctx(ctx).WithField("channel", channel.GetAccountID()).Info("Creating channel account")
+		err = p.createChannel(ctx, channel)
+		if err != nil {
+			return errors.Wrap(err, "creating channel account")
+		}
+		account, err = p.Horizon.AccountDetailContext(ctx, horizonclient.AccountRequest{AccountID: channel.GetAccountID()})
+	}
+	if err != nil {
+		return errors.Wrap(err, "loading channel account")
+	}
+
+	channel.Sequence, err = account.GetSequenceNumber()
+	if err != nil {
+		return errors.Wrap(err, "parsing channel sequence number")
+	}
+
+	balance, err := account.GetNativeBalance()
+	if err != nil {
+		return errors.Wrap(err, "getting channel balance")
+	}
+
+	err = p.topUp(ctx, channel, balance)
+	if err != nil {
+		return errors.Wrap(err, "topping up channel")
+	}
+
+	channel.loaded = true
+	channel.uses = 0
+	return nil
+}
+
+func isNotFound(err error) bool {
+	herr, ok := err.(*horizonclient.Error)
+	return ok && herr.Problem.Status == http.StatusNotFound
+}
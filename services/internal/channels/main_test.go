@@ -0,0 +1,183 @@
+package channels
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Public key: GDLIF7FJCNBAVZIASPSIV4ACNXLDHOETA2QZGD5GBZCKWJOJUH7ETFTY
+const funderSeed = "SAW74LFCXQU7TYOB3LU4J7FKVKM2FFEAR2OXYPEJDIR5OAW36ZO2T67E"
+
+// Public key: GBUJ6YAPVUTSOBKVRKRM4NPKZNV73VGPEAZBT5TIVXNACTR73PGMQQLZ
+const channelSeed = "SBLEN2RFOV7EFRLLRIIAU5Q4PECCZOTL77LKJPKFONONNYYPDFWSNUNE"
+
+const testNetwork = "Test SDF Network ; September 2015"
+
+func account(address, sequence, balance string) hProtocol.Account {
+	return hProtocol.Account{
+		AccountID: address,
+		Sequence:  sequence,
+		Balances: []hProtocol.Balance{
+			{Balance: balance, Asset: base.Asset{Type: "native"}},
+		},
+	}
+}
+
+func newTestPool(t *testing.T, horizon horizonclient.ClientInterface) *Pool {
+	pool, err := NewPool(horizon, testNetwork, keypair.MustParse(funderSeed).(*keypair.Full), []string{channelSeed})
+	require.NoError(t, err)
+	return pool
+}
+
+func TestNewPool(t *testing.T) {
+	_, err := NewPool(nil, testNetwork, nil, nil)
+	assert.EqualError(t, err, "at least one channel seed is required")
+
+	_, err = NewPool(nil, testNetwork, nil, []string{"GDLIF7FJCNBAVZIASPSIV4ACNXLDHOETA2QZGD5GBZCKWJOJUH7ETFTY"})
+	assert.EqualError(t, err, "channel seed at index 0 is not a secret seed")
+
+	pool := newTestPool(t, nil)
+	assert.Equal(t, 1, pool.Size())
+}
+
+func TestPoolCreatesChannel(t *testing.T) {
+	horizon := &horizonclient.MockClient{}
+	pool := newTestPool(t, horizon)
+	channelID := keypair.MustParse(channelSeed).Address()
+	funderID := keypair.MustParse(funderSeed).Address()
+
+	notFound := &horizonclient.Error{Problem: problem.P{Status: http.StatusNotFound}}
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(hProtocol.Account{}, notFound).Once()
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: funderID}).
+		Return(account(funderID, "10", "1000"), nil).Once()
+	horizon.On("SubmitTransactionXDR", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			var txe xdr.TransactionEnvelope
+			require.NoError(t, xdr.SafeUnmarshalBase64(args.String(0), &txe))
+			assert.Equal(t, xdr.SequenceNumber(11), txe.Tx.SeqNum)
+			require.Len(t, txe.Tx.Operations, 1)
+			assert.Equal(t, xdr.OperationTypeCreateAccount, txe.Tx.Operations[0].Body.Type)
+		}).
+		Return(hProtocol.TransactionSuccess{Ledger: 1}, nil).Once()
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(account(channelID, "100", DefaultStartingBalance), nil).Once()
+
+	channel, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, channelID, channel.GetAccountID())
+
+	seq, err := channel.IncrementSequenceNumber()
+	require.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(101), seq)
+	pool.Release(channel, nil)
+
+	// The sequence number is tracked in memory, horizon is not called again.
+	channel, err = pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(101), channel.Sequence)
+	pool.Release(channel, nil)
+
+	horizon.AssertExpectations(t)
+}
+
+func TestPoolTopsUpChannel(t *testing.T) {
+	horizon := &horizonclient.MockClient{}
+	pool := newTestPool(t, horizon)
+	channelID := keypair.MustParse(channelSeed).Address()
+	funderID := keypair.MustParse(funderSeed).Address()
+
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(account(channelID, "100", "1.5"), nil).Once()
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: funderID}).
+		Return(account(funderID, "10", "1000"), nil).Once()
+	horizon.On("SubmitTransactionXDR", mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) {
+			var txe xdr.TransactionEnvelope
+			require.NoError(t, xdr.SafeUnmarshalBase64(args.String(0), &txe))
+			require.Len(t, txe.Tx.Operations, 1)
+			payment := txe.Tx.Operations[0].Body.MustPaymentOp()
+			assert.Equal(t, xdr.Int64(35000000), payment.Amount)
+		}).
+		Return(hProtocol.TransactionSuccess{Ledger: 1}, nil).Once()
+
+	channel, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	pool.Release(channel, nil)
+
+	horizon.AssertExpectations(t)
+}
+
+func TestPoolReloadsAfterError(t *testing.T) {
+	horizon := &horizonclient.MockClient{}
+	pool := newTestPool(t, horizon)
+	channelID := keypair.MustParse(channelSeed).Address()
+
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(account(channelID, "100", "10"), nil).Once()
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(account(channelID, "105", "10"), nil).Once()
+
+	channel, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	_, err = channel.IncrementSequenceNumber()
+	require.NoError(t, err)
+	pool.Release(channel, errors.New("tx_bad_seq"))
+
+	channel, err = pool.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(105), channel.Sequence)
+	pool.Release(channel, nil)
+
+	horizon.AssertExpectations(t)
+}
+
+func TestPoolAcquireError(t *testing.T) {
+	horizon := &horizonclient.MockClient{}
+	pool := newTestPool(t, horizon)
+	channelID := keypair.MustParse(channelSeed).Address()
+
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(hProtocol.Account{}, errors.New("connection refused")).Once()
+
+	_, err := pool.Acquire(context.Background())
+	assert.EqualError(t, err, "loading channel account: connection refused")
+
+	// The channel is back in the pool.
+	assert.Len(t, pool.free, 1)
+	horizon.AssertExpectations(t)
+}
+
+func TestPoolAcquireCancelled(t *testing.T) {
+	horizon := &horizonclient.MockClient{}
+	pool := newTestPool(t, horizon)
+	channelID := keypair.MustParse(channelSeed).Address()
+
+	horizon.On("AccountDetail", horizonclient.AccountRequest{AccountID: channelID}).
+		Return(account(channelID, "100", "10"), nil).Once()
+
+	channel, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	// No channel is free, so Acquire returns when ctx is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pool.Acquire(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	pool.Release(channel, nil)
+	assert.Len(t, pool.free, 1)
+	horizon.AssertExpectations(t)
+}
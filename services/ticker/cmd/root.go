@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -41,12 +42,23 @@ func init() {
 }
 
 func initConfig() {
+	defaultClient := horizonclient.DefaultPublicNetClient
 	if UseTestNet {
 		Logger.Debug("Using Stellar Default Test Network")
-		Client = horizonclient.DefaultTestNetClient
+		defaultClient = horizonclient.DefaultTestNetClient
 	} else {
 		Logger.Debug("Using Stellar Default Public Network")
-		Client = horizonclient.DefaultPublicNetClient
+	}
+	// The default clients are shared by every user of horizonclient, so the
+	// retry policy is set on a client of our own.
+	Client = &horizonclient.Client{
+		HorizonURL: defaultClient.HorizonURL,
+		HTTP:       defaultClient.HTTP,
+		// Horizon rate limits the scrapers, which back off until it lets them in.
+		RetryPolicy: horizonclient.ExponentialBackoff{
+			MaxRetries: 4,
+			Delay:      5 * time.Second,
+		},
 	}
 }
 
@@ -12,7 +12,7 @@ import (
 
 // RefreshOrderbookEntries updates the orderbook entries for the relevant markets that were active
 // in the past 7-day interval
-func RefreshOrderbookEntries(s *tickerdb.TickerSession, c *horizonclient.Client, l *hlog.Entry) error {
+func RefreshOrderbookEntries(s *tickerdb.TickerSession, c horizonclient.ClientInterface, l *hlog.Entry) error {
 	sc := scraper.ScraperConfig{
 		Client: c,
 		Logger: l,
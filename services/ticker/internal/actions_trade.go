@@ -18,7 +18,7 @@ import (
 func StreamTrades(
 	ctx context.Context,
 	s *tickerdb.TickerSession,
-	c *horizonclient.Client,
+	c horizonclient.ClientInterface,
 	l *hlog.Entry,
 ) error {
 	sc := scraper.ScraperConfig{
@@ -60,7 +60,7 @@ func StreamTrades(
 // into the database.
 func BackfillTrades(
 	s *tickerdb.TickerSession,
-	c *horizonclient.Client,
+	c horizonclient.ClientInterface,
 	l *hlog.Entry,
 	numHours int,
 	limit int,
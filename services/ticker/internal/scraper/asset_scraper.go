@@ -1,6 +1,7 @@
 package scraper
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -12,11 +13,10 @@ import (
 	"sync"
 	"time"
 
-	"github.com/BurntSushi/toml"
-
 	horizonclient "github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/clients/stellartoml"
 	hProtocol "github.com/stellar/go/protocols/horizon"
-	"github.com/stellar/go/services/ticker/internal/utils"
+	hlog "github.com/stellar/go/support/log"
 )
 
 // shouldDiscardAsset maps the criteria for discarding an asset from the asset index
@@ -56,7 +56,12 @@ func shouldDiscardAsset(asset hProtocol.AssetStat, shouldValidateTOML bool) bool
 
 // decodeTOMLIssuer decodes retrieved TOML issuer data into a TOMLIssuer struct
 func decodeTOMLIssuer(tomlData string) (issuer TOMLIssuer, err error) {
-	_, err = toml.Decode(tomlData, &issuer)
+	resp, err := stellartoml.Decode(strings.NewReader(tomlData))
+	if err != nil {
+		return
+	}
+
+	issuer.Response = *resp
 	return
 }
 
@@ -209,8 +214,9 @@ func makeFinalAsset(
 	return
 }
 
-// processAsset merges data from an AssetStat with data retrieved from its corresponding TOML file
-func processAsset(asset hProtocol.AssetStat, shouldValidateTOML bool) (FinalAsset, error) {
+// processAsset merges data from an AssetStat with data retrieved from its corresponding TOML file.
+// TOML files which don't conform to SEP-1 are still used, their problems are logged.
+func (c *ScraperConfig) processAsset(asset hProtocol.AssetStat, shouldValidateTOML bool) (FinalAsset, error) {
 	var errors []error
 	var issuer TOMLIssuer
 
@@ -224,16 +230,31 @@ func processAsset(asset hProtocol.AssetStat, shouldValidateTOML bool) (FinalAsse
 		if err != nil {
 			errors = append(errors, err)
 		}
+
+		if len(errors) == 0 {
+			if errs := issuer.Response.Validate(); len(errs) > 0 {
+				c.Logger.WithFields(hlog.F{
+					"toml_url": asset.Links.Toml.Href,
+					"err":      errs,
+				}).Warn("stellar.toml does not conform to SEP-1")
+			}
+		}
 	}
 
 	return makeFinalAsset(asset, issuer, errors)
 }
 
+// isTestNet reports whether client connects to the TestNet horizon server.
+func isTestNet(client horizonclient.ClientInterface) bool {
+	hc, ok := client.(*horizonclient.Client)
+	return ok && hc.HorizonURL == horizonclient.DefaultTestNetClient.HorizonURL
+}
+
 // parallelProcessAssets filters the assets that don't match the shouldDiscardAsset criteria.
 // The TOML validation is performed in parallel to improve performance.
 func (c *ScraperConfig) parallelProcessAssets(assets []hProtocol.AssetStat, parallelism int) (cleanAssets []FinalAsset, numTrash int) {
 	queue := make(chan FinalAsset, parallelism)
-	shouldValidateTOML := c.Client != horizonclient.DefaultTestNetClient // TOMLs shouldn't be validated on TestNet
+	shouldValidateTOML := !isTestNet(c.Client) // TOMLs shouldn't be validated on TestNet
 
 	var mutex = &sync.Mutex{}
 	var wg sync.WaitGroup
@@ -253,7 +274,7 @@ func (c *ScraperConfig) parallelProcessAssets(assets []hProtocol.AssetStat, para
 
 			for j := start; j < end; j++ {
 				if !shouldDiscardAsset(assets[j], shouldValidateTOML) {
-					finalAsset, err := processAsset(assets[j], shouldValidateTOML)
+					finalAsset, err := c.processAsset(assets[j], shouldValidateTOML)
 					if err != nil {
 						mutex.Lock()
 						numTrash++
@@ -300,44 +321,18 @@ func (c *ScraperConfig) parallelProcessAssets(assets []hProtocol.AssetStat, para
 // retrieveAssets retrieves existing assets from the Horizon API. If limit=0, will fetch all assets.
 func (c *ScraperConfig) retrieveAssets(limit int) (assets []hProtocol.AssetStat, err error) {
 	r := horizonclient.AssetRequest{Limit: 200}
-
-	assetsPage, err := c.Client.Assets(r)
-	if err != nil {
-		return
-	}
+	it := horizonclient.NewAssetIterator(context.Background(), c.Client, r, horizonclient.IteratorOptions{
+		Limit: limit,
+	})
+	defer it.Close()
 
 	c.Logger.Infoln("Fetching assets from Horizon")
 
-	for assetsPage.Links.Next.Href != assetsPage.Links.Self.Href {
-		err = utils.Retry(5, 5*time.Second, c.Logger, func() error {
-			assetsPage, err = c.Client.Assets(r)
-			if err != nil {
-				c.Logger.Infoln("Horizon rate limit reached!")
-			}
-			return err
-		})
-		if err != nil {
-			return
-		}
-		assets = append(assets, assetsPage.Embedded.Records...)
-
-		if limit != 0 { // for performance reasons, only perform these additional checks when limit != 0
-			numAssets := len(assets)
-			if numAssets >= limit {
-				diff := numAssets - limit
-				assets = assets[0 : numAssets-diff]
-				break
-			}
-		}
-
-		nextURL := assetsPage.Links.Next.Href
-		n, err := nextCursor(nextURL)
-		if err != nil {
-			return assets, err
-		}
-		c.Logger.Debugln("Cursor currently at:", n)
-
-		r = horizonclient.AssetRequest{Limit: 200, Cursor: n}
+	for it.Next() {
+		assets = append(assets, it.Asset())
+	}
+	if err = it.Err(); err != nil {
+		return
 	}
 
 	c.Logger.Infof("Fetched: %d assets\n", len(assets))
@@ -1,11 +1,18 @@
 package scraper
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/sirupsen/logrus"
+	horizonclient "github.com/stellar/go/clients/horizonclient"
 	hProtocol "github.com/stellar/go/protocols/horizon"
+	hlog "github.com/stellar/go/support/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestShouldDiscardAsset(t *testing.T) {
@@ -129,3 +136,40 @@ func TestIsDomainVerified(t *testing.T) {
 	hasCurrency = true
 	assert.False(t, isDomainVerified(orgURL, tomlURL, hasCurrency))
 }
+
+func TestProcessAssetLogsInvalidTOML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `FEDERATION_SERVER="not a url"
+[DOCUMENTATION]
+ORG_NAME="Example"
+`)
+	}))
+	defer server.Close()
+
+	c := ScraperConfig{Logger: hlog.New()}
+	done := c.Logger.StartTest(logrus.WarnLevel)
+
+	var asset hProtocol.AssetStat
+	asset.Code = "EXA"
+	asset.Amount = "100.0000000"
+	asset.Links.Toml.Href = server.URL + "/.well-known/stellar.toml"
+	finalAsset, err := c.processAsset(asset, true)
+	require.NoError(t, err)
+
+	// the file is still used
+	assert.True(t, finalAsset.IsValid)
+	assert.Equal(t, "Example", finalAsset.IssuerDetails.Documentation.OrgName)
+
+	logs := done()
+	require.Len(t, logs, 1)
+	assert.Equal(t, "stellar.toml does not conform to SEP-1", logs[0].Message)
+	assert.Equal(t, asset.Links.Toml.Href, logs[0].Data["toml_url"])
+}
+
+func TestIsTestNet(t *testing.T) {
+	assert.True(t, isTestNet(horizonclient.DefaultTestNetClient))
+	assert.True(t, isTestNet(&horizonclient.Client{HorizonURL: horizonclient.DefaultTestNetClient.HorizonURL}))
+	assert.False(t, isTestNet(horizonclient.DefaultPublicNetClient))
+	assert.False(t, isTestNet(&horizonclient.Client{HorizonURL: horizonclient.DefaultPublicNetClient.HorizonURL}))
+	assert.False(t, isTestNet(&horizonclient.MockClient{}))
+}
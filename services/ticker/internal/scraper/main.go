@@ -5,58 +5,23 @@ import (
 	"time"
 
 	horizonclient "github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/clients/stellartoml"
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/ticker/internal/utils"
 	hlog "github.com/stellar/go/support/log"
 )
 
 type ScraperConfig struct {
-	Client *horizonclient.Client
+	Client horizonclient.ClientInterface
 	Logger *hlog.Entry
 	Ctx    *context.Context
 }
 
-// TOMLDoc is the interface for storing TOML Issuer Documentation.
-// See: https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0001.md#currency-documentation
-type TOMLDoc struct {
-	OrgName    string `toml:"ORG_NAME"`
-	OrgURL     string `toml:"ORG_URL"`
-	OrgTwitter string `toml:"ORG_TWITTER"`
-}
-
-// TOMLCurrency is the interface for storing TOML Currency Information.
-// See: https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0001.md#currency-documentation
-type TOMLCurrency struct {
-	Code                        string   `toml:"code"`
-	Issuer                      string   `toml:"issuer"`
-	IsAssetAnchored             bool     `toml:"is_asset_anchored"`
-	AnchorAsset                 string   `toml:"anchor_asset"`
-	AnchorAssetType             string   `toml:"anchor_asset_type"`
-	DisplayDecimals             int      `toml:"display_decimals"`
-	Name                        string   `toml:"name"`
-	Desc                        string   `toml:"desc"`
-	Conditions                  string   `toml:"conditions"`
-	FixedNumber                 int      `toml:"fixed_number"`
-	MaxNumber                   int      `toml:"max_number"`
-	IsUnlimited                 bool     `toml:"is_unlimited"`
-	RedemptionInstructions      string   `toml:"redemption_instructions"`
-	CollateralAddresses         []string `toml:"collateral_addresses"`
-	CollateralAddressSignatures []string `toml:"collateral_address_signatures"`
-	Status                      string   `toml:"status"`
-}
-
 // TOMLIssuer is the interface for storing TOML Issuer Information.
 // See: https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0001.md#currency-documentation
 type TOMLIssuer struct {
-	FederationServer string         `toml:"FEDERATION_SERVER"`
-	AuthServer       string         `toml:"AUTH_SERVER"`
-	TransferServer   string         `toml:"TRANSFER_SERVER"`
-	WebAuthEndpoint  string         `toml:"WEB_AUTH_ENDPOINT"`
-	SigningKey       string         `toml:"SIGNING_KEY"`
-	DepositServer    string         `toml:"DEPOSIT_SERVER"` // for legacy purposes
-	Documentation    TOMLDoc        `toml:"DOCUMENTATION"`
-	Currencies       []TOMLCurrency `toml:"CURRENCIES"`
-	TOMLURL          string         `toml:"-"`
+	stellartoml.Response
+	TOMLURL string
 }
 
 // FinalAsset is the interface to represent the aggregated Asset data.
@@ -2,10 +2,9 @@ package scraper
 
 import (
 	"math"
-	"strconv"
-	"time"
 
 	"github.com/pkg/errors"
+	"github.com/stellar/go/amount"
 	horizonclient "github.com/stellar/go/clients/horizonclient"
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/ticker/internal/utils"
@@ -30,13 +29,7 @@ func (c *ScraperConfig) fetchOrderbook(bType, bCode, bIssuer, cType, cCode, cIss
 	}
 	r := createOrderbookRequest(bType, bCode, bIssuer, cType, cCode, cIssuer)
 
-	err = utils.Retry(5, 5*time.Second, c.Logger, func() error {
-		summary, err = c.Client.OrderBook(r)
-		if err != nil {
-			c.Logger.Infoln("Horizon rate limit reached!")
-		}
-		return err
-	})
+	summary, err = c.Client.OrderBook(r)
 	if err != nil {
 		return obStats, errors.Wrap(err, "could not fetch orderbook summary")
 	}
@@ -50,33 +43,41 @@ func (c *ScraperConfig) fetchOrderbook(bType, bCode, bIssuer, cType, cCode, cIss
 
 // calcOrderbookStats calculates the NumBids, BidVolume, BidMax, NumAsks, AskVolume and AskMin
 // statistics for a given OrdebookStats instance
+//
+// The volumes are summed up exactly, and only converted to float64 at the end.
 func calcOrderbookStats(obStats *OrderbookStats, summary hProtocol.OrderBookSummary) error {
 	// Calculate Bid Data:
 	obStats.NumBids = len(summary.Bids)
 	if obStats.NumBids == 0 {
 		obStats.HighestBid = 0
 	}
+	var bidVolume amount.Amount
 	for _, bid := range summary.Bids {
 		pricef := float64(bid.PriceR.N) / float64(bid.PriceR.D)
 		if pricef > obStats.HighestBid {
 			obStats.HighestBid = pricef
 		}
 
-		amountf, err := strconv.ParseFloat(bid.Amount, 64)
+		bidAmount, err := amount.ParseAmount(bid.Amount)
 		if err != nil {
 			return errors.Wrap(err, "invalid bid amount")
 		}
-		obStats.BidVolume += amountf
+		bidVolume, err = bidVolume.Add(bidAmount)
+		if err != nil {
+			return errors.Wrap(err, "invalid bid volume")
+		}
 	}
+	obStats.BidVolume = bidVolume.Float64()
 
 	// Calculate Ask Data:
 	obStats.NumAsks = len(summary.Asks)
 	if obStats.NumAsks == 0 {
 		obStats.LowestAsk = 0
 	}
+	var askVolume amount.Amount
 	for _, ask := range summary.Asks {
 		pricef := float64(ask.PriceR.N) / float64(ask.PriceR.D)
-		amountf, err := strconv.ParseFloat(ask.Amount, 64)
+		askAmount, err := amount.ParseAmount(ask.Amount)
 		if err != nil {
 			return errors.Wrap(err, "invalid ask amount")
 		}
@@ -84,11 +85,19 @@ func calcOrderbookStats(obStats *OrderbookStats, summary hProtocol.OrderBookSumm
 		// On Horizon, Ask prices are in units of counter, but
 		// amount is in units of base. Therefore, real amount = amount * price
 		// See: https://github.com/stellar/go/issues/612
-		obStats.AskVolume += pricef * amountf
+		askAmount, err = askAmount.MulFraction(int64(ask.PriceR.N), int64(ask.PriceR.D), amount.RoundHalfEven)
+		if err != nil {
+			return errors.Wrap(err, "invalid ask amount")
+		}
+		askVolume, err = askVolume.Add(askAmount)
+		if err != nil {
+			return errors.Wrap(err, "invalid ask volume")
+		}
 		if pricef < obStats.LowestAsk {
 			obStats.LowestAsk = pricef
 		}
 	}
+	obStats.AskVolume = askVolume.Float64()
 
 	obStats.Spread, obStats.SpreadMidPoint = utils.CalcSpread(obStats.HighestBid, obStats.LowestAsk)
 
@@ -0,0 +1,36 @@
+package scraper
+
+import (
+	"math"
+	"testing"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcOrderbookStats(t *testing.T) {
+	obStats := OrderbookStats{HighestBid: math.Inf(-1), LowestAsk: math.Inf(1)}
+	summary := hProtocol.OrderBookSummary{
+		Bids: []hProtocol.PriceLevel{
+			{PriceR: hProtocol.Price{N: 1, D: 10}, Amount: "0.1000000"},
+			{PriceR: hProtocol.Price{N: 1, D: 5}, Amount: "0.2000000"},
+		},
+		Asks: []hProtocol.PriceLevel{
+			{PriceR: hProtocol.Price{N: 1, D: 3}, Amount: "3.0000000"},
+			{PriceR: hProtocol.Price{N: 1, D: 2}, Amount: "0.3000000"},
+		},
+	}
+
+	require.NoError(t, calcOrderbookStats(&obStats, summary))
+	assert.Equal(t, 2, obStats.NumBids)
+	assert.Equal(t, 0.2, obStats.HighestBid)
+	// 0.1 + 0.2 is exactly 0.3, unlike with float64 maths
+	assert.Equal(t, 0.3, obStats.BidVolume)
+	assert.Equal(t, 2, obStats.NumAsks)
+	assert.Equal(t, 1.0/3, obStats.LowestAsk)
+	assert.Equal(t, 1.15, obStats.AskVolume)
+
+	summary.Bids[0].Amount = "invalid"
+	assert.Error(t, calcOrderbookStats(&obStats, summary))
+}
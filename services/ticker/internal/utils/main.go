@@ -2,11 +2,8 @@ package utils
 
 import (
 	"fmt"
-	"math/rand"
 	"os"
 	"time"
-
-	hlog "github.com/stellar/go/support/log"
 )
 
 // PanicIfError is an utility function that panics if err != nil
@@ -76,26 +73,3 @@ func CalcSpread(bidMax float64, askMin float64) (spread float64, midPoint float6
 	midPoint = bidMax + spread/2.0
 	return
 }
-
-// Retry retries running a function that returns an error numRetries times, multiplying
-// the sleep time by a factor of 2 each time it retries.
-func Retry(numRetries int, delay time.Duration, logger *hlog.Entry, f func() error) error {
-	if err := f(); err != nil {
-		if numRetries--; numRetries > 0 {
-			jitter := time.Duration(rand.Int63n(int64(delay)))
-			delay = delay + jitter/2
-
-			logger.Infof("Backing off for %.3f seconds before retrying", delay.Seconds())
-
-			time.Sleep(delay)
-			return Retry(numRetries, 2*delay, logger, f)
-		}
-		return err
-	}
-
-	return nil
-}
-
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
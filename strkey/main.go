@@ -31,8 +31,25 @@ const (
 	//VersionByteHashX is the version byte used for encoded stellar hashX
 	//signer keys.
 	VersionByteHashX = 23 << 3 // Base32-encodes to 'X...'
+
+	//VersionByteMuxedAccount is the version byte used for encoded muxed
+	//accounts: an account ID followed by a 64-bit subaccount ID.
+	VersionByteMuxedAccount = 12 << 3 // Base32-encodes to 'M...'
 )
 
+// muxedAccountPayloadLength is the length of the payload of a muxed account:
+// a 32 bytes ed25519 public key followed by a 8 bytes big-endian ID.
+const muxedAccountPayloadLength = 32 + 8
+
+// muxedAccountEncodedLength is the length of an encoded muxed account: the
+// version byte, payload and checksum, base32 encoded without padding.
+const muxedAccountEncodedLength = 69
+
+// unpaddedEncoding is used for muxed accounts, the only strkeys whose length
+// isn't a multiple of 5 bytes. Other strkeys are the same with or without
+// padding.
+var unpaddedEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
 // DecodeAny decodes the provided StrKey into a raw value, checking the checksum
 // and if the version byte is one of allowed values.
 func DecodeAny(src string) (VersionByte, []byte, error) {
@@ -128,7 +145,7 @@ func Encode(version VersionByte, src []byte) (string, error) {
 		return "", err
 	}
 
-	result := base32.StdEncoding.EncodeToString(raw.Bytes())
+	result := unpaddedEncoding.EncodeToString(raw.Bytes())
 	return result, nil
 }
 
@@ -171,6 +188,10 @@ func checkValidVersionByte(version VersionByte) error {
 		return nil
 	}
 
+	if version == VersionByteMuxedAccount {
+		return nil
+	}
+
 	return ErrInvalidVersionByte
 }
 
@@ -178,7 +199,12 @@ func checkValidVersionByte(version VersionByte) error {
 // potentially be strkey encoded (i.e. it has both a version byte and a
 // checksum, neither of which are explicitly checked by this func)
 func decodeString(src string) ([]byte, error) {
-	raw, err := base32.StdEncoding.DecodeString(src)
+	enc := base32.StdEncoding
+	if len(src) == muxedAccountEncodedLength {
+		enc = unpaddedEncoding
+	}
+
+	raw, err := enc.DecodeString(src)
 	if err != nil {
 		return nil, errors.Wrap(err, "base32 decode failed")
 	}
@@ -203,6 +229,80 @@ func IsValidEd25519PublicKey(i interface{}) bool {
 	return err == nil
 }
 
+// EncodeMuxedAccount encodes the provided account ID (a G... address) and
+// subaccount ID to a muxed account (an M... address).
+func EncodeMuxedAccount(accountID string, id uint64) (string, error) {
+	key, err := Decode(VersionByteAccountID, accountID)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid account id")
+	}
+
+	payload := make([]byte, 0, muxedAccountPayloadLength)
+	payload = append(payload, key...)
+	payload = append(payload, make([]byte, 8)...)
+	binary.BigEndian.PutUint64(payload[len(key):], id)
+
+	return Encode(VersionByteMuxedAccount, payload)
+}
+
+// DecodeMuxedAccount decodes the provided muxed account (an M... address) into
+// the account ID (a G... address) and the subaccount ID.
+func DecodeMuxedAccount(src string) (accountID string, id uint64, err error) {
+	payload, err := Decode(VersionByteMuxedAccount, src)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if len(payload) != muxedAccountPayloadLength {
+		return "", 0, errors.Errorf("invalid muxed account payload length: %d", len(payload))
+	}
+
+	accountID, err = Encode(VersionByteAccountID, payload[:32])
+	if err != nil {
+		return "", 0, err
+	}
+
+	return accountID, binary.BigEndian.Uint64(payload[32:]), nil
+}
+
+// IsValidMuxedAccount validates a stellar muxed account
+func IsValidMuxedAccount(i interface{}) bool {
+	enc, ok := i.(string)
+
+	if !ok {
+		return false
+	}
+
+	_, _, err := DecodeMuxedAccount(enc)
+
+	return err == nil
+}
+
+// IsValidSignerKey validates a stellar signer key: an account ID ("G..."), a
+// pre-authorized transaction hash ("T...") or a hash-x ("X...").
+func IsValidSignerKey(i interface{}) bool {
+	enc, ok := i.(string)
+
+	if !ok {
+		return false
+	}
+
+	version, err := Version(enc)
+	if err != nil {
+		return false
+	}
+
+	switch version {
+	case VersionByteAccountID, VersionByteHashTx, VersionByteHashX:
+	default:
+		return false
+	}
+
+	_, err = Decode(version, enc)
+
+	return err == nil
+}
+
 // IsValidEd25519SecretSeed validates a stellar secret key
 func IsValidEd25519SecretSeed(i interface{}) bool {
 	enc, ok := i.(string)
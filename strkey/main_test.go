@@ -33,9 +33,9 @@ func TestVersion(t *testing.T) {
 			ExpectedVersionByte: VersionByteHashX,
 		},
 		{
-			Name:                "Other (0x60)",
+			Name:                "MuxedAccount",
 			Address:             "MBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG",
-			ExpectedVersionByte: VersionByte(0x60),
+			ExpectedVersionByte: VersionByteMuxedAccount,
 		},
 	}
 
@@ -82,3 +82,66 @@ func TestIsValidEd25519SecretSeed(t *testing.T) {
 	isValid = IsValidEd25519SecretSeed(invalidKey)
 	assert.Equal(t, false, isValid)
 }
+
+func TestIsValidSignerKey(t *testing.T) {
+	valid := []string{
+		"GDWZCOEQRODFCH6ISYQPWY67L3ULLWS5ISXYYL5GH43W7YFMTLB65PYM",
+		"TBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHXL7",
+		"XBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG",
+	}
+	for _, key := range valid {
+		assert.True(t, IsValidSignerKey(key), key)
+	}
+
+	invalid := []interface{}{
+		"",
+		"SBCVMMCBEDB64TVJZFYJOJAERZC4YVVUOE6SYR2Y76CBTENGUSGWRRVO",
+		"TBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHXL",
+		42,
+	}
+	for _, key := range invalid {
+		assert.False(t, IsValidSignerKey(key), key)
+	}
+}
+
+func TestMuxedAccount(t *testing.T) {
+	accountID := "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"
+
+	muxed, err := EncodeMuxedAccount(accountID, 1234)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAE2JUG6", muxed)
+		assert.True(t, IsValidMuxedAccount(muxed))
+		assert.False(t, IsValidEd25519PublicKey(muxed))
+
+		version, payload, err := DecodeAny(muxed)
+		assert.NoError(t, err)
+		assert.Equal(t, VersionByte(VersionByteMuxedAccount), version)
+		assert.Len(t, payload, 40)
+
+		decodedID, id, err := DecodeMuxedAccount(muxed)
+		assert.NoError(t, err)
+		assert.Equal(t, accountID, decodedID)
+		assert.Equal(t, uint64(1234), id)
+	}
+
+	// max id
+	muxed, err = EncodeMuxedAccount(accountID, ^uint64(0))
+	if assert.NoError(t, err) {
+		_, id, err := DecodeMuxedAccount(muxed)
+		assert.NoError(t, err)
+		assert.Equal(t, ^uint64(0), id)
+	}
+
+	_, err = EncodeMuxedAccount("SBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHOKR", 1)
+	assert.EqualError(t, err, "invalid account id: invalid version byte")
+
+	// account ID is not a muxed account
+	_, _, err = DecodeMuxedAccount(accountID)
+	assert.Equal(t, ErrInvalidVersionByte, err)
+	assert.False(t, IsValidMuxedAccount(accountID))
+
+	// muxed account with a 32 bytes payload
+	short := MustEncode(VersionByteMuxedAccount, make([]byte, 32))
+	_, _, err = DecodeMuxedAccount(short)
+	assert.EqualError(t, err, "invalid muxed account payload length: 32")
+}
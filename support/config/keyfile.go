@@ -0,0 +1,77 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/howeyc/gopass"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/errors"
+)
+
+// Keyfile represents a common configuration snippet for loading a secret key
+// from an encrypted keyfile, see `keypair.SaveEncrypted`, instead of storing a
+// plaintext seed in the configuration.
+type Keyfile struct {
+	Path string `toml:"path" valid:"required"`
+	// Password is where the password of the keyfile is read from, see
+	// `ReadPassword`.
+	Password string `toml:"password" valid:"required"`
+}
+
+// Load decrypts the keyfile with the password read from its source.
+func (k *Keyfile) Load() (*keypair.Full, error) {
+	password, err := ReadPassword(k.Password, fmt.Sprintf("Enter password of %s: ", k.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	kp, err := keypair.LoadEncrypted(k.Path, password)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading keyfile %s", k.Path)
+	}
+	return kp, nil
+}
+
+// ReadPassword reads a password from source: "env:NAME" reads the
+// environment variable NAME, "file:PATH" reads the first line of the file at
+// PATH and "prompt" reads it from the terminal, showing prompt.
+func ReadPassword(source, prompt string) ([]byte, error) {
+	var password string
+	switch {
+	case strings.HasPrefix(source, "env:"):
+		name := strings.TrimPrefix(source, "env:")
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return nil, errors.Errorf("environment variable %s is not set", name)
+		}
+		password = value
+	case strings.HasPrefix(source, "file:"):
+		f, err := os.Open(strings.TrimPrefix(source, "file:"))
+		if err != nil {
+			return nil, errors.Wrap(err, "opening password file")
+		}
+		defer f.Close()
+
+		password, err = bufio.NewReader(f).ReadString('\n')
+		if err != nil && password == "" {
+			return nil, errors.Wrap(err, "reading password file")
+		}
+		password = strings.TrimRight(password, "\r\n")
+	case source == "prompt":
+		value, err := gopass.GetPasswdPrompt(prompt, true, os.Stdin, os.Stderr)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading password")
+		}
+		password = string(value)
+	default:
+		return nil, errors.Errorf("invalid password source %q, expected env:NAME, file:PATH or prompt", source)
+	}
+
+	if password == "" {
+		return nil, errors.New("empty password")
+	}
+	return []byte(password), nil
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPassword(t *testing.T) {
+	dir, err := ioutil.TempDir("", "password")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	os.Setenv("CONFIG_TEST_PASSWORD", "from env")
+	defer os.Unsetenv("CONFIG_TEST_PASSWORD")
+	password, err := ReadPassword("env:CONFIG_TEST_PASSWORD", "")
+	require.NoError(t, err)
+	assert.Equal(t, "from env", string(password))
+
+	_, err = ReadPassword("env:CONFIG_TEST_MISSING", "")
+	assert.EqualError(t, err, "environment variable CONFIG_TEST_MISSING is not set")
+
+	path := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(path, []byte("from file\r\nignored\n"), 0600))
+	password, err = ReadPassword("file:"+path, "")
+	require.NoError(t, err)
+	assert.Equal(t, "from file", string(password))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("\n"), 0600))
+	_, err = ReadPassword("file:"+path, "")
+	assert.EqualError(t, err, "empty password")
+
+	_, err = ReadPassword("hunter2", "")
+	assert.EqualError(t, err, `invalid password source "hunter2", expected env:NAME, file:PATH or prompt`)
+}
+
+func TestKeyfileLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyfile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	kp := keypair.MustRandom()
+	path := filepath.Join(dir, "key.json")
+	data, err := keypair.EncryptKey(kp, []byte("secret"), keypair.KDF{Name: keypair.KDFScrypt, N: 1 << 10, R: 8, P: 1})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	os.Setenv("CONFIG_TEST_PASSWORD", "secret")
+	defer os.Unsetenv("CONFIG_TEST_PASSWORD")
+	loaded, err := (&Keyfile{Path: path, Password: "env:CONFIG_TEST_PASSWORD"}).Load()
+	require.NoError(t, err)
+	assert.Equal(t, kp.Seed(), loaded.Seed())
+
+	os.Setenv("CONFIG_TEST_PASSWORD", "wrong")
+	_, err = (&Keyfile{Path: path, Password: "env:CONFIG_TEST_PASSWORD"}).Load()
+	assert.EqualError(t, err, "loading keyfile "+path+": invalid password or corrupted keyfile")
+}
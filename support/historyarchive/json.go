@@ -16,7 +16,25 @@ import (
 	"github.com/stellar/go/xdr"
 )
 
+// DumpXdrAsJson writes the entries of the XDR files to stdout, encoded with
+// encoding/json.
 func DumpXdrAsJson(args []string) error {
+	return dumpXdr(args, func(v interface{}) ([]byte, error) {
+		return json.MarshalIndent(v, "", "    ")
+	})
+}
+
+// DumpXdrAsXdrJson writes the entries of the XDR files to stdout, encoded with
+// the XDR JSON encoding. Unlike the output of DumpXdrAsJson, it can be
+// converted back to XDR, see `xdr.MarshalJSON`.
+func DumpXdrAsXdrJson(args []string) error {
+	return dumpXdr(args, func(v interface{}) ([]byte, error) {
+		encoded, err := xdr.MarshalJSON(v)
+		return append(encoded, '\n'), err
+	})
+}
+
+func dumpXdr(args []string, marshal func(interface{}) ([]byte, error)) error {
 	var tmp interface{}
 	var rdr io.ReadCloser
 	var err error
@@ -58,7 +76,7 @@ func DumpXdrAsJson(args []string) error {
 				return fmt.Errorf("Error: unrecognized XDR file type %s", base)
 			}
 
-			if err = xr.ReadOne(&tmp); err != nil {
+			if err = xr.ReadOne(tmp); err != nil {
 				if err == io.EOF {
 					break
 				} else {
@@ -67,7 +85,7 @@ func DumpXdrAsJson(args []string) error {
 				}
 			}
 			n++
-			buf, err := json.MarshalIndent(tmp, "", "    ")
+			buf, err := marshal(tmp)
 			if err != nil {
 				return err
 			}
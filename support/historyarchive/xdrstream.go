@@ -21,6 +21,7 @@ import (
 
 type XdrStream struct {
 	buf        bytes.Buffer
+	decoder    xdr.Decoder
 	rdr        io.ReadCloser
 	rdr2       io.ReadCloser
 	sha256Hash hash.Hash
@@ -132,7 +133,16 @@ func (x *XdrStream) ReadOne(in interface{}) error {
 		return errors.New("Read wrong number of bytes from XDR")
 	}
 
-	readi, err := xdr.Unmarshal(&x.buf, in)
+	// Values with a generated decoder are decoded without reflection, reusing
+	// the stream's buffer and decoder.
+	var readi int
+	if value, ok := in.(xdr.DecoderFrom); ok {
+		x.decoder.Reset(&x.buf)
+		err = value.DecodeFrom(&x.decoder)
+		readi = x.decoder.Count()
+	} else {
+		readi, err = xdr.Unmarshal(&x.buf, in)
+	}
 	if err != nil {
 		x.rdr.Close()
 		return err
@@ -146,7 +156,15 @@ func (x *XdrStream) ReadOne(in interface{}) error {
 
 func WriteFramedXdr(out io.Writer, in interface{}) error {
 	var tmp bytes.Buffer
-	n, err := xdr.Marshal(&tmp, in)
+	var n int
+	var err error
+	if value, ok := in.(xdr.EncoderTo); ok {
+		encoder := xdr.NewEncoder(&tmp)
+		err = value.EncodeTo(encoder)
+		n = encoder.Count()
+	} else {
+		n, err = xdr.Marshal(&tmp, in)
+	}
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ package historyarchive
 import (
 	"bytes"
 	"crypto/sha256"
+	"io"
 	"io/ioutil"
 	"testing"
 
@@ -48,6 +49,65 @@ func TestXdrStreamHash(t *testing.T) {
 	assert.NoError(t, stream.Close())
 }
 
+func TestXdrStreamReuse(t *testing.T) {
+	account := xdr.BucketEntry{
+		Type: xdr.BucketEntryTypeLiveentry,
+		LiveEntry: &xdr.LedgerEntry{
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+					Balance:   xdr.Int64(200000000),
+				},
+			},
+		},
+	}
+	removed := xdr.BucketEntry{
+		Type: xdr.BucketEntryTypeDeadentry,
+		DeadEntry: &xdr.LedgerKey{
+			Type: xdr.LedgerEntryTypeAccount,
+			Account: &xdr.LedgerKeyAccount{
+				AccountId: xdr.MustAddress("GC3C4AKRBQLHOJ45U4XG35ESVWRDECWO5XLDGYADO6DPR3L7KIDVUMML"),
+			},
+		},
+	}
+	stream := createXdrStream(account, removed, account)
+
+	// Entries read into the same value are not modified by the next reads
+	var entry xdr.BucketEntry
+	var entries []xdr.BucketEntry
+	for {
+		err := stream.ReadOne(&entry)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	assert.Equal(t, []xdr.BucketEntry{account, removed, account}, entries)
+
+	meta := xdr.TransactionMeta{
+		V: 1,
+		V1: &xdr.TransactionMetaV1{
+			TxChanges: xdr.LedgerEntryChanges{
+				{Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved, Removed: removed.DeadEntry},
+			},
+		},
+	}
+	b := &bytes.Buffer{}
+	require.NoError(t, WriteFramedXdr(b, meta))
+	require.NoError(t, WriteFramedXdr(b, meta))
+	stream = NewXdrStream(ioutil.NopCloser(b))
+
+	for i := 0; i < 2; i++ {
+		var readMeta xdr.TransactionMeta
+		require.NoError(t, stream.ReadOne(&readMeta))
+		assert.Equal(t, meta, readMeta)
+	}
+	var readMeta xdr.TransactionMeta
+	assert.Equal(t, io.EOF, stream.ReadOne(&readMeta))
+}
+
 func createXdrStream(entries ...xdr.BucketEntry) *XdrStream {
 	b := &bytes.Buffer{}
 	for _, e := range entries {
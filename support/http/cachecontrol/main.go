@@ -0,0 +1,63 @@
+// Package cachecontrol reads the freshness lifetime of an HTTP response from
+// its Cache-Control and Expires headers, so clients can cache responses for as
+// long as the server allows.
+package cachecontrol
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Lifetime returns the time the response with the given headers can be
+// cached for, as of now. ok is false when the headers don't specify a lifetime,
+// in which case callers should use their own default. A zero lifetime means
+// the response must not be cached.
+func Lifetime(header http.Header, now time.Time) (lifetime time.Duration, ok bool) {
+	if cc := header.Get("Cache-Control"); cc != "" {
+		for _, directive := range strings.Split(cc, ",") {
+			directive = strings.ToLower(strings.TrimSpace(directive))
+
+			switch {
+			case directive == "no-store" || directive == "no-cache":
+				return 0, true
+			case strings.HasPrefix(directive, "max-age="):
+				seconds, err := strconv.ParseInt(strings.TrimPrefix(directive, "max-age="), 10, 64)
+				if err != nil || seconds < 0 {
+					return 0, true
+				}
+				lifetime = time.Duration(seconds) * time.Second
+				ok = true
+			}
+		}
+		if ok {
+			return lifetime, true
+		}
+	}
+
+	if header.Get("Pragma") == "no-cache" {
+		return 0, true
+	}
+
+	if expires := header.Get("Expires"); expires != "" {
+		t, err := http.ParseTime(expires)
+		// Invalid values, like "0", mean the response is already expired.
+		if err != nil || !t.After(now) {
+			return 0, true
+		}
+		return t.Sub(now), true
+	}
+
+	return 0, false
+}
+
+// TTL returns the lifetime of the response with the given headers, capped at
+// max. max is returned when the headers don't specify a lifetime.
+func TTL(header http.Header, now time.Time, max time.Duration) time.Duration {
+	lifetime, ok := Lifetime(header, now)
+	if !ok || lifetime > max {
+		return max
+	}
+	return lifetime
+}
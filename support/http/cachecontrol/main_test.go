@@ -0,0 +1,50 @@
+package cachecontrol
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifetime(t *testing.T) {
+	now := time.Date(2019, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		header       http.Header
+		wantLifetime time.Duration
+		wantOK       bool
+	}{
+		{"no headers", http.Header{}, 0, false},
+		{"max-age", http.Header{"Cache-Control": {"public, max-age=300"}}, 5 * time.Minute, true},
+		{"no-store", http.Header{"Cache-Control": {"no-store"}}, 0, true},
+		{"no-cache wins", http.Header{"Cache-Control": {"max-age=300, no-cache"}}, 0, true},
+		{"invalid max-age", http.Header{"Cache-Control": {"max-age=abc"}}, 0, true},
+		{"max-age over expires", http.Header{
+			"Cache-Control": {"max-age=60"},
+			"Expires":       {"Sun, 01 Dec 2019 11:00:00 GMT"},
+		}, time.Minute, true},
+		{"expires", http.Header{"Expires": {"Sun, 01 Dec 2019 11:00:00 GMT"}}, time.Hour, true},
+		{"expired", http.Header{"Expires": {"Sun, 01 Dec 2019 09:00:00 GMT"}}, 0, true},
+		{"invalid expires", http.Header{"Expires": {"0"}}, 0, true},
+		{"pragma", http.Header{"Pragma": {"no-cache"}}, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lifetime, ok := Lifetime(tc.header, now)
+			assert.Equal(t, tc.wantLifetime, lifetime)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
+
+func TestTTL(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, time.Hour, TTL(http.Header{}, now, time.Hour))
+	assert.Equal(t, time.Hour, TTL(http.Header{"Cache-Control": {"max-age=86400"}}, now, time.Hour))
+	assert.Equal(t, time.Minute, TTL(http.Header{"Cache-Control": {"max-age=60"}}, now, time.Hour))
+	assert.Equal(t, time.Duration(0), TTL(http.Header{"Cache-Control": {"no-store"}}, now, time.Hour))
+}
@@ -0,0 +1,35 @@
+package http
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+)
+
+// PostFormContext posts data to url like client.PostForm but, when client is
+// an *http.Client, in ctx and with the traceparent header of ctx, see
+// log.SetTraceparent. Other clients, e.g. mocks, are simply sent the form with
+// PostForm.
+func PostFormContext(
+	ctx context.Context,
+	client SimpleHTTPClientInterface,
+	url string,
+	data url.Values,
+) (*stdhttp.Response, error) {
+	c, ok := client.(*stdhttp.Client)
+	if !ok {
+		return client.PostForm(url, data)
+	}
+
+	req, err := stdhttp.NewRequest("POST", url, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating HTTP request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	log.SetTraceparent(ctx, req.Header)
+	return c.Do(req.WithContext(ctx))
+}
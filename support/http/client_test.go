@@ -0,0 +1,29 @@
+package http
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stellar/go/support/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostFormContext(t *testing.T) {
+	trace := log.NewTrace()
+	server := httptest.NewServer(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "bar", r.PostFormValue("foo"))
+		assert.Equal(t, trace.Traceparent(), r.Header.Get(log.TraceparentHeader))
+	}))
+	defer server.Close()
+
+	ctx := log.WithTrace(context.Background(), trace)
+	resp, err := PostFormContext(ctx, stdhttp.DefaultClient, server.URL, url.Values{"foo": {"bar"}})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, stdhttp.StatusOK, resp.StatusCode)
+}
@@ -10,20 +10,21 @@ import (
 	"github.com/stellar/go/support/log"
 )
 
-// LoggingMiddleware is a middleware that logs requests to the logger.
+// LoggingMiddleware is a middleware that logs requests to the logger. The
+// handlers get the request's logger through log.Ctx.
 func LoggingMiddleware(next stdhttp.Handler) stdhttp.Handler {
 	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 		mw := mutil.WrapWriter(w)
 		ctx := log.PushContext(r.Context(), func(l *log.Entry) *log.Entry {
 			return l.WithFields(log.F{
-				"req": middleware.GetReqID(r.Context()),
+				log.RequestKey: middleware.GetReqID(r.Context()),
 			})
 		})
 
 		logStartOfRequest(ctx, r)
 
 		then := time.Now()
-		next.ServeHTTP(mw, r)
+		next.ServeHTTP(mw, r.WithContext(ctx))
 		duration := time.Since(then)
 
 		logEndOfRequest(ctx, r, duration, mw)
@@ -37,11 +38,11 @@ func logStartOfRequest(
 	r *stdhttp.Request,
 ) {
 	log.Ctx(ctx).WithFields(log.F{
-		"subsys": "http",
-		"path":   r.URL.String(),
-		"method": r.Method,
-		"ip":     r.RemoteAddr,
-		"host":   r.Host,
+		log.SubsysKey: "http",
+		"path":        r.URL.String(),
+		"method":      r.Method,
+		"ip":          r.RemoteAddr,
+		"host":        r.Host,
 	}).Info("starting request")
 }
 
@@ -53,11 +54,11 @@ func logEndOfRequest(
 	mw mutil.WriterProxy,
 ) {
 	log.Ctx(ctx).WithFields(log.F{
-		"subsys":   "http",
-		"path":     r.URL.String(),
-		"method":   r.Method,
-		"status":   mw.Status(),
-		"bytes":    mw.BytesWritten(),
-		"duration": duration,
+		log.SubsysKey: "http",
+		"path":        r.URL.String(),
+		"method":      r.Method,
+		"status":      mw.Status(),
+		"bytes":       mw.BytesWritten(),
+		"duration":    duration,
 	}).Info("finished request")
 }
@@ -16,6 +16,7 @@ func NewMux(behindProxy bool) *chi.Mux {
 	}
 
 	mux.Use(middleware.RequestID)
+	mux.Use(TraceMiddleware)
 	mux.Use(middleware.Recoverer)
 	mux.Use(LoggingMiddleware)
 
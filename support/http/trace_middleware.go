@@ -0,0 +1,25 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/stellar/go/support/log"
+)
+
+// TraceMiddleware binds each request to a trace, see log.WithTrace. Requests
+// with a valid traceparent header continue the trace of the client in a new
+// span, the other ones start a new trace. The traceparent of the request's
+// span is sent back in the response headers.
+func TraceMiddleware(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		trace, err := log.ParseTraceparent(r.Header.Get(log.TraceparentHeader))
+		if err == nil {
+			trace = trace.Child()
+		} else {
+			trace = log.NewTrace()
+		}
+
+		w.Header().Set(log.TraceparentHeader, trace.Traceparent())
+		next.ServeHTTP(w, r.WithContext(log.WithTrace(r.Context(), trace)))
+	})
+}
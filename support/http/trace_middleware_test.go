@@ -0,0 +1,52 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/support/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceMiddleware(t *testing.T) {
+	done := log.DefaultLogger.StartTest(log.InfoLevel)
+	mux := chi.NewMux()
+	mux.Use(TraceMiddleware)
+	mux.Use(LoggingMiddleware)
+
+	var traces []log.Trace
+	mux.Get("/", stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		trace, ok := log.TraceFromContext(r.Context())
+		assert.True(t, ok)
+		traces = append(traces, trace)
+		log.Ctx(r.Context()).Info("handling request")
+	}))
+
+	src := httptest.NewServer(t, mux)
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	response := src.GET("/").WithHeader(log.TraceparentHeader, traceparent).
+		Expect().
+		Status(stdhttp.StatusOK)
+	src.GET("/").WithHeader(log.TraceparentHeader, "invalid").
+		Expect().
+		Status(stdhttp.StatusOK)
+
+	require.Len(t, traces, 2)
+	// the client's trace is continued in a new span
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traces[0].TraceID)
+	assert.NotEqual(t, "00f067aa0ba902b7", traces[0].SpanID)
+	response.Header(log.TraceparentHeader).Equal(traces[0].Traceparent())
+	// invalid traceparents start a new trace
+	assert.NotEqual(t, traces[0].TraceID, traces[1].TraceID)
+
+	logged := done()
+	require.Len(t, logged, 6)
+	assert.Equal(t, "handling request", logged[1].Message)
+	for i, line := range logged {
+		assert.Equal(t, traces[i/3].TraceID, line.Data[log.TraceIDKey])
+		assert.Equal(t, traces[i/3].SpanID, line.Data[log.SpanIDKey])
+	}
+}
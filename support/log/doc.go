@@ -7,4 +7,11 @@
 // responding to "Oh my god something is horribly wrong" within the context
 // of an HTTP request is to panic on that request.
 //
+// Log lines are correlated across services with traces: `WithTrace` binds a
+// trace to a context, so that everything logged with `Ctx` on that context,
+// including the SQL queries of a db.Session using it, has the trace_id and
+// span_id fields. Servers using support/http read the trace of incoming
+// requests from their W3C traceparent header, and the stellar clients send it
+// along with their outgoing requests, see `SetTraceparent`.
+//
 package log
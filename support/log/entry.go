@@ -37,6 +37,12 @@ func (e *Entry) SetLevel(level logrus.Level) {
 	e.Logger.Level = level
 }
 
+// UseJSONFormatter switches the logger of e to JSON output, see
+// `NewJSONFormatter`.
+func (e *Entry) UseJSONFormatter() {
+	e.Logger.Formatter = NewJSONFormatter()
+}
+
 // WithField creates a child logger annotated with the provided key value pair.
 // A subsequent call to one of the logging methods (Debug(), Error(), etc.) to
 // the return value from this function will cause the emitted log line to
@@ -0,0 +1,33 @@
+package log
+
+import "github.com/sirupsen/logrus"
+
+// TimestampFormat is the format of the time of log lines.
+const TimestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// Keys of the fields shared by the log lines of all the services, which log
+// aggregators can rely on. The JSON formatter always writes time, level and
+// msg, the other fields are present when known.
+const (
+	TimeKey    = "time"
+	LevelKey   = "level"
+	MessageKey = "msg"
+	PidKey     = "pid"
+	TraceIDKey = "trace_id"
+	SpanIDKey  = "span_id"
+	RequestKey = "req"
+	SubsysKey  = "subsys"
+)
+
+// NewJSONFormatter returns a formatter writing each log line as a JSON object
+// on its own line, using the keys above. Fields named like one of time, level
+// or msg are renamed to fields.time, fields.level and fields.msg.
+func NewJSONFormatter() logrus.Formatter {
+	return &logrus.JSONFormatter{TimestampFormat: TimestampFormat}
+}
+
+// UseJSONFormatter switches the default logger to JSON output, see
+// `NewJSONFormatter`.
+func UseJSONFormatter() {
+	DefaultLogger.UseJSONFormatter()
+}
@@ -43,8 +43,8 @@ func New() *Entry {
 	l := logrus.New()
 	l.Level = logrus.WarnLevel
 	l.Formatter.(*logrus.TextFormatter).FullTimestamp = true
-	l.Formatter.(*logrus.TextFormatter).TimestampFormat = "2006-01-02T15:04:05.000Z07:00"
-	return &Entry{Entry: *logrus.NewEntry(l).WithField("pid", os.Getpid())}
+	l.Formatter.(*logrus.TextFormatter).TimestampFormat = TimestampFormat
+	return &Entry{Entry: *logrus.NewEntry(l).WithField(PidKey, os.Getpid())}
 }
 
 // Set establishes a new context to which the provided sub-logger is bound
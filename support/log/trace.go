@@ -0,0 +1,162 @@
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// TraceparentHeader is the W3C Trace Context header carrying the trace of a
+// request: https://www.w3.org/TR/trace-context/
+const TraceparentHeader = "traceparent"
+
+// Trace identifies the unit of work, e.g. the handling of a request or a
+// background job, a log line belongs to. The trace ID is shared by all the
+// services taking part in the same operation, while each of them uses its own
+// span ID.
+type Trace struct {
+	// TraceID is the 32 lowercase hex digits ID of the whole operation.
+	TraceID string
+	// SpanID is the 16 lowercase hex digits ID of the work done by this
+	// service.
+	SpanID string
+	// Sampled is the sampled flag of the traceparent header.
+	Sampled bool
+}
+
+// NewTrace returns a new trace with random IDs.
+func NewTrace() Trace {
+	return Trace{
+		TraceID: randomID(16),
+		SpanID:  randomID(8),
+		Sampled: true,
+	}
+}
+
+// ParseTraceparent parses the value of a traceparent header, e.g.
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+func ParseTraceparent(value string) (Trace, error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 {
+		return Trace{}, errors.Errorf("invalid traceparent %q", value)
+	}
+
+	version, traceID, spanID, flags := parts[0], parts[1], parts[2], parts[3]
+	if !isHexByte(version) || version == "ff" || (version == "00" && len(parts) != 4) {
+		return Trace{}, errors.Errorf("invalid traceparent version %q", version)
+	}
+	if !isHexID(traceID, 16) {
+		return Trace{}, errors.Errorf("invalid traceparent trace id %q", traceID)
+	}
+	if !isHexID(spanID, 8) {
+		return Trace{}, errors.Errorf("invalid traceparent parent id %q", spanID)
+	}
+	if !isHexByte(flags) {
+		return Trace{}, errors.Errorf("invalid traceparent flags %q", flags)
+	}
+
+	flagBits, _ := hex.DecodeString(flags)
+	return Trace{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Sampled: flagBits[0]&1 == 1,
+	}, nil
+}
+
+// Child returns a trace continuing t with a new span ID, e.g. for the
+// handling of a request received with the traceparent t.
+func (t Trace) Child() Trace {
+	t.SpanID = randomID(8)
+	return t
+}
+
+// Traceparent returns the value of the traceparent header sent by requests
+// made as part of t.
+func (t Trace) Traceparent() string {
+	flags := "00"
+	if t.Sampled {
+		flags = "01"
+	}
+	return "00-" + t.TraceID + "-" + t.SpanID + "-" + flags
+}
+
+// WithTrace returns a context bound to t, whose logger includes the trace_id
+// and span_id fields of t.
+func WithTrace(parent context.Context, t Trace) context.Context {
+	ctx := context.WithValue(parent, &traceContextKey, t)
+	return PushContext(ctx, func(l *Entry) *Entry {
+		return l.WithFields(F{
+			TraceIDKey: t.TraceID,
+			SpanIDKey:  t.SpanID,
+		})
+	})
+}
+
+// TraceFromContext returns the trace bound to ctx, if any.
+func TraceFromContext(ctx context.Context) (Trace, bool) {
+	if ctx == nil {
+		return Trace{}, false
+	}
+	t, ok := ctx.Value(&traceContextKey).(Trace)
+	return t, ok
+}
+
+// StartTrace returns a context bound to a new span of the trace of parent, or
+// to a new trace when parent has none. It's meant for background work, which
+// otherwise wouldn't show up in any trace.
+func StartTrace(parent context.Context) context.Context {
+	if t, ok := TraceFromContext(parent); ok {
+		return WithTrace(parent, t.Child())
+	}
+	return WithTrace(parent, NewTrace())
+}
+
+// SetTraceparent sets the traceparent header of an outgoing request made in
+// ctx, so that the server handling it logs the same trace ID. It does nothing
+// when ctx has no trace.
+func SetTraceparent(ctx context.Context, header http.Header) {
+	if t, ok := TraceFromContext(ctx); ok {
+		header.Set(TraceparentHeader, t.Traceparent())
+	}
+}
+
+// randomID returns n random bytes in hex, which aren't all zeros as the
+// Trace Context spec forbids it.
+func randomID(n int) string {
+	b := make([]byte, n)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			panic(errors.Wrap(err, "read random bytes"))
+		}
+		for _, c := range b {
+			if c != 0 {
+				return hex.EncodeToString(b)
+			}
+		}
+	}
+}
+
+// isHexID returns true if s is n bytes in lowercase hex, not all zeros.
+func isHexID(s string, n int) bool {
+	return len(s) == 2*n && isHex(s) && strings.Trim(s, "0") != ""
+}
+
+// isHexByte returns true if s is a single byte in lowercase hex.
+func isHexByte(s string) bool {
+	return len(s) == 2 && isHex(s)
+}
+
+func isHex(s string) bool {
+	for _, c := range s {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+var traceContextKey = contextKey("trace")
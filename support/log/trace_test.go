@@ -0,0 +1,107 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	trace, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	assert.Equal(t, Trace{
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:  "00f067aa0ba902b7",
+		Sampled: true,
+	}, trace)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", trace.Traceparent())
+
+	trace, err = ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.NoError(t, err)
+	assert.False(t, trace.Sampled)
+
+	// future versions may have more fields
+	_, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+	assert.NoError(t, err)
+
+	for _, value := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-1",
+	} {
+		_, err := ParseTraceparent(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestTraceChild(t *testing.T) {
+	trace := NewTrace()
+	assert.Len(t, trace.TraceID, 32)
+	assert.Len(t, trace.SpanID, 16)
+
+	parsed, err := ParseTraceparent(trace.Traceparent())
+	require.NoError(t, err)
+	assert.Equal(t, trace, parsed)
+
+	child := trace.Child()
+	assert.Equal(t, trace.TraceID, child.TraceID)
+	assert.NotEqual(t, trace.SpanID, child.SpanID)
+	assert.NotEqual(t, trace.TraceID, NewTrace().TraceID)
+}
+
+func TestWithTrace(t *testing.T) {
+	_, ok := TraceFromContext(context.Background())
+	assert.False(t, ok)
+
+	var output bytes.Buffer
+	l := New()
+	l.Logger.Out = &output
+	l.UseJSONFormatter()
+
+	trace := NewTrace()
+	ctx := WithTrace(Set(context.Background(), l.WithField("foo", "bar")), trace)
+	found, ok := TraceFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, trace, found)
+
+	Ctx(ctx).WithField("msg", "field").Warn("hello")
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), &line))
+	assert.Equal(t, "hello", line[MessageKey])
+	assert.Equal(t, "warning", line[LevelKey])
+	assert.Equal(t, "field", line["fields.msg"])
+	assert.Equal(t, "bar", line["foo"])
+	assert.Equal(t, trace.TraceID, line[TraceIDKey])
+	assert.Equal(t, trace.SpanID, line[SpanIDKey])
+	assert.Contains(t, line, TimeKey)
+	assert.Contains(t, line, PidKey)
+
+	// background work started from ctx continues its trace
+	background, _ := TraceFromContext(StartTrace(ctx))
+	assert.Equal(t, trace.TraceID, background.TraceID)
+	assert.NotEqual(t, trace.SpanID, background.SpanID)
+
+	other, _ := TraceFromContext(StartTrace(context.Background()))
+	assert.NotEqual(t, trace.TraceID, other.TraceID)
+}
+
+func TestSetTraceparent(t *testing.T) {
+	header := http.Header{}
+	SetTraceparent(context.Background(), header)
+	assert.Empty(t, header)
+
+	trace := NewTrace()
+	SetTraceparent(WithTrace(context.Background(), trace), header)
+	assert.Equal(t, trace.Traceparent(), header.Get(TraceparentHeader))
+}
@@ -9,10 +9,12 @@ import (
 	"github.com/stellar/go/exp/ingest/adapters"
 	"github.com/stellar/go/exp/ingest/io"
 	"github.com/stellar/go/support/historyarchive"
+	"github.com/stellar/go/xdr"
 )
 
 func main() {
 	ledgerPtr := flag.Uint64("ledger", 0, "`ledger to analyze` (tip: has to be of the form `ledger = 64*n - 1`, where n is > 0)")
+	jsonPtr := flag.Bool("json", false, "write the ledger entries to stdout with the XDR JSON encoding")
 	flag.Parse()
 	var seqNum uint32 = uint32(*ledgerPtr)
 
@@ -45,6 +47,14 @@ func main() {
 			return
 		}
 
+		if *jsonPtr {
+			encoded, e := xdr.MarshalJSON(le.State)
+			if e != nil {
+				panic(e)
+			}
+			fmt.Printf("%s\n", encoded)
+		}
+
 		if ae, valid := le.State.Data.GetAccount(); valid {
 			addr := ae.AccountId.Address()
 			if _, exists := accounts[addr]; exists {
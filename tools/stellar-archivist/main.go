@@ -284,15 +284,27 @@ func main() {
 		},
 	})
 
-	rootCmd.AddCommand(&cobra.Command{
+	var xdrJSON bool
+	dumpXdrCmd := &cobra.Command{
 		Use: "dumpxdr",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := historyarchive.DumpXdrAsJson(args)
+			dump := historyarchive.DumpXdrAsJson
+			if xdrJSON {
+				dump = historyarchive.DumpXdrAsXdrJson
+			}
+			err := dump(args)
 			if err != nil {
 				log.Fatal(err)
 			}
 		},
-	})
+	}
+	dumpXdrCmd.Flags().BoolVar(
+		&xdrJSON,
+		"xdr-json",
+		false,
+		"output the XDR JSON encoding, which can be converted back to XDR with stellar-xdr",
+	)
+	rootCmd.AddCommand(dumpXdrCmd)
 
 	rootCmd.Execute()
 }
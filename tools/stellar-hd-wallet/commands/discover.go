@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/clients/horizonclient"
+)
+
+var horizonURL string
+var gap uint32
+
+// horizonClient is the client used by DiscoverCmd, tests replace it.
+var horizonClient = func() horizonclient.ClientInterface {
+	return &horizonclient.Client{
+		HorizonURL: horizonURL,
+		HTTP:       &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+var DiscoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "Display the funded accounts of a mnemonic code",
+	Long:  "Looks up the accounts of a mnemonic code in order and stops after --gap consecutive accounts which don't exist.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		wallet, err := readWallet()
+		if err != nil {
+			return err
+		}
+
+		accounts, err := wallet.Discover(horizonClient(), gap)
+		if err != nil {
+			return err
+		}
+
+		println("")
+		println("Funded accounts:", len(accounts))
+		for _, account := range accounts {
+			println(account.Path, account.Address)
+		}
+		return nil
+	},
+}
+
+func init() {
+	DiscoverCmd.Flags().StringVar(&horizonURL, "horizon-url", "https://horizon.stellar.org/", "URL of the horizon server to look up the accounts in")
+	DiscoverCmd.Flags().Uint32VarP(&gap, "gap", "g", 20, "number of consecutive unfunded accounts after which the discovery stops")
+}
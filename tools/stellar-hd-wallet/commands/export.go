@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/support/errors"
+)
+
+var exportFormat string
+
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the public keys of the accounts of a mnemonic code for watch-only setups",
+	Long:  "",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if exportFormat != "text" && exportFormat != "json" {
+			return errors.Errorf("Invalid format %s, allowed values: text, json", exportFormat)
+		}
+
+		wallet, err := readWallet()
+		if err != nil {
+			return err
+		}
+
+		accounts, err := wallet.Export(startID, count)
+		if err != nil {
+			return err
+		}
+
+		println("")
+
+		if exportFormat == "json" {
+			encoded, err := json.MarshalIndent(accounts, "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "Error encoding accounts")
+			}
+			println(string(encoded))
+			return nil
+		}
+
+		for _, account := range accounts {
+			println(account.Path, account.Address)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ExportCmd.Flags().Uint32VarP(&count, "count", "c", 10, "number of accounts to export")
+	ExportCmd.Flags().Uint32VarP(&startID, "start", "s", 0, "ID of the first wallet to export")
+	ExportCmd.Flags().StringVarP(&exportFormat, "format", "f", "text", "output format: text or json")
+}
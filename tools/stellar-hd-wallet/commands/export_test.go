@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/clients/horizonclient"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const mnemonicInput = "12\nillness\nspike\nretreat\ntruth\ngenius\nclock\nbrain\npass\nfit\ncave\nbargain\ntoe\n"
+
+func setInput(input string) *bytes.Buffer {
+	reader = bufio.NewReader(bytes.NewBufferString(input))
+	buffer := &bytes.Buffer{}
+	out = buffer
+	return buffer
+}
+
+func TestValidate(t *testing.T) {
+	output := setInput(mnemonicInput)
+	require.NoError(t, ValidateCmd.RunE(nil, []string{}))
+	assert.Contains(t, output.String(), "Mnemonic code is valid, language: english")
+
+	words := "あいこくしん\n"
+	setInput("12\n" + strings.Repeat(words, 11) + "あおぞら\n")
+	require.NoError(t, ValidateCmd.RunE(nil, []string{}))
+
+	setInput(strings.Replace(mnemonicInput, "toe", "illness", 1))
+	assert.EqualError(t, ValidateCmd.RunE(nil, []string{}), "Invalid words or checksum")
+}
+
+func TestExport(t *testing.T) {
+	count, startID = 2, 1
+
+	exportFormat = "text"
+	output := setInput(mnemonicInput + "\n")
+	require.NoError(t, ExportCmd.RunE(nil, []string{}))
+	assert.Contains(t, output.String(), `m/44'/148'/1' GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX
+m/44'/148'/2' GAY5PRAHJ2HIYBYCLZXTHID6SPVELOOYH2LBPH3LD4RUMXUW3DOYTLXW
+`)
+	assert.NotContains(t, output.String(), "SCEPFFWGAG5P2VX5DHIYK3XEMZYLTYWIPWYEKXFHSK25RVMIUNJ7CTIS")
+
+	exportFormat = "json"
+	output = setInput(mnemonicInput + "\n")
+	require.NoError(t, ExportCmd.RunE(nil, []string{}))
+	assert.Contains(t, output.String(), `{
+    "index": 2,
+    "path": "m/44'/148'/2'",
+    "address": "GAY5PRAHJ2HIYBYCLZXTHID6SPVELOOYH2LBPH3LD4RUMXUW3DOYTLXW"
+  }`)
+
+	exportFormat = "csv"
+	assert.EqualError(t, ExportCmd.RunE(nil, []string{}), "Invalid format csv, allowed values: text, json")
+}
+
+func TestDiscover(t *testing.T) {
+	client := &horizonclient.MockClient{}
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: "GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX"}).
+		Return(hProtocol.Account{}, nil)
+	client.On("AccountDetail", mock.Anything).
+		Return(hProtocol.Account{}, &horizonclient.Error{Problem: problem.P{Status: http.StatusNotFound}})
+	horizonClient = func() horizonclient.ClientInterface { return client }
+	gap = 2
+
+	output := setInput(mnemonicInput + "\n")
+	require.NoError(t, DiscoverCmd.RunE(nil, []string{}))
+	assert.Contains(t, output.String(), `Funded accounts: 1
+m/44'/148'/1' GBAW5XGWORWVFE2XTJYDTLDHXTY2Q2MO73HYCGB3XMFMQ562Q2W2GJQX
+`)
+	client.AssertNumberOfCalls(t, "AccountDetail", 4)
+}
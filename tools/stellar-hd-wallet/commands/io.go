@@ -8,11 +8,17 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/stellar/go/exp/crypto/hdwallet"
+	"github.com/stellar/go/support/errors"
 )
 
 var reader = bufio.NewReader(os.Stdin)
 var out io.Writer = os.Stdout
 
+var allowedNumbers = map[uint32]bool{12: true, 15: true, 18: true, 21: true, 24: true}
+
 func readString() string {
 	line, _ := reader.ReadString('\n')
 	return strings.TrimRight(line, "\r\n")
@@ -28,6 +34,57 @@ func readUint() uint32 {
 	return uint32(number)
 }
 
+// readMnemonic reads the words of a mnemonic one by one. The words can be in
+// any of the BIP-39 languages.
+func readMnemonic() (string, error) {
+	printf("How many words? ")
+	wordsCount := readUint()
+	if _, exist := allowedNumbers[wordsCount]; !exist {
+		return "", errors.New("Invalid value, allowed values: 12, 15, 18, 21, 24")
+	}
+
+	words := make([]string, wordsCount)
+	for i := uint32(0); i < wordsCount; i++ {
+		printf("Enter word #%-4d", i+1)
+		words[i] = strings.TrimSpace(readString())
+		if !isWord(words[i]) {
+			println("Invalid word, try again.")
+			i--
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// readWallet reads a mnemonic and its password, and returns their wallet.
+func readWallet() (*hdwallet.Wallet, error) {
+	mnemonic, err := readMnemonic()
+	if err != nil {
+		return nil, err
+	}
+
+	printf("Enter password (leave empty if none): ")
+	password := readString()
+
+	wallet, err := hdwallet.New(mnemonic, password)
+	if err != nil {
+		return nil, errors.New("Invalid words or checksum")
+	}
+	return wallet, nil
+}
+
+func isWord(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			return false
+		}
+	}
+	return true
+}
+
 func printf(format string, a ...interface{}) {
 	fmt.Fprintf(out, format, a...)
 }
@@ -4,30 +4,36 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/stellar/go/exp/crypto/hdwallet"
 	"github.com/stellar/go/support/errors"
-	"github.com/tyler-smith/go-bip39"
 )
 
-const DefaultEntropySize = 256
+var wordsCount uint32
+var language string
 
 var NewCmd = &cobra.Command{
 	Use:   "new",
 	Short: "Generates a new mnemonic code",
 	Long:  "",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		entropy, err := bip39.NewEntropy(DefaultEntropySize)
+		if _, exist := allowedNumbers[wordsCount]; !exist {
+			return errors.New("Invalid number of words, allowed values: 12, 15, 18, 21, 24")
+		}
+
+		wordlist, err := hdwallet.WordlistByName(language)
 		if err != nil {
-			return errors.Wrap(err, "Error generating entropy")
+			return err
 		}
 
-		mnemonic, err := bip39.NewMnemonic(entropy)
+		// each word encodes 11 bits, 1 bit every 3 words is the checksum
+		mnemonic, err := hdwallet.NewMnemonic(int(wordsCount)*32/3, wordlist)
 		if err != nil {
 			return errors.Wrap(err, "Error generating mnemonic code")
 		}
 
-		words := strings.Split(mnemonic, " ")
+		words := strings.Fields(mnemonic)
 		for i := 0; i < len(words); i++ {
-			printf("word %02d/24: %10s", i+1, words[i])
+			printf("word %02d/%d: %10s", i+1, len(words), words[i])
 			readString()
 		}
 
@@ -40,3 +46,8 @@ var NewCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	NewCmd.Flags().Uint32VarP(&wordsCount, "words", "w", 24, "number of words: 12, 15, 18, 21 or 24")
+	NewCmd.Flags().StringVarP(&language, "language", "l", hdwallet.English.Name, "language of the words: english, chinese_simplified, chinese_traditional, french, italian, japanese, korean or spanish")
+}
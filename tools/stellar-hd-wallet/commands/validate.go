@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/exp/crypto/hdwallet"
+	"github.com/stellar/go/support/errors"
+)
+
+var ValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Checks the words and the checksum of a mnemonic code",
+	Long:  "",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mnemonic, err := readMnemonic()
+		if err != nil {
+			return err
+		}
+
+		wordlist, err := hdwallet.ValidateMnemonic(mnemonic)
+		if err != nil {
+			return errors.New("Invalid words or checksum")
+		}
+
+		println("Mnemonic code is valid, language:", wordlist.Name)
+		return nil
+	},
+}
@@ -15,6 +15,9 @@ var mainCmd = &cobra.Command{
 func init() {
 	mainCmd.AddCommand(commands.NewCmd)
 	mainCmd.AddCommand(commands.AccountsCmd)
+	mainCmd.AddCommand(commands.ValidateCmd)
+	mainCmd.AddCommand(commands.ExportCmd)
+	mainCmd.AddCommand(commands.DiscoverCmd)
 }
 
 func main() {
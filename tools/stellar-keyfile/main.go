@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/howeyc/gopass"
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/config"
+	"github.com/stellar/go/support/errors"
+)
+
+var passwordSource, kdfName string
+
+var mainCmd = &cobra.Command{
+	Use:   "stellar-keyfile",
+	Short: "Create and inspect encrypted stellar keyfiles",
+}
+
+var newCmd = &cobra.Command{
+	Use:   "new <keyfile>",
+	Short: "Generate a random key pair and write it to a new encrypted keyfile",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("require exactly 1 keyfile argument")
+		}
+		kp, err := keypair.Random()
+		if err != nil {
+			return err
+		}
+		return save(args[0], kp)
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import <keyfile>",
+	Short: "Write an existing seed, entered on the terminal, to a new encrypted keyfile",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("require exactly 1 keyfile argument")
+		}
+		seed, err := gopass.GetPasswdPrompt("Enter seed: ", true, os.Stdin, os.Stderr)
+		if err != nil {
+			return errors.Wrap(err, "reading seed")
+		}
+		kp, err := keypair.Parse(string(seed))
+		if err != nil {
+			return errors.New("invalid seed")
+		}
+		full, ok := kp.(*keypair.Full)
+		if !ok {
+			return errors.New("invalid seed, got an address")
+		}
+		return save(args[0], full)
+	},
+}
+
+var addressCmd = &cobra.Command{
+	Use:   "address <keyfile>",
+	Short: "Print the address of a keyfile, without decrypting it",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("require exactly 1 keyfile argument")
+		}
+		data, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		address, err := keypair.KeyfileAddress(data)
+		if err != nil {
+			return err
+		}
+		fmt.Println(address)
+		return nil
+	},
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <keyfile>",
+	Short: "Check that a keyfile can be decrypted with its password",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("require exactly 1 keyfile argument")
+		}
+		kp, err := (&config.Keyfile{Path: args[0], Password: passwordSource}).Load()
+		if err != nil {
+			return err
+		}
+		fmt.Println("Keyfile is valid:", kp.Address())
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{newCmd, importCmd, verifyCmd} {
+		cmd.Flags().StringVar(&passwordSource, "password", "prompt", "where the password is read from: env:NAME, file:PATH or prompt")
+	}
+	for _, cmd := range []*cobra.Command{newCmd, importCmd} {
+		cmd.Flags().StringVar(&kdfName, "kdf", keypair.KDFScrypt, "key derivation function: scrypt or argon2id")
+	}
+
+	mainCmd.AddCommand(newCmd)
+	mainCmd.AddCommand(importCmd)
+	mainCmd.AddCommand(addressCmd)
+	mainCmd.AddCommand(verifyCmd)
+}
+
+func main() {
+	if err := mainCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// save writes kp to a new keyfile at path, encrypted with the password read
+// from passwordSource.
+func save(path string, kp *keypair.Full) error {
+	password, err := config.ReadPassword(passwordSource, "Enter password: ")
+	if err != nil {
+		return err
+	}
+	if passwordSource == "prompt" {
+		repeated, err := config.ReadPassword(passwordSource, "Repeat password: ")
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(password, repeated) {
+			return errors.New("passwords don't match")
+		}
+	}
+
+	if err = createKeyfile(path, kp, password, kdfName); err != nil {
+		return err
+	}
+	fmt.Println("Wrote keyfile of", kp.Address(), "to", path)
+	return nil
+}
+
+// createKeyfile writes kp to a new keyfile at path, readable by its owner
+// only.
+func createKeyfile(path string, kp *keypair.Full, password []byte, kdf string) error {
+	var params keypair.KDF
+	switch kdf {
+	case keypair.KDFScrypt:
+		params = keypair.DefaultScrypt
+	case keypair.KDFArgon2id:
+		params = keypair.DefaultArgon2id
+	default:
+		return errors.Errorf("invalid kdf %s", kdf)
+	}
+
+	data, err := keypair.EncryptKey(kp, password, params)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(f, "%s\n", data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateKeyfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stellar-keyfile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defaultScrypt := keypair.DefaultScrypt
+	keypair.DefaultScrypt = keypair.KDF{Name: keypair.KDFScrypt, N: 1 << 10, R: 8, P: 1}
+	defer func() { keypair.DefaultScrypt = defaultScrypt }()
+
+	kp := keypair.MustRandom()
+	path := filepath.Join(dir, "key.json")
+	require.NoError(t, createKeyfile(path, kp, []byte("secret"), keypair.KDFScrypt))
+
+	loaded, err := keypair.LoadEncrypted(path, []byte("secret"))
+	require.NoError(t, err)
+	assert.Equal(t, kp.Seed(), loaded.Seed())
+
+	err = createKeyfile(path, kp, []byte("secret"), keypair.KDFScrypt)
+	assert.True(t, os.IsExist(err))
+
+	err = createKeyfile(filepath.Join(dir, "other.json"), kp, []byte("secret"), "md5")
+	assert.EqualError(t, err, "invalid kdf md5")
+}
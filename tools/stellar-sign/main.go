@@ -15,12 +15,15 @@ import (
 
 	"github.com/howeyc/gopass"
 	"github.com/stellar/go/build"
+	"github.com/stellar/go/support/config"
 	"github.com/stellar/go/xdr"
 )
 
 var in *bufio.Reader
 
 var infile = flag.String("infile", "", "transaction envelope")
+var keyfile = flag.String("keyfile", "", "encrypted keyfile of the signing key, instead of entering a seed")
+var password = flag.String("password", "prompt", "where the password of the keyfile is read from: env:NAME, file:PATH or prompt")
 
 func main() {
 	flag.Parse()
@@ -71,9 +74,18 @@ func main() {
 	// TODO: add operation details
 
 	// read seed
-	seed, err := readLine("Enter seed: ", true)
-	if err != nil {
-		log.Fatal(err)
+	var seed string
+	if *keyfile == "" {
+		seed, err = readLine("Enter seed: ", true)
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		kp, err := (&config.Keyfile{Path: *keyfile, Password: *password}).Load()
+		if err != nil {
+			log.Fatal(err)
+		}
+		seed = kp.Seed()
 	}
 
 	// sign the transaction
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+var inspectJSON bool
+var inspectSigners []string
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect [file]",
+	Short: "Print the content, signatures and hashes of an envelope",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		tx, err := readTransaction(args)
+		if err != nil {
+			return err
+		}
+		if inspectJSON {
+			return inspectAsJSON(os.Stdout, &tx, inspectSigners)
+		}
+		return inspect(os.Stdout, &tx, inspectSigners)
+	},
+}
+
+func init() {
+	inspectCmd.Flags().BoolVar(&inspectJSON, "json", false, "print the envelope as JSON")
+	inspectCmd.Flags().StringSliceVar(&inspectSigners, "signer", nil, "additional signer keys to identify the signatures with, besides the source accounts")
+}
+
+// hashes returns the hashes of tx for the public and test networks, and the
+// network selected by the flags when it's another one.
+func hashes(tx *txnbuild.Transaction) ([]string, map[string][32]byte, error) {
+	passphrases := []string{network.PublicNetworkPassphrase, network.TestNetworkPassphrase}
+	if tx.Network != passphrases[0] && tx.Network != passphrases[1] {
+		passphrases = append(passphrases, tx.Network)
+	}
+
+	result := map[string][32]byte{}
+	for _, passphrase := range passphrases {
+		hash, err := network.HashTransaction(&tx.TxEnvelope().Tx, passphrase)
+		if err != nil {
+			return nil, nil, err
+		}
+		result[passphrase] = hash
+	}
+	return passphrases, result, nil
+}
+
+// signature describes a signature of an envelope.
+type signature struct {
+	Hint string `json:"hint"`
+	// Signer is the key which made the signature, if known.
+	Signer string `json:"signer,omitempty"`
+	// Network is the passphrase of the network the signature is valid for.
+	Network string `json:"network,omitempty"`
+}
+
+// identifySignatures returns the signers, among the source accounts and
+// extra keys, and networks of the signatures of tx.
+func identifySignatures(tx *txnbuild.Transaction, extra []string) ([]signature, error) {
+	passphrases, hashes, err := hashes(tx)
+	if err != nil {
+		return nil, err
+	}
+	keys := append(sourceAccounts(tx), extra...)
+
+	var signatures []signature
+	for _, sig := range tx.TxEnvelope().Signatures {
+		s := signature{Hint: hex.EncodeToString(sig.Hint[:])}
+		for _, passphrase := range passphrases {
+			if signer := signerOf(sig, hashes[passphrase], keys); signer != "" {
+				s.Signer = signer
+				s.Network = passphrase
+				break
+			}
+		}
+		signatures = append(signatures, s)
+	}
+	return signatures, nil
+}
+
+func inspect(out io.Writer, tx *txnbuild.Transaction, extraSigners []string) error {
+	envelope := tx.TxEnvelope()
+
+	fmt.Fprintln(out, "Transaction:")
+	fmt.Fprintf(out, "  source: %s\n", envelope.Tx.SourceAccount.Address())
+	fmt.Fprintf(out, "  fee: %d stroops (%s XLM)\n", envelope.Tx.Fee, amount.StringFromInt64(int64(envelope.Tx.Fee)))
+	fmt.Fprintf(out, "  sequence: %d\n", envelope.Tx.SeqNum)
+	fmt.Fprintf(out, "  time bounds: %s\n", formatTimeBounds(envelope.Tx.TimeBounds))
+	fmt.Fprintf(out, "  memo: %s\n", formatMemo(envelope.Tx.Memo))
+
+	fmt.Fprintf(out, "\nOperations (%d):\n", len(envelope.Tx.Operations))
+	for i, op := range envelope.Tx.Operations {
+		opType, err := xdr.MarshalJSON(op.Body.Type)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "  #%d %s", i, strings.Trim(string(opType), `"`))
+		if op.SourceAccount != nil {
+			fmt.Fprintf(out, " (source: %s)", op.SourceAccount.Address())
+		}
+		fmt.Fprintln(out)
+
+		body, err := xdr.MarshalJSON(op.Body)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "    %s\n", strings.Replace(string(body), "\n", "\n    ", -1))
+	}
+
+	signatures, err := identifySignatures(tx, extraSigners)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "\nSignatures (%d):\n", len(signatures))
+	for i, sig := range signatures {
+		if sig.Signer == "" {
+			fmt.Fprintf(out, "  #%d hint %s: unknown signer\n", i, sig.Hint)
+		} else {
+			fmt.Fprintf(out, "  #%d hint %s: %s on %s\n", i, sig.Hint, sig.Signer, sig.Network)
+		}
+	}
+
+	passphrases, hashes, err := hashes(tx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "\nHashes:")
+	for _, passphrase := range passphrases {
+		hash := hashes[passphrase]
+		fmt.Fprintf(out, "  %s: %s\n", passphrase, hex.EncodeToString(hash[:]))
+	}
+	return nil
+}
+
+func inspectAsJSON(out io.Writer, tx *txnbuild.Transaction, extraSigners []string) error {
+	envelope, err := xdr.MarshalJSON(tx.TxEnvelope())
+	if err != nil {
+		return errors.Wrap(err, "encoding envelope")
+	}
+
+	signatures, err := identifySignatures(tx, extraSigners)
+	if err != nil {
+		return err
+	}
+
+	passphrases, hashes, err := hashes(tx)
+	if err != nil {
+		return err
+	}
+	hexHashes := map[string]string{}
+	for _, passphrase := range passphrases {
+		hash := hashes[passphrase]
+		hexHashes[passphrase] = hex.EncodeToString(hash[:])
+	}
+
+	encoded, err := json.MarshalIndent(struct {
+		Hashes     map[string]string `json:"hashes"`
+		Signatures []signature       `json:"signatures"`
+		Envelope   json.RawMessage   `json:"envelope"`
+	}{hexHashes, signatures, envelope}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%s\n", encoded)
+	return err
+}
+
+func formatTimeBounds(tb *xdr.TimeBounds) string {
+	if tb == nil {
+		return "none"
+	}
+	format := func(t xdr.TimePoint, zero string) string {
+		if t == 0 {
+			return zero
+		}
+		return time.Unix(int64(t), 0).UTC().Format(time.RFC3339)
+	}
+	return format(tb.MinTime, "any time") + " to " + format(tb.MaxTime, "no limit")
+}
+
+func formatMemo(memo xdr.Memo) string {
+	switch memo.Type {
+	case xdr.MemoTypeMemoText:
+		return fmt.Sprintf("text %q", memo.MustText())
+	case xdr.MemoTypeMemoId:
+		return fmt.Sprintf("id %d", memo.MustId())
+	case xdr.MemoTypeMemoHash:
+		hash := memo.MustHash()
+		return "hash " + hex.EncodeToString(hash[:])
+	case xdr.MemoTypeMemoReturn:
+		hash := memo.MustRetHash()
+		return "return " + hex.EncodeToString(hash[:])
+	}
+	return "none"
+}
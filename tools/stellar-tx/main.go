@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+var networkPassphrase string
+var testnet bool
+
+var mainCmd = &cobra.Command{
+	Use:   "stellar-tx",
+	Short: "Inspect, sign and verify stellar transaction envelopes",
+	Long: `Inspect, sign and verify stellar transaction envelopes.
+
+The envelope is read in base64 from the file given as argument, or stdin.
+Commands modifying the envelope write it in base64 to stdout, so they can be
+chained with pipes. Only the verify command with --horizon-url connects to
+the network.`,
+}
+
+func init() {
+	mainCmd.PersistentFlags().StringVar(&networkPassphrase, "network-passphrase", network.PublicNetworkPassphrase, "passphrase of the network of the transaction")
+	mainCmd.PersistentFlags().BoolVar(&testnet, "testnet", false, "use the test network passphrase")
+
+	mainCmd.AddCommand(inspectCmd)
+	mainCmd.AddCommand(signCmd)
+	mainCmd.AddCommand(stripCmd)
+	mainCmd.AddCommand(verifyCmd)
+}
+
+func main() {
+	if err := mainCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// passphrase returns the network passphrase selected by the flags.
+func passphrase() string {
+	if testnet {
+		return network.TestNetworkPassphrase
+	}
+	return networkPassphrase
+}
+
+// readTransaction reads the base64 envelope of the file in args, or stdin.
+func readTransaction(args []string) (txnbuild.Transaction, error) {
+	var in io.Reader = os.Stdin
+	if len(args) > 0 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return txnbuild.Transaction{}, err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return txnbuild.Transaction{}, errors.Wrap(err, "reading envelope")
+	}
+	return parseTransaction(string(data), passphrase())
+}
+
+func parseTransaction(envelope, passphrase string) (txnbuild.Transaction, error) {
+	tx, err := txnbuild.TransactionFromXDR(strings.TrimSpace(envelope))
+	if err != nil {
+		return txnbuild.Transaction{}, err
+	}
+	tx.Network = passphrase
+	return tx, nil
+}
+
+// writeTransaction writes the base64 envelope of tx to out.
+func writeTransaction(out io.Writer, tx *txnbuild.Transaction) error {
+	envelope, err := tx.Base64()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, envelope)
+	return err
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stellar/go/clients/horizonclient"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	source   = keypair.MustParse("SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R").(*keypair.Full)
+	opSource = keypair.MustParse("SDHOAMBNLGCE2MV5ZKIVZAQD3VCLGP53P3OBSBI6UN5L5XZI5TKHFQL4").(*keypair.Full)
+	other    = keypair.MustParse("SDYNO6TLFNV3IM6THLNGUG5FII4ET2H7NH3KCT6OAHIUSHKR4XBEEI6A").(*keypair.Full)
+)
+
+const mnemonic = "illness spike retreat truth genius clock brain pass fit cave bargain toe"
+
+// buildEnvelope returns a testnet transaction with a payment from its source
+// account and a set options operation changing a signer of opSource.
+func buildEnvelope(t *testing.T) string {
+	tx := txnbuild.Transaction{
+		SourceAccount: &txnbuild.SimpleAccount{AccountID: source.Address(), Sequence: 41},
+		Operations: []txnbuild.Operation{
+			&txnbuild.Payment{Destination: other.Address(), Amount: "10", Asset: txnbuild.NativeAsset{}},
+			&txnbuild.SetOptions{
+				Signer:        &txnbuild.Signer{Address: other.Address(), Weight: 1},
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: opSource.Address()},
+			},
+		},
+		Timebounds: txnbuild.NewInfiniteTimeout(),
+		Memo:       txnbuild.MemoText("hello"),
+		Network:    network.TestNetworkPassphrase,
+		BaseFee:    100,
+	}
+	require.NoError(t, tx.Build())
+	envelope, err := tx.Base64()
+	require.NoError(t, err)
+	return envelope
+}
+
+func signedTransaction(t *testing.T, kps ...*keypair.Full) txnbuild.Transaction {
+	tx, err := parseTransaction(buildEnvelope(t), network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	require.NoError(t, sign(&tx, kps))
+	return tx
+}
+
+func TestInspect(t *testing.T) {
+	tx := signedTransaction(t, source, other)
+
+	var out bytes.Buffer
+	require.NoError(t, inspect(&out, &tx, nil))
+	output := out.String()
+	assert.Contains(t, output, "source: "+source.Address())
+	assert.Contains(t, output, "fee: 200 stroops (0.0000200 XLM)")
+	assert.Contains(t, output, "sequence: 42")
+	assert.Contains(t, output, `memo: text "hello"`)
+	assert.Contains(t, output, "#0 payment\n")
+	assert.Contains(t, output, `"amount": "100000000"`)
+	assert.Contains(t, output, "#1 set_options (source: "+opSource.Address()+")")
+	assert.Contains(t, output, "hint "+hint(source)+": "+source.Address()+" on "+network.TestNetworkPassphrase)
+	assert.Contains(t, output, "hint "+hint(other)+": unknown signer")
+
+	hash, err := tx.HashHex()
+	require.NoError(t, err)
+	assert.Contains(t, output, network.TestNetworkPassphrase+": "+hash)
+	assert.Contains(t, output, network.PublicNetworkPassphrase+": ")
+
+	out.Reset()
+	require.NoError(t, inspectAsJSON(&out, &tx, []string{other.Address()}))
+	var decoded struct {
+		Hashes     map[string]string
+		Signatures []signature
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, hash, decoded.Hashes[network.TestNetworkPassphrase])
+	assert.Len(t, decoded.Hashes, 2)
+	assert.Equal(t, []signature{
+		{Hint: hint(source), Signer: source.Address(), Network: network.TestNetworkPassphrase},
+		{Hint: hint(other), Signer: other.Address(), Network: network.TestNetworkPassphrase},
+	}, decoded.Signatures)
+}
+
+func TestSign(t *testing.T) {
+	os.Setenv("STELLAR_TX_TEST_SEED", source.Seed())
+	defer os.Unsetenv("STELLAR_TX_TEST_SEED")
+
+	kps, err := signers{
+		seeds:    []string{"env:STELLAR_TX_TEST_SEED"},
+		mnemonic: "env:STELLAR_TX_TEST_MNEMONIC",
+		hdPaths:  []string{"m/44'/148'/0'"},
+	}.load()
+	assert.EqualError(t, err, "reading mnemonic code: environment variable STELLAR_TX_TEST_MNEMONIC is not set")
+	assert.Nil(t, kps)
+
+	os.Setenv("STELLAR_TX_TEST_MNEMONIC", mnemonic)
+	defer os.Unsetenv("STELLAR_TX_TEST_MNEMONIC")
+	kps, err = signers{
+		seeds:    []string{"env:STELLAR_TX_TEST_SEED"},
+		mnemonic: "env:STELLAR_TX_TEST_MNEMONIC",
+		hdPaths:  []string{"m/44'/148'/0'"},
+	}.load()
+	require.NoError(t, err)
+	require.Len(t, kps, 2)
+	assert.Equal(t, source.Address(), kps[0].Address())
+	assert.Equal(t, "GDRXE2BQUC3AZNPVFSCEZ76NJ3WWL25FYFK6RGZGIEKWE4SOOHSUJUJ6", kps[1].Address())
+
+	_, err = signers{hdPaths: []string{"m/44'/148'/0'"}}.load()
+	assert.EqualError(t, err, "--hd-path requires --mnemonic")
+
+	// keys which already signed are skipped
+	tx := signedTransaction(t, source, source)
+	require.NoError(t, sign(&tx, kps))
+	assert.Len(t, tx.TxEnvelope().Signatures, 2)
+}
+
+func TestStrip(t *testing.T) {
+	tx := signedTransaction(t, source, opSource, other)
+
+	require.NoError(t, strip(&tx, []string{opSource.Address(), keypair.MustRandom().Address()}))
+	signatures := tx.TxEnvelope().Signatures
+	require.Len(t, signatures, 2)
+	assert.Equal(t, source.Hint(), [4]byte(signatures[0].Hint))
+	assert.Equal(t, other.Hint(), [4]byte(signatures[1].Hint))
+
+	require.NoError(t, strip(&tx, nil))
+	assert.Empty(t, tx.TxEnvelope().Signatures)
+}
+
+func TestVerifyOffline(t *testing.T) {
+	tx := signedTransaction(t, source, opSource)
+	var out bytes.Buffer
+	ok, err := verify(&out, &tx, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, ok, out.String())
+
+	tx = signedTransaction(t, source, other)
+	ok, err = verify(&out, &tx, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	out.Reset()
+	ok, err = verify(&out, &tx, []string{other.Address()}, nil)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Contains(t, out.String(), "valid, signed by "+other.Address())
+
+	// signatures for another network are invalid
+	tx.Network = network.PublicNetworkPassphrase
+	ok, err = verify(&out, &tx, []string{other.Address()}, nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestVerifyOnline(t *testing.T) {
+	client := &horizonclient.MockClient{}
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: source.Address()}).Return(hProtocol.Account{
+		AccountID:  source.Address(),
+		Thresholds: hProtocol.AccountThresholds{LowThreshold: 1, MedThreshold: 2, HighThreshold: 3},
+		Signers: []hProtocol.Signer{
+			{Key: source.Address(), Weight: 1, Type: "ed25519_public_key"},
+			{Key: other.Address(), Weight: 1, Type: "ed25519_public_key"},
+		},
+	}, nil)
+	client.On("AccountDetail", horizonclient.AccountRequest{AccountID: opSource.Address()}).Return(hProtocol.Account{
+		AccountID:  opSource.Address(),
+		Thresholds: hProtocol.AccountThresholds{HighThreshold: 2},
+		Signers: []hProtocol.Signer{
+			{Key: opSource.Address(), Weight: 2, Type: "ed25519_public_key"},
+		},
+	}, nil)
+
+	tx := signedTransaction(t, source, opSource)
+	var out bytes.Buffer
+	ok, err := verify(&out, &tx, nil, client)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, out.String(), source.Address()+": weight 1, medium threshold 2: insufficient")
+	assert.Contains(t, out.String(), opSource.Address()+": weight 2, high threshold 2: ok")
+
+	tx = signedTransaction(t, source, opSource, other)
+	out.Reset()
+	ok, err = verify(&out, &tx, nil, client)
+	require.NoError(t, err)
+	assert.True(t, ok, out.String())
+
+	// extra signatures make the transaction fail
+	tx = signedTransaction(t, source, opSource, other, keypair.MustRandom())
+	out.Reset()
+	ok, err = verify(&out, &tx, []string{}, client)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, out.String(), "signature #3 isn't used by any source account")
+}
+
+func hint(kp *keypair.Full) string {
+	h := kp.Hint()
+	return hex.EncodeToString(h[:])
+}
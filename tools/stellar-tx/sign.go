@@ -0,0 +1,164 @@
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/exp/crypto/derivation"
+	"github.com/stellar/go/exp/crypto/hdwallet"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/config"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+)
+
+// signers are the sources of the keys signing a transaction.
+type signers struct {
+	seeds              []string
+	keyfiles           []string
+	password           string
+	mnemonic           string
+	mnemonicPassphrase string
+	hdPaths            []string
+}
+
+var signFlags signers
+
+var signCmd = &cobra.Command{
+	Use:   "sign [file]",
+	Short: "Add signatures to an envelope",
+	Long: `Add signatures to an envelope, made with keys read from the sources given
+by the flags. Secrets are read from env:NAME, the environment variable NAME,
+or file:PATH, the first line of the file at PATH, or prompt, the terminal.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		tx, err := readTransaction(args)
+		if err != nil {
+			return err
+		}
+
+		kps, err := signFlags.load()
+		if err != nil {
+			return err
+		}
+		if len(kps) == 0 {
+			return errors.New("no signing key, use --seed, --keyfile or --mnemonic with --hd-path")
+		}
+
+		if err = sign(&tx, kps); err != nil {
+			return err
+		}
+		return writeTransaction(os.Stdout, &tx)
+	},
+}
+
+func init() {
+	signCmd.Flags().StringArrayVar(&signFlags.seeds, "seed", nil, "source of a secret seed, e.g. env:SIGNER_SEED")
+	signCmd.Flags().StringArrayVar(&signFlags.keyfiles, "keyfile", nil, "path of an encrypted keyfile")
+	signCmd.Flags().StringVar(&signFlags.password, "password", "prompt", "source of the password of the keyfiles")
+	signCmd.Flags().StringVar(&signFlags.mnemonic, "mnemonic", "", "source of a SEP-5 mnemonic code to derive keys from")
+	signCmd.Flags().StringVar(&signFlags.mnemonicPassphrase, "mnemonic-passphrase", "", "source of the passphrase of the mnemonic code, if any")
+	signCmd.Flags().StringArrayVar(&signFlags.hdPaths, "hd-path", nil, "derivation path of a key of the mnemonic code, e.g. m/44'/148'/0'")
+}
+
+// load reads the keys of all the sources.
+func (s signers) load() ([]*keypair.Full, error) {
+	var kps []*keypair.Full
+
+	for _, source := range s.seeds {
+		seed, err := config.ReadPassword(source, "Enter seed: ")
+		if err != nil {
+			return nil, errors.Wrap(err, "reading seed")
+		}
+		kp, err := keypair.Parse(string(seed))
+		full, ok := kp.(*keypair.Full)
+		if err != nil || !ok {
+			return nil, errors.Errorf("invalid seed in %s", source)
+		}
+		kps = append(kps, full)
+	}
+
+	for _, path := range s.keyfiles {
+		kp, err := (&config.Keyfile{Path: path, Password: s.password}).Load()
+		if err != nil {
+			return nil, err
+		}
+		kps = append(kps, kp)
+	}
+
+	if len(s.hdPaths) > 0 {
+		if s.mnemonic == "" {
+			return nil, errors.New("--hd-path requires --mnemonic")
+		}
+		hdKeys, err := s.deriveKeys()
+		if err != nil {
+			return nil, err
+		}
+		kps = append(kps, hdKeys...)
+	}
+
+	return kps, nil
+}
+
+func (s signers) deriveKeys() ([]*keypair.Full, error) {
+	mnemonic, err := config.ReadPassword(s.mnemonic, "Enter mnemonic code: ")
+	if err != nil {
+		return nil, errors.Wrap(err, "reading mnemonic code")
+	}
+	if _, err = hdwallet.ValidateMnemonic(string(mnemonic)); err != nil {
+		return nil, err
+	}
+
+	var passphrase []byte
+	if s.mnemonicPassphrase != "" {
+		passphrase, err = config.ReadPassword(s.mnemonicPassphrase, "Enter mnemonic passphrase: ")
+		if err != nil {
+			return nil, errors.Wrap(err, "reading mnemonic passphrase")
+		}
+	}
+	seed := hdwallet.Seed(string(mnemonic), string(passphrase))
+
+	var kps []*keypair.Full
+	for _, path := range s.hdPaths {
+		key, err := derivation.DeriveForPath(path, seed)
+		if err != nil {
+			return nil, errors.Wrapf(err, "deriving %s", path)
+		}
+		kp, err := keypair.FromRawSeed(key.RawSeed())
+		if err != nil {
+			return nil, err
+		}
+		kps = append(kps, kp)
+	}
+	return kps, nil
+}
+
+// sign adds the signatures of kps to tx, skipping the keys which already
+// signed it.
+func sign(tx *txnbuild.Transaction, kps []*keypair.Full) error {
+	hash, err := tx.Hash()
+	if err != nil {
+		return err
+	}
+
+	var missing []*keypair.Full
+	added := map[string]bool{}
+	for _, kp := range kps {
+		if !added[kp.Address()] && !hasSigned(tx, hash, kp.Address()) {
+			added[kp.Address()] = true
+			missing = append(missing, kp)
+		}
+	}
+	return tx.Sign(missing...)
+}
+
+func hasSigned(tx *txnbuild.Transaction, hash [32]byte, key string) bool {
+	for _, sig := range tx.TxEnvelope().Signatures {
+		if signedBy(sig, hash, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+// sourceAccounts returns the source account of tx followed by the distinct
+// source accounts of its operations.
+func sourceAccounts(tx *txnbuild.Transaction) []string {
+	envelope := tx.TxEnvelope()
+	accounts := []string{envelope.Tx.SourceAccount.Address()}
+	seen := map[string]bool{accounts[0]: true}
+	for _, op := range envelope.Tx.Operations {
+		if op.SourceAccount == nil {
+			continue
+		}
+		address := op.SourceAccount.Address()
+		if !seen[address] {
+			seen[address] = true
+			accounts = append(accounts, address)
+		}
+	}
+	return accounts
+}
+
+// signedBy returns true if sig is a valid signature of hash by the signer
+// key, an account address or a hash(x) key. Pre-authorized transaction
+// signers don't sign, see `preAuthorizes`.
+func signedBy(sig xdr.DecoratedSignature, hash [32]byte, key string) bool {
+	switch {
+	case strings.HasPrefix(key, "G"):
+		kp, err := keypair.Parse(key)
+		if err != nil || kp.Hint() != sig.Hint {
+			return false
+		}
+		return kp.Verify(hash[:], sig.Signature) == nil
+	case strings.HasPrefix(key, "X"):
+		raw, err := strkey.Decode(strkey.VersionByteHashX, key)
+		if err != nil {
+			return false
+		}
+		preimageHash := sha256.Sum256(sig.Signature)
+		return bytes.Equal(preimageHash[:], raw)
+	}
+	return false
+}
+
+// preAuthorizes returns true if key is the pre-authorized transaction signer
+// of the transaction with the given hash.
+func preAuthorizes(key string, hash [32]byte) bool {
+	raw, err := strkey.Decode(strkey.VersionByteHashTx, key)
+	return err == nil && bytes.Equal(raw, hash[:])
+}
+
+// signerOf returns the first of keys which made sig, or "" if none did.
+func signerOf(sig xdr.DecoratedSignature, hash [32]byte, keys []string) string {
+	for _, key := range keys {
+		if signedBy(sig, hash, key) {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+var stripSigners []string
+
+var stripCmd = &cobra.Command{
+	Use:   "strip [file]",
+	Short: "Remove signatures from an envelope",
+	Long:  "Remove all the signatures of an envelope, or only the ones made by the keys given with --signer.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		tx, err := readTransaction(args)
+		if err != nil {
+			return err
+		}
+		if err = strip(&tx, stripSigners); err != nil {
+			return err
+		}
+		return writeTransaction(os.Stdout, &tx)
+	},
+}
+
+func init() {
+	stripCmd.Flags().StringSliceVar(&stripSigners, "signer", nil, "key whose signatures are removed, all signatures are removed when not set")
+}
+
+// strip removes the signatures of tx made by keys, or all of them when keys
+// is empty.
+func strip(tx *txnbuild.Transaction, keys []string) error {
+	envelope := tx.TxEnvelope()
+	if len(keys) == 0 {
+		envelope.Signatures = nil
+		return nil
+	}
+
+	hash, err := tx.Hash()
+	if err != nil {
+		return err
+	}
+
+	var kept []xdr.DecoratedSignature
+	for _, sig := range envelope.Signatures {
+		if signerOf(sig, hash, keys) == "" {
+			kept = append(kept, sig)
+		}
+	}
+	envelope.Signatures = kept
+	return nil
+}
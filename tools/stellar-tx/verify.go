@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/clients/horizonclient"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/txnbuild"
+	"github.com/stellar/go/xdr"
+)
+
+var verifySigners []string
+var horizonURL string
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify [file]",
+	Short: "Verify the signatures of an envelope",
+	Long: `Verify the signatures of an envelope for the selected network.
+
+Offline, every signature must be made by a source account of the transaction
+or a key given with --signer. With --horizon-url, the signers and thresholds of
+the source accounts are loaded from horizon and the weight of the signatures
+must meet the threshold of every operation, as stellar-core requires.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		tx, err := readTransaction(args)
+		if err != nil {
+			return err
+		}
+
+		var client horizonclient.ClientInterface
+		if horizonURL != "" {
+			client = &horizonclient.Client{
+				HorizonURL: horizonURL,
+				HTTP:       &http.Client{Timeout: 30 * time.Second},
+			}
+		}
+
+		ok, err := verify(os.Stdout, &tx, verifySigners, client)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("verification failed")
+		}
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.Flags().StringSliceVar(&verifySigners, "signer", nil, "additional signer keys, besides the source accounts")
+	verifyCmd.Flags().StringVar(&horizonURL, "horizon-url", "", "URL of the horizon server to load the signers and thresholds of the source accounts from")
+}
+
+// thresholdLevel is the category of threshold an operation needs.
+type thresholdLevel int
+
+const (
+	thresholdLow thresholdLevel = iota
+	thresholdMedium
+	thresholdHigh
+)
+
+func (l thresholdLevel) String() string {
+	return [...]string{"low", "medium", "high"}[l]
+}
+
+func (l thresholdLevel) of(thresholds hProtocol.AccountThresholds) int32 {
+	return int32([...]byte{thresholds.LowThreshold, thresholds.MedThreshold, thresholds.HighThreshold}[l])
+}
+
+// operationThreshold returns the threshold level body needs, see
+// https://www.stellar.org/developers/guides/concepts/multi-sig.html
+func operationThreshold(body xdr.OperationBody) thresholdLevel {
+	switch body.Type {
+	case xdr.OperationTypeAllowTrust, xdr.OperationTypeBumpSequence:
+		return thresholdLow
+	case xdr.OperationTypeAccountMerge:
+		return thresholdHigh
+	case xdr.OperationTypeSetOptions:
+		op := body.MustSetOptionsOp()
+		if op.MasterWeight != nil || op.LowThreshold != nil || op.MedThreshold != nil ||
+			op.HighThreshold != nil || op.Signer != nil {
+			return thresholdHigh
+		}
+	}
+	return thresholdMedium
+}
+
+// neededThresholds returns the highest threshold level needed from each
+// source account of tx. The transaction itself needs the low threshold of its
+// source account.
+func neededThresholds(tx *txnbuild.Transaction) map[string]thresholdLevel {
+	envelope := tx.TxEnvelope()
+	levels := map[string]thresholdLevel{envelope.Tx.SourceAccount.Address(): thresholdLow}
+	for _, op := range envelope.Tx.Operations {
+		source := envelope.Tx.SourceAccount.Address()
+		if op.SourceAccount != nil {
+			source = op.SourceAccount.Address()
+		}
+		if level := operationThreshold(op.Body); level > levels[source] {
+			levels[source] = level
+		}
+	}
+	return levels
+}
+
+// verify writes the signers of the signatures of tx and returns true if
+// they're all known. When client isn't nil, it also checks the weight of the
+// signatures of each source account against its thresholds.
+func verify(out io.Writer, tx *txnbuild.Transaction, extraSigners []string, client horizonclient.ClientInterface) (bool, error) {
+	hash, err := tx.Hash()
+	if err != nil {
+		return false, err
+	}
+	signatures := tx.TxEnvelope().Signatures
+	used := make([]bool, len(signatures))
+	ok := true
+
+	keys := append(sourceAccounts(tx), extraSigners...)
+	var accounts []hProtocol.Account
+	if client != nil {
+		for _, address := range sourceAccounts(tx) {
+			account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: address})
+			if err != nil {
+				return false, errors.Wrapf(err, "loading account %s", address)
+			}
+			accounts = append(accounts, account)
+			for _, signer := range account.Signers {
+				keys = append(keys, signer.Key)
+			}
+		}
+	}
+
+	fmt.Fprintf(out, "Signatures (%d) for %s:\n", len(signatures), tx.Network)
+	for i, sig := range signatures {
+		hint := hex.EncodeToString(sig.Hint[:])
+		if signer := signerOf(sig, hash, keys); signer != "" {
+			fmt.Fprintf(out, "  #%d hint %s: valid, signed by %s\n", i, hint, signer)
+		} else {
+			fmt.Fprintf(out, "  #%d hint %s: invalid or unknown signer\n", i, hint)
+			ok = false
+		}
+	}
+
+	if client == nil {
+		return ok, nil
+	}
+
+	fmt.Fprintln(out, "\nThresholds:")
+	levels := neededThresholds(tx)
+	for _, account := range accounts {
+		level := levels[account.AccountID]
+		needed := level.of(account.Thresholds)
+
+		var weight int32
+		var matched bool
+		for _, signer := range account.Signers {
+			if signer.Weight <= 0 {
+				continue
+			}
+			signed := preAuthorizes(signer.Key, hash)
+			for i, sig := range signatures {
+				if signedBy(sig, hash, signer.Key) {
+					used[i] = true
+					signed = true
+				}
+			}
+			if signed {
+				matched = true
+				weight += signer.Weight
+			}
+		}
+
+		status := "ok"
+		if !matched || weight < needed {
+			status = "insufficient"
+			ok = false
+		}
+		fmt.Fprintf(out, "  %s: weight %d, %s threshold %d: %s\n", account.AccountID, weight, level, needed, status)
+	}
+
+	for i, isUsed := range used {
+		if !isUsed {
+			fmt.Fprintf(out, "  signature #%d isn't used by any source account, the transaction would fail with tx_bad_auth_extra\n", i)
+			ok = false
+		}
+	}
+	return ok, nil
+}
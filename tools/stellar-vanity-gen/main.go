@@ -1,58 +1,170 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
-	"strconv"
-	"strings"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"time"
 
 	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/config"
 )
 
-var prefix string
+var (
+	suffix     = flag.String("suffix", "", "search addresses ending with `SUFFIX`")
+	contains   = flag.String("contains", "", "search addresses containing `VALUE`")
+	regex      = flag.String("regex", "", "search addresses matching the regular expression `RE`")
+	workers    = flag.Int("workers", runtime.NumCPU(), "number of goroutines searching in parallel")
+	count      = flag.Int("count", 1, "number of addresses to find")
+	estimate   = flag.Bool("estimate", false, "print the expected number of attempts and duration of the search, and exit")
+	keyfileDir = flag.String("keyfile-dir", "", "write the key pairs found as encrypted keyfiles in `DIR` instead of printing their seeds")
+	password   = flag.String("password", "prompt", "where the password of the keyfiles is read from: env:NAME, file:PATH or prompt")
+)
 
-const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+// progressInterval is the interval between the progress reports.
+const progressInterval = 10 * time.Second
 
 func main() {
+	flag.Usage = usage
+	flag.Parse()
 
-	if len(os.Args) != 2 {
+	p, err := parsePattern()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		usage()
 		os.Exit(1)
 	}
+	if *workers < 1 || *count < 1 {
+		log.Fatal("-workers and -count must be positive")
+	}
 
-	prefix = strings.ToUpper(os.Args[1])
-	checkPlausible()
+	expected := p.expectedAttempts(*count)
+	if expected > 0 {
+		fmt.Fprintf(os.Stderr, "Searching %s with %d workers, about %.0f attempts expected\n", p, *workers, expected)
+	} else {
+		fmt.Fprintf(os.Stderr, "Searching %s with %d workers, the number of attempts can't be estimated\n", p, *workers)
+	}
 
-	for {
-		kp, err := keypair.Random()
+	if *estimate {
+		rate := measureRate(p, *workers, 2*time.Second)
+		fmt.Printf("Rate: %.0f attempts/s\n", rate)
+		if expected > 0 {
+			fmt.Printf("Expected attempts: %.0f\n", expected)
+			fmt.Printf("Expected duration: %s\n", formatDuration(expected/rate))
+		}
+		return
+	}
 
+	var pass []byte
+	if *keyfileDir != "" {
+		pass, err = config.ReadPassword(*password, "Enter keyfiles password: ")
 		if err != nil {
 			log.Fatal(err)
 		}
+	}
 
-		// NOTE: the first letter of an address will always be G, and the second letter will be one of only a few
-		// possibilities in the base32 alphabet, so we are actually searching for the vanity value after this 2
-		// character prefix.
-		if strings.HasPrefix(kp.Address()[2:], prefix) {
-			fmt.Println("Found!")
+	var attempts uint64
+	start := time.Now()
+	stop := reportProgress(&attempts, expected, start)
+	kps, err := search(p, *workers, *count, &attempts)
+	close(stop)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Found %d after %d attempts in %s\n", len(kps), atomic.LoadUint64(&attempts), time.Since(start).Round(time.Second))
+	for _, kp := range kps {
+		if *keyfileDir == "" {
 			fmt.Printf("Secret seed: %s\n", kp.Seed())
 			fmt.Printf("Public: %s\n", kp.Address())
-			os.Exit(0)
+			continue
+		}
+
+		path := filepath.Join(*keyfileDir, kp.Address()+".json")
+		if err = keypair.SaveEncrypted(path, kp, pass); err != nil {
+			log.Fatal(err)
 		}
+		fmt.Printf("Public: %s (keyfile: %s)\n", kp.Address(), path)
 	}
 }
 
 func usage() {
-	fmt.Printf("Usage:\n\tstellar-vanity-gen PREFIX\n")
+	fmt.Fprintf(os.Stderr, "Usage:\n\tstellar-vanity-gen [flags] PREFIX\n\tstellar-vanity-gen [flags] -suffix SUFFIX | -contains VALUE | -regex RE\n\nFlags:\n")
+	flag.PrintDefaults()
 }
 
-// aborts the attempt if a desired character is not a valid base32 digit
-func checkPlausible() {
-	for _, r := range prefix {
-		if !strings.ContainsRune(alphabet, r) {
-			fmt.Printf("Invalid prefix: %s is not in the base32 alphabet\n", strconv.QuoteRune(r))
-			os.Exit(1)
+// parsePattern returns the pattern given by the flags or the prefix
+// argument. Exactly one of them must be set.
+func parsePattern() (*pattern, error) {
+	var kinds, values []string
+	if flag.NArg() > 1 {
+		return nil, fmt.Errorf("too many arguments")
+	}
+	if flag.NArg() == 1 {
+		kinds, values = append(kinds, "prefix"), append(values, flag.Arg(0))
+	}
+	for kind, value := range map[string]string{"suffix": *suffix, "contains": *contains, "regex": *regex} {
+		if value != "" {
+			kinds, values = append(kinds, kind), append(values, value)
 		}
 	}
+	if len(kinds) != 1 {
+		return nil, fmt.Errorf("exactly one of PREFIX, -suffix, -contains or -regex is required")
+	}
+	return newPattern(kinds[0], values[0])
+}
+
+// measureRate returns the number of attempts per second of a search for p
+// during d.
+func measureRate(p *pattern, workers int, d time.Duration) float64 {
+	// a pattern nothing matches, as addresses are upper case
+	never, _ := newPattern("regex", "^$")
+	var attempts uint64
+	done := make(chan struct{})
+	results := make(chan *keypair.Full)
+	for i := 0; i < workers; i++ {
+		go searchWorker(never, &attempts, results, done)
+	}
+	time.Sleep(d)
+	close(done)
+	return float64(atomic.LoadUint64(&attempts)) / d.Seconds()
+}
+
+// reportProgress prints the number of attempts, rate and expected duration
+// of the search every progressInterval, until the returned channel is closed.
+func reportProgress(attempts *uint64, expected float64, start time.Time) chan struct{} {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				n := float64(atomic.LoadUint64(attempts))
+				rate := n / time.Since(start).Seconds()
+				if expected == 0 {
+					fmt.Fprintf(os.Stderr, "%.0f attempts, %.0f/s\n", n, rate)
+					continue
+				}
+				// the attempts are independent, so the expected duration
+				// doesn't decrease as the search goes on
+				fmt.Fprintf(os.Stderr, "%.0f attempts (%.0f%% of expected), %.0f/s, expected duration %s\n",
+					n, 100*n/expected, rate, formatDuration(expected/rate))
+			}
+		}
+	}()
+	return stop
+}
+
+func formatDuration(seconds float64) string {
+	if seconds > float64(100*365*24*3600) {
+		return fmt.Sprintf("%.0f years", seconds/(365*24*3600))
+	}
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second).String()
 }
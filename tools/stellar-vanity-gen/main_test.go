@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const address = "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"
+
+func TestPattern(t *testing.T) {
+	for _, testCase := range []struct {
+		kind, value string
+		match       bool
+	}{
+		{"prefix", "rpy", true},
+		{"prefix", "GBR", false},
+		{"suffix", "OX2H", true},
+		{"suffix", "OX2", false},
+		{"contains", "NQ4BX", true},
+		{"contains", "GBR", false},
+		{"regex", "^G.RPY.*2H$", true},
+		{"regex", "rpy", false},
+	} {
+		p, err := newPattern(testCase.kind, testCase.value)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.match, p.match(address), "%s", p)
+	}
+
+	_, err := newPattern("prefix", "ABC1")
+	assert.EqualError(t, err, `invalid prefix: '1' is not in the base32 alphabet`)
+	_, err = newPattern("suffix", strings.Repeat("A", 60))
+	assert.EqualError(t, err, "invalid suffix: longer than an address")
+	_, err = newPattern("regex", "(")
+	assert.Error(t, err)
+	_, err = newPattern("contains", "")
+	assert.EqualError(t, err, "empty contains")
+}
+
+func TestExpectedAttempts(t *testing.T) {
+	p, err := newPattern("prefix", "ABCD")
+	require.NoError(t, err)
+	assert.Equal(t, float64(1<<20), p.expectedAttempts(1))
+	assert.Equal(t, float64(2<<20), p.expectedAttempts(2))
+
+	p, err = newPattern("contains", "ABCD")
+	require.NoError(t, err)
+	assert.InDelta(t, float64(1<<20)/51, p.expectedAttempts(1), 1000)
+
+	p, err = newPattern("regex", "ABCD")
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), p.expectedAttempts(1))
+}
+
+func TestSearch(t *testing.T) {
+	p, err := newPattern("suffix", "A")
+	require.NoError(t, err)
+
+	var attempts uint64
+	kps, err := search(p, 4, 3, &attempts)
+	require.NoError(t, err)
+	require.Len(t, kps, 3)
+	for _, kp := range kps {
+		assert.True(t, strings.HasSuffix(kp.Address(), "A"))
+	}
+	assert.True(t, attempts >= 3)
+}
+
+func TestSearchIndependentSeeds(t *testing.T) {
+	p, err := newPattern("regex", ".")
+	require.NoError(t, err)
+
+	var attempts uint64
+	kps, err := search(p, 1, 2, &attempts)
+	require.NoError(t, err)
+	require.Len(t, kps, 2)
+	assert.NotEqual(t, kps[0].Seed()[:20], kps[1].Seed()[:20])
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/strkey"
+	"golang.org/x/crypto/ed25519"
+)
+
+const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+
+// addressLength is the length of account addresses. The first character is
+// always G and the second one is one of A, B, C or D, so prefixes are
+// searched after them.
+const addressLength = 56
+
+// pattern is searched in the base32 addresses of random key pairs.
+type pattern struct {
+	kind  string
+	value string
+	re    *regexp.Regexp
+}
+
+// newPattern returns the pattern of kind prefix, suffix, contains or regex.
+// The value of the other kinds than regex is case insensitive.
+func newPattern(kind, value string) (*pattern, error) {
+	if value == "" {
+		return nil, fmt.Errorf("empty %s", kind)
+	}
+
+	p := &pattern{kind: kind, value: strings.ToUpper(value)}
+	switch kind {
+	case "prefix", "suffix", "contains":
+		for _, r := range p.value {
+			if !strings.ContainsRune(alphabet, r) {
+				return nil, fmt.Errorf("invalid %s: %q is not in the base32 alphabet", kind, r)
+			}
+		}
+		if len(p.value) > addressLength-2 {
+			return nil, fmt.Errorf("invalid %s: longer than an address", kind)
+		}
+	case "regex":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %v", err)
+		}
+		p.value = value
+		p.re = re
+	default:
+		return nil, fmt.Errorf("invalid pattern kind %s", kind)
+	}
+	return p, nil
+}
+
+func (p *pattern) String() string {
+	return p.kind + " " + p.value
+}
+
+func (p *pattern) match(address string) bool {
+	switch p.kind {
+	case "prefix":
+		return strings.HasPrefix(address[2:], p.value)
+	case "suffix":
+		return strings.HasSuffix(address, p.value)
+	case "contains":
+		return strings.Contains(address[2:], p.value)
+	}
+	return p.re.MatchString(address)
+}
+
+// probability returns the probability that a random address matches, or 0
+// when it's unknown, as for regular expressions.
+func (p *pattern) probability() float64 {
+	single := math.Pow(32, -float64(len(p.value)))
+	switch p.kind {
+	case "prefix", "suffix":
+		return single
+	case "contains":
+		// the value can start at any of the positions after the first 2
+		// characters, overlaps are rare enough to be ignored
+		positions := float64(addressLength - 2 - len(p.value) + 1)
+		return 1 - math.Pow(1-single, positions)
+	}
+	return 0
+}
+
+// expectedAttempts returns the number of key pairs generated on average to
+// find count matching ones, or 0 when it's unknown.
+func (p *pattern) expectedAttempts(count int) float64 {
+	probability := p.probability()
+	if probability == 0 {
+		return 0
+	}
+	return float64(count) / probability
+}
+
+// search generates random key pairs on workers goroutines until it finds
+// count ones matching p. attempts counts the generated key pairs while the
+// search is running.
+func search(p *pattern, workers, count int, attempts *uint64) ([]*keypair.Full, error) {
+	results := make(chan *keypair.Full)
+	errs := make(chan error, workers)
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := searchWorker(p, attempts, results, done); err != nil {
+				errs <- err
+			}
+		}()
+	}
+
+	var found []*keypair.Full
+	var err error
+	for len(found) < count && err == nil {
+		select {
+		case kp := <-results:
+			found = append(found, kp)
+		case err = <-errs:
+		}
+	}
+	close(done)
+	wg.Wait()
+	return found, err
+}
+
+// searchWorker sends the key pairs matching p to results until done is
+// closed. Seeds are a random base seed plus a counter, which is much faster
+// than reading random bytes for every key pair and as safe, since keys are
+// derived from the hash of their seed. The base seed is renewed after every
+// match, so that the seeds found don't reveal each other.
+func searchWorker(p *pattern, attempts *uint64, results chan<- *keypair.Full, done <-chan struct{}) error {
+	var seed [32]byte
+	if _, err := rand.Read(seed[:]); err != nil {
+		return err
+	}
+	base := binary.BigEndian.Uint64(seed[24:])
+
+	for counter := uint64(0); ; counter++ {
+		if counter%1024 == 0 {
+			select {
+			case <-done:
+				return nil
+			default:
+			}
+		}
+
+		binary.BigEndian.PutUint64(seed[24:], base+counter)
+		// the second half of an ed25519 private key is its public key
+		publicKey := ed25519.NewKeyFromSeed(seed[:])[32:]
+		address, err := strkey.Encode(strkey.VersionByteAccountID, publicKey)
+		if err != nil {
+			return err
+		}
+		atomic.AddUint64(attempts, 1)
+
+		if !p.match(address) {
+			continue
+		}
+
+		kp, err := keypair.FromRawSeed(seed)
+		if err != nil {
+			return err
+		}
+		select {
+		case results <- kp:
+		case <-done:
+			return nil
+		}
+
+		if _, err = rand.Read(seed[:]); err != nil {
+			return err
+		}
+		base = binary.BigEndian.Uint64(seed[24:])
+	}
+}
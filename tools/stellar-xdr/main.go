@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	formatBase64 = "base64"
+	formatBinary = "binary"
+)
+
+var typeName, inputFormat, outputFormat string
+
+var mainCmd = &cobra.Command{
+	Use:   "stellar-xdr",
+	Short: "Convert stellar XDR values between base64, binary and JSON",
+}
+
+var decodeCmd = &cobra.Command{
+	Use:   "decode [file]",
+	Short: "Decode a XDR value, read from file or stdin, to JSON",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		in, err := openInput(args)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		return decode(typeName, inputFormat, in, os.Stdout)
+	},
+}
+
+var encodeCmd = &cobra.Command{
+	Use:   "encode [file]",
+	Short: "Encode a JSON value, read from file or stdin, to XDR",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return errors.New("require at most 1 file argument")
+		}
+		in, err := openInput(args)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		return encode(typeName, outputFormat, in, os.Stdout)
+	},
+}
+
+var typesCmd = &cobra.Command{
+	Use:   "types",
+	Short: "List the XDR types",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return errors.New("types takes no arguments")
+		}
+		fmt.Println(strings.Join(xdr.TypeNames(), "\n"))
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{decodeCmd, encodeCmd} {
+		cmd.Flags().StringVarP(&typeName, "type", "t", "TransactionEnvelope", "XDR type of the value, see the types command")
+	}
+	decodeCmd.Flags().StringVar(&inputFormat, "input", formatBase64, "format of the XDR value: base64 or binary")
+	encodeCmd.Flags().StringVar(&outputFormat, "output", formatBase64, "format of the XDR value: base64 or binary")
+
+	mainCmd.AddCommand(decodeCmd)
+	mainCmd.AddCommand(encodeCmd)
+	mainCmd.AddCommand(typesCmd)
+}
+
+func main() {
+	if err := mainCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func openInput(args []string) (io.ReadCloser, error) {
+	if len(args) == 0 || args[0] == "-" {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+	return os.Open(args[0])
+}
+
+// decode writes the JSON encoding of the value of type name read from in.
+func decode(name, format string, in io.Reader, out io.Writer) error {
+	value, ok := xdr.NewValue(name)
+	if !ok {
+		return errors.Errorf("unknown type %s", name)
+	}
+
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return errors.Wrap(err, "read input")
+	}
+
+	switch format {
+	case formatBase64:
+		err = xdr.SafeUnmarshalBase64(string(bytes.TrimSpace(data)), value)
+	case formatBinary:
+		err = xdr.SafeUnmarshal(data, value)
+	default:
+		return errors.Errorf("invalid input format %s", format)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "decode %s", name)
+	}
+
+	encoded, err := xdr.MarshalJSON(value)
+	if err != nil {
+		return errors.Wrap(err, "encode json")
+	}
+	_, err = fmt.Fprintf(out, "%s\n", encoded)
+	return err
+}
+
+// encode writes the XDR encoding of the JSON value of type name read from in.
+func encode(name, format string, in io.Reader, out io.Writer) error {
+	value, ok := xdr.NewValue(name)
+	if !ok {
+		return errors.Errorf("unknown type %s", name)
+	}
+
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return errors.Wrap(err, "read input")
+	}
+
+	err = xdr.UnmarshalJSON(data, value)
+	if err != nil {
+		return errors.Wrapf(err, "decode %s", name)
+	}
+
+	var raw bytes.Buffer
+	_, err = xdr.Marshal(&raw, value)
+	if err != nil {
+		return errors.Wrapf(err, "encode %s", name)
+	}
+
+	switch format {
+	case formatBase64:
+		_, err = fmt.Fprintf(out, "%s\n", base64.StdEncoding.EncodeToString(raw.Bytes()))
+	case formatBinary:
+		_, err = out.Write(raw.Bytes())
+	default:
+		return errors.Errorf("invalid output format %s", format)
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const envelope = "AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAACgAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAEAKZ7IPj/46PuWU6ZOtyMosctNAkXRNX9WCAI5RnfRk+AyxDLoDZP/9l3NvsxQtWj9juQOuoBlFLnWu8intgxQA"
+
+func TestDecodeEncode(t *testing.T) {
+	var decoded bytes.Buffer
+	err := decode("TransactionEnvelope", formatBase64, strings.NewReader(envelope+"\n"), &decoded)
+	require.NoError(t, err)
+	assert.Contains(t, decoded.String(), `"destination": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"`)
+
+	var encoded bytes.Buffer
+	err = encode("TransactionEnvelope", formatBase64, bytes.NewReader(decoded.Bytes()), &encoded)
+	require.NoError(t, err)
+	assert.Equal(t, envelope+"\n", encoded.String())
+
+	var binary bytes.Buffer
+	err = encode("TransactionEnvelope", formatBinary, bytes.NewReader(decoded.Bytes()), &binary)
+	require.NoError(t, err)
+	raw, err := base64.StdEncoding.DecodeString(envelope)
+	require.NoError(t, err)
+	assert.Equal(t, raw, binary.Bytes())
+
+	var fromBinary bytes.Buffer
+	err = decode("TransactionEnvelope", formatBinary, bytes.NewReader(raw), &fromBinary)
+	require.NoError(t, err)
+	assert.Equal(t, decoded.String(), fromBinary.String())
+}
+
+func TestDecodeEncodeErrors(t *testing.T) {
+	var out bytes.Buffer
+
+	err := decode("Envelope", formatBase64, strings.NewReader(envelope), &out)
+	assert.EqualError(t, err, "unknown type Envelope")
+
+	err = decode("TransactionEnvelope", "hex", strings.NewReader(envelope), &out)
+	assert.EqualError(t, err, "invalid input format hex")
+
+	err = decode("TransactionResult", formatBase64, strings.NewReader(envelope), &out)
+	assert.Error(t, err)
+
+	err = encode("Memo", formatBase64, strings.NewReader(`{"type": "memo_none", "id": "1"}`), &out)
+	assert.EqualError(t, err, `decode Memo: unknown key "id"`)
+
+	err = encode("Memo", "hex", strings.NewReader(`{"type": "memo_none"}`), &out)
+	assert.EqualError(t, err, "invalid output format hex")
+
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,123 @@
+package txnbuild
+
+import (
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+)
+
+// MinBaseFee is the minimum base fee, in stroops per operation, accepted by the network.
+const MinBaseFee = 100
+
+// FeeStrategy picks the base fee, in stroops per operation, of a Transaction.
+type FeeStrategy interface {
+	BaseFee() (uint32, error)
+}
+
+// FixedFee is a FeeStrategy which always picks the same base fee.
+type FixedFee uint32
+
+// BaseFee implements FeeStrategy.
+func (f FixedFee) BaseFee() (uint32, error) {
+	if f < MinBaseFee {
+		return 0, errors.Errorf("base fee %d is below the network minimum of %d", f, MinBaseFee)
+	}
+	return uint32(f), nil
+}
+
+// PercentileFee is a FeeStrategy which bids the Percentile of the fees per
+// operation accepted in the recent ledgers, as reported by Horizon's
+// /fee_stats endpoint. FeeStats is usually the FeeStats method of a
+// horizonclient.Client.
+//
+// When SurgeThreshold is set and the capacity usage of the recent ledgers is
+// below it, transactions aren't competing for inclusion and the base fee of
+// the last ledger is bid instead.
+type PercentileFee struct {
+	// Percentile is rounded up to one of the percentiles Horizon reports
+	// (10, 20, ..., 90, 95 and 99), 0 picks the lowest accepted fee.
+	Percentile     int
+	SurgeThreshold float64
+	FeeStats       func() (hProtocol.FeeStats, error)
+}
+
+// BaseFee implements FeeStrategy.
+func (f PercentileFee) BaseFee() (uint32, error) {
+	if f.FeeStats == nil {
+		return 0, errors.New("no fee stats source")
+	}
+	if f.Percentile < 0 || f.Percentile > 100 {
+		return 0, errors.Errorf("invalid percentile %d", f.Percentile)
+	}
+
+	stats, err := f.FeeStats()
+	if err != nil {
+		return 0, errors.Wrap(err, "couldn't load fee stats")
+	}
+
+	fee := stats.LastLedgerBaseFee
+	if f.SurgeThreshold == 0 || stats.LedgerCapacityUsage >= f.SurgeThreshold {
+		fee = percentileFee(stats, f.Percentile)
+	}
+	if fee < stats.LastLedgerBaseFee {
+		fee = stats.LastLedgerBaseFee
+	}
+	if fee < MinBaseFee {
+		fee = MinBaseFee
+	}
+	return uint32(fee), nil
+}
+
+func percentileFee(stats hProtocol.FeeStats, percentile int) int {
+	switch {
+	case percentile == 0:
+		return stats.MinAcceptedFee
+	case percentile <= 10:
+		return stats.P10AcceptedFee
+	case percentile <= 20:
+		return stats.P20AcceptedFee
+	case percentile <= 30:
+		return stats.P30AcceptedFee
+	case percentile <= 40:
+		return stats.P40AcceptedFee
+	case percentile <= 50:
+		return stats.P50AcceptedFee
+	case percentile <= 60:
+		return stats.P60AcceptedFee
+	case percentile <= 70:
+		return stats.P70AcceptedFee
+	case percentile <= 80:
+		return stats.P80AcceptedFee
+	case percentile <= 90:
+		return stats.P90AcceptedFee
+	case percentile <= 95:
+		return stats.P95AcceptedFee
+	default:
+		return stats.P99AcceptedFee
+	}
+}
+
+// CappedFee is a FeeStrategy which never picks more than Max, whatever the
+// base fee picked by Strategy.
+type CappedFee struct {
+	Strategy FeeStrategy
+	Max      uint32
+}
+
+// BaseFee implements FeeStrategy.
+func (f CappedFee) BaseFee() (uint32, error) {
+	if f.Strategy == nil {
+		return 0, errors.New("no fee strategy to cap")
+	}
+
+	fee, err := f.Strategy.BaseFee()
+	if err != nil {
+		return 0, err
+	}
+	if fee > f.Max {
+		fee = f.Max
+	}
+	if fee < MinBaseFee {
+		return 0, errors.Errorf("maximum base fee %d is below the network minimum of %d", f.Max, MinBaseFee)
+	}
+	return fee, nil
+}
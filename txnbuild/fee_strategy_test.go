@@ -0,0 +1,130 @@
+package txnbuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFeeStats = hProtocol.FeeStats{
+	LastLedgerBaseFee:   100,
+	LedgerCapacityUsage: 0.5,
+	MinAcceptedFee:      100,
+	P10AcceptedFee:      100,
+	P20AcceptedFee:      100,
+	P30AcceptedFee:      100,
+	P40AcceptedFee:      100,
+	P50AcceptedFee:      150,
+	P60AcceptedFee:      200,
+	P70AcceptedFee:      250,
+	P80AcceptedFee:      300,
+	P90AcceptedFee:      400,
+	P95AcceptedFee:      800,
+	P99AcceptedFee:      1500,
+}
+
+func feeStats(stats hProtocol.FeeStats, err error) func() (hProtocol.FeeStats, error) {
+	return func() (hProtocol.FeeStats, error) {
+		return stats, err
+	}
+}
+
+func TestFixedFee(t *testing.T) {
+	fee, err := FixedFee(200).BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(200), fee)
+
+	_, err = FixedFee(50).BaseFee()
+	assert.EqualError(t, err, "base fee 50 is below the network minimum of 100")
+}
+
+func TestPercentileFee(t *testing.T) {
+	for percentile, expected := range map[int]uint32{
+		0:   100,
+		50:  150,
+		51:  200,
+		90:  400,
+		95:  800,
+		100: 1500,
+	} {
+		fee, err := PercentileFee{Percentile: percentile, FeeStats: feeStats(testFeeStats, nil)}.BaseFee()
+		require.NoError(t, err)
+		assert.Equal(t, expected, fee, "percentile %d", percentile)
+	}
+
+	// below the surge threshold, the last base fee is enough
+	strategy := PercentileFee{Percentile: 90, SurgeThreshold: 0.8, FeeStats: feeStats(testFeeStats, nil)}
+	fee, err := strategy.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(100), fee)
+
+	stats := testFeeStats
+	stats.LedgerCapacityUsage = 0.95
+	strategy.FeeStats = feeStats(stats, nil)
+	fee, err = strategy.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(400), fee)
+
+	// the fee never goes below the last base fee
+	stats.LastLedgerBaseFee = 500
+	strategy.FeeStats = feeStats(stats, nil)
+	fee, err = strategy.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(500), fee)
+
+	strategy.FeeStats = feeStats(stats, errors.New("connection refused"))
+	_, err = strategy.BaseFee()
+	assert.EqualError(t, err, "couldn't load fee stats: connection refused")
+
+	_, err = PercentileFee{Percentile: 101, FeeStats: strategy.FeeStats}.BaseFee()
+	assert.EqualError(t, err, "invalid percentile 101")
+	_, err = PercentileFee{Percentile: 50}.BaseFee()
+	assert.EqualError(t, err, "no fee stats source")
+}
+
+func TestCappedFee(t *testing.T) {
+	percentile := PercentileFee{Percentile: 99, FeeStats: feeStats(testFeeStats, nil)}
+
+	fee, err := CappedFee{Strategy: percentile, Max: 1000}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1000), fee)
+
+	fee, err = CappedFee{Strategy: FixedFee(200), Max: 1000}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(200), fee)
+
+	_, err = CappedFee{Strategy: percentile, Max: 50}.BaseFee()
+	assert.EqualError(t, err, "maximum base fee 50 is below the network minimum of 100")
+}
+
+func TestTransactionFeeStrategy(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&BumpSequence{BumpTo: 1}, &BumpSequence{BumpTo: 2}},
+		FeeStrategy:   PercentileFee{Percentile: 90, FeeStats: feeStats(testFeeStats, nil)},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, tx.Build())
+	assert.Equal(t, uint32(400), tx.BaseFee)
+	assert.Equal(t, 800, tx.TransactionFee())
+
+	// the sequence number isn't consumed when no fee can be picked
+	sourceAccount = NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	tx = Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&BumpSequence{BumpTo: 1}},
+		FeeStrategy:   PercentileFee{Percentile: 90, FeeStats: feeStats(testFeeStats, errors.New("timeout"))},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	assert.EqualError(t, tx.Build(), "couldn't pick base fee: couldn't load fee stats: timeout")
+	assert.Equal(t, int64(9605939170639897), sourceAccount.Sequence)
+}
@@ -0,0 +1,83 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/errors"
+)
+
+// demuxOperations returns a copy of ops where muxed account destinations
+// ("M...") are replaced by the account ID they are made of, along with the ID
+// that has to be sent as a memo. The network doesn't support muxed accounts
+// yet, so the ID is carried by a MemoID, which limits a transaction to a single
+// muxed ID. ops is not modified; id is nil when no destination is muxed.
+func demuxOperations(ops []Operation) ([]Operation, *uint64, error) {
+	var id *uint64
+	result := make([]Operation, len(ops))
+
+	demux := func(destination *string) error {
+		if !strkey.IsValidMuxedAccount(*destination) {
+			return nil
+		}
+
+		accountID, opID, err := strkey.DecodeMuxedAccount(*destination)
+		if err != nil {
+			return err
+		}
+		if id != nil && *id != opID {
+			return errors.New("destinations with different muxed account ids")
+		}
+
+		id = &opID
+		*destination = accountID
+		return nil
+	}
+
+	for i, op := range ops {
+		var err error
+
+		switch o := op.(type) {
+		case *Payment:
+			cp := *o
+			err = demux(&cp.Destination)
+			op = &cp
+		case *CreateAccount:
+			cp := *o
+			err = demux(&cp.Destination)
+			op = &cp
+		case *PathPaymentStrictReceive:
+			cp := *o
+			err = demux(&cp.Destination)
+			op = &cp
+		case *PathPaymentStrictSend:
+			cp := *o
+			err = demux(&cp.Destination)
+			op = &cp
+		case *AccountMerge:
+			cp := *o
+			err = demux(&cp.Destination)
+			op = &cp
+		}
+
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid destination for %T operation", op)
+		}
+		result[i] = op
+	}
+
+	return result, id, nil
+}
+
+// muxedMemo returns the memo of a transaction sending to the muxed ID id. It
+// fails if the transaction already has a different memo.
+func muxedMemo(memo Memo, id uint64) (Memo, error) {
+	switch m := memo.(type) {
+	case nil:
+		return MemoID(id), nil
+	case MemoID:
+		if uint64(m) == id {
+			return m, nil
+		}
+	}
+
+	return nil, errors.New("muxed account destination conflicts with the transaction memo")
+}
@@ -0,0 +1,88 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/strkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuxedDestination(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	muxed, err := strkey.EncodeMuxedAccount(kp1.Address(), 1234)
+	assert.NoError(t, err)
+
+	payment := Payment{
+		Destination: muxed,
+		Amount:      "10",
+		Asset:       NativeAsset{},
+	}
+	createAccount := CreateAccount{
+		Destination: muxed,
+		Amount:      "10",
+	}
+
+	sourceAccount := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&payment, &createAccount},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	received := buildSignEncode(t, tx, kp0)
+
+	sourceAccount = NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	tx = Transaction{
+		SourceAccount: &sourceAccount,
+		Operations: []Operation{
+			&Payment{Destination: kp1.Address(), Amount: "10", Asset: NativeAsset{}},
+			&CreateAccount{Destination: kp1.Address(), Amount: "10"},
+		},
+		Memo:       MemoID(1234),
+		Timebounds: NewInfiniteTimeout(),
+		Network:    network.TestNetworkPassphrase,
+	}
+	expected := buildSignEncode(t, tx, kp0)
+
+	assert.Equal(t, expected, received, "Base 64 XDR should match")
+	// The operations provided are left untouched
+	assert.Equal(t, muxed, payment.Destination)
+	assert.Equal(t, muxed, createAccount.Destination)
+}
+
+func TestMuxedDestinationMemo(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	muxed, err := strkey.EncodeMuxedAccount(kp1.Address(), 1234)
+	assert.NoError(t, err)
+	other, err := strkey.EncodeMuxedAccount(kp1.Address(), 5678)
+	assert.NoError(t, err)
+
+	build := func(memo Memo, destinations ...string) error {
+		sourceAccount := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+		tx := Transaction{
+			SourceAccount: &sourceAccount,
+			Memo:          memo,
+			Timebounds:    NewInfiniteTimeout(),
+			Network:       network.TestNetworkPassphrase,
+		}
+		for _, destination := range destinations {
+			tx.Operations = append(tx.Operations, &AccountMerge{Destination: destination})
+		}
+		return tx.Build()
+	}
+
+	assert.NoError(t, build(MemoID(1234), muxed))
+	assert.NoError(t, build(nil, muxed, muxed, kp1.Address()))
+
+	err = build(MemoID(1), muxed)
+	assert.EqualError(t, err, "muxed account destination conflicts with the transaction memo")
+
+	err = build(MemoText("hello"), muxed)
+	assert.EqualError(t, err, "muxed account destination conflicts with the transaction memo")
+
+	err = build(nil, muxed, other)
+	assert.EqualError(t, err, "invalid destination for *txnbuild.AccountMerge operation: destinations with different muxed account ids")
+}
@@ -1,6 +1,9 @@
 package txnbuild
 
 import (
+	"fmt"
+
+	"github.com/stellar/go/strkey"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -315,6 +318,9 @@ func (so *SetOptions) FromXDR(xdrOp xdr.Operation) error {
 func (so *SetOptions) Validate() error {
 	// skipping checks here because the individual methods above already check for required fields.
 	// Refactoring is out of the scope of this issue(https://github.com/stellar/go/issues/1041) so will leave as is for now.
+	if so.Signer != nil && !strkey.IsValidSignerKey(so.Signer.Address) {
+		return NewValidationError("Signer", fmt.Sprintf("%s is not a valid signer key", so.Signer.Address))
+	}
 	return nil
 }
 
@@ -0,0 +1,105 @@
+package txnbuild
+
+import (
+	"crypto/sha256"
+
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// PreAuthTxAddress returns the signer key ("T...") authorizing tx, which must have
+// been built. An account with this signer accepts tx without any signature,
+// once, as the signer is removed when tx is applied. See
+// https://www.stellar.org/developers/guides/concepts/multi-sig.html#pre-authorized-transaction.
+func PreAuthTxAddress(tx *Transaction) (string, error) {
+	if tx.xdrEnvelope == nil {
+		return "", errors.New("transaction has not been built")
+	}
+
+	hash, err := tx.Hash()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash transaction")
+	}
+
+	return strkey.Encode(strkey.VersionByteHashTx, hash[:])
+}
+
+// HashXAddress returns the hash-x signer key ("X...") for preimage. A
+// transaction signed with SignHashX(preimage) is authorized by this signer. See
+// https://www.stellar.org/developers/guides/concepts/multi-sig.html#hashx.
+func HashXAddress(preimage []byte) (string, error) {
+	if len(preimage) > xdr.Signature(preimage).XDRMaxSize() {
+		return "", errors.New("preimage cannnot be more than 64 bytes")
+	}
+
+	hash := sha256.Sum256(preimage)
+	return strkey.Encode(strkey.VersionByteHashX, hash[:])
+}
+
+// NewPreAuthTxSigner is syntactic sugar returning the Signer, to be added with
+// SetOptions, authorizing the built transaction tx.
+func NewPreAuthTxSigner(tx *Transaction, weight Threshold) (*Signer, error) {
+	address, err := PreAuthTxAddress(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{Address: address, Weight: weight}, nil
+}
+
+// NewHashXSigner is syntactic sugar returning the Signer, to be added with
+// SetOptions, authorizing transactions signed with preimage.
+func NewHashXSigner(preimage []byte, weight Threshold) (*Signer, error) {
+	address, err := HashXAddress(preimage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{Address: address, Weight: weight}, nil
+}
+
+// ValidatePreAuthTx checks that preAuthTx can be submitted once signerTx, the
+// transaction adding its pre-authorized transaction signer, has been applied.
+// Both transactions must have been built. signerTx must add the signer with a
+// SetOptions operation and a non zero weight. When both transactions have the
+// same source account, preAuthTx must use a later sequence number than
+// signerTx, as signerTx would otherwise consume it. It isn't required to be
+// the next one: pre-authorized transactions are commonly submitted after other
+// transactions of the account, ex. an escrow account pre-authorizes the
+// transaction releasing the funds, which becomes valid once the transactions
+// of the escrow period have used the sequence numbers in between.
+func ValidatePreAuthTx(signerTx, preAuthTx *Transaction) error {
+	if signerTx.xdrEnvelope == nil {
+		return errors.New("signer transaction has not been built")
+	}
+
+	address, err := PreAuthTxAddress(preAuthTx)
+	if err != nil {
+		return errors.Wrap(err, "invalid pre-authorized transaction")
+	}
+
+	found := false
+	for _, op := range signerTx.Operations {
+		so, ok := op.(*SetOptions)
+		if ok && so.Signer != nil && so.Signer.Address == address && so.Signer.Weight > 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.Errorf("signer transaction doesn't add signer %s", address)
+	}
+
+	signerSource := signerTx.xdrTransaction.SourceAccount.Address()
+	preAuthSource := preAuthTx.xdrTransaction.SourceAccount.Address()
+	if signerSource == preAuthSource && preAuthTx.xdrTransaction.SeqNum <= signerTx.xdrTransaction.SeqNum {
+		return errors.Errorf(
+			"pre-authorized transaction sequence number %d must be greater than %d",
+			preAuthTx.xdrTransaction.SeqNum,
+			signerTx.xdrTransaction.SeqNum,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package txnbuild
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/strkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreAuthTx(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+
+	// The pre-authorized transaction is applied after the one adding its signer
+	preAuthSource := NewSimpleAccount(kp0.Address(), int64(9605939170639898))
+	preAuthTx := Transaction{
+		SourceAccount: &preAuthSource,
+		Operations:    []Operation{&Payment{Destination: kp1.Address(), Amount: "10", Asset: NativeAsset{}}},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, preAuthTx.Build())
+
+	signer, err := NewPreAuthTxSigner(&preAuthTx, 1)
+	require.NoError(t, err)
+	hash, err := preAuthTx.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, strkey.MustEncode(strkey.VersionByteHashTx, hash[:]), signer.Address)
+	assert.Equal(t, Threshold(1), signer.Weight)
+
+	signerSource := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	signerTx := Transaction{
+		SourceAccount: &signerSource,
+		Operations:    []Operation{&SetOptions{Signer: signer}},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, signerTx.Build())
+	assert.NoError(t, ValidatePreAuthTx(&signerTx, &preAuthTx))
+
+	// The pre-authorized transaction is submitted without signatures and can be
+	// parsed back
+	txeB64, err := preAuthTx.Base64()
+	require.NoError(t, err)
+	parsed, err := TransactionFromXDR(txeB64)
+	require.NoError(t, err)
+	parsed.Network = network.TestNetworkPassphrase
+	address, err := PreAuthTxAddress(&parsed)
+	require.NoError(t, err)
+	assert.Equal(t, signer.Address, address)
+	assert.Empty(t, parsed.TxEnvelope().Signatures)
+
+	// Using the same sequence number as the transaction adding the signer
+	sameSeqSource := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	sameSeqTx := Transaction{
+		SourceAccount: &sameSeqSource,
+		Operations:    []Operation{&Payment{Destination: kp1.Address(), Amount: "10", Asset: NativeAsset{}}},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, sameSeqTx.Build())
+	sameSeqSigner, err := NewPreAuthTxSigner(&sameSeqTx, 1)
+	require.NoError(t, err)
+	signerSource = NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	signerTx.Operations = []Operation{&SetOptions{Signer: sameSeqSigner}}
+	require.NoError(t, signerTx.Build())
+	err = ValidatePreAuthTx(&signerTx, &sameSeqTx)
+	assert.EqualError(t, err, "pre-authorized transaction sequence number 9605939170639898 must be greater than 9605939170639898")
+
+	// A later sequence number, left for the transactions submitted in between
+	laterSource := NewSimpleAccount(kp0.Address(), int64(9605939170639900))
+	laterTx := Transaction{
+		SourceAccount: &laterSource,
+		Operations:    []Operation{&Payment{Destination: kp1.Address(), Amount: "10", Asset: NativeAsset{}}},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, laterTx.Build())
+	laterSigner, err := NewPreAuthTxSigner(&laterTx, 1)
+	require.NoError(t, err)
+	signerSource = NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	signerTx.Operations = []Operation{&SetOptions{Signer: laterSigner}}
+	require.NoError(t, signerTx.Build())
+	assert.NoError(t, ValidatePreAuthTx(&signerTx, &laterTx))
+
+	// The signer transaction doesn't add the pre-authorized transaction signer
+	err = ValidatePreAuthTx(&signerTx, &preAuthTx)
+	assert.EqualError(t, err, "signer transaction doesn't add signer "+signer.Address)
+
+	// Transactions must be built
+	err = ValidatePreAuthTx(&Transaction{}, &preAuthTx)
+	assert.EqualError(t, err, "signer transaction has not been built")
+	err = ValidatePreAuthTx(&signerTx, &Transaction{})
+	assert.EqualError(t, err, "invalid pre-authorized transaction: transaction has not been built")
+}
+
+func TestHashXSigner(t *testing.T) {
+	preimage := []byte("this is a preimage for hashx transactions on the stellar network")
+
+	signer, err := NewHashXSigner(preimage, 2)
+	require.NoError(t, err)
+	hash := sha256.Sum256(preimage)
+	assert.Equal(t, strkey.MustEncode(strkey.VersionByteHashX, hash[:]), signer.Address)
+	assert.Equal(t, Threshold(2), signer.Weight)
+
+	// The signature hint matches the signer
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&SetOptions{Signer: signer}},
+		Timebounds:    NewInfiniteTimeout(),
+		Network:       network.TestNetworkPassphrase,
+	}
+	require.NoError(t, tx.Build())
+	require.NoError(t, tx.SignHashX(preimage))
+	signatures := tx.TxEnvelope().Signatures
+	require.Len(t, signatures, 1)
+	assert.Equal(t, hash[28:], signatures[0].Hint[:])
+
+	_, err = NewHashXSigner(make([]byte, 65), 1)
+	assert.EqualError(t, err, "preimage cannnot be more than 64 bytes")
+}
+
+func TestSetOptionsInvalidSigner(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), int64(9605939170639897))
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations: []Operation{&SetOptions{Signer: &Signer{
+			Address: "SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R",
+			Weight:  1,
+		}}},
+		Timebounds: NewInfiniteTimeout(),
+		Network:    network.TestNetworkPassphrase,
+	}
+	err := tx.Build()
+	assert.EqualError(t, err, "validation failed for *txnbuild.SetOptions operation: Field: Signer, Error: SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R is not a valid signer key")
+}
@@ -40,6 +40,7 @@ type Transaction struct {
 	SourceAccount  Account
 	Operations     []Operation
 	BaseFee        uint32
+	FeeStrategy    FeeStrategy
 	Memo           Memo
 	Timebounds     Timebounds
 	Network        string
@@ -119,6 +120,15 @@ func (tx *Transaction) Build() error {
 	// Set account ID in XDR
 	tx.xdrTransaction.SourceAccount.SetAddress(accountID)
 
+	// Pick the base fee from the fee strategy, if it hasn't been set yet, before
+	// consuming a sequence number
+	if tx.BaseFee == 0 && tx.FeeStrategy != nil {
+		tx.BaseFee, err = tx.FeeStrategy.BaseFee()
+		if err != nil {
+			return errors.Wrap(err, "couldn't pick base fee")
+		}
+	}
+
 	// Action needed in release: horizonclient-v2.0.0
 	// Validate Seq Num is present in struct. Requires Account.GetSequenceNumber (v.2.0.0)
 	seqnum, err := tx.SourceAccount.IncrementSequenceNumber()
@@ -127,7 +137,21 @@ func (tx *Transaction) Build() error {
 	}
 	tx.xdrTransaction.SeqNum = seqnum
 
-	for _, op := range tx.Operations {
+	// Muxed account destinations are sent to the account with a memo ID
+	operations, muxedID, err := demuxOperations(tx.Operations)
+	if err != nil {
+		return err
+	}
+
+	memo := tx.Memo
+	if muxedID != nil {
+		memo, err = muxedMemo(memo, *muxedID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, op := range operations {
 		if verr := op.Validate(); verr != nil {
 			return errors.Wrap(verr, fmt.Sprintf("validation failed for %T operation", op))
 		}
@@ -148,8 +172,8 @@ func (tx *Transaction) Build() error {
 		MaxTime: xdr.TimePoint(tx.Timebounds.MaxTime)}
 
 	// Handle the memo, if one is present
-	if tx.Memo != nil {
-		xdrMemo, err := tx.Memo.ToXDR()
+	if memo != nil {
+		xdrMemo, err := memo.ToXDR()
 		if err != nil {
 			return errors.Wrap(err, "couldn't build memo XDR")
 		}
@@ -0,0 +1,334 @@
+package xdr
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// opaqueChunkSize is the size of the chunks variable length opaque values
+// larger than it are read in, so that a corrupted length doesn't cause a huge
+// allocation before running out of input.
+const opaqueChunkSize = 64 * 1024
+
+// arrayPreallocLen is the maximum number of elements allocated upfront when
+// decoding a variable length array, for the same reason.
+const arrayPreallocLen = 1024
+
+// EncoderTo is implemented by the XDR types of this package. EncodeTo writes the
+// XDR encoding of the value to the Encoder, producing the same bytes as
+// `Marshal` without using reflection.
+type EncoderTo interface {
+	EncodeTo(e *Encoder) error
+}
+
+// DecoderFrom is implemented by pointers to the XDR types of this package.
+// DecodeFrom reads the value from the Decoder, accepting the same input as
+// `Unmarshal` without using reflection. All the fields of the value are
+// overwritten, pointers and slices are freshly allocated.
+type DecoderFrom interface {
+	DecodeFrom(d *Decoder) error
+}
+
+// Encoder writes XDR primitives to an io.Writer. It's used by the generated
+// `EncodeTo` methods.
+type Encoder struct {
+	w       io.Writer
+	n       int
+	scratch [8]byte
+}
+
+// NewEncoder returns an Encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Reset makes the Encoder write to w and resets its byte count, so that the
+// Encoder can be reused.
+func (e *Encoder) Reset(w io.Writer) {
+	e.w = w
+	e.n = 0
+}
+
+// Count returns the number of bytes written since the Encoder was created or
+// reset.
+func (e *Encoder) Count() int {
+	return e.n
+}
+
+func (e *Encoder) write(b []byte) error {
+	n, err := e.w.Write(b)
+	e.n += n
+	return err
+}
+
+// EncodeInt encodes a 32-bit signed integer.
+func (e *Encoder) EncodeInt(v int32) error {
+	return e.EncodeUint(uint32(v))
+}
+
+// EncodeUint encodes a 32-bit unsigned integer.
+func (e *Encoder) EncodeUint(v uint32) error {
+	binary.BigEndian.PutUint32(e.scratch[:4], v)
+	return e.write(e.scratch[:4])
+}
+
+// EncodeHyper encodes a 64-bit signed integer.
+func (e *Encoder) EncodeHyper(v int64) error {
+	return e.EncodeUhyper(uint64(v))
+}
+
+// EncodeUhyper encodes a 64-bit unsigned integer.
+func (e *Encoder) EncodeUhyper(v uint64) error {
+	binary.BigEndian.PutUint64(e.scratch[:8], v)
+	return e.write(e.scratch[:8])
+}
+
+// EncodeBool encodes a boolean as 0 or 1.
+func (e *Encoder) EncodeBool(v bool) error {
+	if v {
+		return e.EncodeUint(1)
+	}
+	return e.EncodeUint(0)
+}
+
+// EncodeFixedOpaque encodes a fixed length opaque value, padded to a multiple
+// of 4 bytes.
+func (e *Encoder) EncodeFixedOpaque(v []byte) error {
+	err := e.write(v)
+	if err != nil {
+		return err
+	}
+	return e.pad(len(v))
+}
+
+// EncodeOpaque encodes a variable length opaque value. max is the maximum
+// length of the value, 0 meaning unlimited.
+func (e *Encoder) EncodeOpaque(v []byte, max int) error {
+	err := e.EncodeArrayLen(len(v), max)
+	if err != nil {
+		return err
+	}
+	return e.EncodeFixedOpaque(v)
+}
+
+// EncodeString encodes a string. max is the maximum length of the string, 0
+// meaning unlimited.
+func (e *Encoder) EncodeString(v string, max int) error {
+	err := e.EncodeArrayLen(len(v), max)
+	if err != nil {
+		return err
+	}
+	err = e.write([]byte(v))
+	if err != nil {
+		return err
+	}
+	return e.pad(len(v))
+}
+
+// EncodeArrayLen encodes the length of a variable length value. max is the
+// maximum length, 0 meaning unlimited.
+func (e *Encoder) EncodeArrayLen(l, max int) error {
+	if max > 0 && l > max {
+		return errors.Errorf("length %d exceeds max size %d", l, max)
+	}
+	if uint64(l) > uint64(^uint32(0)) {
+		return errors.Errorf("length %d is too large", l)
+	}
+	return e.EncodeUint(uint32(l))
+}
+
+func (e *Encoder) pad(l int) error {
+	if l%4 == 0 {
+		return nil
+	}
+	e.scratch = [8]byte{}
+	return e.write(e.scratch[:4-l%4])
+}
+
+// Decoder reads XDR primitives from an io.Reader. It's used by the generated
+// `DecodeFrom` methods. A Decoder can be reused with Reset to decode many values
+// without allocating a new one.
+type Decoder struct {
+	r       io.Reader
+	n       int
+	scratch [8]byte
+}
+
+// NewDecoder returns a Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Reset makes the Decoder read from r and resets its byte count.
+func (d *Decoder) Reset(r io.Reader) {
+	d.r = r
+	d.n = 0
+}
+
+// Count returns the number of bytes read since the Decoder was created or
+// reset.
+func (d *Decoder) Count() int {
+	return d.n
+}
+
+func (d *Decoder) read(b []byte) error {
+	n, err := io.ReadFull(d.r, b)
+	d.n += n
+	return err
+}
+
+// DecodeInt decodes a 32-bit signed integer.
+func (d *Decoder) DecodeInt() (int32, error) {
+	v, err := d.DecodeUint()
+	return int32(v), err
+}
+
+// DecodeUint decodes a 32-bit unsigned integer.
+func (d *Decoder) DecodeUint() (uint32, error) {
+	err := d.read(d.scratch[:4])
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(d.scratch[:4]), nil
+}
+
+// DecodeHyper decodes a 64-bit signed integer.
+func (d *Decoder) DecodeHyper() (int64, error) {
+	v, err := d.DecodeUhyper()
+	return int64(v), err
+}
+
+// DecodeUhyper decodes a 64-bit unsigned integer.
+func (d *Decoder) DecodeUhyper() (uint64, error) {
+	err := d.read(d.scratch[:8])
+	if err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(d.scratch[:8]), nil
+}
+
+// DecodeBool decodes a boolean, failing on values other than 0 and 1.
+func (d *Decoder) DecodeBool() (bool, error) {
+	v, err := d.DecodeUint()
+	if err != nil {
+		return false, err
+	}
+	switch v {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	}
+	return false, errors.Errorf("invalid bool value %d", v)
+}
+
+// DecodeFixedOpaque decodes a fixed length opaque value into dst, which must
+// have the length of the value.
+func (d *Decoder) DecodeFixedOpaque(dst []byte) error {
+	err := d.read(dst)
+	if err != nil {
+		return err
+	}
+	return d.skipPad(len(dst))
+}
+
+// DecodeOpaque decodes a variable length opaque value. max is the maximum
+// length of the value, 0 meaning unlimited. An empty value is returned as nil.
+func (d *Decoder) DecodeOpaque(max int) ([]byte, error) {
+	l, err := d.DecodeArrayLen(max)
+	if err != nil {
+		return nil, err
+	}
+	if l == 0 {
+		return nil, nil
+	}
+
+	if l <= opaqueChunkSize {
+		result := make([]byte, l)
+		return result, d.DecodeFixedOpaque(result)
+	}
+
+	// Large values are read in chunks, see opaqueChunkSize
+	var result []byte
+	for len(result) < l {
+		chunk := l - len(result)
+		if chunk > opaqueChunkSize {
+			chunk = opaqueChunkSize
+		}
+		start := len(result)
+		result = append(result, make([]byte, chunk)...)
+		err = d.read(result[start:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, d.skipPad(l)
+}
+
+// DecodeString decodes a string. max is the maximum length of the string, 0
+// meaning unlimited.
+func (d *Decoder) DecodeString(max int) (string, error) {
+	b, err := d.DecodeOpaque(max)
+	return string(b), err
+}
+
+// DecodeArrayLen decodes the length of a variable length value. max is the
+// maximum length, 0 meaning unlimited.
+func (d *Decoder) DecodeArrayLen(max int) (int, error) {
+	l, err := d.DecodeUint()
+	if err != nil {
+		return 0, err
+	}
+	if max > 0 && uint64(l) > uint64(max) {
+		return 0, errors.Errorf("length %d exceeds max size %d", l, max)
+	}
+	if uint64(l) > uint64(^uint(0)>>1) {
+		return 0, errors.Errorf("length %d is too large", l)
+	}
+	return int(l), nil
+}
+
+// ArrayPreallocLen returns the capacity to allocate for a variable length
+// array of length l, see arrayPreallocLen.
+func (d *Decoder) ArrayPreallocLen(l int) int {
+	if l > arrayPreallocLen {
+		return arrayPreallocLen
+	}
+	return l
+}
+
+// skipPad reads the padding of a value of length l, which must be zeros.
+func (d *Decoder) skipPad(l int) error {
+	if l%4 == 0 {
+		return nil
+	}
+	pad := d.scratch[:4-l%4]
+	if err := d.read(pad); err != nil {
+		return err
+	}
+	for _, b := range pad {
+		if b != 0 {
+			return errors.Errorf("non-zero padding %x", pad)
+		}
+	}
+	return nil
+}
+
+// errInvalidEnum returns the error for an invalid value v of the enum typ.
+func errInvalidEnum(typ string, v int32) error {
+	return fmt.Errorf("'%d' is not a valid %s enum value", v, typ)
+}
+
+// errInvalidUnion returns the error for an invalid switch value v of the
+// union typ.
+func errInvalidUnion(typ string, v int32) error {
+	return fmt.Errorf("invalid union switch %d for %s", v, typ)
+}
+
+// errNilArm returns the error for encoding the union typ whose arm is nil.
+func errNilArm(typ, arm string) error {
+	return fmt.Errorf("union arm %s of %s is nil", arm, typ)
+}
@@ -0,0 +1,407 @@
+package xdr_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	. "github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// codecTypes are the types whose generated codec is compared with the
+// reflection based one. They reference almost all the other types.
+var codecTypes = []interface{}{
+	AuthenticatedMessage{},
+	BucketEntry{},
+	LedgerEntry{},
+	LedgerEntryChanges{},
+	LedgerHeaderHistoryEntry{},
+	LedgerKey{},
+	LedgerScpMessages{},
+	ScpHistoryEntry{},
+	TransactionEnvelope{},
+	TransactionHistoryEntry{},
+	TransactionHistoryResultEntry{},
+	TransactionMeta{},
+	TransactionResult{},
+	TransactionResultPair{},
+	TransactionSet{},
+}
+
+// codecFixtures are encoded values from the network.
+var codecFixtures = []struct {
+	Value  interface{}
+	Base64 string
+}{
+	{
+		&TransactionEnvelope{},
+		"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAACgAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAEAKZ7IPj/46PuWU6ZOtyMosctNAkXRNX9WCAI5RnfRk+AyxDLoDZP/9l3NvsxQtWj9juQOuoBlFLnWu8intgxQA",
+	},
+	{
+		&TransactionResult{},
+		"AAAAAAAAAGQAAAAAAAAAAQAAAAAAAAABAAAAAAAAAAA=",
+	},
+	{
+		&TransactionMeta{},
+		"AAAAAAAAAAEAAAACAAAAAAAAAAMAAAAAAAAAAK6jei3jmoI8TGlD/egc37PXtHKKzWV8wViZBaCu5L5MAAAAADuaygAAAAADAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAMAAAAAAAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3DeC2s6dj/9YAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAA",
+	},
+}
+
+func TestCodecFixtures(t *testing.T) {
+	for _, fixture := range codecFixtures {
+		raw, err := base64.StdEncoding.DecodeString(fixture.Base64)
+		require.NoError(t, err)
+
+		reflected := reflect.New(reflect.TypeOf(fixture.Value).Elem()).Interface()
+		_, err = Unmarshal(bytes.NewReader(raw), reflected)
+		require.NoError(t, err)
+
+		decoder := NewDecoder(bytes.NewReader(raw))
+		generated := fixture.Value.(DecoderFrom)
+		require.NoError(t, generated.DecodeFrom(decoder))
+		assert.Equal(t, len(raw), decoder.Count())
+		assert.Equal(t, reflected, generated)
+
+		var encoded bytes.Buffer
+		require.NoError(t, generated.(EncoderTo).EncodeTo(NewEncoder(&encoded)))
+		assert.Equal(t, raw, encoded.Bytes())
+	}
+}
+
+// TestCodecRoundTrip checks that random values are encoded to the same bytes
+// by both codecs, and that decoding these bytes gives the same values.
+func TestCodecRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, value := range codecTypes {
+		typ := reflect.TypeOf(value)
+		for i := 0; i < 200; i++ {
+			v := randomValue(t, r, typ, 0, 0)
+			raw := assertSameEncoding(t, v.Interface())
+			assertSameDecoding(t, typ, raw)
+		}
+	}
+}
+
+// TestCodecFuzz checks that the inputs built by mutating valid encodings which
+// are accepted by the generated codec are decoded to the same values by both
+// codecs.
+func TestCodecFuzz(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	for _, value := range codecTypes {
+		typ := reflect.TypeOf(value)
+		for i := 0; i < 200; i++ {
+			v := randomValue(t, r, typ, 0, 0)
+			var buf bytes.Buffer
+			_, err := Marshal(&buf, v.Interface())
+			require.NoError(t, err)
+
+			assertSameMutatedDecoding(t, typ, mutate(r, buf.Bytes()))
+		}
+	}
+}
+
+func TestCodecInvalidValues(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+
+	err := AssetType(42).EncodeTo(e)
+	assert.EqualError(t, err, "'42' is not a valid AssetType enum value")
+
+	err = Asset{Type: AssetTypeAssetTypeCreditAlphanum4}.EncodeTo(e)
+	assert.EqualError(t, err, "union arm AlphaNum4 of Asset is nil")
+
+	err = String32("this string is longer than 32 bytes").EncodeTo(e)
+	assert.EqualError(t, err, "length 35 exceeds max size 32")
+
+	var asset Asset
+	err = asset.DecodeFrom(NewDecoder(bytes.NewReader([]byte{0, 0, 0, 42})))
+	assert.EqualError(t, err, "'42' is not a valid AssetType enum value")
+
+	var meta TransactionMeta
+	err = meta.DecodeFrom(NewDecoder(bytes.NewReader([]byte{0, 0, 0, 2})))
+	assert.EqualError(t, err, "invalid union switch 2 for TransactionMeta")
+
+	var entry AccountEntry
+	err = entry.DecodeFrom(NewDecoder(bytes.NewReader([]byte{0, 0})))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	var name String64
+	err = name.DecodeFrom(NewDecoder(bytes.NewReader([]byte{0, 0, 0, 1, 'a', 0, 0, 1})))
+	assert.EqualError(t, err, "non-zero padding 000001")
+}
+
+func TestCodecReuse(t *testing.T) {
+	first := TransactionMeta{
+		V: 1,
+		V1: &TransactionMetaV1{
+			TxChanges: LedgerEntryChanges{
+				{
+					Type:    LedgerEntryChangeTypeLedgerEntryRemoved,
+					Removed: &LedgerKey{Type: LedgerEntryTypeData, Data: &LedgerKeyData{AccountId: MustAddress("GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"), DataName: "a"}},
+				},
+			},
+		},
+	}
+	second := TransactionMeta{V: 0, Operations: &[]OperationMeta{}}
+
+	var buf bytes.Buffer
+	require.NoError(t, first.EncodeTo(NewEncoder(&buf)))
+	require.NoError(t, second.EncodeTo(NewEncoder(&buf)))
+
+	var meta TransactionMeta
+	d := NewDecoder(&buf)
+	require.NoError(t, meta.DecodeFrom(d))
+	v1 := meta.V1
+	assert.Equal(t, first, meta)
+
+	// Decoding into the same value doesn't modify what was decoded before
+	require.NoError(t, meta.DecodeFrom(d))
+	assert.Equal(t, TransactionMeta{V: 0, Operations: new([]OperationMeta)}, meta)
+	assert.Equal(t, first.V1, v1)
+}
+
+func BenchmarkDecodeReflection(b *testing.B) {
+	raw, _ := base64.StdEncoding.DecodeString(codecFixtures[0].Base64)
+	r := bytes.NewReader(raw)
+	for i := 0; i < b.N; i++ {
+		var tx TransactionEnvelope
+		r.Reset(raw)
+		_, _ = Unmarshal(r, &tx)
+	}
+}
+
+func BenchmarkDecodeGenerated(b *testing.B) {
+	raw, _ := base64.StdEncoding.DecodeString(codecFixtures[0].Base64)
+	r := bytes.NewReader(raw)
+	d := NewDecoder(r)
+	for i := 0; i < b.N; i++ {
+		var tx TransactionEnvelope
+		r.Reset(raw)
+		d.Reset(r)
+		_ = tx.DecodeFrom(d)
+	}
+}
+
+func assertSameEncoding(t *testing.T, v interface{}) []byte {
+	var reflected, generated bytes.Buffer
+
+	n, err := Marshal(&reflected, v)
+	require.NoError(t, err)
+	assert.Equal(t, reflected.Len(), n)
+
+	e := NewEncoder(&generated)
+	require.NoError(t, v.(EncoderTo).EncodeTo(e))
+	assert.Equal(t, generated.Len(), e.Count())
+
+	require.Equal(t, reflected.Bytes(), generated.Bytes(), "%T", v)
+	return reflected.Bytes()
+}
+
+func assertSameDecoding(t *testing.T, typ reflect.Type, raw []byte) {
+	reflected := reflect.New(typ).Interface()
+	n, reflectedErr := Unmarshal(bytes.NewReader(raw), reflected)
+
+	generated := reflect.New(typ).Interface()
+	d := NewDecoder(bytes.NewReader(raw))
+	generatedErr := generated.(DecoderFrom).DecodeFrom(d)
+
+	if reflectedErr != nil {
+		require.Error(t, generatedErr, "%s: %x", typ, raw)
+		return
+	}
+	require.NoError(t, generatedErr, "%s: %x", typ, raw)
+	require.Equal(t, n, d.Count())
+	require.Equal(t, reflected, generated)
+
+	assertSameEncoding(t, reflect.ValueOf(generated).Elem().Interface())
+}
+
+// assertSameMutatedDecoding works like assertSameDecoding for inputs which may
+// be invalid. The reflection based codec allocates arrays of the length it
+// reads before decoding their elements, so it can't be given corrupt lengths.
+// The generated codec only accepts lengths backed by the elements of the input,
+// so the reflection based one is only compared with it on the inputs it
+// accepts.
+func assertSameMutatedDecoding(t *testing.T, typ reflect.Type, raw []byte) {
+	generated := reflect.New(typ).Interface()
+	d := NewDecoder(bytes.NewReader(raw))
+	if err := generated.(DecoderFrom).DecodeFrom(d); err != nil {
+		require.True(t, d.Count() <= len(raw))
+		return
+	}
+
+	assertSameDecoding(t, typ, raw[:d.Count()])
+}
+
+func mutate(r *rand.Rand, raw []byte) []byte {
+	result := append([]byte{}, raw...)
+	if len(result) == 0 {
+		return result
+	}
+
+	switch r.Intn(4) {
+	case 0:
+		// truncate
+		return result[:r.Intn(len(result))]
+	case 1:
+		// set a 32-bit word to a small value, like lengths and discriminants
+		i := r.Intn(len(result)) &^ 3
+		if i+4 <= len(result) {
+			copy(result[i:], []byte{0, 0, 0, byte(r.Intn(8))})
+		}
+	default:
+		for n := r.Intn(3) + 1; n > 0; n-- {
+			result[r.Intn(len(result))] ^= byte(1 << uint(r.Intn(8)))
+		}
+	}
+	return result
+}
+
+var (
+	enumType  = reflect.TypeOf((*interface{ ValidEnum(int32) bool })(nil)).Elem()
+	unionType = reflect.TypeOf((*interface {
+		ArmForSwitch(int32) (string, bool)
+		SwitchFieldName() string
+	})(nil)).Elem()
+	sizedType = reflect.TypeOf((*interface{ XDRMaxSize() int })(nil)).Elem()
+)
+
+// randomValue returns a random valid value of typ. max is the maximum size of
+// variable length values, 0 meaning unlimited.
+func randomValue(t *testing.T, r *rand.Rand, typ reflect.Type, max, depth int) reflect.Value {
+	v := reflect.New(typ).Elem()
+
+	if typ.Implements(sizedType) && (typ.Kind() == reflect.String || typ.Kind() == reflect.Slice) {
+		max = v.Interface().(interface{ XDRMaxSize() int }).XDRMaxSize()
+	}
+
+	switch {
+	case typ.Implements(enumType) && typ.Kind() == reflect.Int32:
+		values := validSwitchValues(v, nil)
+		require.NotEmpty(t, values, "no valid value for %s", typ)
+		v.SetInt(int64(values[r.Intn(len(values))]))
+		return v
+	case typ.Implements(unionType) && typ.Kind() == reflect.Struct:
+		return randomUnion(t, r, v, depth)
+	}
+
+	length := func(limit int) int {
+		if max > 0 && max < limit {
+			limit = max
+		}
+		if depth > 4 {
+			return 0
+		}
+		return r.Intn(limit + 1)
+	}
+
+	switch typ.Kind() {
+	case reflect.Int32, reflect.Int64:
+		v.SetInt(r.Int63() - r.Int63())
+	case reflect.Uint32, reflect.Uint64:
+		v.SetUint(r.Uint64())
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.String:
+		b := make([]byte, length(20))
+		for i := range b {
+			b[i] = byte('a' + r.Intn(26))
+		}
+		v.SetString(string(b))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			v.Index(i).Set(randomValue(t, r, typ.Elem(), 0, depth+1))
+		}
+	case reflect.Slice:
+		l := length(3)
+		if typ.Elem().Kind() == reflect.Uint8 {
+			l = length(40)
+		}
+		if l > 0 {
+			v.Set(reflect.MakeSlice(typ, l, l))
+			for i := 0; i < l; i++ {
+				v.Index(i).Set(randomValue(t, r, typ.Elem(), 0, depth+1))
+			}
+		}
+	case reflect.Uint8:
+		v.SetUint(uint64(r.Intn(256)))
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			fieldMax, _ := strconv.Atoi(field.Tag.Get("xdrmaxsize"))
+			if field.Type.Kind() == reflect.Ptr {
+				// optional
+				if depth > 4 || r.Intn(2) == 0 {
+					continue
+				}
+				ptr := reflect.New(field.Type.Elem())
+				ptr.Elem().Set(randomValue(t, r, field.Type.Elem(), fieldMax, depth+1))
+				v.Field(i).Set(ptr)
+				continue
+			}
+			v.Field(i).Set(randomValue(t, r, field.Type, fieldMax, depth+1))
+		}
+	default:
+		t.Fatalf("unsupported type %s", typ)
+	}
+
+	return v
+}
+
+func randomUnion(t *testing.T, r *rand.Rand, v reflect.Value, depth int) reflect.Value {
+	u := v.Interface().(interface {
+		ArmForSwitch(int32) (string, bool)
+		SwitchFieldName() string
+	})
+	switchField := v.FieldByName(u.SwitchFieldName())
+
+	values := validSwitchValues(switchField, u.ArmForSwitch)
+	require.NotEmpty(t, values, "no valid switch value for %s", v.Type())
+	sw := values[r.Intn(len(values))]
+	if switchField.Kind() == reflect.Uint32 {
+		switchField.SetUint(uint64(sw))
+	} else {
+		switchField.SetInt(int64(sw))
+	}
+
+	arm, _ := u.ArmForSwitch(sw)
+	if arm == "" {
+		return v
+	}
+
+	field, _ := v.Type().FieldByName(arm)
+	fieldMax, _ := strconv.Atoi(field.Tag.Get("xdrmaxsize"))
+	ptr := reflect.New(field.Type.Elem())
+	ptr.Elem().Set(randomValue(t, r, field.Type.Elem(), fieldMax, depth+1))
+	v.FieldByName(arm).Set(ptr)
+	return v
+}
+
+// validSwitchValues returns the values of the enum or int32 v which are valid,
+// and have an arm when armForSwitch is not nil.
+func validSwitchValues(v reflect.Value, armForSwitch func(int32) (string, bool)) []int32 {
+	var values []int32
+	for i := int32(-100); i <= 100; i++ {
+		if i < 0 && v.Kind() == reflect.Uint32 {
+			continue
+		}
+		if e, ok := v.Interface().(interface{ ValidEnum(int32) bool }); ok && !e.ValidEnum(i) {
+			continue
+		}
+		if armForSwitch != nil {
+			if _, ok := armForSwitch(i); !ok {
+				continue
+			}
+		}
+		values = append(values, i)
+	}
+	return values
+}
@@ -0,0 +1,46 @@
+// +build gofuzz
+
+package xdr
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// Fuzz is the go-fuzz (https://github.com/dvyukov/go-fuzz) entry point
+// comparing the generated decoder with `Unmarshal`. Run it with:
+//
+//	go-fuzz-build github.com/stellar/go/xdr && go-fuzz -bin=xdr-fuzz.zip -workdir=fuzz
+func Fuzz(data []byte) int {
+	var reflected, generated TransactionEnvelope
+
+	n, reflectedErr := Unmarshal(bytes.NewReader(data), &reflected)
+	d := NewDecoder(bytes.NewReader(data))
+	generatedErr := generated.DecodeFrom(d)
+
+	if (reflectedErr == nil) != (generatedErr == nil) {
+		panic(fmt.Sprintf("decoders disagree: %v, %v", reflectedErr, generatedErr))
+	}
+	if reflectedErr != nil {
+		return 0
+	}
+	if n != d.Count() || !reflect.DeepEqual(reflected, generated) {
+		panic("decoded values differ")
+	}
+
+	// The input isn't compared with the encoding as padding bytes are ignored
+	var marshaled, encoded bytes.Buffer
+	_, err := Marshal(&marshaled, reflected)
+	if err != nil {
+		panic(err)
+	}
+	err = generated.EncodeTo(NewEncoder(&encoded))
+	if err != nil {
+		panic(err)
+	}
+	if !bytes.Equal(marshaled.Bytes(), encoded.Bytes()) {
+		panic("encodings differ")
+	}
+	return 1
+}
@@ -0,0 +1,642 @@
+// codecgen generates xdr_codec_generated.go, the non-reflective `EncodeTo` and
+// `DecodeFrom` methods of the types defined in xdr_generated.go, and
+// xdr_json_generated.go, the tables of types and enum values used by the XDR
+// JSON encoding. It's run by `go generate` in the xdr package, after xdrgen
+// regenerated xdr_generated.go:
+//
+//	cd xdr && go generate
+//
+// The generated methods produce and accept the same bytes as `xdr.Marshal` and
+// `xdr.Unmarshal`, following the conventions of the types xdrgen generates:
+// pointer fields of structs are optionals, pointer fields of unions are arms,
+// and the maximum size of variable length values comes from `xdrmaxsize` tags
+// and `XDRMaxSize` methods.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	in := flag.String("in", "xdr_generated.go", "file defining the xdr types")
+	out := flag.String("out", "xdr_codec_generated.go", "codec file to generate")
+	jsonOut := flag.String("json-out", "xdr_json_generated.go", "JSON tables file to generate")
+	flag.Parse()
+
+	src, err := ioutil.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code, tables, err := generate(*in, src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(*out, code, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(*jsonOut, tables, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// typeDef is a type defined in the source file.
+type typeDef struct {
+	name string
+	expr ast.Expr
+}
+
+// generator holds what's known about the source file types.
+type generator struct {
+	buf bytes.Buffer
+
+	defs     []typeDef
+	named    map[string]bool
+	enums    map[string]bool
+	unions   map[string]string
+	maxSizes map[string]int
+	vars     map[string]bool
+
+	nextVar int
+}
+
+// generate returns the codec and JSON tables files generated from the source
+// file.
+func generate(filename string, src []byte) ([]byte, []byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := &generator{
+		named:    map[string]bool{},
+		enums:    map[string]bool{},
+		unions:   map[string]string{},
+		maxSizes: map[string]int{},
+		vars:     map[string]bool{},
+	}
+	err = g.collect(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g.printf("// Code generated by xdr/internal/codecgen from %s. DO NOT EDIT.\n\n", filename)
+	g.printf("package xdr\n")
+
+	for _, def := range g.defs {
+		err = g.typeCodec(def)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", def.name, err)
+		}
+	}
+	code, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g.buf.Reset()
+	err = g.jsonTables(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	tables, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return code, tables, nil
+}
+
+// jsonTables generates the table of the xdr types, by name, and the table of
+// the names of the enum values, reusing the maps xdrgen generates.
+func (g *generator) jsonTables(filename string) error {
+	g.printf("// Code generated by xdr/internal/codecgen from %s. DO NOT EDIT.\n\n", filename)
+	g.printf("package xdr\n\n")
+	g.printf("import \"reflect\"\n\n")
+
+	g.printf("// xdrTypes maps the names of the xdr types to their type.\n")
+	g.printf("var xdrTypes = map[string]reflect.Type{\n")
+	for _, def := range g.defs {
+		g.printf("\t%q: reflect.TypeOf((*%s)(nil)).Elem(),\n", def.name, def.name)
+	}
+	g.printf("}\n\n")
+
+	g.printf("// enumNames maps the enum types to the names of their values.\n")
+	g.printf("var enumNames = map[reflect.Type]map[int32]string{\n")
+	for _, def := range g.defs {
+		if !g.enums[def.name] {
+			continue
+		}
+		mapName := strings.ToLower(def.name[:1]) + def.name[1:] + "Map"
+		if !g.vars[mapName] {
+			return fmt.Errorf("%s: map %s not found", def.name, mapName)
+		}
+		g.printf("\treflect.TypeOf((*%s)(nil)).Elem(): %s,\n", def.name, mapName)
+	}
+	g.printf("}\n")
+	return nil
+}
+
+// collect finds the types defined in file and the methods telling their kind
+// and maximum size.
+func (g *generator) collect(file *ast.File) error {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.VAR {
+				for _, spec := range decl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						g.vars[name.Name] = true
+					}
+				}
+				continue
+			}
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				ts := spec.(*ast.TypeSpec)
+				g.defs = append(g.defs, typeDef{name: ts.Name.Name, expr: ts.Type})
+				g.named[ts.Name.Name] = true
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) != 1 {
+				continue
+			}
+			recv := types.ExprString(decl.Recv.List[0].Type)
+			switch decl.Name.Name {
+			case "ValidEnum":
+				g.enums[recv] = true
+			case "SwitchFieldName":
+				if lit, ok := returnedLiteral(decl); ok {
+					name, err := strconv.Unquote(lit)
+					if err != nil {
+						return err
+					}
+					g.unions[recv] = name
+				}
+			case "XDRMaxSize":
+				if lit, ok := returnedLiteral(decl); ok {
+					max, err := strconv.Atoi(lit)
+					if err != nil {
+						return err
+					}
+					g.maxSizes[recv] = max
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// returnedLiteral returns the literal returned by a function made of a single
+// return statement.
+func returnedLiteral(decl *ast.FuncDecl) (string, bool) {
+	if decl.Body == nil || len(decl.Body.List) != 1 {
+		return "", false
+	}
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok {
+		return "", false
+	}
+	return lit.Value, true
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) newVar(prefix string) string {
+	g.nextVar++
+	return fmt.Sprintf("%s%d", prefix, g.nextVar)
+}
+
+// typeCodec generates the methods of def.
+func (g *generator) typeCodec(def typeDef) error {
+	g.nextVar = 0
+
+	switch expr := def.expr.(type) {
+	case *ast.StructType:
+		if switchField, ok := g.unions[def.name]; ok {
+			return g.unionCodec(def.name, expr, switchField)
+		}
+		return g.structCodec(def.name, expr)
+	case *ast.Ident:
+		if g.enums[def.name] {
+			g.enumCodec(def.name)
+			return nil
+		}
+		if g.named[expr.Name] {
+			g.aliasCodec(def.name, expr.Name)
+			return nil
+		}
+	}
+
+	return g.typedefCodec(def.name, def.expr)
+}
+
+func (g *generator) assertions(name string) {
+	g.printf("var (\n")
+	g.printf("\t_ EncoderTo   = (*%s)(nil)\n", name)
+	g.printf("\t_ DecoderFrom = (*%s)(nil)\n", name)
+	g.printf(")\n\n")
+}
+
+func (g *generator) enumCodec(name string) {
+	g.printf("\n// EncodeTo encodes this value using the Encoder.\n")
+	g.printf("func (e %s) EncodeTo(enc *Encoder) error {\n", name)
+	g.printf("\tif !e.ValidEnum(int32(e)) {\n")
+	g.printf("\t\treturn errInvalidEnum(%q, int32(e))\n", name)
+	g.printf("\t}\n")
+	g.printf("\treturn enc.EncodeInt(int32(e))\n")
+	g.printf("}\n\n")
+
+	g.printf("// DecodeFrom decodes this value using the Decoder.\n")
+	g.printf("func (e *%s) DecodeFrom(dec *Decoder) error {\n", name)
+	g.printf("\tv, err := dec.DecodeInt()\n")
+	g.printf("\tif err != nil {\n\t\treturn err\n\t}\n")
+	g.printf("\tif !e.ValidEnum(v) {\n")
+	g.printf("\t\treturn errInvalidEnum(%q, v)\n", name)
+	g.printf("\t}\n")
+	g.printf("\t*e = %s(v)\n", name)
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.assertions(name)
+}
+
+// aliasCodec generates the methods of a type defined as another xdr type, by
+// converting to it.
+func (g *generator) aliasCodec(name, target string) {
+	g.printf("\n// EncodeTo encodes this value using the Encoder.\n")
+	g.printf("func (s %s) EncodeTo(enc *Encoder) error {\n", name)
+	g.printf("\treturn %s(s).EncodeTo(enc)\n", target)
+	g.printf("}\n\n")
+
+	g.printf("// DecodeFrom decodes this value using the Decoder.\n")
+	g.printf("func (s *%s) DecodeFrom(dec *Decoder) error {\n", name)
+	g.printf("\treturn (*%s)(s).DecodeFrom(dec)\n", target)
+	g.printf("}\n\n")
+
+	g.assertions(name)
+}
+
+func (g *generator) typedefCodec(name string, expr ast.Expr) error {
+	max := g.maxSizes[name]
+
+	g.printf("\n// EncodeTo encodes this value using the Encoder.\n")
+	g.printf("func (s %s) EncodeTo(enc *Encoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	err := g.encode("s", expr, max)
+	if err != nil {
+		return err
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.printf("// DecodeFrom decodes this value using the Decoder.\n")
+	g.printf("func (s *%s) DecodeFrom(dec *Decoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	err = g.decode("(*s)", expr, name, max)
+	if err != nil {
+		return err
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.assertions(name)
+	return nil
+}
+
+func (g *generator) structCodec(name string, expr *ast.StructType) error {
+	g.printf("\n// EncodeTo encodes this value using the Encoder.\n")
+	g.printf("func (s %s) EncodeTo(enc *Encoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	for _, field := range expr.Fields.List {
+		max, err := fieldMaxSize(field)
+		if err != nil {
+			return err
+		}
+		for _, fieldName := range field.Names {
+			err = g.encode("s."+fieldName.Name, field.Type, max)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.printf("// DecodeFrom decodes this value using the Decoder.\n")
+	g.printf("func (s *%s) DecodeFrom(dec *Decoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	for _, field := range expr.Fields.List {
+		max, err := fieldMaxSize(field)
+		if err != nil {
+			return err
+		}
+		for _, fieldName := range field.Names {
+			err = g.decode("s."+fieldName.Name, field.Type, types.ExprString(field.Type), max)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.assertions(name)
+	return nil
+}
+
+func (g *generator) unionCodec(name string, expr *ast.StructType, switchField string) error {
+	var switchType ast.Expr
+	var arms []*ast.Field
+	for _, field := range expr.Fields.List {
+		if len(field.Names) != 1 {
+			return fmt.Errorf("unexpected union field %v", field.Names)
+		}
+		if field.Names[0].Name == switchField {
+			switchType = field.Type
+			continue
+		}
+		if _, ok := field.Type.(*ast.StarExpr); !ok {
+			return fmt.Errorf("union arm %s is not a pointer", field.Names[0].Name)
+		}
+		arms = append(arms, field)
+	}
+	if switchType == nil {
+		return fmt.Errorf("switch field %s not found", switchField)
+	}
+
+	g.printf("\n// EncodeTo encodes this value using the Encoder.\n")
+	g.printf("func (u %s) EncodeTo(enc *Encoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	err := g.encode("u."+switchField, switchType, 0)
+	if err != nil {
+		return err
+	}
+	g.printf("\tarm, ok := u.ArmForSwitch(int32(u.%s))\n", switchField)
+	g.printf("\tif !ok {\n")
+	g.printf("\t\treturn errInvalidUnion(%q, int32(u.%s))\n", name, switchField)
+	g.printf("\t}\n")
+	if len(arms) > 0 {
+		g.printf("\tswitch arm {\n")
+		for _, arm := range arms {
+			armName := arm.Names[0].Name
+			max, err := fieldMaxSize(arm)
+			if err != nil {
+				return err
+			}
+			g.printf("\tcase %q:\n", armName)
+			g.printf("\t\tif u.%s == nil {\n", armName)
+			g.printf("\t\t\treturn errNilArm(%q, %q)\n", name, armName)
+			g.printf("\t\t}\n")
+			err = g.encode("(*u."+armName+")", arm.Type.(*ast.StarExpr).X, max)
+			if err != nil {
+				return err
+			}
+		}
+		g.printf("\t}\n")
+	} else {
+		g.printf("\t_ = arm\n")
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.printf("// DecodeFrom decodes this value using the Decoder.\n")
+	g.printf("func (u *%s) DecodeFrom(dec *Decoder) error {\n", name)
+	g.printf("\tvar err error\n")
+	g.printf("\tvar sw %s\n", types.ExprString(switchType))
+	err = g.decode("sw", switchType, types.ExprString(switchType), 0)
+	if err != nil {
+		return err
+	}
+	g.printf("\tarm, ok := u.ArmForSwitch(int32(sw))\n")
+	g.printf("\tif !ok {\n")
+	g.printf("\t\treturn errInvalidUnion(%q, int32(sw))\n", name)
+	g.printf("\t}\n")
+	g.printf("\t*u = %s{%s: sw}\n", name, switchField)
+	if len(arms) > 0 {
+		g.printf("\tswitch arm {\n")
+		for _, arm := range arms {
+			armName := arm.Names[0].Name
+			armType := arm.Type.(*ast.StarExpr).X
+			max, err := fieldMaxSize(arm)
+			if err != nil {
+				return err
+			}
+			g.printf("\tcase %q:\n", armName)
+			g.printf("\t\tu.%s = new(%s)\n", armName, types.ExprString(armType))
+			err = g.decode("(*u."+armName+")", armType, types.ExprString(armType), max)
+			if err != nil {
+				return err
+			}
+		}
+		g.printf("\t}\n")
+	} else {
+		g.printf("\t_ = arm\n")
+	}
+	g.printf("\treturn nil\n")
+	g.printf("}\n\n")
+
+	g.assertions(name)
+	return nil
+}
+
+func fieldMaxSize(field *ast.Field) (int, error) {
+	if field.Tag == nil {
+		return 0, nil
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return 0, err
+	}
+	value := reflect.StructTag(tag).Get("xdrmaxsize")
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// primitives maps the builtin types to the name of the Encoder and Decoder
+// methods handling them, and the type these methods use.
+var primitives = map[string][2]string{
+	"int32":  {"Int", "int32"},
+	"uint32": {"Uint", "uint32"},
+	"int64":  {"Hyper", "int64"},
+	"uint64": {"Uhyper", "uint64"},
+	"bool":   {"Bool", "bool"},
+}
+
+func (g *generator) checkErr(call string) {
+	g.printf("\tif err = %s; err != nil {\n\t\treturn err\n\t}\n", call)
+}
+
+func (g *generator) assignErr(lhs, call string) {
+	g.printf("\tif %s, err = %s; err != nil {\n\t\treturn err\n\t}\n", lhs, call)
+}
+
+// encode generates the code encoding value, an expression of type expr.
+func (g *generator) encode(value string, expr ast.Expr, max int) error {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if g.named[expr.Name] {
+			g.checkErr(value + ".EncodeTo(enc)")
+			return nil
+		}
+		if expr.Name == "string" {
+			g.checkErr(fmt.Sprintf("enc.EncodeString(string(%s), %d)", value, max))
+			return nil
+		}
+		primitive, ok := primitives[expr.Name]
+		if !ok {
+			return fmt.Errorf("unsupported type %s", expr.Name)
+		}
+		g.checkErr(fmt.Sprintf("enc.Encode%s(%s(%s))", primitive[0], primitive[1], value))
+		return nil
+
+	case *ast.StarExpr:
+		// optional value
+		g.checkErr(fmt.Sprintf("enc.EncodeBool(%s != nil)", value))
+		g.printf("\tif %s != nil {\n", value)
+		err := g.encode("(*"+value+")", expr.X, max)
+		if err != nil {
+			return err
+		}
+		g.printf("\t}\n")
+		return nil
+
+	case *ast.ArrayType:
+		isBytes := isByte(expr.Elt)
+		switch {
+		case expr.Len != nil && isBytes:
+			g.checkErr(fmt.Sprintf("enc.EncodeFixedOpaque(%s[:])", value))
+			return nil
+		case isBytes:
+			g.checkErr(fmt.Sprintf("enc.EncodeOpaque(%s, %d)", value, max))
+			return nil
+		case expr.Len == nil:
+			g.checkErr(fmt.Sprintf("enc.EncodeArrayLen(len(%s), %d)", value, max))
+		}
+		i := g.newVar("i")
+		g.printf("\tfor %s := range %s {\n", i, value)
+		err := g.encode(fmt.Sprintf("%s[%s]", value, i), expr.Elt, 0)
+		if err != nil {
+			return err
+		}
+		g.printf("\t}\n")
+		return nil
+	}
+
+	return fmt.Errorf("unsupported type %s", types.ExprString(expr))
+}
+
+// decode generates the code decoding into target, an addressable expression
+// of type typeName whose definition is expr.
+func (g *generator) decode(target string, expr ast.Expr, typeName string, max int) error {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if g.named[expr.Name] {
+			g.checkErr(target + ".DecodeFrom(dec)")
+			return nil
+		}
+		method, valueType := "String", "string"
+		if expr.Name == "string" {
+			method = fmt.Sprintf("String(%d)", max)
+		} else {
+			primitive, ok := primitives[expr.Name]
+			if !ok {
+				return fmt.Errorf("unsupported type %s", expr.Name)
+			}
+			method, valueType = primitive[0]+"()", primitive[1]
+		}
+		v := g.newVar("v")
+		g.printf("\tvar %s %s\n", v, valueType)
+		g.assignErr(v, fmt.Sprintf("dec.Decode%s", method))
+		g.printf("\t%s = %s(%s)\n", target, typeName, v)
+		return nil
+
+	case *ast.StarExpr:
+		// optional value
+		present := g.newVar("present")
+		g.printf("\tvar %s bool\n", present)
+		g.assignErr(present, "dec.DecodeBool()")
+		g.printf("\t%s = nil\n", target)
+		g.printf("\tif %s {\n", present)
+		g.printf("\t%s = new(%s)\n", target, types.ExprString(expr.X))
+		err := g.decode("(*"+target+")", expr.X, types.ExprString(expr.X), max)
+		if err != nil {
+			return err
+		}
+		g.printf("\t}\n")
+		return nil
+
+	case *ast.ArrayType:
+		isBytes := isByte(expr.Elt)
+		switch {
+		case expr.Len != nil && isBytes:
+			g.checkErr(fmt.Sprintf("dec.DecodeFixedOpaque(%s[:])", target))
+			return nil
+		case isBytes:
+			g.assignErr(target, fmt.Sprintf("dec.DecodeOpaque(%d)", max))
+			return nil
+		case expr.Len != nil:
+			i := g.newVar("i")
+			g.printf("\tfor %s := range %s {\n", i, target)
+			err := g.decode(fmt.Sprintf("%s[%s]", target, i), expr.Elt, types.ExprString(expr.Elt), 0)
+			if err != nil {
+				return err
+			}
+			g.printf("\t}\n")
+			return nil
+		}
+
+		l := g.newVar("l")
+		g.printf("\tvar %s int\n", l)
+		g.assignErr(l, fmt.Sprintf("dec.DecodeArrayLen(%d)", max))
+		g.printf("\t%s = nil\n", target)
+		g.printf("\tif %s > 0 {\n", l)
+		g.printf("\t%s = make(%s, 0, dec.ArrayPreallocLen(%s))\n", target, typeName, l)
+		i := g.newVar("i")
+		g.printf("\tfor %s := 0; %s < %s; %s++ {\n", i, i, l, i)
+		elem := g.newVar("elem")
+		g.printf("\tvar %s %s\n", elem, types.ExprString(expr.Elt))
+		err := g.decode(elem, expr.Elt, types.ExprString(expr.Elt), 0)
+		if err != nil {
+			return err
+		}
+		g.printf("\t%s = append(%s, %s)\n", target, target, elem)
+		g.printf("\t}\n")
+		g.printf("\t}\n")
+		return nil
+	}
+
+	return fmt.Errorf("unsupported type %s", types.ExprString(expr))
+}
+
+func isByte(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && strings.TrimSpace(ident.Name) == "byte"
+}
@@ -0,0 +1,669 @@
+package xdr
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stellar/go/support/errors"
+)
+
+// MarshalJSON returns the XDR JSON encoding of v, indented with two spaces.
+//
+// The XDR JSON encoding is a canonical and reversible JSON representation of
+// the xdr types, meant to be read and edited by humans:
+//
+//   - structs are objects whose keys are the snake_case field names, in the
+//     order of the fields
+//   - unions are objects with the snake_case switch field name and, unless
+//     the arm is void, the snake_case arm name
+//   - enum values are the snake_case names of their constants without the
+//     type prefix, e.g. "memo_text" for `MemoTypeMemoText`
+//   - optional values are null when absent
+//   - 32-bit integers are numbers, 64-bit integers are strings so that they
+//     survive JavaScript parsers
+//   - opaque values are hex strings
+//   - strings and asset codes are JSON strings, where bytes that are not
+//     printable UTF-8 are escaped as `\xNN` and backslashes as `\\`. Asset
+//     codes are trimmed of their zero padding.
+//   - public keys, account ids, node ids and signer keys are strkeys
+//
+// For example:
+//
+//	{
+//	  "type": "memo_text",
+//	  "text": "hello"
+//	}
+func MarshalJSON(v interface{}) ([]byte, error) {
+	if v == nil {
+		return nil, errors.New("nil value")
+	}
+	value, err := toJSON(reflect.ValueOf(v), "")
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(value, "", "  ")
+}
+
+// UnmarshalJSON decodes the XDR JSON encoding in data into v, which must be a
+// pointer to a xdr type. Unknown and missing object keys are errors, and the
+// decoded value must be encodable as XDR. 64-bit integers can be numbers as
+// well as strings.
+func UnmarshalJSON(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.Errorf("non-nil pointer expected, got %T", v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return errors.Wrap(err, "invalid json")
+	}
+	if decoder.More() {
+		return errors.New("invalid json: unexpected data after the value")
+	}
+
+	err = fromJSON(value, rv.Elem(), "")
+	if err != nil {
+		return err
+	}
+
+	// the size limits and union arms are checked by encoding the result
+	_, err = encode(ioutil.Discard, v)
+	return errors.Wrap(err, "invalid value")
+}
+
+// TypeNames returns the sorted names of the xdr types, as accepted by
+// `NewValue`.
+func TypeNames() []string {
+	names := make([]string, 0, len(xdrTypes))
+	for name := range xdrTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewValue returns a pointer to a new zero value of the xdr type called name,
+// e.g. "TransactionEnvelope", and false if there is no such type.
+func NewValue(name string) (interface{}, bool) {
+	typ, ok := xdrTypes[name]
+	if !ok {
+		return nil, false
+	}
+	return reflect.New(typ).Interface(), true
+}
+
+var (
+	accountIDType   = reflect.TypeOf(AccountId{})
+	signerKeyType   = reflect.TypeOf(SignerKey{})
+	assetCode4Type  = reflect.TypeOf(AssetCode4{})
+	assetCode12Type = reflect.TypeOf(AssetCode12{})
+	keyTypes        = map[reflect.Type]bool{
+		reflect.TypeOf(PublicKey{}): true,
+		accountIDType:               true,
+		reflect.TypeOf(NodeId{}):    true,
+	}
+)
+
+// union is implemented by the xdr unions.
+type union interface {
+	SwitchFieldName() string
+	ArmForSwitch(sw int32) (string, bool)
+}
+
+// jsonObject is a JSON object keeping the order of its keys.
+type jsonObject []jsonField
+
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(field.key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// toJSON returns the value encoding v with encoding/json. path locates v in
+// the marshaled value, for error messages.
+func toJSON(v reflect.Value, path string) (interface{}, error) {
+	typ := v.Type()
+
+	switch {
+	case keyTypes[typ]:
+		id := v.Convert(accountIDType).Interface().(AccountId)
+		if id.Type == PublicKeyTypePublicKeyTypeEd25519 && id.Ed25519 != nil {
+			return id.Address(), nil
+		}
+	case typ == signerKeyType:
+		key := v.Interface().(SignerKey)
+		if arm, ok := key.ArmForSwitch(int32(key.Type)); ok &&
+			!reflect.ValueOf(key).FieldByName(arm).IsNil() {
+			return key.Address(), nil
+		}
+	case typ == assetCode4Type || typ == assetCode12Type:
+		code := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(code), v)
+		return escapeString(string(bytes.TrimRight(code, "\x00"))), nil
+	}
+
+	if names, ok := enumNames[typ]; ok {
+		name, ok := names[int32(v.Int())]
+		if !ok {
+			return nil, pathErrorf(path, "'%d' is not a valid %s enum value", v.Int(), typ.Name())
+		}
+		return enumJSONName(typ, name), nil
+	}
+
+	if u, ok := v.Interface().(union); ok && v.Kind() == reflect.Struct {
+		return unionToJSON(u, v, path)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		object := make(jsonObject, 0, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			key := snakeCase(typ.Field(i).Name)
+			value, err := toJSON(v.Field(i), joinPath(path, key))
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, jsonField{key, value})
+		}
+		return object, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return toJSON(v.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			raw := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(raw), v)
+			return hex.EncodeToString(raw), nil
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return []interface{}{}, nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			value, err := toJSON(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			result[i] = value
+		}
+		return result, nil
+	case reflect.String:
+		return escapeString(v.String()), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int32:
+		return v.Int(), nil
+	case reflect.Uint32:
+		return v.Uint(), nil
+	case reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+
+	return nil, pathErrorf(path, "unsupported type %s", typ)
+}
+
+func unionToJSON(u union, v reflect.Value, path string) (interface{}, error) {
+	switchName := u.SwitchFieldName()
+	switchKey := snakeCase(switchName)
+	sw := v.FieldByName(switchName)
+
+	switchValue, err := toJSON(sw, joinPath(path, switchKey))
+	if err != nil {
+		return nil, err
+	}
+	object := jsonObject{{switchKey, switchValue}}
+
+	arm, ok := u.ArmForSwitch(switchInt(sw))
+	if !ok {
+		return nil, pathErrorf(path, "invalid union switch %d for %s", switchInt(sw), v.Type().Name())
+	}
+	if arm == "" {
+		return object, nil
+	}
+
+	armKey := snakeCase(arm)
+	armValue := v.FieldByName(arm)
+	if armValue.IsNil() {
+		return nil, pathErrorf(joinPath(path, armKey), "union arm %s of %s is nil", arm, v.Type().Name())
+	}
+	value, err := toJSON(armValue.Elem(), joinPath(path, armKey))
+	if err != nil {
+		return nil, err
+	}
+	return append(object, jsonField{armKey, value}), nil
+}
+
+// fromJSON decodes value, as decoded by encoding/json, into v. path locates
+// v in the unmarshaled value, for error messages.
+func fromJSON(value interface{}, v reflect.Value, path string) error {
+	typ := v.Type()
+
+	switch {
+	case keyTypes[typ]:
+		s, ok := value.(string)
+		if !ok {
+			return pathErrorf(path, "strkey expected")
+		}
+		var id AccountId
+		err := id.SetAddress(s)
+		if err != nil {
+			return pathErrorf(path, "invalid account id %q", s)
+		}
+		v.Set(reflect.ValueOf(id).Convert(typ))
+		return nil
+	case typ == signerKeyType:
+		s, ok := value.(string)
+		if !ok {
+			return pathErrorf(path, "strkey expected")
+		}
+		var key SignerKey
+		err := key.SetAddress(s)
+		if err != nil {
+			return pathErrorf(path, "invalid signer key %q", s)
+		}
+		v.Set(reflect.ValueOf(key))
+		return nil
+	case typ == assetCode4Type || typ == assetCode12Type:
+		s, err := stringFromJSON(value, path)
+		if err != nil {
+			return err
+		}
+		if len(s) > v.Len() {
+			return pathErrorf(path, "asset code %q is longer than %d bytes", s, v.Len())
+		}
+		reflect.Copy(v, reflect.ValueOf(make([]byte, v.Len())))
+		reflect.Copy(v, reflect.ValueOf([]byte(s)))
+		return nil
+	}
+
+	if _, ok := enumNames[typ]; ok {
+		s, ok := value.(string)
+		if !ok {
+			return pathErrorf(path, "%s enum value name expected", typ.Name())
+		}
+		e, ok := enumValue(typ, s)
+		if !ok {
+			return pathErrorf(path, "%q is not a valid %s enum value", s, typ.Name())
+		}
+		v.SetInt(int64(e))
+		return nil
+	}
+
+	if u, ok := v.Interface().(union); ok && v.Kind() == reflect.Struct {
+		return unionFromJSON(u, value, v, path)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return pathErrorf(path, "object expected")
+		}
+		keys := make([]string, v.NumField())
+		for i := range keys {
+			keys[i] = snakeCase(typ.Field(i).Name)
+		}
+		err := checkKeys(object, keys, path)
+		if err != nil {
+			return err
+		}
+		for i, key := range keys {
+			err = fromJSON(object[key], v.Field(i), joinPath(path, key))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Ptr:
+		if value == nil {
+			v.Set(reflect.Zero(typ))
+			return nil
+		}
+		elem := reflect.New(typ.Elem())
+		err := fromJSON(value, elem.Elem(), path)
+		if err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return bytesFromJSON(value, v, path)
+		}
+		array, ok := value.([]interface{})
+		if !ok {
+			return pathErrorf(path, "array expected")
+		}
+		if v.Kind() == reflect.Array {
+			if len(array) != v.Len() {
+				return pathErrorf(path, "array of %d elements expected", v.Len())
+			}
+		} else if len(array) == 0 {
+			v.Set(reflect.Zero(typ))
+			return nil
+		} else {
+			v.Set(reflect.MakeSlice(typ, len(array), len(array)))
+		}
+		for i, elem := range array {
+			err := fromJSON(elem, v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.String:
+		s, err := stringFromJSON(value, path)
+		if err != nil {
+			return err
+		}
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return pathErrorf(path, "boolean expected")
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int32, reflect.Int64:
+		s, err := numberFromJSON(value, v.Kind() == reflect.Int64, path)
+		if err != nil {
+			return err
+		}
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return pathErrorf(path, "invalid %d-bit integer %q", typ.Bits(), s)
+		}
+		v.SetInt(i)
+		return nil
+	case reflect.Uint32, reflect.Uint64:
+		s, err := numberFromJSON(value, v.Kind() == reflect.Uint64, path)
+		if err != nil {
+			return err
+		}
+		i, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return pathErrorf(path, "invalid %d-bit unsigned integer %q", typ.Bits(), s)
+		}
+		v.SetUint(i)
+		return nil
+	}
+
+	return pathErrorf(path, "unsupported type %s", typ)
+}
+
+func unionFromJSON(u union, value interface{}, v reflect.Value, path string) error {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return pathErrorf(path, "object expected")
+	}
+
+	switchName := u.SwitchFieldName()
+	switchKey := snakeCase(switchName)
+	sw := reflect.New(v.FieldByName(switchName).Type()).Elem()
+	switchValue, ok := object[switchKey]
+	if !ok {
+		return pathErrorf(path, "missing key %q", switchKey)
+	}
+	err := fromJSON(switchValue, sw, joinPath(path, switchKey))
+	if err != nil {
+		return err
+	}
+
+	arm, ok := u.ArmForSwitch(switchInt(sw))
+	if !ok {
+		return pathErrorf(path, "invalid union switch %d for %s", switchInt(sw), v.Type().Name())
+	}
+	keys := []string{switchKey}
+	if arm != "" {
+		keys = append(keys, snakeCase(arm))
+	}
+	err = checkKeys(object, keys, path)
+	if err != nil {
+		return err
+	}
+
+	v.Set(reflect.Zero(v.Type()))
+	v.FieldByName(switchName).Set(sw)
+	if arm == "" {
+		return nil
+	}
+	return fromJSON(object[keys[1]], v.FieldByName(arm), joinPath(path, keys[1]))
+}
+
+// checkKeys returns an error unless object has exactly the keys.
+func checkKeys(object map[string]interface{}, keys []string, path string) error {
+	for _, key := range keys {
+		if _, ok := object[key]; !ok {
+			return pathErrorf(path, "missing key %q", key)
+		}
+	}
+	if len(object) == len(keys) {
+		return nil
+	}
+
+	expected := map[string]bool{}
+	for _, key := range keys {
+		expected[key] = true
+	}
+	var unknown []string
+	for key := range object {
+		if !expected[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return pathErrorf(path, "unknown key %q", unknown[0])
+}
+
+func bytesFromJSON(value interface{}, v reflect.Value, path string) error {
+	s, ok := value.(string)
+	if !ok {
+		return pathErrorf(path, "hex string expected")
+	}
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return pathErrorf(path, "invalid hex string")
+	}
+
+	if v.Kind() == reflect.Array {
+		if len(raw) != v.Len() {
+			return pathErrorf(path, "%d bytes expected, got %d", v.Len(), len(raw))
+		}
+		reflect.Copy(v, reflect.ValueOf(raw))
+		return nil
+	}
+	if len(raw) == 0 {
+		raw = nil
+	}
+	v.SetBytes(raw)
+	return nil
+}
+
+func stringFromJSON(value interface{}, path string) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", pathErrorf(path, "string expected")
+	}
+	result, err := unescapeString(s)
+	if err != nil {
+		return "", pathErrorf(path, "%s", err)
+	}
+	return result, nil
+}
+
+// numberFromJSON returns the string form of the number value. 64-bit integers
+// can be strings too.
+func numberFromJSON(value interface{}, is64 bool, path string) (string, error) {
+	switch value := value.(type) {
+	case json.Number:
+		return value.String(), nil
+	case string:
+		if is64 {
+			return value, nil
+		}
+	}
+	if is64 {
+		return "", pathErrorf(path, "integer string expected")
+	}
+	return "", pathErrorf(path, "integer expected")
+}
+
+func switchInt(sw reflect.Value) int32 {
+	if sw.Kind() == reflect.Uint32 {
+		return int32(sw.Uint())
+	}
+	return int32(sw.Int())
+}
+
+// escapeString escapes the backslashes of s, and the bytes of s which aren't
+// printable UTF-8 as `\xNN`.
+func escapeString(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == utf8.RuneError && size == 1, !unicode.IsPrint(r):
+			for _, c := range []byte(s[i : i+size]) {
+				fmt.Fprintf(&b, `\x%02x`, c)
+			}
+		default:
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return b.String()
+}
+
+// unescapeString reverses escapeString.
+func unescapeString(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch {
+		case strings.HasPrefix(s[i:], `\\`):
+			b.WriteByte('\\')
+			i++
+		case strings.HasPrefix(s[i:], `\x`) && len(s) >= i+4:
+			c, err := strconv.ParseUint(s[i+2:i+4], 16, 8)
+			if err != nil {
+				return "", errors.Errorf("invalid escape sequence %q", s[i:i+4])
+			}
+			b.WriteByte(byte(c))
+			i += 3
+		default:
+			return "", errors.Errorf("invalid escape sequence at byte %d", i)
+		}
+	}
+	return b.String(), nil
+}
+
+var (
+	enumValuesOnce sync.Once
+	enumValues     map[reflect.Type]map[string]int32
+)
+
+// enumJSONName returns the name of an enum value in the XDR JSON encoding:
+// its Go name, without the type prefix, in snake_case.
+func enumJSONName(typ reflect.Type, name string) string {
+	return snakeCase(strings.TrimPrefix(name, typ.Name()))
+}
+
+// enumValue returns the value of the enum type typ called name.
+func enumValue(typ reflect.Type, name string) (int32, bool) {
+	enumValuesOnce.Do(func() {
+		enumValues = map[reflect.Type]map[string]int32{}
+		for typ, names := range enumNames {
+			values := map[string]int32{}
+			for value, name := range names {
+				values[enumJSONName(typ, name)] = value
+			}
+			enumValues[typ] = values
+		}
+	})
+
+	value, ok := enumValues[typ][name]
+	return value, ok
+}
+
+// snakeCase converts a Go identifier to snake_case, e.g. "SeqNum" to
+// "seq_num" and "Ed25519" to "ed25519".
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func pathErrorf(path, format string, args ...interface{}) error {
+	if path == "" {
+		return errors.Errorf(format, args...)
+	}
+	return errors.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,171 @@
+package xdr_test
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	. "github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	var envelope TransactionEnvelope
+	require.NoError(t, SafeUnmarshalBase64(codecFixtures[0].Base64, &envelope))
+
+	encoded, err := MarshalJSON(envelope)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+	  "tx": {
+	    "source_account": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H",
+	    "fee": 10,
+	    "seq_num": "1",
+	    "time_bounds": null,
+	    "memo": {"type": "memo_none"},
+	    "operations": [
+	      {
+	        "source_account": null,
+	        "body": {
+	          "type": "create_account",
+	          "create_account_op": {
+	            "destination": "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU",
+	            "starting_balance": "1000000000"
+	          }
+	        }
+	      }
+	    ],
+	    "ext": {"v": 0}
+	  },
+	  "signatures": [
+	    {
+	      "hint": "56fc05f7",
+	      "signature": "0a67b20f8ffe3a3ee594e993adc8ca2c72d34091744d5fd582008e519df464f80cb10cba0364fffd97736fb3142d5a3f63b903aea019452e75aef229ed831400"
+	    }
+	  ]
+	}`, string(encoded))
+
+	// keys are in the order of the fields
+	assert.True(t, bytes.Index(encoded, []byte(`"source_account"`)) < bytes.Index(encoded, []byte(`"fee"`)))
+
+	var decoded TransactionEnvelope
+	require.NoError(t, UnmarshalJSON(encoded, &decoded))
+	assert.Equal(t, envelope, decoded)
+
+	// pointers are marshaled as their value
+	fromPointer, err := MarshalJSON(&envelope)
+	require.NoError(t, err)
+	assert.Equal(t, encoded, fromPointer)
+}
+
+func TestMarshalJSONSpecialValues(t *testing.T) {
+	text := "a\\b\xffc\né"
+	asset := MustNewCreditAsset("AB", "GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ")
+	signer := MustSigner("XBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG")
+
+	for _, testCase := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{Memo{Type: MemoTypeMemoText, Text: &text}, `{"type":"memo_text","text":"a\\\\b\\xffc\\x0aé"}`},
+		{asset, `{"type":"asset_type_credit_alphanum4","alpha_num4":{"asset_code":"AB","issuer":"GA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJVSGZ"}}`},
+		{signer, `"XBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG"`},
+		{PaymentResultCodePaymentNoTrust, `"payment_no_trust"`},
+		{DataValue(nil), `""`},
+		{Uint64(18446744073709551615), `"18446744073709551615"`},
+	} {
+		encoded, err := MarshalJSON(testCase.value)
+		require.NoError(t, err)
+		assert.JSONEq(t, testCase.expected, string(encoded))
+
+		decoded := reflect.New(reflect.TypeOf(testCase.value))
+		require.NoError(t, UnmarshalJSON(encoded, decoded.Interface()))
+		assert.Equal(t, testCase.value, decoded.Elem().Interface())
+	}
+}
+
+func TestMarshalJSONInvalidValues(t *testing.T) {
+	_, err := MarshalJSON(Asset{Type: AssetTypeAssetTypeCreditAlphanum4})
+	assert.EqualError(t, err, "alpha_num4: union arm AlphaNum4 of Asset is nil")
+
+	_, err = MarshalJSON(TransactionEnvelope{Tx: Transaction{Memo: Memo{Type: 42}}})
+	assert.EqualError(t, err, "tx.source_account.ed25519: union arm Ed25519 of AccountId is nil")
+
+	_, err = MarshalJSON(Memo{Type: 42})
+	assert.EqualError(t, err, "type: '42' is not a valid MemoType enum value")
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	for _, testCase := range []struct {
+		json     string
+		expected string
+	}{
+		{`{"type": "memo_none"} {}`, "invalid json: unexpected data after the value"},
+		{`{"type": "memo_none"`, "invalid json: unexpected EOF"},
+		{`{"type": "memo_nothing"}`, `type: "memo_nothing" is not a valid MemoType enum value`},
+		{`{"type": "memo_none", "text": "a"}`, `unknown key "text"`},
+		{`{"type": "memo_text"}`, `missing key "text"`},
+		{`{"type": "memo_text", "text": null}`, "invalid value: union arm Text of Memo is nil"},
+		{`{"type": "memo_text", "text": "\\x4"}`, "text: invalid escape sequence at byte 0"},
+		{`{"type": "memo_text", "text": "this memo text is longer than 28 bytes"}`, "invalid value: length 38 exceeds max size 28"},
+		{`{"type": "memo_id", "id": true}`, "id: integer string expected"},
+		{`{"type": "memo_id", "id": "-1"}`, `id: invalid 64-bit unsigned integer "-1"`},
+		{`{"type": "memo_hash", "hash": "00"}`, "hash: 32 bytes expected, got 1"},
+		{`{"type": "memo_hash", "hash": "zz"}`, "hash: invalid hex string"},
+		{`[]`, "object expected"},
+	} {
+		var memo Memo
+		err := UnmarshalJSON([]byte(testCase.json), &memo)
+		assert.EqualError(t, err, testCase.expected, testCase.json)
+	}
+
+	// 64-bit integers can be numbers too
+	var memo Memo
+	require.NoError(t, UnmarshalJSON([]byte(`{"type": "memo_id", "id": 1}`), &memo))
+	assert.Equal(t, Uint64(1), memo.MustId())
+
+	var id AccountId
+	err := UnmarshalJSON([]byte(`"GA7QYNF7"`), &id)
+	assert.EqualError(t, err, `invalid account id "GA7QYNF7"`)
+
+	var code AssetCode4
+	err = UnmarshalJSON([]byte(`"ABCDE"`), &code)
+	assert.EqualError(t, err, `asset code "ABCDE" is longer than 4 bytes`)
+
+	err = UnmarshalJSON([]byte(`"ABCD"`), code)
+	assert.EqualError(t, err, "non-nil pointer expected, got xdr.AssetCode4")
+}
+
+// TestJSONRoundTrip checks that random values of all the xdr types are
+// unchanged by encoding them to JSON and back.
+func TestJSONRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	names := TypeNames()
+	require.Contains(t, names, "TransactionEnvelope")
+
+	for _, name := range names {
+		value, ok := NewValue(name)
+		require.True(t, ok, name)
+		typ := reflect.TypeOf(value).Elem()
+
+		for i := 0; i < 20; i++ {
+			v := randomValue(t, r, typ, 0, 0)
+			expected, err := MarshalBase64(v.Interface())
+			require.NoError(t, err)
+
+			encoded, err := MarshalJSON(v.Interface())
+			require.NoError(t, err, name)
+
+			decoded := reflect.New(typ).Interface()
+			require.NoError(t, UnmarshalJSON(encoded, decoded), "%s: %s", name, encoded)
+			actual, err := MarshalBase64(decoded)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual, "%s: %s", name, encoded)
+		}
+	}
+
+	_, ok := NewValue("Unknown")
+	assert.False(t, ok)
+}
@@ -2,6 +2,8 @@
 // for stellar.
 package xdr
 
+//go:generate go run ./internal/codecgen
+
 import (
 	"bytes"
 	"encoding/base64"
@@ -27,7 +29,7 @@ func SafeUnmarshalBase64(data string, dest interface{}) error {
 
 	b64 := io.TeeReader(strings.NewReader(data), count)
 	raw := base64.NewDecoder(base64.StdEncoding, b64)
-	_, err := Unmarshal(raw, dest)
+	_, err := decode(raw, dest)
 
 	if err != nil {
 		return err
@@ -44,7 +46,7 @@ func SafeUnmarshalBase64(data string, dest interface{}) error {
 // that provided bytes are all consumed by the unmarshalling process.
 func SafeUnmarshal(data []byte, dest interface{}) error {
 	r := bytes.NewReader(data)
-	n, err := Unmarshal(r, dest)
+	n, err := decode(r, dest)
 
 	if err != nil {
 		return err
@@ -60,7 +62,7 @@ func SafeUnmarshal(data []byte, dest interface{}) error {
 func MarshalBase64(v interface{}) (string, error) {
 	var raw bytes.Buffer
 
-	_, err := Marshal(&raw, v)
+	_, err := encode(&raw, v)
 
 	if err != nil {
 		return "", err
@@ -69,6 +71,28 @@ func MarshalBase64(v interface{}) (string, error) {
 	return base64.StdEncoding.EncodeToString(raw.Bytes()), nil
 }
 
+// decode reads an xdr element from r into v like `Unmarshal`, using the
+// generated `DecodeFrom` method when v has one.
+func decode(r io.Reader, v interface{}) (int, error) {
+	if df, ok := v.(DecoderFrom); ok {
+		d := NewDecoder(r)
+		err := df.DecodeFrom(d)
+		return d.Count(), err
+	}
+	return Unmarshal(r, v)
+}
+
+// encode writes v into w like `Marshal`, using the generated `EncodeTo` method
+// when v has one.
+func encode(w io.Writer, v interface{}) (int, error) {
+	if et, ok := v.(EncoderTo); ok {
+		e := NewEncoder(w)
+		err := et.EncodeTo(e)
+		return e.Count(), err
+	}
+	return Marshal(w, v)
+}
+
 type countWriter struct {
 	Count int
 }
@@ -40,4 +40,8 @@ func TestSignerKey_SetAddress(t *testing.T) {
 	var dest SignerKey
 	err := dest.SetAddress("SBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWHOKR")
 	assert.Error(t, err)
+
+	// so does a muxed account
+	err = dest.SetAddress("MA7QYNF7SOWQ3GLR2BGMZEHXAVIRZA4KVWLTJJFC7MGXUA74P7UJUAAAAAAAAAAE2JUG6")
+	assert.Error(t, err)
 }
@@ -0,0 +1,7656 @@
+// Code generated by xdr/internal/codecgen from xdr_generated.go. DO NOT EDIT.
+
+package xdr
+
+// EncodeTo encodes this value using the Encoder.
+func (s Value) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeOpaque(s, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Value) DecodeFrom(dec *Decoder) error {
+	var err error
+	if (*s), err = dec.DecodeOpaque(0); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Value)(nil)
+	_ DecoderFrom = (*Value)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpBallot) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Counter.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Value.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpBallot) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Counter.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Value.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpBallot)(nil)
+	_ DecoderFrom = (*ScpBallot)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ScpStatementType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ScpStatementType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ScpStatementType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ScpStatementType", v)
+	}
+	*e = ScpStatementType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatementType)(nil)
+	_ DecoderFrom = (*ScpStatementType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpNomination) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.QuorumSetHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Votes), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Votes {
+		if err = s.Votes[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeArrayLen(len(s.Accepted), 0); err != nil {
+		return err
+	}
+	for i2 := range s.Accepted {
+		if err = s.Accepted[i2].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpNomination) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.QuorumSetHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l3 int
+	if l3, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Votes = nil
+	if l3 > 0 {
+		s.Votes = make([]Value, 0, dec.ArrayPreallocLen(l3))
+		for i4 := 0; i4 < l3; i4++ {
+			var elem5 Value
+			if err = elem5.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Votes = append(s.Votes, elem5)
+		}
+	}
+	var l6 int
+	if l6, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Accepted = nil
+	if l6 > 0 {
+		s.Accepted = make([]Value, 0, dec.ArrayPreallocLen(l6))
+		for i7 := 0; i7 < l6; i7++ {
+			var elem8 Value
+			if err = elem8.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Accepted = append(s.Accepted, elem8)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpNomination)(nil)
+	_ DecoderFrom = (*ScpNomination)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpStatementPrepare) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.QuorumSetHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ballot.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeBool(s.Prepared != nil); err != nil {
+		return err
+	}
+	if s.Prepared != nil {
+		if err = (*s.Prepared).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.PreparedPrime != nil); err != nil {
+		return err
+	}
+	if s.PreparedPrime != nil {
+		if err = (*s.PreparedPrime).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.NC.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NH.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpStatementPrepare) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.QuorumSetHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ballot.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var present1 bool
+	if present1, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.Prepared = nil
+	if present1 {
+		s.Prepared = new(ScpBallot)
+		if err = (*s.Prepared).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present2 bool
+	if present2, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.PreparedPrime = nil
+	if present2 {
+		s.PreparedPrime = new(ScpBallot)
+		if err = (*s.PreparedPrime).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	if err = s.NC.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NH.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatementPrepare)(nil)
+	_ DecoderFrom = (*ScpStatementPrepare)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpStatementConfirm) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Ballot.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NPrepared.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NCommit.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NH.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.QuorumSetHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpStatementConfirm) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Ballot.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NPrepared.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NCommit.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NH.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.QuorumSetHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatementConfirm)(nil)
+	_ DecoderFrom = (*ScpStatementConfirm)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpStatementExternalize) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Commit.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NH.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.CommitQuorumSetHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpStatementExternalize) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Commit.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NH.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.CommitQuorumSetHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatementExternalize)(nil)
+	_ DecoderFrom = (*ScpStatementExternalize)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ScpStatementPledges) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("ScpStatementPledges", int32(u.Type))
+	}
+	switch arm {
+	case "Prepare":
+		if u.Prepare == nil {
+			return errNilArm("ScpStatementPledges", "Prepare")
+		}
+		if err = (*u.Prepare).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Confirm":
+		if u.Confirm == nil {
+			return errNilArm("ScpStatementPledges", "Confirm")
+		}
+		if err = (*u.Confirm).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Externalize":
+		if u.Externalize == nil {
+			return errNilArm("ScpStatementPledges", "Externalize")
+		}
+		if err = (*u.Externalize).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Nominate":
+		if u.Nominate == nil {
+			return errNilArm("ScpStatementPledges", "Nominate")
+		}
+		if err = (*u.Nominate).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ScpStatementPledges) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ScpStatementType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ScpStatementPledges", int32(sw))
+	}
+	*u = ScpStatementPledges{Type: sw}
+	switch arm {
+	case "Prepare":
+		u.Prepare = new(ScpStatementPrepare)
+		if err = (*u.Prepare).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Confirm":
+		u.Confirm = new(ScpStatementConfirm)
+		if err = (*u.Confirm).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Externalize":
+		u.Externalize = new(ScpStatementExternalize)
+		if err = (*u.Externalize).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Nominate":
+		u.Nominate = new(ScpNomination)
+		if err = (*u.Nominate).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatementPledges)(nil)
+	_ DecoderFrom = (*ScpStatementPledges)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpStatement) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.NodeId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.SlotIndex.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Pledges.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpStatement) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.NodeId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.SlotIndex.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Pledges.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpStatement)(nil)
+	_ DecoderFrom = (*ScpStatement)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpEnvelope) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Statement.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Signature.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpEnvelope) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Statement.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Signature.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpEnvelope)(nil)
+	_ DecoderFrom = (*ScpEnvelope)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpQuorumSet) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Threshold.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Validators), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Validators {
+		if err = s.Validators[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeArrayLen(len(s.InnerSets), 0); err != nil {
+		return err
+	}
+	for i2 := range s.InnerSets {
+		if err = s.InnerSets[i2].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpQuorumSet) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Threshold.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l3 int
+	if l3, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Validators = nil
+	if l3 > 0 {
+		s.Validators = make([]PublicKey, 0, dec.ArrayPreallocLen(l3))
+		for i4 := 0; i4 < l3; i4++ {
+			var elem5 PublicKey
+			if err = elem5.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Validators = append(s.Validators, elem5)
+		}
+	}
+	var l6 int
+	if l6, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.InnerSets = nil
+	if l6 > 0 {
+		s.InnerSets = make([]ScpQuorumSet, 0, dec.ArrayPreallocLen(l6))
+		for i7 := 0; i7 < l6; i7++ {
+			var elem8 ScpQuorumSet
+			if err = elem8.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.InnerSets = append(s.InnerSets, elem8)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpQuorumSet)(nil)
+	_ DecoderFrom = (*ScpQuorumSet)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AccountId) EncodeTo(enc *Encoder) error {
+	return PublicKey(s).EncodeTo(enc)
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AccountId) DecodeFrom(dec *Decoder) error {
+	return (*PublicKey)(s).DecodeFrom(dec)
+}
+
+var (
+	_ EncoderTo   = (*AccountId)(nil)
+	_ DecoderFrom = (*AccountId)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Thresholds) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Thresholds) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Thresholds)(nil)
+	_ DecoderFrom = (*Thresholds)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s String32) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeString(string(s), 32); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *String32) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 string
+	if v1, err = dec.DecodeString(32); err != nil {
+		return err
+	}
+	(*s) = String32(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*String32)(nil)
+	_ DecoderFrom = (*String32)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s String64) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeString(string(s), 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *String64) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 string
+	if v1, err = dec.DecodeString(64); err != nil {
+		return err
+	}
+	(*s) = String64(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*String64)(nil)
+	_ DecoderFrom = (*String64)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s SequenceNumber) EncodeTo(enc *Encoder) error {
+	return Int64(s).EncodeTo(enc)
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *SequenceNumber) DecodeFrom(dec *Decoder) error {
+	return (*Int64)(s).DecodeFrom(dec)
+}
+
+var (
+	_ EncoderTo   = (*SequenceNumber)(nil)
+	_ DecoderFrom = (*SequenceNumber)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TimePoint) EncodeTo(enc *Encoder) error {
+	return Uint64(s).EncodeTo(enc)
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TimePoint) DecodeFrom(dec *Decoder) error {
+	return (*Uint64)(s).DecodeFrom(dec)
+}
+
+var (
+	_ EncoderTo   = (*TimePoint)(nil)
+	_ DecoderFrom = (*TimePoint)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s DataValue) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeOpaque(s, 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *DataValue) DecodeFrom(dec *Decoder) error {
+	var err error
+	if (*s), err = dec.DecodeOpaque(64); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*DataValue)(nil)
+	_ DecoderFrom = (*DataValue)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AssetCode4) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AssetCode4) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AssetCode4)(nil)
+	_ DecoderFrom = (*AssetCode4)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AssetCode12) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AssetCode12) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AssetCode12)(nil)
+	_ DecoderFrom = (*AssetCode12)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e AssetType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("AssetType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *AssetType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("AssetType", v)
+	}
+	*e = AssetType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AssetType)(nil)
+	_ DecoderFrom = (*AssetType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AssetAlphaNum4) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AssetCode.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Issuer.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AssetAlphaNum4) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AssetCode.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Issuer.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AssetAlphaNum4)(nil)
+	_ DecoderFrom = (*AssetAlphaNum4)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AssetAlphaNum12) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AssetCode.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Issuer.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AssetAlphaNum12) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AssetCode.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Issuer.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AssetAlphaNum12)(nil)
+	_ DecoderFrom = (*AssetAlphaNum12)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u Asset) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("Asset", int32(u.Type))
+	}
+	switch arm {
+	case "AlphaNum4":
+		if u.AlphaNum4 == nil {
+			return errNilArm("Asset", "AlphaNum4")
+		}
+		if err = (*u.AlphaNum4).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "AlphaNum12":
+		if u.AlphaNum12 == nil {
+			return errNilArm("Asset", "AlphaNum12")
+		}
+		if err = (*u.AlphaNum12).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *Asset) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw AssetType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("Asset", int32(sw))
+	}
+	*u = Asset{Type: sw}
+	switch arm {
+	case "AlphaNum4":
+		u.AlphaNum4 = new(AssetAlphaNum4)
+		if err = (*u.AlphaNum4).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "AlphaNum12":
+		u.AlphaNum12 = new(AssetAlphaNum12)
+		if err = (*u.AlphaNum12).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Asset)(nil)
+	_ DecoderFrom = (*Asset)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Price) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.N.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.D.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Price) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.N.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.D.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Price)(nil)
+	_ DecoderFrom = (*Price)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Liabilities) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Buying.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Selling.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Liabilities) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Buying.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Selling.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Liabilities)(nil)
+	_ DecoderFrom = (*Liabilities)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ThresholdIndexes) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ThresholdIndexes", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ThresholdIndexes) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ThresholdIndexes", v)
+	}
+	*e = ThresholdIndexes(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ThresholdIndexes)(nil)
+	_ DecoderFrom = (*ThresholdIndexes)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e LedgerEntryType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("LedgerEntryType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *LedgerEntryType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("LedgerEntryType", v)
+	}
+	*e = LedgerEntryType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryType)(nil)
+	_ DecoderFrom = (*LedgerEntryType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Signer) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Key.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Weight.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Signer) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Key.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Weight.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Signer)(nil)
+	_ DecoderFrom = (*Signer)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e AccountFlags) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("AccountFlags", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *AccountFlags) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("AccountFlags", v)
+	}
+	*e = AccountFlags(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountFlags)(nil)
+	_ DecoderFrom = (*AccountFlags)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AccountEntryV1Ext) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("AccountEntryV1Ext", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AccountEntryV1Ext) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AccountEntryV1Ext", int32(sw))
+	}
+	*u = AccountEntryV1Ext{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountEntryV1Ext)(nil)
+	_ DecoderFrom = (*AccountEntryV1Ext)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AccountEntryV1) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Liabilities.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AccountEntryV1) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Liabilities.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountEntryV1)(nil)
+	_ DecoderFrom = (*AccountEntryV1)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AccountEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("AccountEntryExt", int32(u.V))
+	}
+	switch arm {
+	case "V1":
+		if u.V1 == nil {
+			return errNilArm("AccountEntryExt", "V1")
+		}
+		if err = (*u.V1).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AccountEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AccountEntryExt", int32(sw))
+	}
+	*u = AccountEntryExt{V: sw}
+	switch arm {
+	case "V1":
+		u.V1 = new(AccountEntryV1)
+		if err = (*u.V1).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountEntryExt)(nil)
+	_ DecoderFrom = (*AccountEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AccountEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Balance.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.SeqNum.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NumSubEntries.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeBool(s.InflationDest != nil); err != nil {
+		return err
+	}
+	if s.InflationDest != nil {
+		if err = (*s.InflationDest).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Flags.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.HomeDomain.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Thresholds.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Signers), 20); err != nil {
+		return err
+	}
+	for i1 := range s.Signers {
+		if err = s.Signers[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AccountEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Balance.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.SeqNum.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NumSubEntries.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var present2 bool
+	if present2, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.InflationDest = nil
+	if present2 {
+		s.InflationDest = new(AccountId)
+		if err = (*s.InflationDest).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	if err = s.Flags.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.HomeDomain.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Thresholds.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l3 int
+	if l3, err = dec.DecodeArrayLen(20); err != nil {
+		return err
+	}
+	s.Signers = nil
+	if l3 > 0 {
+		s.Signers = make([]Signer, 0, dec.ArrayPreallocLen(l3))
+		for i4 := 0; i4 < l3; i4++ {
+			var elem5 Signer
+			if err = elem5.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Signers = append(s.Signers, elem5)
+		}
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountEntry)(nil)
+	_ DecoderFrom = (*AccountEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e TrustLineFlags) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("TrustLineFlags", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *TrustLineFlags) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("TrustLineFlags", v)
+	}
+	*e = TrustLineFlags(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TrustLineFlags)(nil)
+	_ DecoderFrom = (*TrustLineFlags)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TrustLineEntryV1Ext) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TrustLineEntryV1Ext", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TrustLineEntryV1Ext) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TrustLineEntryV1Ext", int32(sw))
+	}
+	*u = TrustLineEntryV1Ext{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TrustLineEntryV1Ext)(nil)
+	_ DecoderFrom = (*TrustLineEntryV1Ext)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TrustLineEntryV1) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Liabilities.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TrustLineEntryV1) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Liabilities.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TrustLineEntryV1)(nil)
+	_ DecoderFrom = (*TrustLineEntryV1)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TrustLineEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TrustLineEntryExt", int32(u.V))
+	}
+	switch arm {
+	case "V1":
+		if u.V1 == nil {
+			return errNilArm("TrustLineEntryExt", "V1")
+		}
+		if err = (*u.V1).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TrustLineEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TrustLineEntryExt", int32(sw))
+	}
+	*u = TrustLineEntryExt{V: sw}
+	switch arm {
+	case "V1":
+		u.V1 = new(TrustLineEntryV1)
+		if err = (*u.V1).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TrustLineEntryExt)(nil)
+	_ DecoderFrom = (*TrustLineEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TrustLineEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Asset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Balance.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Limit.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Flags.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TrustLineEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Asset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Balance.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Limit.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Flags.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TrustLineEntry)(nil)
+	_ DecoderFrom = (*TrustLineEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e OfferEntryFlags) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("OfferEntryFlags", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *OfferEntryFlags) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("OfferEntryFlags", v)
+	}
+	*e = OfferEntryFlags(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OfferEntryFlags)(nil)
+	_ DecoderFrom = (*OfferEntryFlags)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u OfferEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("OfferEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *OfferEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("OfferEntryExt", int32(sw))
+	}
+	*u = OfferEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OfferEntryExt)(nil)
+	_ DecoderFrom = (*OfferEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s OfferEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SellerId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OfferId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Selling.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Buying.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Price.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Flags.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *OfferEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SellerId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OfferId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Selling.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Buying.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Price.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Flags.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OfferEntry)(nil)
+	_ DecoderFrom = (*OfferEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u DataEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("DataEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *DataEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("DataEntryExt", int32(sw))
+	}
+	*u = DataEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*DataEntryExt)(nil)
+	_ DecoderFrom = (*DataEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s DataEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DataName.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DataValue.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *DataEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DataName.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DataValue.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*DataEntry)(nil)
+	_ DecoderFrom = (*DataEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerEntryData) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("LedgerEntryData", int32(u.Type))
+	}
+	switch arm {
+	case "Account":
+		if u.Account == nil {
+			return errNilArm("LedgerEntryData", "Account")
+		}
+		if err = (*u.Account).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "TrustLine":
+		if u.TrustLine == nil {
+			return errNilArm("LedgerEntryData", "TrustLine")
+		}
+		if err = (*u.TrustLine).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Offer":
+		if u.Offer == nil {
+			return errNilArm("LedgerEntryData", "Offer")
+		}
+		if err = (*u.Offer).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Data":
+		if u.Data == nil {
+			return errNilArm("LedgerEntryData", "Data")
+		}
+		if err = (*u.Data).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerEntryData) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw LedgerEntryType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerEntryData", int32(sw))
+	}
+	*u = LedgerEntryData{Type: sw}
+	switch arm {
+	case "Account":
+		u.Account = new(AccountEntry)
+		if err = (*u.Account).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "TrustLine":
+		u.TrustLine = new(TrustLineEntry)
+		if err = (*u.TrustLine).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Offer":
+		u.Offer = new(OfferEntry)
+		if err = (*u.Offer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Data":
+		u.Data = new(DataEntry)
+		if err = (*u.Data).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryData)(nil)
+	_ DecoderFrom = (*LedgerEntryData)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("LedgerEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerEntryExt", int32(sw))
+	}
+	*u = LedgerEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryExt)(nil)
+	_ DecoderFrom = (*LedgerEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LastModifiedLedgerSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Data.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LastModifiedLedgerSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Data.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntry)(nil)
+	_ DecoderFrom = (*LedgerEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e EnvelopeType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("EnvelopeType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *EnvelopeType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("EnvelopeType", v)
+	}
+	*e = EnvelopeType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*EnvelopeType)(nil)
+	_ DecoderFrom = (*EnvelopeType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s UpgradeType) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeOpaque(s, 128); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *UpgradeType) DecodeFrom(dec *Decoder) error {
+	var err error
+	if (*s), err = dec.DecodeOpaque(128); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*UpgradeType)(nil)
+	_ DecoderFrom = (*UpgradeType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e StellarValueType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("StellarValueType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *StellarValueType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("StellarValueType", v)
+	}
+	*e = StellarValueType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*StellarValueType)(nil)
+	_ DecoderFrom = (*StellarValueType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerCloseValueSignature) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.NodeId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Signature.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerCloseValueSignature) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.NodeId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Signature.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerCloseValueSignature)(nil)
+	_ DecoderFrom = (*LedgerCloseValueSignature)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u StellarValueExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.V.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("StellarValueExt", int32(u.V))
+	}
+	switch arm {
+	case "LcValueSignature":
+		if u.LcValueSignature == nil {
+			return errNilArm("StellarValueExt", "LcValueSignature")
+		}
+		if err = (*u.LcValueSignature).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *StellarValueExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw StellarValueType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("StellarValueExt", int32(sw))
+	}
+	*u = StellarValueExt{V: sw}
+	switch arm {
+	case "LcValueSignature":
+		u.LcValueSignature = new(LedgerCloseValueSignature)
+		if err = (*u.LcValueSignature).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*StellarValueExt)(nil)
+	_ DecoderFrom = (*StellarValueExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s StellarValue) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.TxSetHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.CloseTime.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Upgrades), 6); err != nil {
+		return err
+	}
+	for i1 := range s.Upgrades {
+		if err = s.Upgrades[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *StellarValue) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.TxSetHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.CloseTime.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(6); err != nil {
+		return err
+	}
+	s.Upgrades = nil
+	if l2 > 0 {
+		s.Upgrades = make([]UpgradeType, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 UpgradeType
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Upgrades = append(s.Upgrades, elem4)
+		}
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*StellarValue)(nil)
+	_ DecoderFrom = (*StellarValue)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerHeaderExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("LedgerHeaderExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerHeaderExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerHeaderExt", int32(sw))
+	}
+	*u = LedgerHeaderExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerHeaderExt)(nil)
+	_ DecoderFrom = (*LedgerHeaderExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerHeader) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerVersion.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.PreviousLedgerHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.ScpValue.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.TxSetResultHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.BucketListHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.LedgerSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.TotalCoins.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.FeePool.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.InflationSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.IdPool.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.BaseFee.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.BaseReserve.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.MaxTxSetSize.EncodeTo(enc); err != nil {
+		return err
+	}
+	for i1 := range s.SkipList {
+		if err = s.SkipList[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerHeader) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerVersion.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.PreviousLedgerHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.ScpValue.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.TxSetResultHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.BucketListHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.LedgerSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.TotalCoins.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.FeePool.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.InflationSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.IdPool.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.BaseFee.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.BaseReserve.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.MaxTxSetSize.DecodeFrom(dec); err != nil {
+		return err
+	}
+	for i2 := range s.SkipList {
+		if err = s.SkipList[i2].DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerHeader)(nil)
+	_ DecoderFrom = (*LedgerHeader)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e LedgerUpgradeType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("LedgerUpgradeType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *LedgerUpgradeType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("LedgerUpgradeType", v)
+	}
+	*e = LedgerUpgradeType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerUpgradeType)(nil)
+	_ DecoderFrom = (*LedgerUpgradeType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerUpgrade) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("LedgerUpgrade", int32(u.Type))
+	}
+	switch arm {
+	case "NewLedgerVersion":
+		if u.NewLedgerVersion == nil {
+			return errNilArm("LedgerUpgrade", "NewLedgerVersion")
+		}
+		if err = (*u.NewLedgerVersion).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "NewBaseFee":
+		if u.NewBaseFee == nil {
+			return errNilArm("LedgerUpgrade", "NewBaseFee")
+		}
+		if err = (*u.NewBaseFee).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "NewMaxTxSetSize":
+		if u.NewMaxTxSetSize == nil {
+			return errNilArm("LedgerUpgrade", "NewMaxTxSetSize")
+		}
+		if err = (*u.NewMaxTxSetSize).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "NewBaseReserve":
+		if u.NewBaseReserve == nil {
+			return errNilArm("LedgerUpgrade", "NewBaseReserve")
+		}
+		if err = (*u.NewBaseReserve).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerUpgrade) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw LedgerUpgradeType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerUpgrade", int32(sw))
+	}
+	*u = LedgerUpgrade{Type: sw}
+	switch arm {
+	case "NewLedgerVersion":
+		u.NewLedgerVersion = new(Uint32)
+		if err = (*u.NewLedgerVersion).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "NewBaseFee":
+		u.NewBaseFee = new(Uint32)
+		if err = (*u.NewBaseFee).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "NewMaxTxSetSize":
+		u.NewMaxTxSetSize = new(Uint32)
+		if err = (*u.NewMaxTxSetSize).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "NewBaseReserve":
+		u.NewBaseReserve = new(Uint32)
+		if err = (*u.NewBaseReserve).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerUpgrade)(nil)
+	_ DecoderFrom = (*LedgerUpgrade)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerKeyAccount) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerKeyAccount) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerKeyAccount)(nil)
+	_ DecoderFrom = (*LedgerKeyAccount)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerKeyTrustLine) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Asset.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerKeyTrustLine) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Asset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerKeyTrustLine)(nil)
+	_ DecoderFrom = (*LedgerKeyTrustLine)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerKeyOffer) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SellerId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OfferId.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerKeyOffer) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SellerId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OfferId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerKeyOffer)(nil)
+	_ DecoderFrom = (*LedgerKeyOffer)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerKeyData) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.AccountId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DataName.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerKeyData) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.AccountId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DataName.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerKeyData)(nil)
+	_ DecoderFrom = (*LedgerKeyData)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerKey) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("LedgerKey", int32(u.Type))
+	}
+	switch arm {
+	case "Account":
+		if u.Account == nil {
+			return errNilArm("LedgerKey", "Account")
+		}
+		if err = (*u.Account).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "TrustLine":
+		if u.TrustLine == nil {
+			return errNilArm("LedgerKey", "TrustLine")
+		}
+		if err = (*u.TrustLine).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Offer":
+		if u.Offer == nil {
+			return errNilArm("LedgerKey", "Offer")
+		}
+		if err = (*u.Offer).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Data":
+		if u.Data == nil {
+			return errNilArm("LedgerKey", "Data")
+		}
+		if err = (*u.Data).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerKey) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw LedgerEntryType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerKey", int32(sw))
+	}
+	*u = LedgerKey{Type: sw}
+	switch arm {
+	case "Account":
+		u.Account = new(LedgerKeyAccount)
+		if err = (*u.Account).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "TrustLine":
+		u.TrustLine = new(LedgerKeyTrustLine)
+		if err = (*u.TrustLine).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Offer":
+		u.Offer = new(LedgerKeyOffer)
+		if err = (*u.Offer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Data":
+		u.Data = new(LedgerKeyData)
+		if err = (*u.Data).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerKey)(nil)
+	_ DecoderFrom = (*LedgerKey)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e BucketEntryType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("BucketEntryType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *BucketEntryType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("BucketEntryType", v)
+	}
+	*e = BucketEntryType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BucketEntryType)(nil)
+	_ DecoderFrom = (*BucketEntryType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u BucketMetadataExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("BucketMetadataExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *BucketMetadataExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("BucketMetadataExt", int32(sw))
+	}
+	*u = BucketMetadataExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BucketMetadataExt)(nil)
+	_ DecoderFrom = (*BucketMetadataExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s BucketMetadata) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerVersion.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *BucketMetadata) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerVersion.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BucketMetadata)(nil)
+	_ DecoderFrom = (*BucketMetadata)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u BucketEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("BucketEntry", int32(u.Type))
+	}
+	switch arm {
+	case "LiveEntry":
+		if u.LiveEntry == nil {
+			return errNilArm("BucketEntry", "LiveEntry")
+		}
+		if err = (*u.LiveEntry).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "DeadEntry":
+		if u.DeadEntry == nil {
+			return errNilArm("BucketEntry", "DeadEntry")
+		}
+		if err = (*u.DeadEntry).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "MetaEntry":
+		if u.MetaEntry == nil {
+			return errNilArm("BucketEntry", "MetaEntry")
+		}
+		if err = (*u.MetaEntry).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *BucketEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw BucketEntryType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("BucketEntry", int32(sw))
+	}
+	*u = BucketEntry{Type: sw}
+	switch arm {
+	case "LiveEntry":
+		u.LiveEntry = new(LedgerEntry)
+		if err = (*u.LiveEntry).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "DeadEntry":
+		u.DeadEntry = new(LedgerKey)
+		if err = (*u.DeadEntry).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "MetaEntry":
+		u.MetaEntry = new(BucketMetadata)
+		if err = (*u.MetaEntry).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BucketEntry)(nil)
+	_ DecoderFrom = (*BucketEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionSet) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.PreviousLedgerHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Txs), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Txs {
+		if err = s.Txs[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionSet) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.PreviousLedgerHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Txs = nil
+	if l2 > 0 {
+		s.Txs = make([]TransactionEnvelope, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 TransactionEnvelope
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Txs = append(s.Txs, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionSet)(nil)
+	_ DecoderFrom = (*TransactionSet)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionResultPair) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.TransactionHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Result.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionResultPair) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.TransactionHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Result.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResultPair)(nil)
+	_ DecoderFrom = (*TransactionResultPair)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionResultSet) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s.Results), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Results {
+		if err = s.Results[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionResultSet) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Results = nil
+	if l2 > 0 {
+		s.Results = make([]TransactionResultPair, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 TransactionResultPair
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Results = append(s.Results, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResultSet)(nil)
+	_ DecoderFrom = (*TransactionResultSet)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionHistoryEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TransactionHistoryEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionHistoryEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionHistoryEntryExt", int32(sw))
+	}
+	*u = TransactionHistoryEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionHistoryEntryExt)(nil)
+	_ DecoderFrom = (*TransactionHistoryEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionHistoryEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.TxSet.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionHistoryEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.TxSet.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionHistoryEntry)(nil)
+	_ DecoderFrom = (*TransactionHistoryEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionHistoryResultEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TransactionHistoryResultEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionHistoryResultEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionHistoryResultEntryExt", int32(sw))
+	}
+	*u = TransactionHistoryResultEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionHistoryResultEntryExt)(nil)
+	_ DecoderFrom = (*TransactionHistoryResultEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionHistoryResultEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.TxResultSet.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionHistoryResultEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.TxResultSet.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionHistoryResultEntry)(nil)
+	_ DecoderFrom = (*TransactionHistoryResultEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerHeaderHistoryEntryExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("LedgerHeaderHistoryEntryExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerHeaderHistoryEntryExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerHeaderHistoryEntryExt", int32(sw))
+	}
+	*u = LedgerHeaderHistoryEntryExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerHeaderHistoryEntryExt)(nil)
+	_ DecoderFrom = (*LedgerHeaderHistoryEntryExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerHeaderHistoryEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Hash.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Header.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerHeaderHistoryEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Hash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Header.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerHeaderHistoryEntry)(nil)
+	_ DecoderFrom = (*LedgerHeaderHistoryEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerScpMessages) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerSeq.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Messages), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Messages {
+		if err = s.Messages[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerScpMessages) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerSeq.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Messages = nil
+	if l2 > 0 {
+		s.Messages = make([]ScpEnvelope, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 ScpEnvelope
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Messages = append(s.Messages, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerScpMessages)(nil)
+	_ DecoderFrom = (*LedgerScpMessages)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ScpHistoryEntryV0) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s.QuorumSets), 0); err != nil {
+		return err
+	}
+	for i1 := range s.QuorumSets {
+		if err = s.QuorumSets[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.LedgerMessages.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ScpHistoryEntryV0) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.QuorumSets = nil
+	if l2 > 0 {
+		s.QuorumSets = make([]ScpQuorumSet, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 ScpQuorumSet
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.QuorumSets = append(s.QuorumSets, elem4)
+		}
+	}
+	if err = s.LedgerMessages.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpHistoryEntryV0)(nil)
+	_ DecoderFrom = (*ScpHistoryEntryV0)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ScpHistoryEntry) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("ScpHistoryEntry", int32(u.V))
+	}
+	switch arm {
+	case "V0":
+		if u.V0 == nil {
+			return errNilArm("ScpHistoryEntry", "V0")
+		}
+		if err = (*u.V0).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ScpHistoryEntry) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ScpHistoryEntry", int32(sw))
+	}
+	*u = ScpHistoryEntry{V: sw}
+	switch arm {
+	case "V0":
+		u.V0 = new(ScpHistoryEntryV0)
+		if err = (*u.V0).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ScpHistoryEntry)(nil)
+	_ DecoderFrom = (*ScpHistoryEntry)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e LedgerEntryChangeType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("LedgerEntryChangeType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *LedgerEntryChangeType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("LedgerEntryChangeType", v)
+	}
+	*e = LedgerEntryChangeType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryChangeType)(nil)
+	_ DecoderFrom = (*LedgerEntryChangeType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u LedgerEntryChange) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("LedgerEntryChange", int32(u.Type))
+	}
+	switch arm {
+	case "Created":
+		if u.Created == nil {
+			return errNilArm("LedgerEntryChange", "Created")
+		}
+		if err = (*u.Created).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Updated":
+		if u.Updated == nil {
+			return errNilArm("LedgerEntryChange", "Updated")
+		}
+		if err = (*u.Updated).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Removed":
+		if u.Removed == nil {
+			return errNilArm("LedgerEntryChange", "Removed")
+		}
+		if err = (*u.Removed).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "State":
+		if u.State == nil {
+			return errNilArm("LedgerEntryChange", "State")
+		}
+		if err = (*u.State).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *LedgerEntryChange) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw LedgerEntryChangeType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("LedgerEntryChange", int32(sw))
+	}
+	*u = LedgerEntryChange{Type: sw}
+	switch arm {
+	case "Created":
+		u.Created = new(LedgerEntry)
+		if err = (*u.Created).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Updated":
+		u.Updated = new(LedgerEntry)
+		if err = (*u.Updated).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Removed":
+		u.Removed = new(LedgerKey)
+		if err = (*u.Removed).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "State":
+		u.State = new(LedgerEntry)
+		if err = (*u.State).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryChange)(nil)
+	_ DecoderFrom = (*LedgerEntryChange)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s LedgerEntryChanges) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s), 0); err != nil {
+		return err
+	}
+	for i1 := range s {
+		if err = s[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *LedgerEntryChanges) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	(*s) = nil
+	if l2 > 0 {
+		(*s) = make(LedgerEntryChanges, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 LedgerEntryChange
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			(*s) = append((*s), elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*LedgerEntryChanges)(nil)
+	_ DecoderFrom = (*LedgerEntryChanges)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s OperationMeta) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Changes.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *OperationMeta) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Changes.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationMeta)(nil)
+	_ DecoderFrom = (*OperationMeta)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionMetaV1) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.TxChanges.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Operations), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Operations {
+		if err = s.Operations[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionMetaV1) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.TxChanges.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Operations = nil
+	if l2 > 0 {
+		s.Operations = make([]OperationMeta, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 OperationMeta
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Operations = append(s.Operations, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionMetaV1)(nil)
+	_ DecoderFrom = (*TransactionMetaV1)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionMeta) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TransactionMeta", int32(u.V))
+	}
+	switch arm {
+	case "Operations":
+		if u.Operations == nil {
+			return errNilArm("TransactionMeta", "Operations")
+		}
+		if err = enc.EncodeArrayLen(len((*u.Operations)), 0); err != nil {
+			return err
+		}
+		for i1 := range *u.Operations {
+			if err = (*u.Operations)[i1].EncodeTo(enc); err != nil {
+				return err
+			}
+		}
+	case "V1":
+		if u.V1 == nil {
+			return errNilArm("TransactionMeta", "V1")
+		}
+		if err = (*u.V1).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionMeta) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v2 int32
+	if v2, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v2)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionMeta", int32(sw))
+	}
+	*u = TransactionMeta{V: sw}
+	switch arm {
+	case "Operations":
+		u.Operations = new([]OperationMeta)
+		var l3 int
+		if l3, err = dec.DecodeArrayLen(0); err != nil {
+			return err
+		}
+		(*u.Operations) = nil
+		if l3 > 0 {
+			(*u.Operations) = make([]OperationMeta, 0, dec.ArrayPreallocLen(l3))
+			for i4 := 0; i4 < l3; i4++ {
+				var elem5 OperationMeta
+				if err = elem5.DecodeFrom(dec); err != nil {
+					return err
+				}
+				(*u.Operations) = append((*u.Operations), elem5)
+			}
+		}
+	case "V1":
+		u.V1 = new(TransactionMetaV1)
+		if err = (*u.V1).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionMeta)(nil)
+	_ DecoderFrom = (*TransactionMeta)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ErrorCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ErrorCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ErrorCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ErrorCode", v)
+	}
+	*e = ErrorCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ErrorCode)(nil)
+	_ DecoderFrom = (*ErrorCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Error) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeString(string(s.Msg), 100); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Error) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Code.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var v1 string
+	if v1, err = dec.DecodeString(100); err != nil {
+		return err
+	}
+	s.Msg = string(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Error)(nil)
+	_ DecoderFrom = (*Error)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AuthCert) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Pubkey.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Expiration.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Sig.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AuthCert) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Pubkey.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Expiration.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Sig.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AuthCert)(nil)
+	_ DecoderFrom = (*AuthCert)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Hello) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.LedgerVersion.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OverlayVersion.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OverlayMinVersion.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NetworkId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeString(string(s.VersionStr), 100); err != nil {
+		return err
+	}
+	if err = enc.EncodeInt(int32(s.ListeningPort)); err != nil {
+		return err
+	}
+	if err = s.PeerId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Cert.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Nonce.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Hello) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.LedgerVersion.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OverlayVersion.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OverlayMinVersion.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NetworkId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var v1 string
+	if v1, err = dec.DecodeString(100); err != nil {
+		return err
+	}
+	s.VersionStr = string(v1)
+	var v2 int32
+	if v2, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	s.ListeningPort = int32(v2)
+	if err = s.PeerId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Cert.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Nonce.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Hello)(nil)
+	_ DecoderFrom = (*Hello)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Auth) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(s.Unused)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Auth) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	s.Unused = int32(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Auth)(nil)
+	_ DecoderFrom = (*Auth)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e IpAddrType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("IpAddrType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *IpAddrType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("IpAddrType", v)
+	}
+	*e = IpAddrType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*IpAddrType)(nil)
+	_ DecoderFrom = (*IpAddrType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u PeerAddressIp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("PeerAddressIp", int32(u.Type))
+	}
+	switch arm {
+	case "Ipv4":
+		if u.Ipv4 == nil {
+			return errNilArm("PeerAddressIp", "Ipv4")
+		}
+		if err = enc.EncodeFixedOpaque((*u.Ipv4)[:]); err != nil {
+			return err
+		}
+	case "Ipv6":
+		if u.Ipv6 == nil {
+			return errNilArm("PeerAddressIp", "Ipv6")
+		}
+		if err = enc.EncodeFixedOpaque((*u.Ipv6)[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *PeerAddressIp) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw IpAddrType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("PeerAddressIp", int32(sw))
+	}
+	*u = PeerAddressIp{Type: sw}
+	switch arm {
+	case "Ipv4":
+		u.Ipv4 = new([4]byte)
+		if err = dec.DecodeFixedOpaque((*u.Ipv4)[:]); err != nil {
+			return err
+		}
+	case "Ipv6":
+		u.Ipv6 = new([16]byte)
+		if err = dec.DecodeFixedOpaque((*u.Ipv6)[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PeerAddressIp)(nil)
+	_ DecoderFrom = (*PeerAddressIp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PeerAddress) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Ip.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Port.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.NumFailures.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PeerAddress) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Ip.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Port.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.NumFailures.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PeerAddress)(nil)
+	_ DecoderFrom = (*PeerAddress)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e MessageType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("MessageType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *MessageType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("MessageType", v)
+	}
+	*e = MessageType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*MessageType)(nil)
+	_ DecoderFrom = (*MessageType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s DontHave) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.ReqHash.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *DontHave) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Type.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.ReqHash.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*DontHave)(nil)
+	_ DecoderFrom = (*DontHave)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u StellarMessage) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("StellarMessage", int32(u.Type))
+	}
+	switch arm {
+	case "Error":
+		if u.Error == nil {
+			return errNilArm("StellarMessage", "Error")
+		}
+		if err = (*u.Error).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Hello":
+		if u.Hello == nil {
+			return errNilArm("StellarMessage", "Hello")
+		}
+		if err = (*u.Hello).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Auth":
+		if u.Auth == nil {
+			return errNilArm("StellarMessage", "Auth")
+		}
+		if err = (*u.Auth).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "DontHave":
+		if u.DontHave == nil {
+			return errNilArm("StellarMessage", "DontHave")
+		}
+		if err = (*u.DontHave).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Peers":
+		if u.Peers == nil {
+			return errNilArm("StellarMessage", "Peers")
+		}
+		if err = enc.EncodeArrayLen(len((*u.Peers)), 100); err != nil {
+			return err
+		}
+		for i1 := range *u.Peers {
+			if err = (*u.Peers)[i1].EncodeTo(enc); err != nil {
+				return err
+			}
+		}
+	case "TxSetHash":
+		if u.TxSetHash == nil {
+			return errNilArm("StellarMessage", "TxSetHash")
+		}
+		if err = (*u.TxSetHash).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "TxSet":
+		if u.TxSet == nil {
+			return errNilArm("StellarMessage", "TxSet")
+		}
+		if err = (*u.TxSet).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Transaction":
+		if u.Transaction == nil {
+			return errNilArm("StellarMessage", "Transaction")
+		}
+		if err = (*u.Transaction).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "QSetHash":
+		if u.QSetHash == nil {
+			return errNilArm("StellarMessage", "QSetHash")
+		}
+		if err = (*u.QSetHash).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "QSet":
+		if u.QSet == nil {
+			return errNilArm("StellarMessage", "QSet")
+		}
+		if err = (*u.QSet).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Envelope":
+		if u.Envelope == nil {
+			return errNilArm("StellarMessage", "Envelope")
+		}
+		if err = (*u.Envelope).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "GetScpLedgerSeq":
+		if u.GetScpLedgerSeq == nil {
+			return errNilArm("StellarMessage", "GetScpLedgerSeq")
+		}
+		if err = (*u.GetScpLedgerSeq).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *StellarMessage) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw MessageType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("StellarMessage", int32(sw))
+	}
+	*u = StellarMessage{Type: sw}
+	switch arm {
+	case "Error":
+		u.Error = new(Error)
+		if err = (*u.Error).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Hello":
+		u.Hello = new(Hello)
+		if err = (*u.Hello).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Auth":
+		u.Auth = new(Auth)
+		if err = (*u.Auth).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "DontHave":
+		u.DontHave = new(DontHave)
+		if err = (*u.DontHave).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Peers":
+		u.Peers = new([]PeerAddress)
+		var l2 int
+		if l2, err = dec.DecodeArrayLen(100); err != nil {
+			return err
+		}
+		(*u.Peers) = nil
+		if l2 > 0 {
+			(*u.Peers) = make([]PeerAddress, 0, dec.ArrayPreallocLen(l2))
+			for i3 := 0; i3 < l2; i3++ {
+				var elem4 PeerAddress
+				if err = elem4.DecodeFrom(dec); err != nil {
+					return err
+				}
+				(*u.Peers) = append((*u.Peers), elem4)
+			}
+		}
+	case "TxSetHash":
+		u.TxSetHash = new(Uint256)
+		if err = (*u.TxSetHash).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "TxSet":
+		u.TxSet = new(TransactionSet)
+		if err = (*u.TxSet).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Transaction":
+		u.Transaction = new(TransactionEnvelope)
+		if err = (*u.Transaction).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "QSetHash":
+		u.QSetHash = new(Uint256)
+		if err = (*u.QSetHash).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "QSet":
+		u.QSet = new(ScpQuorumSet)
+		if err = (*u.QSet).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Envelope":
+		u.Envelope = new(ScpEnvelope)
+		if err = (*u.Envelope).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "GetScpLedgerSeq":
+		u.GetScpLedgerSeq = new(Uint32)
+		if err = (*u.GetScpLedgerSeq).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*StellarMessage)(nil)
+	_ DecoderFrom = (*StellarMessage)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AuthenticatedMessageV0) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Sequence.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Message.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Mac.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AuthenticatedMessageV0) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Sequence.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Message.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Mac.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AuthenticatedMessageV0)(nil)
+	_ DecoderFrom = (*AuthenticatedMessageV0)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AuthenticatedMessage) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.V.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("AuthenticatedMessage", int32(u.V))
+	}
+	switch arm {
+	case "V0":
+		if u.V0 == nil {
+			return errNilArm("AuthenticatedMessage", "V0")
+		}
+		if err = (*u.V0).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AuthenticatedMessage) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw Uint32
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AuthenticatedMessage", int32(sw))
+	}
+	*u = AuthenticatedMessage{V: sw}
+	switch arm {
+	case "V0":
+		u.V0 = new(AuthenticatedMessageV0)
+		if err = (*u.V0).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AuthenticatedMessage)(nil)
+	_ DecoderFrom = (*AuthenticatedMessage)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s DecoratedSignature) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Hint.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Signature.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *DecoratedSignature) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Hint.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Signature.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*DecoratedSignature)(nil)
+	_ DecoderFrom = (*DecoratedSignature)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e OperationType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("OperationType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *OperationType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("OperationType", v)
+	}
+	*e = OperationType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationType)(nil)
+	_ DecoderFrom = (*OperationType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s CreateAccountOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.StartingBalance.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *CreateAccountOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.StartingBalance.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*CreateAccountOp)(nil)
+	_ DecoderFrom = (*CreateAccountOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PaymentOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Asset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PaymentOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Asset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PaymentOp)(nil)
+	_ DecoderFrom = (*PaymentOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PathPaymentStrictReceiveOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SendAsset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.SendMax.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DestAsset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DestAmount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Path), 5); err != nil {
+		return err
+	}
+	for i1 := range s.Path {
+		if err = s.Path[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PathPaymentStrictReceiveOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SendAsset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.SendMax.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DestAsset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DestAmount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(5); err != nil {
+		return err
+	}
+	s.Path = nil
+	if l2 > 0 {
+		s.Path = make([]Asset, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 Asset
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Path = append(s.Path, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictReceiveOp)(nil)
+	_ DecoderFrom = (*PathPaymentStrictReceiveOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PathPaymentStrictSendOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SendAsset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.SendAmount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DestAsset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.DestMin.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Path), 5); err != nil {
+		return err
+	}
+	for i1 := range s.Path {
+		if err = s.Path[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PathPaymentStrictSendOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SendAsset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.SendAmount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DestAsset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.DestMin.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(5); err != nil {
+		return err
+	}
+	s.Path = nil
+	if l2 > 0 {
+		s.Path = make([]Asset, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 Asset
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Path = append(s.Path, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictSendOp)(nil)
+	_ DecoderFrom = (*PathPaymentStrictSendOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ManageSellOfferOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Selling.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Buying.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Price.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OfferId.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ManageSellOfferOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Selling.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Buying.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Price.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OfferId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageSellOfferOp)(nil)
+	_ DecoderFrom = (*ManageSellOfferOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ManageBuyOfferOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Selling.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Buying.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.BuyAmount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Price.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OfferId.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ManageBuyOfferOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Selling.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Buying.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.BuyAmount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Price.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OfferId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageBuyOfferOp)(nil)
+	_ DecoderFrom = (*ManageBuyOfferOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s CreatePassiveSellOfferOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Selling.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Buying.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Price.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *CreatePassiveSellOfferOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Selling.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Buying.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Price.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*CreatePassiveSellOfferOp)(nil)
+	_ DecoderFrom = (*CreatePassiveSellOfferOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s SetOptionsOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeBool(s.InflationDest != nil); err != nil {
+		return err
+	}
+	if s.InflationDest != nil {
+		if err = (*s.InflationDest).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.ClearFlags != nil); err != nil {
+		return err
+	}
+	if s.ClearFlags != nil {
+		if err = (*s.ClearFlags).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.SetFlags != nil); err != nil {
+		return err
+	}
+	if s.SetFlags != nil {
+		if err = (*s.SetFlags).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.MasterWeight != nil); err != nil {
+		return err
+	}
+	if s.MasterWeight != nil {
+		if err = (*s.MasterWeight).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.LowThreshold != nil); err != nil {
+		return err
+	}
+	if s.LowThreshold != nil {
+		if err = (*s.LowThreshold).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.MedThreshold != nil); err != nil {
+		return err
+	}
+	if s.MedThreshold != nil {
+		if err = (*s.MedThreshold).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.HighThreshold != nil); err != nil {
+		return err
+	}
+	if s.HighThreshold != nil {
+		if err = (*s.HighThreshold).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.HomeDomain != nil); err != nil {
+		return err
+	}
+	if s.HomeDomain != nil {
+		if err = (*s.HomeDomain).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = enc.EncodeBool(s.Signer != nil); err != nil {
+		return err
+	}
+	if s.Signer != nil {
+		if err = (*s.Signer).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *SetOptionsOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	var present1 bool
+	if present1, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.InflationDest = nil
+	if present1 {
+		s.InflationDest = new(AccountId)
+		if err = (*s.InflationDest).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present2 bool
+	if present2, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.ClearFlags = nil
+	if present2 {
+		s.ClearFlags = new(Uint32)
+		if err = (*s.ClearFlags).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present3 bool
+	if present3, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.SetFlags = nil
+	if present3 {
+		s.SetFlags = new(Uint32)
+		if err = (*s.SetFlags).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present4 bool
+	if present4, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.MasterWeight = nil
+	if present4 {
+		s.MasterWeight = new(Uint32)
+		if err = (*s.MasterWeight).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present5 bool
+	if present5, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.LowThreshold = nil
+	if present5 {
+		s.LowThreshold = new(Uint32)
+		if err = (*s.LowThreshold).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present6 bool
+	if present6, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.MedThreshold = nil
+	if present6 {
+		s.MedThreshold = new(Uint32)
+		if err = (*s.MedThreshold).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present7 bool
+	if present7, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.HighThreshold = nil
+	if present7 {
+		s.HighThreshold = new(Uint32)
+		if err = (*s.HighThreshold).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present8 bool
+	if present8, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.HomeDomain = nil
+	if present8 {
+		s.HomeDomain = new(String32)
+		if err = (*s.HomeDomain).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	var present9 bool
+	if present9, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.Signer = nil
+	if present9 {
+		s.Signer = new(Signer)
+		if err = (*s.Signer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SetOptionsOp)(nil)
+	_ DecoderFrom = (*SetOptionsOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ChangeTrustOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Line.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Limit.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ChangeTrustOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Line.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Limit.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ChangeTrustOp)(nil)
+	_ DecoderFrom = (*ChangeTrustOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AllowTrustOpAsset) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("AllowTrustOpAsset", int32(u.Type))
+	}
+	switch arm {
+	case "AssetCode4":
+		if u.AssetCode4 == nil {
+			return errNilArm("AllowTrustOpAsset", "AssetCode4")
+		}
+		if err = (*u.AssetCode4).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "AssetCode12":
+		if u.AssetCode12 == nil {
+			return errNilArm("AllowTrustOpAsset", "AssetCode12")
+		}
+		if err = (*u.AssetCode12).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AllowTrustOpAsset) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw AssetType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AllowTrustOpAsset", int32(sw))
+	}
+	*u = AllowTrustOpAsset{Type: sw}
+	switch arm {
+	case "AssetCode4":
+		u.AssetCode4 = new(AssetCode4)
+		if err = (*u.AssetCode4).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "AssetCode12":
+		u.AssetCode12 = new(AssetCode12)
+		if err = (*u.AssetCode12).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AllowTrustOpAsset)(nil)
+	_ DecoderFrom = (*AllowTrustOpAsset)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s AllowTrustOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Trustor.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Asset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeBool(bool(s.Authorize)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *AllowTrustOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Trustor.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Asset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var v1 bool
+	if v1, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.Authorize = bool(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AllowTrustOp)(nil)
+	_ DecoderFrom = (*AllowTrustOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ManageDataOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.DataName.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeBool(s.DataValue != nil); err != nil {
+		return err
+	}
+	if s.DataValue != nil {
+		if err = (*s.DataValue).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ManageDataOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.DataName.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var present1 bool
+	if present1, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.DataValue = nil
+	if present1 {
+		s.DataValue = new(DataValue)
+		if err = (*s.DataValue).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageDataOp)(nil)
+	_ DecoderFrom = (*ManageDataOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s BumpSequenceOp) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.BumpTo.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *BumpSequenceOp) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.BumpTo.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BumpSequenceOp)(nil)
+	_ DecoderFrom = (*BumpSequenceOp)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u OperationBody) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("OperationBody", int32(u.Type))
+	}
+	switch arm {
+	case "CreateAccountOp":
+		if u.CreateAccountOp == nil {
+			return errNilArm("OperationBody", "CreateAccountOp")
+		}
+		if err = (*u.CreateAccountOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PaymentOp":
+		if u.PaymentOp == nil {
+			return errNilArm("OperationBody", "PaymentOp")
+		}
+		if err = (*u.PaymentOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PathPaymentStrictReceiveOp":
+		if u.PathPaymentStrictReceiveOp == nil {
+			return errNilArm("OperationBody", "PathPaymentStrictReceiveOp")
+		}
+		if err = (*u.PathPaymentStrictReceiveOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageSellOfferOp":
+		if u.ManageSellOfferOp == nil {
+			return errNilArm("OperationBody", "ManageSellOfferOp")
+		}
+		if err = (*u.ManageSellOfferOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "CreatePassiveSellOfferOp":
+		if u.CreatePassiveSellOfferOp == nil {
+			return errNilArm("OperationBody", "CreatePassiveSellOfferOp")
+		}
+		if err = (*u.CreatePassiveSellOfferOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "SetOptionsOp":
+		if u.SetOptionsOp == nil {
+			return errNilArm("OperationBody", "SetOptionsOp")
+		}
+		if err = (*u.SetOptionsOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ChangeTrustOp":
+		if u.ChangeTrustOp == nil {
+			return errNilArm("OperationBody", "ChangeTrustOp")
+		}
+		if err = (*u.ChangeTrustOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "AllowTrustOp":
+		if u.AllowTrustOp == nil {
+			return errNilArm("OperationBody", "AllowTrustOp")
+		}
+		if err = (*u.AllowTrustOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Destination":
+		if u.Destination == nil {
+			return errNilArm("OperationBody", "Destination")
+		}
+		if err = (*u.Destination).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageDataOp":
+		if u.ManageDataOp == nil {
+			return errNilArm("OperationBody", "ManageDataOp")
+		}
+		if err = (*u.ManageDataOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "BumpSequenceOp":
+		if u.BumpSequenceOp == nil {
+			return errNilArm("OperationBody", "BumpSequenceOp")
+		}
+		if err = (*u.BumpSequenceOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageBuyOfferOp":
+		if u.ManageBuyOfferOp == nil {
+			return errNilArm("OperationBody", "ManageBuyOfferOp")
+		}
+		if err = (*u.ManageBuyOfferOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PathPaymentStrictSendOp":
+		if u.PathPaymentStrictSendOp == nil {
+			return errNilArm("OperationBody", "PathPaymentStrictSendOp")
+		}
+		if err = (*u.PathPaymentStrictSendOp).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *OperationBody) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw OperationType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("OperationBody", int32(sw))
+	}
+	*u = OperationBody{Type: sw}
+	switch arm {
+	case "CreateAccountOp":
+		u.CreateAccountOp = new(CreateAccountOp)
+		if err = (*u.CreateAccountOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PaymentOp":
+		u.PaymentOp = new(PaymentOp)
+		if err = (*u.PaymentOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PathPaymentStrictReceiveOp":
+		u.PathPaymentStrictReceiveOp = new(PathPaymentStrictReceiveOp)
+		if err = (*u.PathPaymentStrictReceiveOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageSellOfferOp":
+		u.ManageSellOfferOp = new(ManageSellOfferOp)
+		if err = (*u.ManageSellOfferOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "CreatePassiveSellOfferOp":
+		u.CreatePassiveSellOfferOp = new(CreatePassiveSellOfferOp)
+		if err = (*u.CreatePassiveSellOfferOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "SetOptionsOp":
+		u.SetOptionsOp = new(SetOptionsOp)
+		if err = (*u.SetOptionsOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ChangeTrustOp":
+		u.ChangeTrustOp = new(ChangeTrustOp)
+		if err = (*u.ChangeTrustOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "AllowTrustOp":
+		u.AllowTrustOp = new(AllowTrustOp)
+		if err = (*u.AllowTrustOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Destination":
+		u.Destination = new(AccountId)
+		if err = (*u.Destination).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageDataOp":
+		u.ManageDataOp = new(ManageDataOp)
+		if err = (*u.ManageDataOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "BumpSequenceOp":
+		u.BumpSequenceOp = new(BumpSequenceOp)
+		if err = (*u.BumpSequenceOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageBuyOfferOp":
+		u.ManageBuyOfferOp = new(ManageBuyOfferOp)
+		if err = (*u.ManageBuyOfferOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PathPaymentStrictSendOp":
+		u.PathPaymentStrictSendOp = new(PathPaymentStrictSendOp)
+		if err = (*u.PathPaymentStrictSendOp).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationBody)(nil)
+	_ DecoderFrom = (*OperationBody)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Operation) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeBool(s.SourceAccount != nil); err != nil {
+		return err
+	}
+	if s.SourceAccount != nil {
+		if err = (*s.SourceAccount).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Body.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Operation) DecodeFrom(dec *Decoder) error {
+	var err error
+	var present1 bool
+	if present1, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.SourceAccount = nil
+	if present1 {
+		s.SourceAccount = new(AccountId)
+		if err = (*s.SourceAccount).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	if err = s.Body.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Operation)(nil)
+	_ DecoderFrom = (*Operation)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e MemoType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("MemoType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *MemoType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("MemoType", v)
+	}
+	*e = MemoType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*MemoType)(nil)
+	_ DecoderFrom = (*MemoType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u Memo) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("Memo", int32(u.Type))
+	}
+	switch arm {
+	case "Text":
+		if u.Text == nil {
+			return errNilArm("Memo", "Text")
+		}
+		if err = enc.EncodeString(string((*u.Text)), 28); err != nil {
+			return err
+		}
+	case "Id":
+		if u.Id == nil {
+			return errNilArm("Memo", "Id")
+		}
+		if err = (*u.Id).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "Hash":
+		if u.Hash == nil {
+			return errNilArm("Memo", "Hash")
+		}
+		if err = (*u.Hash).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "RetHash":
+		if u.RetHash == nil {
+			return errNilArm("Memo", "RetHash")
+		}
+		if err = (*u.RetHash).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *Memo) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw MemoType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("Memo", int32(sw))
+	}
+	*u = Memo{Type: sw}
+	switch arm {
+	case "Text":
+		u.Text = new(string)
+		var v1 string
+		if v1, err = dec.DecodeString(28); err != nil {
+			return err
+		}
+		(*u.Text) = string(v1)
+	case "Id":
+		u.Id = new(Uint64)
+		if err = (*u.Id).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "Hash":
+		u.Hash = new(Hash)
+		if err = (*u.Hash).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "RetHash":
+		u.RetHash = new(Hash)
+		if err = (*u.RetHash).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Memo)(nil)
+	_ DecoderFrom = (*Memo)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TimeBounds) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.MinTime.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.MaxTime.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TimeBounds) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.MinTime.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.MaxTime.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TimeBounds)(nil)
+	_ DecoderFrom = (*TimeBounds)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TransactionExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionExt", int32(sw))
+	}
+	*u = TransactionExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionExt)(nil)
+	_ DecoderFrom = (*TransactionExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Transaction) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SourceAccount.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Fee.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.SeqNum.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeBool(s.TimeBounds != nil); err != nil {
+		return err
+	}
+	if s.TimeBounds != nil {
+		if err = (*s.TimeBounds).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Memo.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Operations), 100); err != nil {
+		return err
+	}
+	for i1 := range s.Operations {
+		if err = s.Operations[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Transaction) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SourceAccount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Fee.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.SeqNum.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var present2 bool
+	if present2, err = dec.DecodeBool(); err != nil {
+		return err
+	}
+	s.TimeBounds = nil
+	if present2 {
+		s.TimeBounds = new(TimeBounds)
+		if err = (*s.TimeBounds).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	if err = s.Memo.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l3 int
+	if l3, err = dec.DecodeArrayLen(100); err != nil {
+		return err
+	}
+	s.Operations = nil
+	if l3 > 0 {
+		s.Operations = make([]Operation, 0, dec.ArrayPreallocLen(l3))
+		for i4 := 0; i4 < l3; i4++ {
+			var elem5 Operation
+			if err = elem5.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Operations = append(s.Operations, elem5)
+		}
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Transaction)(nil)
+	_ DecoderFrom = (*Transaction)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionSignaturePayloadTaggedTransaction) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("TransactionSignaturePayloadTaggedTransaction", int32(u.Type))
+	}
+	switch arm {
+	case "Tx":
+		if u.Tx == nil {
+			return errNilArm("TransactionSignaturePayloadTaggedTransaction", "Tx")
+		}
+		if err = (*u.Tx).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionSignaturePayloadTaggedTransaction) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw EnvelopeType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionSignaturePayloadTaggedTransaction", int32(sw))
+	}
+	*u = TransactionSignaturePayloadTaggedTransaction{Type: sw}
+	switch arm {
+	case "Tx":
+		u.Tx = new(Transaction)
+		if err = (*u.Tx).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionSignaturePayloadTaggedTransaction)(nil)
+	_ DecoderFrom = (*TransactionSignaturePayloadTaggedTransaction)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionSignaturePayload) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.NetworkId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.TaggedTransaction.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionSignaturePayload) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.NetworkId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.TaggedTransaction.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionSignaturePayload)(nil)
+	_ DecoderFrom = (*TransactionSignaturePayload)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionEnvelope) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Tx.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = enc.EncodeArrayLen(len(s.Signatures), 20); err != nil {
+		return err
+	}
+	for i1 := range s.Signatures {
+		if err = s.Signatures[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionEnvelope) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Tx.DecodeFrom(dec); err != nil {
+		return err
+	}
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(20); err != nil {
+		return err
+	}
+	s.Signatures = nil
+	if l2 > 0 {
+		s.Signatures = make([]DecoratedSignature, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 DecoratedSignature
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Signatures = append(s.Signatures, elem4)
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionEnvelope)(nil)
+	_ DecoderFrom = (*TransactionEnvelope)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ClaimOfferAtom) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.SellerId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.OfferId.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.AssetSold.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.AmountSold.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.AssetBought.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.AmountBought.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ClaimOfferAtom) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.SellerId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.OfferId.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.AssetSold.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.AmountSold.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.AssetBought.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.AmountBought.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ClaimOfferAtom)(nil)
+	_ DecoderFrom = (*ClaimOfferAtom)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e CreateAccountResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("CreateAccountResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *CreateAccountResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("CreateAccountResultCode", v)
+	}
+	*e = CreateAccountResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*CreateAccountResultCode)(nil)
+	_ DecoderFrom = (*CreateAccountResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u CreateAccountResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("CreateAccountResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *CreateAccountResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw CreateAccountResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("CreateAccountResult", int32(sw))
+	}
+	*u = CreateAccountResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*CreateAccountResult)(nil)
+	_ DecoderFrom = (*CreateAccountResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e PaymentResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("PaymentResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *PaymentResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("PaymentResultCode", v)
+	}
+	*e = PaymentResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PaymentResultCode)(nil)
+	_ DecoderFrom = (*PaymentResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u PaymentResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("PaymentResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *PaymentResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw PaymentResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("PaymentResult", int32(sw))
+	}
+	*u = PaymentResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PaymentResult)(nil)
+	_ DecoderFrom = (*PaymentResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e PathPaymentStrictReceiveResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("PathPaymentStrictReceiveResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *PathPaymentStrictReceiveResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("PathPaymentStrictReceiveResultCode", v)
+	}
+	*e = PathPaymentStrictReceiveResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictReceiveResultCode)(nil)
+	_ DecoderFrom = (*PathPaymentStrictReceiveResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s SimplePaymentResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Asset.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *SimplePaymentResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Asset.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SimplePaymentResult)(nil)
+	_ DecoderFrom = (*SimplePaymentResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PathPaymentStrictReceiveResultSuccess) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s.Offers), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Offers {
+		if err = s.Offers[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Last.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PathPaymentStrictReceiveResultSuccess) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Offers = nil
+	if l2 > 0 {
+		s.Offers = make([]ClaimOfferAtom, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 ClaimOfferAtom
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Offers = append(s.Offers, elem4)
+		}
+	}
+	if err = s.Last.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictReceiveResultSuccess)(nil)
+	_ DecoderFrom = (*PathPaymentStrictReceiveResultSuccess)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u PathPaymentStrictReceiveResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("PathPaymentStrictReceiveResult", int32(u.Code))
+	}
+	switch arm {
+	case "Success":
+		if u.Success == nil {
+			return errNilArm("PathPaymentStrictReceiveResult", "Success")
+		}
+		if err = (*u.Success).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "NoIssuer":
+		if u.NoIssuer == nil {
+			return errNilArm("PathPaymentStrictReceiveResult", "NoIssuer")
+		}
+		if err = (*u.NoIssuer).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *PathPaymentStrictReceiveResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw PathPaymentStrictReceiveResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("PathPaymentStrictReceiveResult", int32(sw))
+	}
+	*u = PathPaymentStrictReceiveResult{Code: sw}
+	switch arm {
+	case "Success":
+		u.Success = new(PathPaymentStrictReceiveResultSuccess)
+		if err = (*u.Success).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "NoIssuer":
+		u.NoIssuer = new(Asset)
+		if err = (*u.NoIssuer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictReceiveResult)(nil)
+	_ DecoderFrom = (*PathPaymentStrictReceiveResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e PathPaymentStrictSendResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("PathPaymentStrictSendResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *PathPaymentStrictSendResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("PathPaymentStrictSendResultCode", v)
+	}
+	*e = PathPaymentStrictSendResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictSendResultCode)(nil)
+	_ DecoderFrom = (*PathPaymentStrictSendResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s PathPaymentStrictSendResultSuccess) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s.Offers), 0); err != nil {
+		return err
+	}
+	for i1 := range s.Offers {
+		if err = s.Offers[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Last.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *PathPaymentStrictSendResultSuccess) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.Offers = nil
+	if l2 > 0 {
+		s.Offers = make([]ClaimOfferAtom, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 ClaimOfferAtom
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.Offers = append(s.Offers, elem4)
+		}
+	}
+	if err = s.Last.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictSendResultSuccess)(nil)
+	_ DecoderFrom = (*PathPaymentStrictSendResultSuccess)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u PathPaymentStrictSendResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("PathPaymentStrictSendResult", int32(u.Code))
+	}
+	switch arm {
+	case "Success":
+		if u.Success == nil {
+			return errNilArm("PathPaymentStrictSendResult", "Success")
+		}
+		if err = (*u.Success).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "NoIssuer":
+		if u.NoIssuer == nil {
+			return errNilArm("PathPaymentStrictSendResult", "NoIssuer")
+		}
+		if err = (*u.NoIssuer).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *PathPaymentStrictSendResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw PathPaymentStrictSendResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("PathPaymentStrictSendResult", int32(sw))
+	}
+	*u = PathPaymentStrictSendResult{Code: sw}
+	switch arm {
+	case "Success":
+		u.Success = new(PathPaymentStrictSendResultSuccess)
+		if err = (*u.Success).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "NoIssuer":
+		u.NoIssuer = new(Asset)
+		if err = (*u.NoIssuer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PathPaymentStrictSendResult)(nil)
+	_ DecoderFrom = (*PathPaymentStrictSendResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ManageSellOfferResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ManageSellOfferResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ManageSellOfferResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ManageSellOfferResultCode", v)
+	}
+	*e = ManageSellOfferResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageSellOfferResultCode)(nil)
+	_ DecoderFrom = (*ManageSellOfferResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ManageOfferEffect) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ManageOfferEffect", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ManageOfferEffect) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ManageOfferEffect", v)
+	}
+	*e = ManageOfferEffect(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageOfferEffect)(nil)
+	_ DecoderFrom = (*ManageOfferEffect)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ManageOfferSuccessResultOffer) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Effect.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Effect))
+	if !ok {
+		return errInvalidUnion("ManageOfferSuccessResultOffer", int32(u.Effect))
+	}
+	switch arm {
+	case "Offer":
+		if u.Offer == nil {
+			return errNilArm("ManageOfferSuccessResultOffer", "Offer")
+		}
+		if err = (*u.Offer).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ManageOfferSuccessResultOffer) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ManageOfferEffect
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ManageOfferSuccessResultOffer", int32(sw))
+	}
+	*u = ManageOfferSuccessResultOffer{Effect: sw}
+	switch arm {
+	case "Offer":
+		u.Offer = new(OfferEntry)
+		if err = (*u.Offer).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageOfferSuccessResultOffer)(nil)
+	_ DecoderFrom = (*ManageOfferSuccessResultOffer)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s ManageOfferSuccessResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeArrayLen(len(s.OffersClaimed), 0); err != nil {
+		return err
+	}
+	for i1 := range s.OffersClaimed {
+		if err = s.OffersClaimed[i1].EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	if err = s.Offer.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *ManageOfferSuccessResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var l2 int
+	if l2, err = dec.DecodeArrayLen(0); err != nil {
+		return err
+	}
+	s.OffersClaimed = nil
+	if l2 > 0 {
+		s.OffersClaimed = make([]ClaimOfferAtom, 0, dec.ArrayPreallocLen(l2))
+		for i3 := 0; i3 < l2; i3++ {
+			var elem4 ClaimOfferAtom
+			if err = elem4.DecodeFrom(dec); err != nil {
+				return err
+			}
+			s.OffersClaimed = append(s.OffersClaimed, elem4)
+		}
+	}
+	if err = s.Offer.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageOfferSuccessResult)(nil)
+	_ DecoderFrom = (*ManageOfferSuccessResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ManageSellOfferResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("ManageSellOfferResult", int32(u.Code))
+	}
+	switch arm {
+	case "Success":
+		if u.Success == nil {
+			return errNilArm("ManageSellOfferResult", "Success")
+		}
+		if err = (*u.Success).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ManageSellOfferResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ManageSellOfferResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ManageSellOfferResult", int32(sw))
+	}
+	*u = ManageSellOfferResult{Code: sw}
+	switch arm {
+	case "Success":
+		u.Success = new(ManageOfferSuccessResult)
+		if err = (*u.Success).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageSellOfferResult)(nil)
+	_ DecoderFrom = (*ManageSellOfferResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ManageBuyOfferResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ManageBuyOfferResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ManageBuyOfferResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ManageBuyOfferResultCode", v)
+	}
+	*e = ManageBuyOfferResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageBuyOfferResultCode)(nil)
+	_ DecoderFrom = (*ManageBuyOfferResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ManageBuyOfferResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("ManageBuyOfferResult", int32(u.Code))
+	}
+	switch arm {
+	case "Success":
+		if u.Success == nil {
+			return errNilArm("ManageBuyOfferResult", "Success")
+		}
+		if err = (*u.Success).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ManageBuyOfferResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ManageBuyOfferResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ManageBuyOfferResult", int32(sw))
+	}
+	*u = ManageBuyOfferResult{Code: sw}
+	switch arm {
+	case "Success":
+		u.Success = new(ManageOfferSuccessResult)
+		if err = (*u.Success).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageBuyOfferResult)(nil)
+	_ DecoderFrom = (*ManageBuyOfferResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e SetOptionsResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("SetOptionsResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *SetOptionsResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("SetOptionsResultCode", v)
+	}
+	*e = SetOptionsResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SetOptionsResultCode)(nil)
+	_ DecoderFrom = (*SetOptionsResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u SetOptionsResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("SetOptionsResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *SetOptionsResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw SetOptionsResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("SetOptionsResult", int32(sw))
+	}
+	*u = SetOptionsResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SetOptionsResult)(nil)
+	_ DecoderFrom = (*SetOptionsResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ChangeTrustResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ChangeTrustResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ChangeTrustResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ChangeTrustResultCode", v)
+	}
+	*e = ChangeTrustResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ChangeTrustResultCode)(nil)
+	_ DecoderFrom = (*ChangeTrustResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ChangeTrustResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("ChangeTrustResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ChangeTrustResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ChangeTrustResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ChangeTrustResult", int32(sw))
+	}
+	*u = ChangeTrustResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ChangeTrustResult)(nil)
+	_ DecoderFrom = (*ChangeTrustResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e AllowTrustResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("AllowTrustResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *AllowTrustResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("AllowTrustResultCode", v)
+	}
+	*e = AllowTrustResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AllowTrustResultCode)(nil)
+	_ DecoderFrom = (*AllowTrustResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AllowTrustResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("AllowTrustResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AllowTrustResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw AllowTrustResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AllowTrustResult", int32(sw))
+	}
+	*u = AllowTrustResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AllowTrustResult)(nil)
+	_ DecoderFrom = (*AllowTrustResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e AccountMergeResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("AccountMergeResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *AccountMergeResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("AccountMergeResultCode", v)
+	}
+	*e = AccountMergeResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountMergeResultCode)(nil)
+	_ DecoderFrom = (*AccountMergeResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u AccountMergeResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("AccountMergeResult", int32(u.Code))
+	}
+	switch arm {
+	case "SourceAccountBalance":
+		if u.SourceAccountBalance == nil {
+			return errNilArm("AccountMergeResult", "SourceAccountBalance")
+		}
+		if err = (*u.SourceAccountBalance).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *AccountMergeResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw AccountMergeResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("AccountMergeResult", int32(sw))
+	}
+	*u = AccountMergeResult{Code: sw}
+	switch arm {
+	case "SourceAccountBalance":
+		u.SourceAccountBalance = new(Int64)
+		if err = (*u.SourceAccountBalance).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*AccountMergeResult)(nil)
+	_ DecoderFrom = (*AccountMergeResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e InflationResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("InflationResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *InflationResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("InflationResultCode", v)
+	}
+	*e = InflationResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*InflationResultCode)(nil)
+	_ DecoderFrom = (*InflationResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s InflationPayout) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.Destination.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Amount.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *InflationPayout) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.Destination.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Amount.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*InflationPayout)(nil)
+	_ DecoderFrom = (*InflationPayout)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u InflationResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("InflationResult", int32(u.Code))
+	}
+	switch arm {
+	case "Payouts":
+		if u.Payouts == nil {
+			return errNilArm("InflationResult", "Payouts")
+		}
+		if err = enc.EncodeArrayLen(len((*u.Payouts)), 0); err != nil {
+			return err
+		}
+		for i1 := range *u.Payouts {
+			if err = (*u.Payouts)[i1].EncodeTo(enc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *InflationResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw InflationResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("InflationResult", int32(sw))
+	}
+	*u = InflationResult{Code: sw}
+	switch arm {
+	case "Payouts":
+		u.Payouts = new([]InflationPayout)
+		var l2 int
+		if l2, err = dec.DecodeArrayLen(0); err != nil {
+			return err
+		}
+		(*u.Payouts) = nil
+		if l2 > 0 {
+			(*u.Payouts) = make([]InflationPayout, 0, dec.ArrayPreallocLen(l2))
+			for i3 := 0; i3 < l2; i3++ {
+				var elem4 InflationPayout
+				if err = elem4.DecodeFrom(dec); err != nil {
+					return err
+				}
+				(*u.Payouts) = append((*u.Payouts), elem4)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*InflationResult)(nil)
+	_ DecoderFrom = (*InflationResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e ManageDataResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("ManageDataResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *ManageDataResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("ManageDataResultCode", v)
+	}
+	*e = ManageDataResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageDataResultCode)(nil)
+	_ DecoderFrom = (*ManageDataResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u ManageDataResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("ManageDataResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *ManageDataResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw ManageDataResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("ManageDataResult", int32(sw))
+	}
+	*u = ManageDataResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*ManageDataResult)(nil)
+	_ DecoderFrom = (*ManageDataResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e BumpSequenceResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("BumpSequenceResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *BumpSequenceResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("BumpSequenceResultCode", v)
+	}
+	*e = BumpSequenceResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BumpSequenceResultCode)(nil)
+	_ DecoderFrom = (*BumpSequenceResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u BumpSequenceResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("BumpSequenceResult", int32(u.Code))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *BumpSequenceResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw BumpSequenceResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("BumpSequenceResult", int32(sw))
+	}
+	*u = BumpSequenceResult{Code: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*BumpSequenceResult)(nil)
+	_ DecoderFrom = (*BumpSequenceResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e OperationResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("OperationResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *OperationResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("OperationResultCode", v)
+	}
+	*e = OperationResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationResultCode)(nil)
+	_ DecoderFrom = (*OperationResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u OperationResultTr) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("OperationResultTr", int32(u.Type))
+	}
+	switch arm {
+	case "CreateAccountResult":
+		if u.CreateAccountResult == nil {
+			return errNilArm("OperationResultTr", "CreateAccountResult")
+		}
+		if err = (*u.CreateAccountResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PaymentResult":
+		if u.PaymentResult == nil {
+			return errNilArm("OperationResultTr", "PaymentResult")
+		}
+		if err = (*u.PaymentResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PathPaymentStrictReceiveResult":
+		if u.PathPaymentStrictReceiveResult == nil {
+			return errNilArm("OperationResultTr", "PathPaymentStrictReceiveResult")
+		}
+		if err = (*u.PathPaymentStrictReceiveResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageSellOfferResult":
+		if u.ManageSellOfferResult == nil {
+			return errNilArm("OperationResultTr", "ManageSellOfferResult")
+		}
+		if err = (*u.ManageSellOfferResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "CreatePassiveSellOfferResult":
+		if u.CreatePassiveSellOfferResult == nil {
+			return errNilArm("OperationResultTr", "CreatePassiveSellOfferResult")
+		}
+		if err = (*u.CreatePassiveSellOfferResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "SetOptionsResult":
+		if u.SetOptionsResult == nil {
+			return errNilArm("OperationResultTr", "SetOptionsResult")
+		}
+		if err = (*u.SetOptionsResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ChangeTrustResult":
+		if u.ChangeTrustResult == nil {
+			return errNilArm("OperationResultTr", "ChangeTrustResult")
+		}
+		if err = (*u.ChangeTrustResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "AllowTrustResult":
+		if u.AllowTrustResult == nil {
+			return errNilArm("OperationResultTr", "AllowTrustResult")
+		}
+		if err = (*u.AllowTrustResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "AccountMergeResult":
+		if u.AccountMergeResult == nil {
+			return errNilArm("OperationResultTr", "AccountMergeResult")
+		}
+		if err = (*u.AccountMergeResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "InflationResult":
+		if u.InflationResult == nil {
+			return errNilArm("OperationResultTr", "InflationResult")
+		}
+		if err = (*u.InflationResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageDataResult":
+		if u.ManageDataResult == nil {
+			return errNilArm("OperationResultTr", "ManageDataResult")
+		}
+		if err = (*u.ManageDataResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "BumpSeqResult":
+		if u.BumpSeqResult == nil {
+			return errNilArm("OperationResultTr", "BumpSeqResult")
+		}
+		if err = (*u.BumpSeqResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "ManageBuyOfferResult":
+		if u.ManageBuyOfferResult == nil {
+			return errNilArm("OperationResultTr", "ManageBuyOfferResult")
+		}
+		if err = (*u.ManageBuyOfferResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PathPaymentStrictSendResult":
+		if u.PathPaymentStrictSendResult == nil {
+			return errNilArm("OperationResultTr", "PathPaymentStrictSendResult")
+		}
+		if err = (*u.PathPaymentStrictSendResult).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *OperationResultTr) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw OperationType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("OperationResultTr", int32(sw))
+	}
+	*u = OperationResultTr{Type: sw}
+	switch arm {
+	case "CreateAccountResult":
+		u.CreateAccountResult = new(CreateAccountResult)
+		if err = (*u.CreateAccountResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PaymentResult":
+		u.PaymentResult = new(PaymentResult)
+		if err = (*u.PaymentResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PathPaymentStrictReceiveResult":
+		u.PathPaymentStrictReceiveResult = new(PathPaymentStrictReceiveResult)
+		if err = (*u.PathPaymentStrictReceiveResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageSellOfferResult":
+		u.ManageSellOfferResult = new(ManageSellOfferResult)
+		if err = (*u.ManageSellOfferResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "CreatePassiveSellOfferResult":
+		u.CreatePassiveSellOfferResult = new(ManageSellOfferResult)
+		if err = (*u.CreatePassiveSellOfferResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "SetOptionsResult":
+		u.SetOptionsResult = new(SetOptionsResult)
+		if err = (*u.SetOptionsResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ChangeTrustResult":
+		u.ChangeTrustResult = new(ChangeTrustResult)
+		if err = (*u.ChangeTrustResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "AllowTrustResult":
+		u.AllowTrustResult = new(AllowTrustResult)
+		if err = (*u.AllowTrustResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "AccountMergeResult":
+		u.AccountMergeResult = new(AccountMergeResult)
+		if err = (*u.AccountMergeResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "InflationResult":
+		u.InflationResult = new(InflationResult)
+		if err = (*u.InflationResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageDataResult":
+		u.ManageDataResult = new(ManageDataResult)
+		if err = (*u.ManageDataResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "BumpSeqResult":
+		u.BumpSeqResult = new(BumpSequenceResult)
+		if err = (*u.BumpSeqResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "ManageBuyOfferResult":
+		u.ManageBuyOfferResult = new(ManageBuyOfferResult)
+		if err = (*u.ManageBuyOfferResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PathPaymentStrictSendResult":
+		u.PathPaymentStrictSendResult = new(PathPaymentStrictSendResult)
+		if err = (*u.PathPaymentStrictSendResult).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationResultTr)(nil)
+	_ DecoderFrom = (*OperationResultTr)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u OperationResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("OperationResult", int32(u.Code))
+	}
+	switch arm {
+	case "Tr":
+		if u.Tr == nil {
+			return errNilArm("OperationResult", "Tr")
+		}
+		if err = (*u.Tr).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *OperationResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw OperationResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("OperationResult", int32(sw))
+	}
+	*u = OperationResult{Code: sw}
+	switch arm {
+	case "Tr":
+		u.Tr = new(OperationResultTr)
+		if err = (*u.Tr).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*OperationResult)(nil)
+	_ DecoderFrom = (*OperationResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e TransactionResultCode) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("TransactionResultCode", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *TransactionResultCode) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("TransactionResultCode", v)
+	}
+	*e = TransactionResultCode(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResultCode)(nil)
+	_ DecoderFrom = (*TransactionResultCode)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionResultResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Code.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Code))
+	if !ok {
+		return errInvalidUnion("TransactionResultResult", int32(u.Code))
+	}
+	switch arm {
+	case "Results":
+		if u.Results == nil {
+			return errNilArm("TransactionResultResult", "Results")
+		}
+		if err = enc.EncodeArrayLen(len((*u.Results)), 0); err != nil {
+			return err
+		}
+		for i1 := range *u.Results {
+			if err = (*u.Results)[i1].EncodeTo(enc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionResultResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw TransactionResultCode
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionResultResult", int32(sw))
+	}
+	*u = TransactionResultResult{Code: sw}
+	switch arm {
+	case "Results":
+		u.Results = new([]OperationResult)
+		var l2 int
+		if l2, err = dec.DecodeArrayLen(0); err != nil {
+			return err
+		}
+		(*u.Results) = nil
+		if l2 > 0 {
+			(*u.Results) = make([]OperationResult, 0, dec.ArrayPreallocLen(l2))
+			for i3 := 0; i3 < l2; i3++ {
+				var elem4 OperationResult
+				if err = elem4.DecodeFrom(dec); err != nil {
+					return err
+				}
+				(*u.Results) = append((*u.Results), elem4)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResultResult)(nil)
+	_ DecoderFrom = (*TransactionResultResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u TransactionResultExt) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(u.V)); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.V))
+	if !ok {
+		return errInvalidUnion("TransactionResultExt", int32(u.V))
+	}
+	_ = arm
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *TransactionResultExt) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw int32
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	sw = int32(v1)
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("TransactionResultExt", int32(sw))
+	}
+	*u = TransactionResultExt{V: sw}
+	_ = arm
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResultExt)(nil)
+	_ DecoderFrom = (*TransactionResultExt)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s TransactionResult) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = s.FeeCharged.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Result.EncodeTo(enc); err != nil {
+		return err
+	}
+	if err = s.Ext.EncodeTo(enc); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *TransactionResult) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = s.FeeCharged.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Result.DecodeFrom(dec); err != nil {
+		return err
+	}
+	if err = s.Ext.DecodeFrom(dec); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*TransactionResult)(nil)
+	_ DecoderFrom = (*TransactionResult)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Hash) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Hash) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Hash)(nil)
+	_ DecoderFrom = (*Hash)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Uint256) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Uint256) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Uint256)(nil)
+	_ DecoderFrom = (*Uint256)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Uint32) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeUint(uint32(s)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Uint32) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 uint32
+	if v1, err = dec.DecodeUint(); err != nil {
+		return err
+	}
+	(*s) = Uint32(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Uint32)(nil)
+	_ DecoderFrom = (*Uint32)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Int32) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeInt(int32(s)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Int32) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 int32
+	if v1, err = dec.DecodeInt(); err != nil {
+		return err
+	}
+	(*s) = Int32(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Int32)(nil)
+	_ DecoderFrom = (*Int32)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Uint64) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeUhyper(uint64(s)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Uint64) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 uint64
+	if v1, err = dec.DecodeUhyper(); err != nil {
+		return err
+	}
+	(*s) = Uint64(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Uint64)(nil)
+	_ DecoderFrom = (*Uint64)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Int64) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeHyper(int64(s)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Int64) DecodeFrom(dec *Decoder) error {
+	var err error
+	var v1 int64
+	if v1, err = dec.DecodeHyper(); err != nil {
+		return err
+	}
+	(*s) = Int64(v1)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Int64)(nil)
+	_ DecoderFrom = (*Int64)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e CryptoKeyType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("CryptoKeyType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *CryptoKeyType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("CryptoKeyType", v)
+	}
+	*e = CryptoKeyType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*CryptoKeyType)(nil)
+	_ DecoderFrom = (*CryptoKeyType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e PublicKeyType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("PublicKeyType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *PublicKeyType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("PublicKeyType", v)
+	}
+	*e = PublicKeyType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PublicKeyType)(nil)
+	_ DecoderFrom = (*PublicKeyType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (e SignerKeyType) EncodeTo(enc *Encoder) error {
+	if !e.ValidEnum(int32(e)) {
+		return errInvalidEnum("SignerKeyType", int32(e))
+	}
+	return enc.EncodeInt(int32(e))
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (e *SignerKeyType) DecodeFrom(dec *Decoder) error {
+	v, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	if !e.ValidEnum(v) {
+		return errInvalidEnum("SignerKeyType", v)
+	}
+	*e = SignerKeyType(v)
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SignerKeyType)(nil)
+	_ DecoderFrom = (*SignerKeyType)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u PublicKey) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("PublicKey", int32(u.Type))
+	}
+	switch arm {
+	case "Ed25519":
+		if u.Ed25519 == nil {
+			return errNilArm("PublicKey", "Ed25519")
+		}
+		if err = (*u.Ed25519).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *PublicKey) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw PublicKeyType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("PublicKey", int32(sw))
+	}
+	*u = PublicKey{Type: sw}
+	switch arm {
+	case "Ed25519":
+		u.Ed25519 = new(Uint256)
+		if err = (*u.Ed25519).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*PublicKey)(nil)
+	_ DecoderFrom = (*PublicKey)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (u SignerKey) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = u.Type.EncodeTo(enc); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(u.Type))
+	if !ok {
+		return errInvalidUnion("SignerKey", int32(u.Type))
+	}
+	switch arm {
+	case "Ed25519":
+		if u.Ed25519 == nil {
+			return errNilArm("SignerKey", "Ed25519")
+		}
+		if err = (*u.Ed25519).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "PreAuthTx":
+		if u.PreAuthTx == nil {
+			return errNilArm("SignerKey", "PreAuthTx")
+		}
+		if err = (*u.PreAuthTx).EncodeTo(enc); err != nil {
+			return err
+		}
+	case "HashX":
+		if u.HashX == nil {
+			return errNilArm("SignerKey", "HashX")
+		}
+		if err = (*u.HashX).EncodeTo(enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (u *SignerKey) DecodeFrom(dec *Decoder) error {
+	var err error
+	var sw SignerKeyType
+	if err = sw.DecodeFrom(dec); err != nil {
+		return err
+	}
+	arm, ok := u.ArmForSwitch(int32(sw))
+	if !ok {
+		return errInvalidUnion("SignerKey", int32(sw))
+	}
+	*u = SignerKey{Type: sw}
+	switch arm {
+	case "Ed25519":
+		u.Ed25519 = new(Uint256)
+		if err = (*u.Ed25519).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "PreAuthTx":
+		u.PreAuthTx = new(Uint256)
+		if err = (*u.PreAuthTx).DecodeFrom(dec); err != nil {
+			return err
+		}
+	case "HashX":
+		u.HashX = new(Uint256)
+		if err = (*u.HashX).DecodeFrom(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SignerKey)(nil)
+	_ DecoderFrom = (*SignerKey)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Signature) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeOpaque(s, 64); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Signature) DecodeFrom(dec *Decoder) error {
+	var err error
+	if (*s), err = dec.DecodeOpaque(64); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Signature)(nil)
+	_ DecoderFrom = (*Signature)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s SignatureHint) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *SignatureHint) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque((*s)[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*SignatureHint)(nil)
+	_ DecoderFrom = (*SignatureHint)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s NodeId) EncodeTo(enc *Encoder) error {
+	return PublicKey(s).EncodeTo(enc)
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *NodeId) DecodeFrom(dec *Decoder) error {
+	return (*PublicKey)(s).DecodeFrom(dec)
+}
+
+var (
+	_ EncoderTo   = (*NodeId)(nil)
+	_ DecoderFrom = (*NodeId)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Curve25519Secret) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Curve25519Secret) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Curve25519Secret)(nil)
+	_ DecoderFrom = (*Curve25519Secret)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s Curve25519Public) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *Curve25519Public) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*Curve25519Public)(nil)
+	_ DecoderFrom = (*Curve25519Public)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s HmacSha256Key) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *HmacSha256Key) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque(s.Key[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*HmacSha256Key)(nil)
+	_ DecoderFrom = (*HmacSha256Key)(nil)
+)
+
+// EncodeTo encodes this value using the Encoder.
+func (s HmacSha256Mac) EncodeTo(enc *Encoder) error {
+	var err error
+	if err = enc.EncodeFixedOpaque(s.Mac[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DecodeFrom decodes this value using the Decoder.
+func (s *HmacSha256Mac) DecodeFrom(dec *Decoder) error {
+	var err error
+	if err = dec.DecodeFixedOpaque(s.Mac[:]); err != nil {
+		return err
+	}
+	return nil
+}
+
+var (
+	_ EncoderTo   = (*HmacSha256Mac)(nil)
+	_ DecoderFrom = (*HmacSha256Mac)(nil)
+)
@@ -0,0 +1,230 @@
+// Code generated by xdr/internal/codecgen from xdr_generated.go. DO NOT EDIT.
+
+package xdr
+
+import "reflect"
+
+// xdrTypes maps the names of the xdr types to their type.
+var xdrTypes = map[string]reflect.Type{
+	"Value":                            reflect.TypeOf((*Value)(nil)).Elem(),
+	"ScpBallot":                        reflect.TypeOf((*ScpBallot)(nil)).Elem(),
+	"ScpStatementType":                 reflect.TypeOf((*ScpStatementType)(nil)).Elem(),
+	"ScpNomination":                    reflect.TypeOf((*ScpNomination)(nil)).Elem(),
+	"ScpStatementPrepare":              reflect.TypeOf((*ScpStatementPrepare)(nil)).Elem(),
+	"ScpStatementConfirm":              reflect.TypeOf((*ScpStatementConfirm)(nil)).Elem(),
+	"ScpStatementExternalize":          reflect.TypeOf((*ScpStatementExternalize)(nil)).Elem(),
+	"ScpStatementPledges":              reflect.TypeOf((*ScpStatementPledges)(nil)).Elem(),
+	"ScpStatement":                     reflect.TypeOf((*ScpStatement)(nil)).Elem(),
+	"ScpEnvelope":                      reflect.TypeOf((*ScpEnvelope)(nil)).Elem(),
+	"ScpQuorumSet":                     reflect.TypeOf((*ScpQuorumSet)(nil)).Elem(),
+	"AccountId":                        reflect.TypeOf((*AccountId)(nil)).Elem(),
+	"Thresholds":                       reflect.TypeOf((*Thresholds)(nil)).Elem(),
+	"String32":                         reflect.TypeOf((*String32)(nil)).Elem(),
+	"String64":                         reflect.TypeOf((*String64)(nil)).Elem(),
+	"SequenceNumber":                   reflect.TypeOf((*SequenceNumber)(nil)).Elem(),
+	"TimePoint":                        reflect.TypeOf((*TimePoint)(nil)).Elem(),
+	"DataValue":                        reflect.TypeOf((*DataValue)(nil)).Elem(),
+	"AssetCode4":                       reflect.TypeOf((*AssetCode4)(nil)).Elem(),
+	"AssetCode12":                      reflect.TypeOf((*AssetCode12)(nil)).Elem(),
+	"AssetType":                        reflect.TypeOf((*AssetType)(nil)).Elem(),
+	"AssetAlphaNum4":                   reflect.TypeOf((*AssetAlphaNum4)(nil)).Elem(),
+	"AssetAlphaNum12":                  reflect.TypeOf((*AssetAlphaNum12)(nil)).Elem(),
+	"Asset":                            reflect.TypeOf((*Asset)(nil)).Elem(),
+	"Price":                            reflect.TypeOf((*Price)(nil)).Elem(),
+	"Liabilities":                      reflect.TypeOf((*Liabilities)(nil)).Elem(),
+	"ThresholdIndexes":                 reflect.TypeOf((*ThresholdIndexes)(nil)).Elem(),
+	"LedgerEntryType":                  reflect.TypeOf((*LedgerEntryType)(nil)).Elem(),
+	"Signer":                           reflect.TypeOf((*Signer)(nil)).Elem(),
+	"AccountFlags":                     reflect.TypeOf((*AccountFlags)(nil)).Elem(),
+	"AccountEntryV1Ext":                reflect.TypeOf((*AccountEntryV1Ext)(nil)).Elem(),
+	"AccountEntryV1":                   reflect.TypeOf((*AccountEntryV1)(nil)).Elem(),
+	"AccountEntryExt":                  reflect.TypeOf((*AccountEntryExt)(nil)).Elem(),
+	"AccountEntry":                     reflect.TypeOf((*AccountEntry)(nil)).Elem(),
+	"TrustLineFlags":                   reflect.TypeOf((*TrustLineFlags)(nil)).Elem(),
+	"TrustLineEntryV1Ext":              reflect.TypeOf((*TrustLineEntryV1Ext)(nil)).Elem(),
+	"TrustLineEntryV1":                 reflect.TypeOf((*TrustLineEntryV1)(nil)).Elem(),
+	"TrustLineEntryExt":                reflect.TypeOf((*TrustLineEntryExt)(nil)).Elem(),
+	"TrustLineEntry":                   reflect.TypeOf((*TrustLineEntry)(nil)).Elem(),
+	"OfferEntryFlags":                  reflect.TypeOf((*OfferEntryFlags)(nil)).Elem(),
+	"OfferEntryExt":                    reflect.TypeOf((*OfferEntryExt)(nil)).Elem(),
+	"OfferEntry":                       reflect.TypeOf((*OfferEntry)(nil)).Elem(),
+	"DataEntryExt":                     reflect.TypeOf((*DataEntryExt)(nil)).Elem(),
+	"DataEntry":                        reflect.TypeOf((*DataEntry)(nil)).Elem(),
+	"LedgerEntryData":                  reflect.TypeOf((*LedgerEntryData)(nil)).Elem(),
+	"LedgerEntryExt":                   reflect.TypeOf((*LedgerEntryExt)(nil)).Elem(),
+	"LedgerEntry":                      reflect.TypeOf((*LedgerEntry)(nil)).Elem(),
+	"EnvelopeType":                     reflect.TypeOf((*EnvelopeType)(nil)).Elem(),
+	"UpgradeType":                      reflect.TypeOf((*UpgradeType)(nil)).Elem(),
+	"StellarValueType":                 reflect.TypeOf((*StellarValueType)(nil)).Elem(),
+	"LedgerCloseValueSignature":        reflect.TypeOf((*LedgerCloseValueSignature)(nil)).Elem(),
+	"StellarValueExt":                  reflect.TypeOf((*StellarValueExt)(nil)).Elem(),
+	"StellarValue":                     reflect.TypeOf((*StellarValue)(nil)).Elem(),
+	"LedgerHeaderExt":                  reflect.TypeOf((*LedgerHeaderExt)(nil)).Elem(),
+	"LedgerHeader":                     reflect.TypeOf((*LedgerHeader)(nil)).Elem(),
+	"LedgerUpgradeType":                reflect.TypeOf((*LedgerUpgradeType)(nil)).Elem(),
+	"LedgerUpgrade":                    reflect.TypeOf((*LedgerUpgrade)(nil)).Elem(),
+	"LedgerKeyAccount":                 reflect.TypeOf((*LedgerKeyAccount)(nil)).Elem(),
+	"LedgerKeyTrustLine":               reflect.TypeOf((*LedgerKeyTrustLine)(nil)).Elem(),
+	"LedgerKeyOffer":                   reflect.TypeOf((*LedgerKeyOffer)(nil)).Elem(),
+	"LedgerKeyData":                    reflect.TypeOf((*LedgerKeyData)(nil)).Elem(),
+	"LedgerKey":                        reflect.TypeOf((*LedgerKey)(nil)).Elem(),
+	"BucketEntryType":                  reflect.TypeOf((*BucketEntryType)(nil)).Elem(),
+	"BucketMetadataExt":                reflect.TypeOf((*BucketMetadataExt)(nil)).Elem(),
+	"BucketMetadata":                   reflect.TypeOf((*BucketMetadata)(nil)).Elem(),
+	"BucketEntry":                      reflect.TypeOf((*BucketEntry)(nil)).Elem(),
+	"TransactionSet":                   reflect.TypeOf((*TransactionSet)(nil)).Elem(),
+	"TransactionResultPair":            reflect.TypeOf((*TransactionResultPair)(nil)).Elem(),
+	"TransactionResultSet":             reflect.TypeOf((*TransactionResultSet)(nil)).Elem(),
+	"TransactionHistoryEntryExt":       reflect.TypeOf((*TransactionHistoryEntryExt)(nil)).Elem(),
+	"TransactionHistoryEntry":          reflect.TypeOf((*TransactionHistoryEntry)(nil)).Elem(),
+	"TransactionHistoryResultEntryExt": reflect.TypeOf((*TransactionHistoryResultEntryExt)(nil)).Elem(),
+	"TransactionHistoryResultEntry":    reflect.TypeOf((*TransactionHistoryResultEntry)(nil)).Elem(),
+	"LedgerHeaderHistoryEntryExt":      reflect.TypeOf((*LedgerHeaderHistoryEntryExt)(nil)).Elem(),
+	"LedgerHeaderHistoryEntry":         reflect.TypeOf((*LedgerHeaderHistoryEntry)(nil)).Elem(),
+	"LedgerScpMessages":                reflect.TypeOf((*LedgerScpMessages)(nil)).Elem(),
+	"ScpHistoryEntryV0":                reflect.TypeOf((*ScpHistoryEntryV0)(nil)).Elem(),
+	"ScpHistoryEntry":                  reflect.TypeOf((*ScpHistoryEntry)(nil)).Elem(),
+	"LedgerEntryChangeType":            reflect.TypeOf((*LedgerEntryChangeType)(nil)).Elem(),
+	"LedgerEntryChange":                reflect.TypeOf((*LedgerEntryChange)(nil)).Elem(),
+	"LedgerEntryChanges":               reflect.TypeOf((*LedgerEntryChanges)(nil)).Elem(),
+	"OperationMeta":                    reflect.TypeOf((*OperationMeta)(nil)).Elem(),
+	"TransactionMetaV1":                reflect.TypeOf((*TransactionMetaV1)(nil)).Elem(),
+	"TransactionMeta":                  reflect.TypeOf((*TransactionMeta)(nil)).Elem(),
+	"ErrorCode":                        reflect.TypeOf((*ErrorCode)(nil)).Elem(),
+	"Error":                            reflect.TypeOf((*Error)(nil)).Elem(),
+	"AuthCert":                         reflect.TypeOf((*AuthCert)(nil)).Elem(),
+	"Hello":                            reflect.TypeOf((*Hello)(nil)).Elem(),
+	"Auth":                             reflect.TypeOf((*Auth)(nil)).Elem(),
+	"IpAddrType":                       reflect.TypeOf((*IpAddrType)(nil)).Elem(),
+	"PeerAddressIp":                    reflect.TypeOf((*PeerAddressIp)(nil)).Elem(),
+	"PeerAddress":                      reflect.TypeOf((*PeerAddress)(nil)).Elem(),
+	"MessageType":                      reflect.TypeOf((*MessageType)(nil)).Elem(),
+	"DontHave":                         reflect.TypeOf((*DontHave)(nil)).Elem(),
+	"StellarMessage":                   reflect.TypeOf((*StellarMessage)(nil)).Elem(),
+	"AuthenticatedMessageV0":           reflect.TypeOf((*AuthenticatedMessageV0)(nil)).Elem(),
+	"AuthenticatedMessage":             reflect.TypeOf((*AuthenticatedMessage)(nil)).Elem(),
+	"DecoratedSignature":               reflect.TypeOf((*DecoratedSignature)(nil)).Elem(),
+	"OperationType":                    reflect.TypeOf((*OperationType)(nil)).Elem(),
+	"CreateAccountOp":                  reflect.TypeOf((*CreateAccountOp)(nil)).Elem(),
+	"PaymentOp":                        reflect.TypeOf((*PaymentOp)(nil)).Elem(),
+	"PathPaymentStrictReceiveOp":       reflect.TypeOf((*PathPaymentStrictReceiveOp)(nil)).Elem(),
+	"PathPaymentStrictSendOp":          reflect.TypeOf((*PathPaymentStrictSendOp)(nil)).Elem(),
+	"ManageSellOfferOp":                reflect.TypeOf((*ManageSellOfferOp)(nil)).Elem(),
+	"ManageBuyOfferOp":                 reflect.TypeOf((*ManageBuyOfferOp)(nil)).Elem(),
+	"CreatePassiveSellOfferOp":         reflect.TypeOf((*CreatePassiveSellOfferOp)(nil)).Elem(),
+	"SetOptionsOp":                     reflect.TypeOf((*SetOptionsOp)(nil)).Elem(),
+	"ChangeTrustOp":                    reflect.TypeOf((*ChangeTrustOp)(nil)).Elem(),
+	"AllowTrustOpAsset":                reflect.TypeOf((*AllowTrustOpAsset)(nil)).Elem(),
+	"AllowTrustOp":                     reflect.TypeOf((*AllowTrustOp)(nil)).Elem(),
+	"ManageDataOp":                     reflect.TypeOf((*ManageDataOp)(nil)).Elem(),
+	"BumpSequenceOp":                   reflect.TypeOf((*BumpSequenceOp)(nil)).Elem(),
+	"OperationBody":                    reflect.TypeOf((*OperationBody)(nil)).Elem(),
+	"Operation":                        reflect.TypeOf((*Operation)(nil)).Elem(),
+	"MemoType":                         reflect.TypeOf((*MemoType)(nil)).Elem(),
+	"Memo":                             reflect.TypeOf((*Memo)(nil)).Elem(),
+	"TimeBounds":                       reflect.TypeOf((*TimeBounds)(nil)).Elem(),
+	"TransactionExt":                   reflect.TypeOf((*TransactionExt)(nil)).Elem(),
+	"Transaction":                      reflect.TypeOf((*Transaction)(nil)).Elem(),
+	"TransactionSignaturePayloadTaggedTransaction": reflect.TypeOf((*TransactionSignaturePayloadTaggedTransaction)(nil)).Elem(),
+	"TransactionSignaturePayload":                  reflect.TypeOf((*TransactionSignaturePayload)(nil)).Elem(),
+	"TransactionEnvelope":                          reflect.TypeOf((*TransactionEnvelope)(nil)).Elem(),
+	"ClaimOfferAtom":                               reflect.TypeOf((*ClaimOfferAtom)(nil)).Elem(),
+	"CreateAccountResultCode":                      reflect.TypeOf((*CreateAccountResultCode)(nil)).Elem(),
+	"CreateAccountResult":                          reflect.TypeOf((*CreateAccountResult)(nil)).Elem(),
+	"PaymentResultCode":                            reflect.TypeOf((*PaymentResultCode)(nil)).Elem(),
+	"PaymentResult":                                reflect.TypeOf((*PaymentResult)(nil)).Elem(),
+	"PathPaymentStrictReceiveResultCode":           reflect.TypeOf((*PathPaymentStrictReceiveResultCode)(nil)).Elem(),
+	"SimplePaymentResult":                          reflect.TypeOf((*SimplePaymentResult)(nil)).Elem(),
+	"PathPaymentStrictReceiveResultSuccess":        reflect.TypeOf((*PathPaymentStrictReceiveResultSuccess)(nil)).Elem(),
+	"PathPaymentStrictReceiveResult":               reflect.TypeOf((*PathPaymentStrictReceiveResult)(nil)).Elem(),
+	"PathPaymentStrictSendResultCode":              reflect.TypeOf((*PathPaymentStrictSendResultCode)(nil)).Elem(),
+	"PathPaymentStrictSendResultSuccess":           reflect.TypeOf((*PathPaymentStrictSendResultSuccess)(nil)).Elem(),
+	"PathPaymentStrictSendResult":                  reflect.TypeOf((*PathPaymentStrictSendResult)(nil)).Elem(),
+	"ManageSellOfferResultCode":                    reflect.TypeOf((*ManageSellOfferResultCode)(nil)).Elem(),
+	"ManageOfferEffect":                            reflect.TypeOf((*ManageOfferEffect)(nil)).Elem(),
+	"ManageOfferSuccessResultOffer":                reflect.TypeOf((*ManageOfferSuccessResultOffer)(nil)).Elem(),
+	"ManageOfferSuccessResult":                     reflect.TypeOf((*ManageOfferSuccessResult)(nil)).Elem(),
+	"ManageSellOfferResult":                        reflect.TypeOf((*ManageSellOfferResult)(nil)).Elem(),
+	"ManageBuyOfferResultCode":                     reflect.TypeOf((*ManageBuyOfferResultCode)(nil)).Elem(),
+	"ManageBuyOfferResult":                         reflect.TypeOf((*ManageBuyOfferResult)(nil)).Elem(),
+	"SetOptionsResultCode":                         reflect.TypeOf((*SetOptionsResultCode)(nil)).Elem(),
+	"SetOptionsResult":                             reflect.TypeOf((*SetOptionsResult)(nil)).Elem(),
+	"ChangeTrustResultCode":                        reflect.TypeOf((*ChangeTrustResultCode)(nil)).Elem(),
+	"ChangeTrustResult":                            reflect.TypeOf((*ChangeTrustResult)(nil)).Elem(),
+	"AllowTrustResultCode":                         reflect.TypeOf((*AllowTrustResultCode)(nil)).Elem(),
+	"AllowTrustResult":                             reflect.TypeOf((*AllowTrustResult)(nil)).Elem(),
+	"AccountMergeResultCode":                       reflect.TypeOf((*AccountMergeResultCode)(nil)).Elem(),
+	"AccountMergeResult":                           reflect.TypeOf((*AccountMergeResult)(nil)).Elem(),
+	"InflationResultCode":                          reflect.TypeOf((*InflationResultCode)(nil)).Elem(),
+	"InflationPayout":                              reflect.TypeOf((*InflationPayout)(nil)).Elem(),
+	"InflationResult":                              reflect.TypeOf((*InflationResult)(nil)).Elem(),
+	"ManageDataResultCode":                         reflect.TypeOf((*ManageDataResultCode)(nil)).Elem(),
+	"ManageDataResult":                             reflect.TypeOf((*ManageDataResult)(nil)).Elem(),
+	"BumpSequenceResultCode":                       reflect.TypeOf((*BumpSequenceResultCode)(nil)).Elem(),
+	"BumpSequenceResult":                           reflect.TypeOf((*BumpSequenceResult)(nil)).Elem(),
+	"OperationResultCode":                          reflect.TypeOf((*OperationResultCode)(nil)).Elem(),
+	"OperationResultTr":                            reflect.TypeOf((*OperationResultTr)(nil)).Elem(),
+	"OperationResult":                              reflect.TypeOf((*OperationResult)(nil)).Elem(),
+	"TransactionResultCode":                        reflect.TypeOf((*TransactionResultCode)(nil)).Elem(),
+	"TransactionResultResult":                      reflect.TypeOf((*TransactionResultResult)(nil)).Elem(),
+	"TransactionResultExt":                         reflect.TypeOf((*TransactionResultExt)(nil)).Elem(),
+	"TransactionResult":                            reflect.TypeOf((*TransactionResult)(nil)).Elem(),
+	"Hash":                                         reflect.TypeOf((*Hash)(nil)).Elem(),
+	"Uint256":                                      reflect.TypeOf((*Uint256)(nil)).Elem(),
+	"Uint32":                                       reflect.TypeOf((*Uint32)(nil)).Elem(),
+	"Int32":                                        reflect.TypeOf((*Int32)(nil)).Elem(),
+	"Uint64":                                       reflect.TypeOf((*Uint64)(nil)).Elem(),
+	"Int64":                                        reflect.TypeOf((*Int64)(nil)).Elem(),
+	"CryptoKeyType":                                reflect.TypeOf((*CryptoKeyType)(nil)).Elem(),
+	"PublicKeyType":                                reflect.TypeOf((*PublicKeyType)(nil)).Elem(),
+	"SignerKeyType":                                reflect.TypeOf((*SignerKeyType)(nil)).Elem(),
+	"PublicKey":                                    reflect.TypeOf((*PublicKey)(nil)).Elem(),
+	"SignerKey":                                    reflect.TypeOf((*SignerKey)(nil)).Elem(),
+	"Signature":                                    reflect.TypeOf((*Signature)(nil)).Elem(),
+	"SignatureHint":                                reflect.TypeOf((*SignatureHint)(nil)).Elem(),
+	"NodeId":                                       reflect.TypeOf((*NodeId)(nil)).Elem(),
+	"Curve25519Secret":                             reflect.TypeOf((*Curve25519Secret)(nil)).Elem(),
+	"Curve25519Public":                             reflect.TypeOf((*Curve25519Public)(nil)).Elem(),
+	"HmacSha256Key":                                reflect.TypeOf((*HmacSha256Key)(nil)).Elem(),
+	"HmacSha256Mac":                                reflect.TypeOf((*HmacSha256Mac)(nil)).Elem(),
+}
+
+// enumNames maps the enum types to the names of their values.
+var enumNames = map[reflect.Type]map[int32]string{
+	reflect.TypeOf((*ScpStatementType)(nil)).Elem():                   scpStatementTypeMap,
+	reflect.TypeOf((*AssetType)(nil)).Elem():                          assetTypeMap,
+	reflect.TypeOf((*ThresholdIndexes)(nil)).Elem():                   thresholdIndexesMap,
+	reflect.TypeOf((*LedgerEntryType)(nil)).Elem():                    ledgerEntryTypeMap,
+	reflect.TypeOf((*AccountFlags)(nil)).Elem():                       accountFlagsMap,
+	reflect.TypeOf((*TrustLineFlags)(nil)).Elem():                     trustLineFlagsMap,
+	reflect.TypeOf((*OfferEntryFlags)(nil)).Elem():                    offerEntryFlagsMap,
+	reflect.TypeOf((*EnvelopeType)(nil)).Elem():                       envelopeTypeMap,
+	reflect.TypeOf((*StellarValueType)(nil)).Elem():                   stellarValueTypeMap,
+	reflect.TypeOf((*LedgerUpgradeType)(nil)).Elem():                  ledgerUpgradeTypeMap,
+	reflect.TypeOf((*BucketEntryType)(nil)).Elem():                    bucketEntryTypeMap,
+	reflect.TypeOf((*LedgerEntryChangeType)(nil)).Elem():              ledgerEntryChangeTypeMap,
+	reflect.TypeOf((*ErrorCode)(nil)).Elem():                          errorCodeMap,
+	reflect.TypeOf((*IpAddrType)(nil)).Elem():                         ipAddrTypeMap,
+	reflect.TypeOf((*MessageType)(nil)).Elem():                        messageTypeMap,
+	reflect.TypeOf((*OperationType)(nil)).Elem():                      operationTypeMap,
+	reflect.TypeOf((*MemoType)(nil)).Elem():                           memoTypeMap,
+	reflect.TypeOf((*CreateAccountResultCode)(nil)).Elem():            createAccountResultCodeMap,
+	reflect.TypeOf((*PaymentResultCode)(nil)).Elem():                  paymentResultCodeMap,
+	reflect.TypeOf((*PathPaymentStrictReceiveResultCode)(nil)).Elem(): pathPaymentStrictReceiveResultCodeMap,
+	reflect.TypeOf((*PathPaymentStrictSendResultCode)(nil)).Elem():    pathPaymentStrictSendResultCodeMap,
+	reflect.TypeOf((*ManageSellOfferResultCode)(nil)).Elem():          manageSellOfferResultCodeMap,
+	reflect.TypeOf((*ManageOfferEffect)(nil)).Elem():                  manageOfferEffectMap,
+	reflect.TypeOf((*ManageBuyOfferResultCode)(nil)).Elem():           manageBuyOfferResultCodeMap,
+	reflect.TypeOf((*SetOptionsResultCode)(nil)).Elem():               setOptionsResultCodeMap,
+	reflect.TypeOf((*ChangeTrustResultCode)(nil)).Elem():              changeTrustResultCodeMap,
+	reflect.TypeOf((*AllowTrustResultCode)(nil)).Elem():               allowTrustResultCodeMap,
+	reflect.TypeOf((*AccountMergeResultCode)(nil)).Elem():             accountMergeResultCodeMap,
+	reflect.TypeOf((*InflationResultCode)(nil)).Elem():                inflationResultCodeMap,
+	reflect.TypeOf((*ManageDataResultCode)(nil)).Elem():               manageDataResultCodeMap,
+	reflect.TypeOf((*BumpSequenceResultCode)(nil)).Elem():             bumpSequenceResultCodeMap,
+	reflect.TypeOf((*OperationResultCode)(nil)).Elem():                operationResultCodeMap,
+	reflect.TypeOf((*TransactionResultCode)(nil)).Elem():              transactionResultCodeMap,
+	reflect.TypeOf((*CryptoKeyType)(nil)).Elem():                      cryptoKeyTypeMap,
+	reflect.TypeOf((*PublicKeyType)(nil)).Elem():                      publicKeyTypeMap,
+	reflect.TypeOf((*SignerKeyType)(nil)).Elem():                      signerKeyTypeMap,
+}